@@ -7,5 +7,14 @@ func All() []infer.InferredComponent {
 	return []infer.InferredComponent{
 		infer.Component(&NetworkBundle{}),
 		infer.Component(&DNSZoneBundle{}),
+		infer.Component(&ServiceAccount{}),
+		infer.Component(&ZeroTrustSegment{}),
+		infer.Component(&ExitNode{}),
+		infer.Component(&SiteToSite{}),
+		infer.Component(&HARouterSet{}),
+		infer.Component(&ReverseProxyApp{}),
+		infer.Component(&TeamOnboarding{}),
+		infer.Component(&PeerDNSRecords{}),
+		infer.Component(&Manifest{}),
 	}
 }
@@ -2,10 +2,23 @@
 package component
 
 import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/mbrav/pulumi-netbird/provider/function"
 	"github.com/mbrav/pulumi-netbird/provider/resource"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// defaultNameserverPort is the port used for nameservers without one.
+const defaultNameserverPort = 53
+
+// defaultRecordTTL is the TTL of generated DNS records without one.
+const defaultRecordTTL = 300
+
 // Resource type tokens derived from the actual resource structs at init time.
 // Using infer.Resource(...).GetToken() mirrors exactly how provider.go registers
 // each resource, so the strings can never drift out of sync.
@@ -16,6 +29,23 @@ var (
 	tokenNetworkResource = mustToken(infer.Resource(&resource.NetworkResource{}))
 	tokenDNSZone         = mustToken(infer.Resource(&resource.DNSZone{}))
 	tokenDNSRecord       = mustToken(infer.Resource(&resource.DNSRecord{}))
+	tokenGroup           = mustToken(infer.Resource(&resource.Group{}))
+	tokenUser            = mustToken(infer.Resource(&resource.User{}))
+	tokenToken           = mustToken(infer.Resource(&resource.Token{}))
+	tokenPostureCheck    = mustToken(infer.Resource(&resource.PostureCheck{}))
+	tokenPolicy          = mustToken(infer.Resource(&resource.Policy{}))
+	tokenRoute           = mustToken(infer.Resource(&resource.Route{}))
+	tokenDNS             = mustToken(infer.Resource(&resource.DNS{}))
+
+	tokenReverseProxyDomain  = mustToken(infer.Resource(&resource.ReverseProxyDomain{}))
+	tokenReverseProxyService = mustToken(infer.Resource(&resource.ReverseProxyService{}))
+	tokenSetupKey            = mustToken(infer.Resource(&resource.SetupKey{}))
+	tokenUserGroupMembership = mustToken(infer.Resource(&resource.UserGroupMembership{}))
+
+	tokenGetReverseProxyClusters = mustFunctionToken(infer.Function(&function.GetReverseProxyClusters{}))
+	tokenGetPeers                = mustFunctionToken(infer.Function(&function.GetPeers{}))
+	tokenGetDNSZones             = mustFunctionToken(infer.Function(&function.GetDNSZones{}))
+	tokenGetTokens               = mustFunctionToken(infer.Function(&function.GetTokens{}))
 )
 
 // mustToken panics if the token cannot be derived — a programming error, not a
@@ -28,3 +58,95 @@ func mustToken(r infer.InferredResource) string {
 
 	return tok.String()
 }
+
+// mustFunctionToken is mustToken for invoke functions.
+func mustFunctionToken(f infer.InferredFunction) string {
+	tok, err := f.GetToken()
+	if err != nil {
+		panic("component: failed to derive function token: " + err.Error())
+	}
+
+	return tok.String()
+}
+
+// childKey turns a value such as a CIDR or an IP address into part of a child
+// resource name. Colons are replaced, since "::" separates the parts of a URN.
+func childKey(value string) string {
+	return strings.ReplaceAll(value, ":", "-")
+}
+
+// nameserverInputs builds the nameservers of a DNS nameserver group from
+// plain IP addresses sharing one UDP port.
+func nameserverInputs(ips []string, port *int) pulumi.Array {
+	nsPort := defaultNameserverPort
+	if port != nil {
+		nsPort = *port
+	}
+
+	nameservers := make(pulumi.Array, len(ips))
+	for i, ip := range ips {
+		nameservers[i] = pulumi.Map{
+			"ip":   pulumi.String(ip),
+			"type": pulumi.String(string(resource.NameserverNsTypeUDP)),
+			"port": pulumi.Int(nsPort),
+		}
+	}
+
+	return nameservers
+}
+
+// validateNameservers rejects nameserver lists nameserverInputs cannot build.
+func validateNameservers(ips []string, port *int) error {
+	if len(ips) == 0 {
+		return errors.New("dns requires at least one nameserver")
+	}
+
+	for _, ip := range ips {
+		if _, err := netip.ParseAddr(ip); err != nil {
+			return fmt.Errorf("dns nameserver %q is not an IP address", ip)
+		}
+	}
+
+	if port != nil && (*port < 1 || *port > 65535) {
+		return fmt.Errorf("dns port %d must be between 1 and 65535", *port)
+	}
+
+	return nil
+}
+
+// portRangeInputs builds the portRanges of a policy rule.
+func portRangeInputs(ranges []resource.RulePortRange) pulumi.Array {
+	inputs := make(pulumi.Array, len(ranges))
+	for i, portRange := range ranges {
+		inputs[i] = pulumi.Map{
+			"start": pulumi.Int(portRange.Start),
+			"end":   pulumi.Int(portRange.End),
+		}
+	}
+
+	return inputs
+}
+
+// validateRulePorts rejects ports and port ranges for a protocol without
+// ports, and port ranges the API would refuse.
+func validateRulePorts(protocol resource.Protocol, ports []string, ranges []resource.RulePortRange) error {
+	withPorts := len(ports) > 0 || len(ranges) > 0
+	if withPorts && protocol != resource.ProtocolTCP && protocol != resource.ProtocolUDP {
+		return fmt.Errorf("ports and portRanges require protocol tcp or udp, not %q", protocol)
+	}
+
+	for _, portRange := range ranges {
+		if portRange.Start < 1 || portRange.End > 65535 || portRange.Start > portRange.End {
+			return fmt.Errorf("invalid port range %d-%d", portRange.Start, portRange.End)
+		}
+	}
+
+	return nil
+}
+
+// unsetStringPtr returns an optional string output with no value.
+// pulumi.StringPtrFromPtr(nil) cannot be used for this: it returns a nil
+// input, and converting that to an output panics.
+func unsetStringPtr() pulumi.StringPtrOutput {
+	return pulumi.ToOutput((*string)(nil)).(pulumi.StringPtrOutput) //nolint:forcetypeassert
+}
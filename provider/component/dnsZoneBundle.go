@@ -2,6 +2,7 @@ package component
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/pulumi/pulumi-go-provider/infer"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
@@ -30,7 +31,8 @@ type DNSZoneBundleArgs struct {
 	Enabled            bool            `pulumi:"enabled"`
 	EnableSearchDomain bool            `pulumi:"enableSearchDomain"`
 	DistributionGroups []string        `pulumi:"distributionGroups"`
-	Records            []DNSRecordSpec `pulumi:"records"`
+	Records            []DNSRecordSpec `pulumi:"records,optional"`
+	ZoneFile           *string         `pulumi:"zoneFile,optional"`
 }
 
 // Annotate adds schema descriptions to DNSZoneBundleArgs fields.
@@ -41,6 +43,9 @@ func (d *DNSZoneBundleArgs) Annotate(ann infer.Annotator) {
 	ann.Describe(&d.EnableSearchDomain, "Whether to enable the zone as a search domain for peers.")
 	ann.Describe(&d.DistributionGroups, "Group IDs whose peers receive this DNS zone.")
 	ann.Describe(&d.Records, "DNS records to create within the zone.")
+	ann.Describe(&d.ZoneFile, "Optional zone file text whose A, AAAA and CNAME records are added to records. "+
+		"Supports $ORIGIN, $TTL and names relative to the origin, which defaults to the domain. "+
+		"Other record types are rejected.")
 }
 
 // DNSZoneBundleState holds the outputs of a DNSZoneBundle component.
@@ -54,7 +59,7 @@ type DNSZoneBundleState struct {
 // Annotate adds schema descriptions to DNSZoneBundleState fields.
 func (s *DNSZoneBundleState) Annotate(a infer.Annotator) {
 	a.Describe(&s.ZoneID, "ID of the created DNSZone resource.")
-	a.Describe(&s.RecordIDs, "IDs of the created DNSRecord resources, in declaration order.")
+	a.Describe(&s.RecordIDs, "IDs of the created DNSRecord resources, records before zone file entries, in declaration order.")
 }
 
 // DNSZoneBundle is the ComponentResource anchor for the DNSZoneBundle component.
@@ -74,9 +79,14 @@ func newDNSZoneBundle(
 	args DNSZoneBundleArgs,
 	opts ...pulumi.ResourceOption,
 ) (*DNSZoneBundleState, error) {
+	records, err := dnsZoneBundleRecords(args)
+	if err != nil {
+		return nil, err
+	}
+
 	comp := &DNSZoneBundleState{} //nolint:exhaustruct
 
-	err := ctx.RegisterComponentResource(typ, name, comp, opts...)
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("registering DNSZoneBundle component: %w", err)
 	}
@@ -101,9 +111,10 @@ func newDNSZoneBundle(
 		return nil, fmt.Errorf("creating DNSZone: %w", err)
 	}
 
-	recordIDs := make(pulumi.StringArray, len(args.Records))
+	recordIDs := make(pulumi.StringArray, len(records))
+	aliased := map[string]bool{}
 
-	for recIdx, rec := range args.Records {
+	for recIdx, rec := range records {
 		recordInputs := pulumi.Map{
 			"zoneID":  zone.ID().ToStringOutput(),
 			"name":    pulumi.String(rec.Name),
@@ -112,17 +123,23 @@ func newDNSZoneBundle(
 			"ttl":     pulumi.Int(rec.TTL),
 		}
 
+		recordOpts := []pulumi.ResourceOption{pulumi.Parent(comp)}
+
+		// Records used to be keyed by name alone, which allowed one record per
+		// name. Only the first record of a name takes over that key, so an
+		// added AAAA or round-robin record does not claim it a second time.
+		if !aliased[rec.Name] {
+			aliased[rec.Name] = true
+			recordOpts = append(recordOpts, pulumi.Aliases([]pulumi.Alias{{Name: pulumi.String(name + "-record-" + rec.Name)}})) //nolint:exhaustruct
+		}
+
 		var recChild pulumi.CustomResourceState
 
-		err = ctx.RegisterResource(
-			tokenDNSRecord,
-			name+"-record-"+rec.Name,
-			recordInputs,
-			&recChild,
-			pulumi.Parent(comp),
-		)
+		// Records are keyed by name, type and content, so reordering them
+		// replaces nothing and round-robin records each get their own child.
+		err = ctx.RegisterResource(tokenDNSRecord, name+"-record-"+dnsRecordKey(rec), recordInputs, &recChild, recordOpts...)
 		if err != nil {
-			return nil, fmt.Errorf("creating DNSRecord %q: %w", rec.Name, err)
+			return nil, fmt.Errorf("creating DNSRecord %s %s: %w", rec.Type, rec.Name, err)
 		}
 
 		recordIDs[recIdx] = recChild.ID().ToStringOutput()
@@ -133,3 +150,37 @@ func newDNSZoneBundle(
 
 	return comp, nil
 }
+
+// dnsZoneBundleRecords merges the records and the zone file of a bundle and
+// rejects records that repeat a name, type and content, which would share a
+// child resource.
+func dnsZoneBundleRecords(args DNSZoneBundleArgs) ([]DNSRecordSpec, error) {
+	records := slices.Clone(args.Records)
+
+	if args.ZoneFile != nil {
+		parsed, err := parseZoneFile(args.Domain, *args.ZoneFile)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, parsed...)
+	}
+
+	seen := map[string]bool{}
+
+	for _, rec := range records {
+		key := dnsRecordKey(rec)
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate %s record %s with content %s", rec.Type, rec.Name, rec.Content)
+		}
+
+		seen[key] = true
+	}
+
+	return records, nil
+}
+
+// dnsRecordKey names the child of a record after its name, type and content.
+func dnsRecordKey(rec DNSRecordSpec) string {
+	return rec.Name + "-" + rec.Type + "-" + childKey(rec.Content)
+}
@@ -0,0 +1,291 @@
+package component
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+)
+
+// zoneFileParser holds the state carried between the lines of a zone file.
+type zoneFileParser struct {
+	domain   string
+	origin   string
+	ttl      *int
+	lastName string
+}
+
+// parseZoneFile converts the A, AAAA and CNAME records of an RFC 1035 zone
+// file into DNSRecordSpecs. Names are resolved against $ORIGIN, which
+// defaults to the zone domain. Any other record type or directive is rejected
+// with the line it appears on.
+func parseZoneFile(domain, text string) ([]DNSRecordSpec, error) {
+	parser := &zoneFileParser{
+		domain:   canonicalName(domain),
+		origin:   canonicalName(domain),
+		ttl:      nil,
+		lastName: "",
+	}
+
+	var records []DNSRecordSpec
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+
+	for line := 1; scanner.Scan(); line++ {
+		record, err := parser.parseLine(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("zoneFile line %d: %w", line, err)
+		}
+
+		if record != nil {
+			records = append(records, *record)
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("reading zoneFile: %w", err)
+	}
+
+	return records, nil
+}
+
+// parseLine handles a single zone file line. It returns nil for blank lines,
+// comments and directives.
+func (p *zoneFileParser) parseLine(line string) (*DNSRecordSpec, error) {
+	if i := strings.IndexByte(line, ';'); i >= 0 {
+		line = line[:i]
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	if strings.HasPrefix(fields[0], "$") {
+		return nil, p.parseDirective(fields)
+	}
+
+	// A line starting with whitespace reuses the owner name of the previous record.
+	name := p.lastName
+	if line[0] != ' ' && line[0] != '\t' {
+		name = p.resolve(fields[0])
+		fields = fields[1:]
+	}
+
+	if name == "" {
+		return nil, errors.New("record has no owner name")
+	}
+
+	ttl, fields, err := p.parseTTLAndClass(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.New("record has no type")
+	}
+
+	recordType := strings.ToUpper(fields[0])
+	if !isZoneFileRecordType(recordType) {
+		return nil, fmt.Errorf("unsupported record type %s; only A, AAAA and CNAME are supported", recordType)
+	}
+
+	if len(fields) != 2 { //nolint:mnd
+		return nil, fmt.Errorf("%s record needs exactly one value, got %d", recordType, len(fields)-1)
+	}
+
+	content, err := p.parseContent(recordType, fields[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if name != p.domain && !strings.HasSuffix(name, "."+p.domain) {
+		return nil, fmt.Errorf("name %s is outside of zone %s",
+			strings.TrimSuffix(name, "."), strings.TrimSuffix(p.domain, "."))
+	}
+
+	p.lastName = name
+
+	return &DNSRecordSpec{
+		Name:    strings.TrimSuffix(name, "."),
+		Type:    recordType,
+		Content: content,
+		TTL:     ttl,
+	}, nil
+}
+
+// parseDirective applies a $ORIGIN or $TTL directive.
+func (p *zoneFileParser) parseDirective(fields []string) error {
+	directive := strings.ToUpper(fields[0])
+
+	if len(fields) != 2 { //nolint:mnd
+		return fmt.Errorf("%s needs exactly one argument", directive)
+	}
+
+	switch directive {
+	case "$ORIGIN":
+		if !strings.HasSuffix(fields[1], ".") {
+			return fmt.Errorf("$ORIGIN %s must be an absolute name ending in a dot", fields[1])
+		}
+
+		p.origin = strings.ToLower(fields[1])
+	case "$TTL":
+		ttl, err := parseZoneTTL(fields[1])
+		if err != nil {
+			return err
+		}
+
+		p.ttl = &ttl
+	default:
+		return fmt.Errorf("unsupported directive %s", fields[0])
+	}
+
+	return nil
+}
+
+// parseTTLAndClass consumes the optional TTL and class fields, which zone
+// files allow in either order, and returns the record TTL and the remaining fields.
+func (p *zoneFileParser) parseTTLAndClass(fields []string) (int, []string, error) {
+	var ttl *int
+
+	for range 2 {
+		if len(fields) == 0 {
+			break
+		}
+
+		if strings.EqualFold(fields[0], "IN") {
+			fields = fields[1:]
+
+			continue
+		}
+
+		if ttl == nil && fields[0][0] >= '0' && fields[0][0] <= '9' {
+			value, err := parseZoneTTL(fields[0])
+			if err != nil {
+				return 0, nil, err
+			}
+
+			ttl = &value
+			fields = fields[1:]
+		}
+	}
+
+	if ttl == nil {
+		ttl = p.ttl
+	}
+
+	if ttl == nil {
+		return 0, nil, errors.New("record has no TTL and no $TTL directive precedes it")
+	}
+
+	return *ttl, fields, nil
+}
+
+// isZoneFileRecordType reports whether a zone file record type maps to a DNSRecord.
+func isZoneFileRecordType(recordType string) bool {
+	switch resource.DNSRecordType(recordType) {
+	case resource.DNSRecordTypeA, resource.DNSRecordTypeAAAA, resource.DNSRecordTypeCNAME:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseContent validates the value of a record and resolves CNAME targets.
+func (p *zoneFileParser) parseContent(recordType, value string) (string, error) {
+	if resource.DNSRecordType(recordType) == resource.DNSRecordTypeCNAME {
+		return strings.TrimSuffix(p.resolve(value), "."), nil
+	}
+
+	return parseRecordAddress(recordType, value)
+}
+
+// parseRecordAddress validates the address of an A or AAAA record and
+// returns it in canonical form.
+func parseRecordAddress(recordType, value string) (string, error) {
+	addr, err := netip.ParseAddr(value)
+
+	switch resource.DNSRecordType(recordType) {
+	case resource.DNSRecordTypeA:
+		if err != nil || !addr.Is4() {
+			return "", fmt.Errorf("A record value %q is not an IPv4 address", value)
+		}
+	case resource.DNSRecordTypeAAAA:
+		if err != nil || !addr.Is6() || addr.Is4In6() {
+			return "", fmt.Errorf("AAAA record value %q is not an IPv6 address", value)
+		}
+	case resource.DNSRecordTypeCNAME:
+		return "", fmt.Errorf("CNAME record value %q has no address", value)
+	default:
+		return "", fmt.Errorf("unsupported record type %s", recordType)
+	}
+
+	return addr.String(), nil
+}
+
+// resolve turns a possibly relative name into an absolute name ending in a dot.
+func (p *zoneFileParser) resolve(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + p.origin
+	}
+}
+
+// canonicalName lowercases a domain and makes it absolute.
+func canonicalName(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(domain, ".")) + "."
+}
+
+// zoneTTLUnits are the BIND time units accepted in TTLs, in seconds.
+var zoneTTLUnits = map[byte]int{ //nolint:gochecknoglobals
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+// parseZoneTTL parses a TTL in seconds, or in BIND units such as 1h30m.
+func parseZoneTTL(value string) (int, error) {
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		return seconds, nil
+	}
+
+	total := 0
+	digits := ""
+
+	for i := range len(value) {
+		char := value[i]
+
+		if char >= '0' && char <= '9' {
+			digits += string(char)
+
+			continue
+		}
+
+		unit, ok := zoneTTLUnits[char|0x20]
+		if !ok || digits == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+
+		count, _ := strconv.Atoi(digits)
+		total += count * unit
+		digits = ""
+	}
+
+	if digits != "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,234 @@
+package component
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dnsZoneBundleArgs returns the arguments of the corp.example.com bundle with
+// records and, unless empty, a zone file.
+func dnsZoneBundleArgs(records []DNSRecordSpec, zoneFile string) DNSZoneBundleArgs {
+	args := DNSZoneBundleArgs{
+		Name:               "corp",
+		Domain:             "corp.example.com",
+		Enabled:            true,
+		EnableSearchDomain: false,
+		DistributionGroups: []string{"all"},
+		Records:            records,
+		ZoneFile:           nil,
+	}
+
+	if zoneFile != "" {
+		args.ZoneFile = &zoneFile
+	}
+
+	return args
+}
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		text   string
+		want   []DNSRecordSpec
+		errMsg string
+	}{
+		{
+			name: "default origin and $TTL",
+			text: "$TTL 300\nwww A 10.0.0.1\n@ AAAA 2001:DB8::1\n",
+			want: []DNSRecordSpec{
+				{Name: "www.corp.example.com", Type: "A", Content: "10.0.0.1", TTL: 300},
+				{Name: "corp.example.com", Type: "AAAA", Content: "2001:db8::1", TTL: 300},
+			},
+		},
+		{
+			name: "$ORIGIN changes relative names and @",
+			text: "$TTL 60\n$ORIGIN lab.corp.example.com.\nhost A 10.0.1.1\n@ A 10.0.1.2\n",
+			want: []DNSRecordSpec{
+				{Name: "host.lab.corp.example.com", Type: "A", Content: "10.0.1.1", TTL: 60},
+				{Name: "lab.corp.example.com", Type: "A", Content: "10.0.1.2", TTL: 60},
+			},
+		},
+		{
+			name: "leading whitespace inherits the owner",
+			text: "$TTL 60\nhost A 10.0.0.1\n\tAAAA ::1\n  ; comment only\n    IN AAAA ::2\n",
+			want: []DNSRecordSpec{
+				{Name: "host.corp.example.com", Type: "A", Content: "10.0.0.1", TTL: 60},
+				{Name: "host.corp.example.com", Type: "AAAA", Content: "::1", TTL: 60},
+				{Name: "host.corp.example.com", Type: "AAAA", Content: "::2", TTL: 60},
+			},
+		},
+		{
+			name:   "record without a TTL or $TTL",
+			text:   "a 120 IN A 10.0.0.1\nb IN 240 A 10.0.0.2\nc IN A 10.0.0.3\n",
+			errMsg: "zoneFile line 3: record has no TTL and no $TTL directive precedes it",
+		},
+		{
+			name: "TTL and class in either order",
+			text: "$TTL 30\na 120 IN A 10.0.0.1\nb IN 240 A 10.0.0.2\nc in a 10.0.0.3\n",
+			want: []DNSRecordSpec{
+				{Name: "a.corp.example.com", Type: "A", Content: "10.0.0.1", TTL: 120},
+				{Name: "b.corp.example.com", Type: "A", Content: "10.0.0.2", TTL: 240},
+				{Name: "c.corp.example.com", Type: "A", Content: "10.0.0.3", TTL: 30},
+			},
+		},
+		{
+			name: "BIND units",
+			text: "$TTL 1h30m\na A 10.0.0.1\nb 2D A 10.0.0.2\nc 1w1d1h1m1s A 10.0.0.3\n",
+			want: []DNSRecordSpec{
+				{Name: "a.corp.example.com", Type: "A", Content: "10.0.0.1", TTL: 5400},
+				{Name: "b.corp.example.com", Type: "A", Content: "10.0.0.2", TTL: 172800},
+				{Name: "c.corp.example.com", Type: "A", Content: "10.0.0.3", TTL: 694861},
+			},
+		},
+		{
+			name: "absolute and relative CNAME targets",
+			text: "$TTL 60\nwww CNAME web\napi CNAME LB.Example.NET.\nroot CNAME @\n",
+			want: []DNSRecordSpec{
+				{Name: "www.corp.example.com", Type: "CNAME", Content: "web.corp.example.com", TTL: 60},
+				{Name: "api.corp.example.com", Type: "CNAME", Content: "lb.example.net", TTL: 60},
+				{Name: "root.corp.example.com", Type: "CNAME", Content: "corp.example.com", TTL: 60},
+			},
+		},
+		{
+			name: "absolute owner in the zone",
+			text: "$TTL 60\nDB.Corp.Example.com. A 10.0.0.5\n",
+			want: []DNSRecordSpec{{Name: "db.corp.example.com", Type: "A", Content: "10.0.0.5", TTL: 60}},
+		},
+		{
+			name:   "absolute owner outside the zone",
+			text:   "$TTL 60\ndb.example.org. A 10.0.0.5\n",
+			errMsg: "zoneFile line 2: name db.example.org is outside of zone corp.example.com",
+		},
+		{
+			name:   "$ORIGIN outside the zone",
+			text:   "$TTL 60\n$ORIGIN example.org.\nwww A 10.0.0.5\n",
+			errMsg: "zoneFile line 3: name www.example.org is outside of zone corp.example.com",
+		},
+		{
+			name:   "suffix that is not a subdomain",
+			text:   "$TTL 60\nevilcorp.example.com. A 10.0.0.5\n",
+			errMsg: "zoneFile line 2: name evilcorp.example.com is outside of zone corp.example.com",
+		},
+		{
+			name:   "relative $ORIGIN",
+			text:   "$ORIGIN lab\n",
+			errMsg: "zoneFile line 1: $ORIGIN lab must be an absolute name ending in a dot",
+		},
+		{name: "unsupported directive", text: "$INCLUDE other.zone\n", errMsg: "zoneFile line 1: unsupported directive $INCLUDE"},
+		{name: "invalid TTL unit", text: "$TTL 5x\n", errMsg: `zoneFile line 1: invalid TTL "5x"`},
+		{name: "TTL without a trailing unit", text: "$TTL 1h30\n", errMsg: `zoneFile line 1: invalid TTL "1h30"`},
+		{
+			name:   "unsupported record type",
+			text:   "$TTL 60\nwww A 10.0.0.1\n\n@ MX 10 mail\n",
+			errMsg: "zoneFile line 4: unsupported record type MX; only A, AAAA and CNAME are supported",
+		},
+		{
+			name:   "whitespace before any owner",
+			text:   "$TTL 60\n  A 10.0.0.1\n",
+			errMsg: "zoneFile line 2: record has no owner name",
+		},
+		{name: "IPv6 in an A record", text: "$TTL 60\nwww A ::1\n", errMsg: `zoneFile line 2: A record value "::1" is not an IPv4 address`},
+		{
+			name:   "mapped IPv4 in an AAAA record",
+			text:   "$TTL 60\nwww AAAA ::ffff:10.0.0.1\n",
+			errMsg: `zoneFile line 2: AAAA record value "::ffff:10.0.0.1" is not an IPv6 address`,
+		},
+		{name: "extra value", text: "$TTL 60\nwww A 10.0.0.1 10.0.0.2\n", errMsg: "zoneFile line 2: A record needs exactly one value, got 2"},
+		{name: "missing type", text: "$TTL 60\nwww 60 IN\n", errMsg: "zoneFile line 2: record has no type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFile("Corp.Example.com.", tt.text)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestDNSZoneBundleZoneFileReorderKeepsChildren checks that zone file records
+// are keyed by name, type and content, so reordering them keeps every child name.
+func TestDNSZoneBundleZoneFileReorderKeepsChildren(t *testing.T) {
+	t.Parallel()
+
+	lines := []string{"www A 10.0.0.1", "www A 10.0.0.3", "www AAAA ::1", "api CNAME www", "@ A 10.0.0.2"}
+	before := newComponentMocks()
+
+	_, err := construct(t.Context(), before, (&DNSZoneBundle{}).Construct, "corp", dnsZoneBundleArgs(nil, "$TTL 60\n"+strings.Join(lines, "\n")))
+	require.NoError(t, err)
+
+	slices.Reverse(lines)
+	after := newComponentMocks()
+
+	_, err = construct(t.Context(), after, (&DNSZoneBundle{}).Construct, "corp", dnsZoneBundleArgs(nil, "$TTL 60\n"+strings.Join(lines, "\n")))
+	require.NoError(t, err)
+
+	want := []string{
+		"corp-record-api.corp.example.com-CNAME-www.corp.example.com",
+		"corp-record-corp.example.com-A-10.0.0.2",
+		"corp-record-www.corp.example.com-A-10.0.0.1",
+		"corp-record-www.corp.example.com-A-10.0.0.3",
+		"corp-record-www.corp.example.com-AAAA---1",
+	}
+	require.Equal(t, want, before.names(tokenDNSRecord))
+	assert.Equal(t, want, after.names(tokenDNSRecord), "records after reordering")
+
+	api := after.find(t, "corp-record-api.corp.example.com-CNAME-www.corp.example.com")
+	assert.Equal(t, "www.corp.example.com", api.inputs["content"].StringValue())
+}
+
+// TestDNSZoneBundleRecordAliases checks that only the first record of a name
+// takes over the former name-only key, so A and AAAA records of one name do
+// not share an alias.
+func TestDNSZoneBundleRecordAliases(t *testing.T) {
+	t.Parallel()
+
+	mocks := newComponentMocks()
+	args := dnsZoneBundleArgs([]DNSRecordSpec{
+		{Name: "www.corp.example.com", Type: "A", Content: "10.0.0.1", TTL: 60},
+		{Name: "www.corp.example.com", Type: "AAAA", Content: "fd00::1", TTL: 60},
+		{Name: "www.corp.example.com", Type: "A", Content: "10.0.0.2", TTL: 60},
+		{Name: "api.corp.example.com", Type: "A", Content: "10.0.0.3", TTL: 60},
+	}, "")
+
+	_, err := construct(t.Context(), mocks, (&DNSZoneBundle{}).Construct, "corp", args)
+	require.NoError(t, err)
+
+	want := map[string][]string{
+		"corp-record-www.corp.example.com-A-10.0.0.1":   {"corp-record-www.corp.example.com"},
+		"corp-record-www.corp.example.com-AAAA-fd00--1": nil,
+		"corp-record-www.corp.example.com-A-10.0.0.2":   nil,
+		"corp-record-api.corp.example.com-A-10.0.0.3":   {"corp-record-api.corp.example.com"},
+	}
+
+	for child, aliases := range want {
+		assert.Equal(t, aliases, mocks.find(t, child).aliases, "%s aliases", child)
+	}
+}
+
+func TestDNSZoneBundleRecordsDuplicate(t *testing.T) {
+	t.Parallel()
+
+	args := dnsZoneBundleArgs(
+		[]DNSRecordSpec{{Name: "www.corp.example.com", Type: "A", Content: "10.0.0.1", TTL: 300}},
+		"$TTL 60\nwww A 10.0.0.1\n",
+	)
+
+	_, err := dnsZoneBundleRecords(args)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate A record www.corp.example.com with content 10.0.0.1")
+}
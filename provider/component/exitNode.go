@@ -0,0 +1,275 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// defaultRouteMetric is the route metric NetBird assigns by default.
+const defaultRouteMetric = 9999
+
+// ExitNodePeerSpec holds one routing peer, or peer group, of an ExitNode.
+type ExitNodePeerSpec struct {
+	Peer      *string `pulumi:"peer,optional"`
+	PeerGroup *string `pulumi:"peerGroup,optional"`
+	Metric    *int    `pulumi:"metric,optional"`
+}
+
+// Annotate adds schema descriptions to ExitNodePeerSpec fields.
+func (s *ExitNodePeerSpec) Annotate(a infer.Annotator) {
+	a.Describe(&s.Peer, "ID of a peer to route through. Conflicts with peerGroup.")
+	a.Describe(&s.PeerGroup, "ID of a group of peers to route through. Conflicts with peer.")
+	a.Describe(&s.Metric, "Route metric; lower values have higher priority. Defaults to 9999. "+
+		"Give each entry a distinct metric to make the others standbys.")
+}
+
+// ExitNodeDNSSpec holds the DNS override applied to ExitNode clients.
+type ExitNodeDNSSpec struct {
+	Nameservers []string `pulumi:"nameservers"`
+	Port        *int     `pulumi:"port,optional"`
+}
+
+// Annotate adds schema descriptions to ExitNodeDNSSpec fields.
+func (s *ExitNodeDNSSpec) Annotate(a infer.Annotator) {
+	a.Describe(&s.Nameservers, "IP addresses of the nameservers clients resolve all domains with.")
+	a.Describe(&s.Port, "UDP port of the nameservers. Defaults to 53.")
+}
+
+// ExitNodeArgs are the inputs for an ExitNode component.
+type ExitNodeArgs struct {
+	Name         string             `pulumi:"name"`
+	Description  *string            `pulumi:"description,optional"`
+	Peers        []ExitNodePeerSpec `pulumi:"peers"`
+	ClientGroups []string           `pulumi:"clientGroups"`
+	IPv6         *bool              `pulumi:"ipv6,optional"`
+	DNS          *ExitNodeDNSSpec   `pulumi:"dns,optional"`
+}
+
+// Annotate adds schema descriptions to ExitNodeArgs fields.
+func (e *ExitNodeArgs) Annotate(a infer.Annotator) {
+	a.Describe(&e.Name, "Name of the exit node. Used as the route network identifier, so all routes form one high-availability group.")
+	a.Describe(&e.Description, "Optional description for the routes and the policy.")
+	a.Describe(&e.Peers, "Routing peers or peer groups. Each entry gets its own default route.")
+	a.Describe(&e.ClientGroups, "IDs of the groups whose peers send all their traffic through the exit node.")
+	a.Describe(&e.IPv6, "Whether to also route the IPv6 default route (::/0). Defaults to false.")
+	a.Describe(&e.DNS, "Optional nameservers the clients use while connected to the exit node.")
+}
+
+// ExitNodeState holds the outputs of an ExitNode component.
+type ExitNodeState struct {
+	pulumi.ResourceState
+
+	RouteIDs          pulumi.StringArrayOutput `pulumi:"routeIds"`
+	AccessGroupID     pulumi.StringOutput      `pulumi:"accessGroupId"`
+	PolicyID          pulumi.StringOutput      `pulumi:"policyId"`
+	NameserverGroupID pulumi.StringPtrOutput   `pulumi:"nameserverGroupId,optional"`
+}
+
+// Annotate adds schema descriptions to ExitNodeState fields.
+func (s *ExitNodeState) Annotate(a infer.Annotator) {
+	a.Describe(&s.RouteIDs, "IDs of the created Route resources, IPv4 before IPv6 for each peer entry, in declaration order.")
+	a.Describe(&s.AccessGroupID, "ID of the created access control Group of the routes.")
+	a.Describe(&s.PolicyID, "ID of the created Policy that lets the client groups use the routes.")
+	a.Describe(&s.NameserverGroupID, "ID of the created DNS nameserver group. Unset without dns.")
+}
+
+// ExitNode is the ComponentResource anchor for the ExitNode component.
+type ExitNode struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*ExitNode) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args ExitNodeArgs, opts pulumi.ResourceOption,
+) (*ExitNodeState, error) {
+	return newExitNode(ctx, name, typ, args, opts)
+}
+
+func newExitNode( //nolint:funlen
+	ctx *pulumi.Context,
+	name, typ string,
+	args ExitNodeArgs,
+	opts ...pulumi.ResourceOption,
+) (*ExitNodeState, error) {
+	err := validateExitNode(args)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &ExitNodeState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering ExitNode component: %w", err)
+	}
+
+	var accessGroup pulumi.CustomResourceState
+
+	err = ctx.RegisterResource(tokenGroup, name+"-access", pulumi.Map{
+		"name": pulumi.String(args.Name + "-access"),
+	}, &accessGroup, pulumi.Parent(comp))
+	if err != nil {
+		return nil, fmt.Errorf("creating Group: %w", err)
+	}
+
+	accessGroupID := accessGroup.ID().ToStringOutput()
+	clientGroups := pulumi.ToStringArray(args.ClientGroups)
+
+	description := ""
+	if args.Description != nil {
+		description = *args.Description
+	}
+
+	networks := []string{"0.0.0.0/0"}
+	if args.IPv6 != nil && *args.IPv6 {
+		networks = append(networks, "::/0")
+	}
+
+	routeIDs := pulumi.StringArray{}
+
+	for _, spec := range args.Peers {
+		metric := defaultRouteMetric
+		if spec.Metric != nil {
+			metric = *spec.Metric
+		}
+
+		for _, network := range networks {
+			routeInputs := pulumi.Map{
+				"networkId":           pulumi.String(args.Name),
+				"description":         pulumi.String(description),
+				"enabled":             pulumi.Bool(true),
+				"masquerade":          pulumi.Bool(true),
+				"metric":              pulumi.Int(metric),
+				"keepRoute":           pulumi.Bool(false),
+				"network":             pulumi.String(network),
+				"groups":              clientGroups,
+				"accessControlGroups": pulumi.StringArray{accessGroupID},
+			}
+			if spec.Peer != nil {
+				routeInputs["peer"] = pulumi.String(*spec.Peer)
+			} else {
+				routeInputs["peerGroups"] = pulumi.StringArray{pulumi.String(*spec.PeerGroup)}
+			}
+
+			// Routes are keyed by peer or peer group, so reordering the
+			// list updates metrics in place instead of replacing routes.
+			routeName := name + "-route-" + exitNodeKey(spec)
+			if network == "::/0" {
+				routeName = name + "-route6-" + exitNodeKey(spec)
+			}
+
+			var route pulumi.CustomResourceState
+
+			err = ctx.RegisterResource(tokenRoute, routeName, routeInputs, &route, pulumi.Parent(comp))
+			if err != nil {
+				return nil, fmt.Errorf("creating Route %s for %s: %w", network, exitNodeKey(spec), err)
+			}
+
+			routeIDs = append(routeIDs, route.ID().ToStringOutput())
+		}
+	}
+
+	policyInputs := pulumi.Map{
+		"name":    pulumi.String(args.Name),
+		"enabled": pulumi.Bool(true),
+		"rules": pulumi.Array{pulumi.Map{
+			"name":          pulumi.String(args.Name),
+			"action":        pulumi.String(string(resource.RuleActionAccept)),
+			"enabled":       pulumi.Bool(true),
+			"bidirectional": pulumi.Bool(false),
+			"protocol":      pulumi.String(string(resource.ProtocolAll)),
+			"sources":       clientGroups,
+			"destinations":  pulumi.StringArray{accessGroupID},
+		}},
+	}
+	if args.Description != nil {
+		policyInputs["description"] = pulumi.String(*args.Description)
+	}
+
+	var policy pulumi.CustomResourceState
+
+	err = ctx.RegisterResource(tokenPolicy, name+"-policy", policyInputs, &policy, pulumi.Parent(comp))
+	if err != nil {
+		return nil, fmt.Errorf("creating Policy: %w", err)
+	}
+
+	comp.NameserverGroupID = unsetStringPtr()
+
+	if args.DNS != nil {
+		var nameserverGroup pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenDNS, name+"-dns", pulumi.Map{
+			"name":                 pulumi.String(args.Name),
+			"description":          pulumi.String(description),
+			"domains":              pulumi.StringArray{},
+			"enabled":              pulumi.Bool(true),
+			"groups":               clientGroups,
+			"primary":              pulumi.Bool(true),
+			"nameservers":          nameserverInputs(args.DNS.Nameservers, args.DNS.Port),
+			"searchDomainsEnabled": pulumi.Bool(false),
+		}, &nameserverGroup, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating DNS nameserver group: %w", err)
+		}
+
+		comp.NameserverGroupID = nameserverGroup.ID().ToStringOutput().ToStringPtrOutput()
+	}
+
+	comp.RouteIDs = routeIDs.ToStringArrayOutput()
+	comp.AccessGroupID = accessGroupID
+	comp.PolicyID = policy.ID().ToStringOutput()
+
+	return comp, nil
+}
+
+// exitNodeKey names the routes of a spec after its peer or peer group rather
+// than its position, as haRouterKey does for routers.
+func exitNodeKey(spec ExitNodePeerSpec) string {
+	if spec.Peer != nil {
+		return "peer-" + *spec.Peer
+	}
+
+	return "group-" + *spec.PeerGroup
+}
+
+// validateExitNode rejects exit nodes that cannot be deployed.
+func validateExitNode(args ExitNodeArgs) error {
+	if len(args.Peers) == 0 {
+		return errors.New("ExitNode requires at least one peer or peer group")
+	}
+
+	if len(args.ClientGroups) == 0 {
+		return errors.New("ExitNode requires at least one client group")
+	}
+
+	seen := map[string]bool{}
+
+	for i, spec := range args.Peers {
+		if (spec.Peer == nil) == (spec.PeerGroup == nil) {
+			return fmt.Errorf("peers[%d]: exactly one of peer and peerGroup must be set", i)
+		}
+
+		key := exitNodeKey(spec)
+		if seen[key] {
+			return fmt.Errorf("peers[%d]: duplicate routing %s", i, key)
+		}
+
+		seen[key] = true
+
+		// A routing peer that is also a client would route its own traffic
+		// through itself.
+		if spec.PeerGroup != nil && slices.Contains(args.ClientGroups, *spec.PeerGroup) {
+			return fmt.Errorf("peers[%d]: peer group %q is also a client group", i, *spec.PeerGroup)
+		}
+	}
+
+	if args.DNS == nil {
+		return nil
+	}
+
+	return validateNameservers(args.DNS.Nameservers, args.DNS.Port)
+}
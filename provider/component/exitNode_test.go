@@ -0,0 +1,127 @@
+package component
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exitNodeArgs() ExitNodeArgs {
+	return ExitNodeArgs{
+		Name:        "egress",
+		Description: nil,
+		Peers: []ExitNodePeerSpec{
+			{Peer: ptr("peer-1"), PeerGroup: nil, Metric: nil},
+			{Peer: nil, PeerGroup: ptr("gateways"), Metric: ptr(200)},
+		},
+		ClientGroups: []string{"laptops"},
+		IPv6:         nil,
+		DNS:          nil,
+	}
+}
+
+func TestValidateExitNode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		mutate func(args *ExitNodeArgs)
+		want   string
+	}{
+		{"valid", func(*ExitNodeArgs) {}, ""},
+		{"no peers", func(a *ExitNodeArgs) { a.Peers = nil }, "at least one peer or peer group"},
+		{"no client groups", func(a *ExitNodeArgs) { a.ClientGroups = nil }, "at least one client group"},
+		{"peer and peer group", func(a *ExitNodeArgs) { a.Peers[0].PeerGroup = ptr("gateways") },
+			"peers[0]: exactly one of peer and peerGroup must be set"},
+		{"neither peer nor peer group", func(a *ExitNodeArgs) { a.Peers[1].PeerGroup = nil },
+			"peers[1]: exactly one of peer and peerGroup must be set"},
+		{"peer group is a client group", func(a *ExitNodeArgs) { a.ClientGroups = []string{"laptops", "gateways"} },
+			`peers[1]: peer group "gateways" is also a client group`},
+		{"duplicate peer", func(a *ExitNodeArgs) { a.Peers = append(a.Peers, a.Peers[0]) }, "peers[2]: duplicate routing peer-peer-1"},
+		{"peer ID matching a client group name", func(a *ExitNodeArgs) { a.ClientGroups = []string{"peer-1"} }, ""},
+		{"bad nameserver", func(a *ExitNodeArgs) { a.DNS = &ExitNodeDNSSpec{Nameservers: []string{"dns.example"}, Port: nil} },
+			`dns nameserver "dns.example" is not an IP address`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := exitNodeArgs()
+			tt.mutate(&args)
+
+			err := validateExitNode(args)
+			if tt.want == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestExitNodeRejectsClientPeerGroupBeforeRegistering(t *testing.T) {
+	t.Parallel()
+
+	args := exitNodeArgs()
+	args.ClientGroups = []string{"gateways"}
+	mocks := newComponentMocks()
+
+	_, err := construct(t.Context(), mocks, (&ExitNode{}).Construct, "exit", args)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is also a client group")
+	assert.Empty(t, mocks.names(tokenRoute), "routes registered despite the error")
+}
+
+func TestExitNodeRoutes(t *testing.T) {
+	t.Parallel()
+
+	args := exitNodeArgs()
+	args.IPv6 = ptr(true)
+	mocks := newComponentMocks()
+
+	state, err := construct(t.Context(), mocks, (&ExitNode{}).Construct, "exit", args)
+	require.NoError(t, err)
+
+	want := []string{"exit-route-group-gateways", "exit-route-peer-peer-1", "exit-route6-group-gateways", "exit-route6-peer-peer-1"}
+	require.Equal(t, want, mocks.names(tokenRoute))
+
+	byPeer := mocks.find(t, "exit-route6-peer-peer-1").inputs
+	assert.Equal(t, "peer-1", byPeer["peer"].StringValue())
+	assert.Equal(t, "::/0", byPeer["network"].StringValue())
+
+	byGroup := mocks.find(t, "exit-route-group-gateways").inputs
+	assert.Equal(t, []string{"gateways"}, stringInputs(byGroup["peerGroups"]))
+	assert.False(t, byGroup.HasValue("peer"), "exit-route-group-gateways sets both peer and peerGroups")
+	assert.InDelta(t, 200, byGroup["metric"].NumberValue(), 0)
+	assert.Equal(t, []string{"exit-access-id"}, stringInputs(byGroup["accessControlGroups"]))
+
+	// Without dns there is no nameserver group.
+	assert.Empty(t, mocks.names(tokenDNS))
+	assert.Nil(t, await[*string](t, state.NameserverGroupID))
+}
+
+// TestExitNodeReorderKeepsRoutes checks that routes are keyed by peer or peer
+// group, so reordering the peers keeps every child name.
+func TestExitNodeReorderKeepsRoutes(t *testing.T) {
+	t.Parallel()
+
+	before := newComponentMocks()
+
+	_, err := construct(t.Context(), before, (&ExitNode{}).Construct, "exit", exitNodeArgs())
+	require.NoError(t, err)
+
+	args := exitNodeArgs()
+	slices.Reverse(args.Peers)
+	after := newComponentMocks()
+
+	_, err = construct(t.Context(), after, (&ExitNode{}).Construct, "exit", args)
+	require.NoError(t, err)
+	assert.Equal(t, before.names(tokenRoute), after.names(tokenRoute))
+	assert.InDelta(t, 200, after.find(t, "exit-route-group-gateways").inputs["metric"].NumberValue(), 0)
+}
@@ -0,0 +1,186 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	// haRouterDefaultBaseMetric is the metric of the first router of an HARouterSet.
+	haRouterDefaultBaseMetric = 100
+	// haRouterDefaultMetricStep is the metric distance between consecutive routers.
+	haRouterDefaultMetricStep = 100
+	// maxRouteMetric is the highest metric NetBird accepts for a router.
+	maxRouteMetric = 9999
+)
+
+// HARouterSpec holds one routing peer, or peer group, of an HARouterSet.
+type HARouterSpec struct {
+	Peer      *string `pulumi:"peer,optional"`
+	PeerGroup *string `pulumi:"peerGroup,optional"`
+}
+
+// Annotate adds schema descriptions to HARouterSpec fields.
+func (s *HARouterSpec) Annotate(a infer.Annotator) {
+	a.Describe(&s.Peer, "ID of a routing peer. Conflicts with peerGroup.")
+	a.Describe(&s.PeerGroup, "ID of a group of routing peers. Conflicts with peer.")
+}
+
+// HARouterSetArgs are the inputs for an HARouterSet component.
+type HARouterSetArgs struct {
+	NetworkID  string         `pulumi:"networkId"`
+	Routers    []HARouterSpec `pulumi:"routers"`
+	Masquerade bool           `pulumi:"masquerade"`
+	Enabled    *bool          `pulumi:"enabled,optional"`
+	BaseMetric *int           `pulumi:"baseMetric,optional"`
+	MetricStep *int           `pulumi:"metricStep,optional"`
+}
+
+// Annotate adds schema descriptions to HARouterSetArgs fields.
+func (h *HARouterSetArgs) Annotate(a infer.Annotator) {
+	a.Describe(&h.NetworkID, "ID of the network the routers serve.")
+	a.Describe(&h.Routers, "Routing peers or peer groups, most preferred first. Each entry gets its own router. "+
+		"Routers are identified by their peer or peer group, so reordering the list only updates their metrics.")
+	a.Describe(&h.Masquerade, "Whether all routers masquerade traffic.")
+	a.Describe(&h.Enabled, "Whether the routers are enabled. Defaults to true.")
+	a.Describe(&h.BaseMetric, "Metric of the first router. Defaults to 100.")
+	a.Describe(&h.MetricStep, "Metric added for each following router. Defaults to 100.")
+}
+
+// HARouterSetState holds the outputs of an HARouterSet component.
+type HARouterSetState struct {
+	pulumi.ResourceState
+
+	RouterIDs pulumi.StringArrayOutput `pulumi:"routerIds"`
+	Metrics   pulumi.IntArrayOutput    `pulumi:"metrics"`
+}
+
+// Annotate adds schema descriptions to HARouterSetState fields.
+func (s *HARouterSetState) Annotate(a infer.Annotator) {
+	a.Describe(&s.RouterIDs, "IDs of the created NetworkRouter resources, in list order.")
+	a.Describe(&s.Metrics, "Metric assigned to each router, in list order.")
+}
+
+// HARouterSet is the ComponentResource anchor for the HARouterSet component.
+type HARouterSet struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*HARouterSet) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args HARouterSetArgs, opts pulumi.ResourceOption,
+) (*HARouterSetState, error) {
+	return newHARouterSet(ctx, name, typ, args, opts)
+}
+
+func newHARouterSet(
+	ctx *pulumi.Context,
+	name, typ string,
+	args HARouterSetArgs,
+	opts ...pulumi.ResourceOption,
+) (*HARouterSetState, error) {
+	metrics, err := haRouterMetrics(args)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &HARouterSetState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering HARouterSet component: %w", err)
+	}
+
+	enabled := args.Enabled == nil || *args.Enabled
+	routerIDs := make(pulumi.StringArray, len(args.Routers))
+
+	for i, spec := range args.Routers {
+		routerInputs := pulumi.Map{
+			"networkID":  pulumi.String(args.NetworkID),
+			"enabled":    pulumi.Bool(enabled),
+			"masquerade": pulumi.Bool(args.Masquerade),
+			"metric":     pulumi.Int(metrics[i]),
+		}
+		if spec.Peer != nil {
+			routerInputs["peer"] = pulumi.String(*spec.Peer)
+		} else {
+			routerInputs["peerGroups"] = pulumi.StringArray{pulumi.String(*spec.PeerGroup)}
+		}
+
+		var router pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenNetworkRouter, name+"-"+haRouterKey(spec), routerInputs, &router, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating NetworkRouter for %s: %w", haRouterKey(spec), err)
+		}
+
+		routerIDs[i] = router.ID().ToStringOutput()
+	}
+
+	comp.RouterIDs = routerIDs.ToStringArrayOutput()
+	comp.Metrics = pulumi.ToIntArray(metrics).ToIntArrayOutput()
+
+	return comp, nil
+}
+
+// haRouterKey names the router of a spec after its peer or peer group rather
+// than its position, so a reordered list keeps the same child resources.
+func haRouterKey(spec HARouterSpec) string {
+	if spec.Peer != nil {
+		return "peer-" + *spec.Peer
+	}
+
+	return "group-" + *spec.PeerGroup
+}
+
+// haRouterMetrics validates the router list and returns the strictly
+// increasing metric of each router.
+func haRouterMetrics(args HARouterSetArgs) ([]int, error) {
+	if len(args.Routers) == 0 {
+		return nil, errors.New("HARouterSet requires at least one router")
+	}
+
+	base := haRouterDefaultBaseMetric
+	if args.BaseMetric != nil {
+		base = *args.BaseMetric
+	}
+
+	step := haRouterDefaultMetricStep
+	if args.MetricStep != nil {
+		step = *args.MetricStep
+	}
+
+	if base < 1 {
+		return nil, fmt.Errorf("baseMetric must be at least 1, got %d", base)
+	}
+
+	if step < 1 {
+		return nil, fmt.Errorf("metricStep must be at least 1, got %d", step)
+	}
+
+	seen := map[string]bool{}
+	metrics := make([]int, len(args.Routers))
+
+	for i, spec := range args.Routers {
+		if (spec.Peer == nil) == (spec.PeerGroup == nil) {
+			return nil, fmt.Errorf("routers[%d]: exactly one of peer and peerGroup must be set", i)
+		}
+
+		key := haRouterKey(spec)
+		if seen[key] {
+			return nil, fmt.Errorf("routers[%d]: duplicate router %s", i, key)
+		}
+
+		seen[key] = true
+		metrics[i] = base + i*step
+	}
+
+	if last := metrics[len(metrics)-1]; last > maxRouteMetric {
+		return nil, fmt.Errorf("metric of the last router (%d) exceeds the maximum of %d; lower baseMetric or metricStep",
+			last, maxRouteMetric)
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,173 @@
+package component
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func peerRouter(id string) HARouterSpec  { return HARouterSpec{Peer: ptr(id), PeerGroup: nil} }
+func groupRouter(id string) HARouterSpec { return HARouterSpec{Peer: nil, PeerGroup: ptr(id)} }
+
+func haRouterSetArgs(routers ...HARouterSpec) HARouterSetArgs {
+	return HARouterSetArgs{
+		NetworkID:  "net-1",
+		Routers:    routers,
+		Masquerade: true,
+		Enabled:    nil,
+		BaseMetric: nil,
+		MetricStep: nil,
+	}
+}
+
+func TestHARouterKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "peer-edge", haRouterKey(peerRouter("edge")))
+
+	// A peer and a peer group with the same ID are different routers.
+	assert.Equal(t, "group-edge", haRouterKey(groupRouter("edge")))
+}
+
+func TestHARouterMetrics(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		args   HARouterSetArgs
+		want   []int
+		errMsg string
+	}{
+		{
+			name: "defaults",
+			args: haRouterSetArgs(peerRouter("a"), groupRouter("b"), peerRouter("c")),
+			want: []int{100, 200, 300},
+		},
+		{
+			name: "custom base and step",
+			args: func() HARouterSetArgs {
+				args := haRouterSetArgs(peerRouter("a"), peerRouter("b"))
+				args.BaseMetric, args.MetricStep = ptr(10), ptr(5)
+
+				return args
+			}(),
+			want: []int{10, 15},
+		},
+		{
+			name: "peer and group with the same ID",
+			args: haRouterSetArgs(peerRouter("edge"), groupRouter("edge")),
+			want: []int{100, 200},
+		},
+		{
+			name: "last metric at the maximum",
+			args: func() HARouterSetArgs {
+				args := haRouterSetArgs(peerRouter("a"), peerRouter("b"))
+				args.BaseMetric, args.MetricStep = ptr(9000), ptr(999)
+
+				return args
+			}(),
+			want: []int{9000, 9999},
+		},
+		{
+			name: "last metric over the maximum",
+			args: func() HARouterSetArgs {
+				args := haRouterSetArgs(peerRouter("a"), peerRouter("b"))
+				args.BaseMetric, args.MetricStep = ptr(9000), ptr(1000)
+
+				return args
+			}(),
+			errMsg: "metric of the last router (10000) exceeds the maximum of 9999",
+		},
+		{
+			name: "default step overflows at the hundredth router",
+			args: func() HARouterSetArgs {
+				routers := make([]HARouterSpec, 100)
+				for i := range routers {
+					routers[i] = peerRouter(strconv.Itoa(i))
+				}
+
+				return haRouterSetArgs(routers...)
+			}(),
+			errMsg: "metric of the last router (10000) exceeds the maximum of 9999",
+		},
+		{name: "no routers", args: haRouterSetArgs(), errMsg: "at least one router"},
+		{name: "duplicate peer", args: haRouterSetArgs(peerRouter("a"), groupRouter("b"), peerRouter("a")),
+			errMsg: "routers[2]: duplicate router peer-a"},
+		{name: "duplicate group", args: haRouterSetArgs(groupRouter("b"), groupRouter("b")),
+			errMsg: "routers[1]: duplicate router group-b"},
+		{name: "peer and group", args: haRouterSetArgs(HARouterSpec{Peer: ptr("a"), PeerGroup: ptr("b")}),
+			errMsg: "routers[0]: exactly one of peer and peerGroup must be set"},
+		{name: "neither peer nor group", args: haRouterSetArgs(peerRouter("a"), HARouterSpec{Peer: nil, PeerGroup: nil}),
+			errMsg: "routers[1]: exactly one of peer and peerGroup must be set"},
+		{
+			name: "zero base metric",
+			args: func() HARouterSetArgs {
+				args := haRouterSetArgs(peerRouter("a"))
+				args.BaseMetric = ptr(0)
+
+				return args
+			}(),
+			errMsg: "baseMetric must be at least 1, got 0",
+		},
+		{
+			name: "zero step",
+			args: func() HARouterSetArgs {
+				args := haRouterSetArgs(peerRouter("a"))
+				args.MetricStep = ptr(0)
+
+				return args
+			}(),
+			errMsg: "metricStep must be at least 1, got 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := haRouterMetrics(tt.args)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestHARouterSetReorderKeepsChildren constructs the same routers in two
+// orders and checks that only the metrics change, not the child names.
+func TestHARouterSetReorderKeepsChildren(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"ha-group-b", "ha-peer-a", "ha-peer-c"}
+	before := newComponentMocks()
+
+	_, err := construct(t.Context(), before, (&HARouterSet{}).Construct, "ha",
+		haRouterSetArgs(peerRouter("a"), groupRouter("b"), peerRouter("c")))
+	require.NoError(t, err)
+	require.Equal(t, want, before.names(tokenNetworkRouter))
+
+	after := newComponentMocks()
+
+	_, err = construct(t.Context(), after, (&HARouterSet{}).Construct, "ha",
+		haRouterSetArgs(peerRouter("c"), peerRouter("a"), groupRouter("b")))
+	require.NoError(t, err)
+	require.Equal(t, want, after.names(tokenNetworkRouter), "routers after reordering")
+
+	metric := func(mocks *componentMocks, name string) float64 {
+		return mocks.find(t, name).inputs["metric"].NumberValue()
+	}
+
+	assert.InDelta(t, 100, metric(before, "ha-peer-a"), 0)
+	assert.InDelta(t, 200, metric(after, "ha-peer-a"), 0)
+	assert.InDelta(t, 300, metric(before, "ha-peer-c"), 0)
+	assert.InDelta(t, 100, metric(after, "ha-peer-c"), 0)
+	assert.Equal(t, []string{"b"}, stringInputs(after.find(t, "ha-group-b").inputs["peerGroups"]))
+}
@@ -0,0 +1,513 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// ManifestArgs are the inputs for a Manifest component.
+type ManifestArgs struct {
+	Document string `pulumi:"document"`
+}
+
+// Annotate adds schema descriptions to ManifestArgs fields.
+func (m *ManifestArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.Document, "JSON or YAML account spec with the top-level keys groups, postureChecks, policies, "+
+		"networks, routes, dns (nameserverGroups and zones) and setupKeys. Objects reference each other by name; "+
+		"peers are referenced by ID. Schema and reference errors are reported with their document path.")
+}
+
+// ManifestState holds the outputs of a Manifest component.
+type ManifestState struct {
+	pulumi.ResourceState
+
+	GroupIDs           pulumi.StringMapOutput `pulumi:"groupIds"`
+	PostureCheckIDs    pulumi.StringMapOutput `pulumi:"postureCheckIds"`
+	PolicyIDs          pulumi.StringMapOutput `pulumi:"policyIds"`
+	NetworkIDs         pulumi.StringMapOutput `pulumi:"networkIds"`
+	RouteIDs           pulumi.StringMapOutput `pulumi:"routeIds"`
+	NameserverGroupIDs pulumi.StringMapOutput `pulumi:"nameserverGroupIds"`
+	ZoneIDs            pulumi.StringMapOutput `pulumi:"zoneIds"`
+	SetupKeyIDs        pulumi.StringMapOutput `pulumi:"setupKeyIds"`
+	SetupKeys          pulumi.StringMapOutput `provider:"secret" pulumi:"setupKeys"`
+}
+
+// Annotate adds schema descriptions to ManifestState fields.
+func (s *ManifestState) Annotate(a infer.Annotator) {
+	a.Describe(&s.GroupIDs, "ID of each created Group, keyed by name.")
+	a.Describe(&s.PostureCheckIDs, "ID of each created PostureCheck, keyed by name.")
+	a.Describe(&s.PolicyIDs, "ID of each created Policy, keyed by name.")
+	a.Describe(&s.NetworkIDs, "ID of each created Network, keyed by name.")
+	a.Describe(&s.RouteIDs, "ID of each created Route, keyed by name.")
+	a.Describe(&s.NameserverGroupIDs, "ID of each created DNS nameserver group, keyed by name.")
+	a.Describe(&s.ZoneIDs, "ID of each created DNSZone, keyed by name.")
+	a.Describe(&s.SetupKeyIDs, "ID of each created SetupKey, keyed by name.")
+	a.Describe(&s.SetupKeys, "Plaintext value of each created setup key, keyed by name. Secret.")
+}
+
+// Manifest is the ComponentResource anchor for the Manifest component.
+type Manifest struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*Manifest) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args ManifestArgs, opts pulumi.ResourceOption,
+) (*ManifestState, error) {
+	return newManifest(ctx, name, typ, args, opts)
+}
+
+// manifestBuilder registers the children of a Manifest. Objects are created
+// in dependency order, and referenced IDs are passed as outputs, so Pulumi
+// orders the API calls accordingly.
+type manifestBuilder struct {
+	ctx    *pulumi.Context
+	name   string
+	parent pulumi.Resource
+
+	groupIDs        pulumi.StringMap
+	postureCheckIDs pulumi.StringMap
+}
+
+func newManifest(
+	ctx *pulumi.Context,
+	name, typ string,
+	args ManifestArgs,
+	opts ...pulumi.ResourceOption,
+) (*ManifestState, error) {
+	doc, err := parseManifest(args.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &ManifestState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering Manifest component: %w", err)
+	}
+
+	builder := &manifestBuilder{
+		ctx:             ctx,
+		name:            name,
+		parent:          comp,
+		groupIDs:        pulumi.StringMap{},
+		postureCheckIDs: pulumi.StringMap{},
+	}
+
+	err = builder.groups(doc.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	err = builder.postureChecks(doc.PostureChecks)
+	if err != nil {
+		return nil, err
+	}
+
+	policyIDs, err := builder.policies(doc.Policies)
+	if err != nil {
+		return nil, err
+	}
+
+	networkIDs, err := builder.networks(doc.Networks)
+	if err != nil {
+		return nil, err
+	}
+
+	routeIDs, err := builder.routes(doc.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	nameserverGroupIDs, err := builder.nameserverGroups(doc.DNS.NameserverGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneIDs, err := builder.zones(doc.DNS.Zones)
+	if err != nil {
+		return nil, err
+	}
+
+	setupKeyIDs, setupKeys, err := builder.setupKeys(doc.SetupKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	comp.GroupIDs = builder.groupIDs.ToStringMapOutput()
+	comp.PostureCheckIDs = builder.postureCheckIDs.ToStringMapOutput()
+	comp.PolicyIDs = policyIDs.ToStringMapOutput()
+	comp.NetworkIDs = networkIDs.ToStringMapOutput()
+	comp.RouteIDs = routeIDs.ToStringMapOutput()
+	comp.NameserverGroupIDs = nameserverGroupIDs.ToStringMapOutput()
+	comp.ZoneIDs = zoneIDs.ToStringMapOutput()
+	comp.SetupKeyIDs = setupKeyIDs.ToStringMapOutput()
+	comp.SetupKeys = pulumi.ToSecret(setupKeys.ToStringMapOutput()).(pulumi.StringMapOutput) //nolint:forcetypeassert
+
+	return comp, nil
+}
+
+// register creates one child resource and returns its ID.
+func (b *manifestBuilder) register(token, childName string, inputs pulumi.Map, kind, objectName string) (pulumi.StringOutput, error) {
+	var res pulumi.CustomResourceState
+
+	err := b.ctx.RegisterResource(token, b.name+"-"+childName, inputs, &res, pulumi.Parent(b.parent))
+	if err != nil {
+		return pulumi.StringOutput{}, fmt.Errorf("creating %s %q: %w", kind, objectName, err)
+	}
+
+	return res.ID().ToStringOutput(), nil
+}
+
+// refs resolves group names to the IDs of the created groups.
+func (b *manifestBuilder) refs(names []string) pulumi.StringArray {
+	ids := make(pulumi.StringArray, len(names))
+	for i, groupName := range names {
+		ids[i] = b.groupIDs[groupName]
+	}
+
+	return ids
+}
+
+func (b *manifestBuilder) groups(groups []manifestGroup) error {
+	for _, group := range groups {
+		inputs := pulumi.Map{
+			"name": pulumi.String(group.Name),
+		}
+		if len(group.Peers) > 0 {
+			inputs["peers"] = pulumi.ToStringArray(group.Peers)
+		}
+
+		id, err := b.register(tokenGroup, "group-"+group.Name, inputs, "Group", group.Name)
+		if err != nil {
+			return err
+		}
+
+		b.groupIDs[group.Name] = id
+	}
+
+	return nil
+}
+
+func (b *manifestBuilder) postureChecks(checks []manifestPostureCheck) error {
+	for _, check := range checks {
+		inputs := pulumi.Map{
+			"name":   pulumi.String(check.Name),
+			"checks": postureChecks(manifestPosture(check)),
+		}
+		if check.Description != nil {
+			inputs["description"] = pulumi.String(*check.Description)
+		}
+
+		id, err := b.register(tokenPostureCheck, "posture-"+check.Name, inputs, "PostureCheck", check.Name)
+		if err != nil {
+			return err
+		}
+
+		b.postureCheckIDs[check.Name] = id
+	}
+
+	return nil
+}
+
+func (b *manifestBuilder) policies(policies []manifestPolicy) (pulumi.StringMap, error) {
+	ids := pulumi.StringMap{}
+
+	for _, policy := range policies {
+		rules := make(pulumi.Array, len(policy.Rules))
+
+		for i, rule := range policy.Rules {
+			ruleName := policy.Name
+			if rule.Name != nil {
+				ruleName = *rule.Name
+			}
+
+			action := resource.RuleActionAccept
+			if rule.Action != nil {
+				action = resource.RuleAction(*rule.Action)
+			}
+
+			ruleInputs := pulumi.Map{
+				"name":          pulumi.String(ruleName),
+				"action":        pulumi.String(string(action)),
+				"enabled":       pulumi.Bool(rule.Enabled == nil || *rule.Enabled),
+				"bidirectional": pulumi.Bool(rule.Bidirectional != nil && *rule.Bidirectional),
+				"protocol":      pulumi.String(string(manifestProtocol(rule))),
+				"sources":       b.refs(rule.Sources),
+				"destinations":  b.refs(rule.Destinations),
+			}
+			if rule.Description != nil {
+				ruleInputs["description"] = pulumi.String(*rule.Description)
+			}
+
+			if len(rule.Ports) > 0 {
+				ruleInputs["ports"] = pulumi.ToStringArray(rule.Ports)
+			}
+
+			if len(rule.PortRanges) > 0 {
+				ruleInputs["portRanges"] = portRangeInputs(manifestPortRanges(rule.PortRanges))
+			}
+
+			rules[i] = ruleInputs
+		}
+
+		inputs := pulumi.Map{
+			"name":    pulumi.String(policy.Name),
+			"enabled": pulumi.Bool(policy.Enabled == nil || *policy.Enabled),
+			"rules":   rules,
+		}
+		if policy.Description != nil {
+			inputs["description"] = pulumi.String(*policy.Description)
+		}
+
+		if len(policy.PostureChecks) > 0 {
+			postureIDs := make(pulumi.StringArray, len(policy.PostureChecks))
+			for i, check := range policy.PostureChecks {
+				postureIDs[i] = b.postureCheckIDs[check]
+			}
+
+			inputs["postureChecks"] = postureIDs
+		}
+
+		id, err := b.register(tokenPolicy, "policy-"+policy.Name, inputs, "Policy", policy.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		ids[policy.Name] = id
+	}
+
+	return ids, nil
+}
+
+func (b *manifestBuilder) networks(networks []manifestNetwork) (pulumi.StringMap, error) {
+	ids := pulumi.StringMap{}
+
+	for _, network := range networks {
+		inputs := pulumi.Map{
+			"name": pulumi.String(network.Name),
+		}
+		if network.Description != nil {
+			inputs["description"] = pulumi.String(*network.Description)
+		}
+
+		networkID, err := b.register(tokenNetwork, "network-"+network.Name, inputs, "Network", network.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, router := range network.Routers {
+			metric := defaultRouteMetric
+			if router.Metric != nil {
+				metric = *router.Metric
+			}
+
+			routerInputs := pulumi.Map{
+				"networkID":  networkID,
+				"enabled":    pulumi.Bool(router.Enabled == nil || *router.Enabled),
+				"masquerade": pulumi.Bool(router.Masquerade == nil || *router.Masquerade),
+				"metric":     pulumi.Int(metric),
+			}
+			if router.Peer != nil {
+				routerInputs["peer"] = pulumi.String(*router.Peer)
+			} else {
+				routerInputs["peerGroups"] = b.refs(router.PeerGroups)
+			}
+
+			key := manifestRouterKey(router)
+
+			_, err = b.register(tokenNetworkRouter, "network-"+network.Name+"-router-"+key, routerInputs,
+				"NetworkRouter", network.Name+"/"+key)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, res := range network.Resources {
+			resourceInputs := pulumi.Map{
+				"name":      pulumi.String(res.Name),
+				"networkID": networkID,
+				"address":   pulumi.String(res.Address),
+				"enabled":   pulumi.Bool(res.Enabled == nil || *res.Enabled),
+				"groupIDs":  b.refs(res.Groups),
+			}
+			if res.Description != nil {
+				resourceInputs["description"] = pulumi.String(*res.Description)
+			}
+
+			_, err = b.register(tokenNetworkResource, "network-"+network.Name+"-resource-"+res.Name, resourceInputs,
+				"NetworkResource", network.Name+"/"+res.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		ids[network.Name] = networkID
+	}
+
+	return ids, nil
+}
+
+func (b *manifestBuilder) routes(routes []manifestRoute) (pulumi.StringMap, error) {
+	ids := pulumi.StringMap{}
+
+	for _, route := range routes {
+		description := ""
+		if route.Description != nil {
+			description = *route.Description
+		}
+
+		metric := defaultRouteMetric
+		if route.Metric != nil {
+			metric = *route.Metric
+		}
+
+		inputs := pulumi.Map{
+			"networkId":   pulumi.String(route.Name),
+			"description": pulumi.String(description),
+			"enabled":     pulumi.Bool(route.Enabled == nil || *route.Enabled),
+			"masquerade":  pulumi.Bool(route.Masquerade == nil || *route.Masquerade),
+			"metric":      pulumi.Int(metric),
+			"keepRoute":   pulumi.Bool(route.KeepRoute != nil && *route.KeepRoute),
+			"groups":      b.refs(route.Groups),
+		}
+		if route.Network != nil {
+			inputs["network"] = pulumi.String(*route.Network)
+		} else {
+			inputs["domains"] = pulumi.ToStringArray(route.Domains)
+		}
+
+		if route.Peer != nil {
+			inputs["peer"] = pulumi.String(*route.Peer)
+		} else {
+			inputs["peerGroups"] = b.refs(route.PeerGroups)
+		}
+
+		if len(route.AccessControlGroups) > 0 {
+			inputs["accessControlGroups"] = b.refs(route.AccessControlGroups)
+		}
+
+		id, err := b.register(tokenRoute, "route-"+route.Name, inputs, "Route", route.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		ids[route.Name] = id
+	}
+
+	return ids, nil
+}
+
+func (b *manifestBuilder) nameserverGroups(groups []manifestNameserverGroup) (pulumi.StringMap, error) {
+	ids := pulumi.StringMap{}
+
+	for _, group := range groups {
+		description := ""
+		if group.Description != nil {
+			description = *group.Description
+		}
+
+		id, err := b.register(tokenDNS, "nameservers-"+group.Name, pulumi.Map{
+			"name":                 pulumi.String(group.Name),
+			"description":          pulumi.String(description),
+			"domains":              pulumi.ToStringArray(group.Domains),
+			"enabled":              pulumi.Bool(group.Enabled == nil || *group.Enabled),
+			"groups":               b.refs(group.Groups),
+			"primary":              pulumi.Bool(len(group.Domains) == 0),
+			"nameservers":          nameserverInputs(group.Nameservers, group.Port),
+			"searchDomainsEnabled": pulumi.Bool(group.SearchDomainsEnabled != nil && *group.SearchDomainsEnabled),
+		}, "DNS nameserver group", group.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		ids[group.Name] = id
+	}
+
+	return ids, nil
+}
+
+func (b *manifestBuilder) zones(zones []manifestZone) (pulumi.StringMap, error) {
+	ids := pulumi.StringMap{}
+
+	for _, zone := range zones {
+		zoneID, err := b.register(tokenDNSZone, "zone-"+zone.Name, pulumi.Map{
+			"name":               pulumi.String(zone.Name),
+			"domain":             pulumi.String(zone.Domain),
+			"enabled":            pulumi.Bool(zone.Enabled == nil || *zone.Enabled),
+			"enableSearchDomain": pulumi.Bool(zone.EnableSearchDomain != nil && *zone.EnableSearchDomain),
+			"distributionGroups": b.refs(zone.Groups),
+		}, "DNSZone", zone.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range zone.Records {
+			recordName := manifestRecordName(zone, record)
+
+			ttl := defaultRecordTTL
+			if record.TTL != nil {
+				ttl = *record.TTL
+			}
+
+			// Records are keyed by name and type, so reordering them replaces nothing.
+			_, err = b.register(tokenDNSRecord, "zone-"+zone.Name+"-record-"+recordName+"-"+record.Type, pulumi.Map{
+				"zoneID":  zoneID,
+				"name":    pulumi.String(recordName),
+				"type":    pulumi.String(record.Type),
+				"content": pulumi.String(record.Content),
+				"ttl":     pulumi.Int(ttl),
+			}, "DNSRecord", recordName+"/"+record.Type)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		ids[zone.Name] = zoneID
+	}
+
+	return ids, nil
+}
+
+func (b *manifestBuilder) setupKeys(keys []manifestSetupKey) (pulumi.StringMap, pulumi.StringMap, error) {
+	ids := pulumi.StringMap{}
+	values := pulumi.StringMap{}
+
+	for _, key := range keys {
+		keyType := resource.SetupKeyTypeReusable
+		if key.Type != nil {
+			keyType = resource.SetupKeyType(*key.Type)
+		}
+
+		usageLimit := 0
+		if key.UsageLimit != nil {
+			usageLimit = *key.UsageLimit
+		}
+
+		inputs := pulumi.Map{
+			"name":       pulumi.String(key.Name),
+			"type":       pulumi.String(string(keyType)),
+			"expiresIn":  pulumi.Int(key.ExpiresIn),
+			"autoGroups": b.refs(key.AutoGroups),
+			"usageLimit": pulumi.Int(usageLimit),
+		}
+		if key.Ephemeral != nil {
+			inputs["ephemeral"] = pulumi.Bool(*key.Ephemeral)
+		}
+
+		var setupKey setupKeyResource
+
+		err := b.ctx.RegisterResource(tokenSetupKey, b.name+"-setup-key-"+key.Name, inputs, &setupKey, pulumi.Parent(b.parent))
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating SetupKey %q: %w", key.Name, err)
+		}
+
+		ids[key.Name] = setupKey.ID().ToStringOutput()
+		values[key.Name] = setupKey.Key
+	}
+
+	return ids, values, nil
+}
@@ -0,0 +1,585 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"gopkg.in/yaml.v3"
+)
+
+// manifestDocument is the account spec a Manifest component deploys. Objects
+// reference each other by name; peers are referenced by ID.
+type manifestDocument struct {
+	Groups        []manifestGroup        `yaml:"groups"`
+	PostureChecks []manifestPostureCheck `yaml:"postureChecks"`
+	Policies      []manifestPolicy       `yaml:"policies"`
+	Networks      []manifestNetwork      `yaml:"networks"`
+	Routes        []manifestRoute        `yaml:"routes"`
+	DNS           manifestDNS            `yaml:"dns"`
+	SetupKeys     []manifestSetupKey     `yaml:"setupKeys"`
+}
+
+type manifestGroup struct {
+	Name  string   `yaml:"name"`
+	Peers []string `yaml:"peers"`
+}
+
+type manifestPostureCheck struct {
+	Name                 string   `yaml:"name"`
+	Description          *string  `yaml:"description"`
+	MinClientVersion     *string  `yaml:"minClientVersion"`
+	AllowedCountries     []string `yaml:"allowedCountries"`
+	BlockedCountries     []string `yaml:"blockedCountries"`
+	AllowedNetworkRanges []string `yaml:"allowedNetworkRanges"`
+	BlockedNetworkRanges []string `yaml:"blockedNetworkRanges"`
+}
+
+type manifestPolicy struct {
+	Name          string               `yaml:"name"`
+	Description   *string              `yaml:"description"`
+	Enabled       *bool                `yaml:"enabled"`
+	PostureChecks []string             `yaml:"postureChecks"`
+	Rules         []manifestPolicyRule `yaml:"rules"`
+}
+
+type manifestPolicyRule struct {
+	Name          *string             `yaml:"name"`
+	Description   *string             `yaml:"description"`
+	Enabled       *bool               `yaml:"enabled"`
+	Action        *string             `yaml:"action"`
+	Protocol      *string             `yaml:"protocol"`
+	Bidirectional *bool               `yaml:"bidirectional"`
+	Ports         []string            `yaml:"ports"`
+	PortRanges    []manifestPortRange `yaml:"portRanges"`
+	Sources       []string            `yaml:"sources"`
+	Destinations  []string            `yaml:"destinations"`
+}
+
+type manifestPortRange struct {
+	Start int `yaml:"start"`
+	End   int `yaml:"end"`
+}
+
+type manifestNetwork struct {
+	Name        string                    `yaml:"name"`
+	Description *string                   `yaml:"description"`
+	Routers     []manifestNetworkRouter   `yaml:"routers"`
+	Resources   []manifestNetworkResource `yaml:"resources"`
+}
+
+type manifestNetworkRouter struct {
+	Peer       *string  `yaml:"peer"`
+	PeerGroups []string `yaml:"peerGroups"`
+	Metric     *int     `yaml:"metric"`
+	Masquerade *bool    `yaml:"masquerade"`
+	Enabled    *bool    `yaml:"enabled"`
+}
+
+type manifestNetworkResource struct {
+	Name        string   `yaml:"name"`
+	Description *string  `yaml:"description"`
+	Address     string   `yaml:"address"`
+	Enabled     *bool    `yaml:"enabled"`
+	Groups      []string `yaml:"groups"`
+}
+
+type manifestRoute struct {
+	Name                string   `yaml:"name"`
+	Description         *string  `yaml:"description"`
+	Network             *string  `yaml:"network"`
+	Domains             []string `yaml:"domains"`
+	Peer                *string  `yaml:"peer"`
+	PeerGroups          []string `yaml:"peerGroups"`
+	Groups              []string `yaml:"groups"`
+	AccessControlGroups []string `yaml:"accessControlGroups"`
+	Metric              *int     `yaml:"metric"`
+	Masquerade          *bool    `yaml:"masquerade"`
+	KeepRoute           *bool    `yaml:"keepRoute"`
+	Enabled             *bool    `yaml:"enabled"`
+}
+
+type manifestDNS struct {
+	NameserverGroups []manifestNameserverGroup `yaml:"nameserverGroups"`
+	Zones            []manifestZone            `yaml:"zones"`
+}
+
+type manifestNameserverGroup struct {
+	Name                 string   `yaml:"name"`
+	Description          *string  `yaml:"description"`
+	Nameservers          []string `yaml:"nameservers"`
+	Port                 *int     `yaml:"port"`
+	Groups               []string `yaml:"groups"`
+	Domains              []string `yaml:"domains"`
+	SearchDomainsEnabled *bool    `yaml:"searchDomainsEnabled"`
+	Enabled              *bool    `yaml:"enabled"`
+}
+
+type manifestZone struct {
+	Name               string           `yaml:"name"`
+	Domain             string           `yaml:"domain"`
+	Enabled            *bool            `yaml:"enabled"`
+	EnableSearchDomain *bool            `yaml:"enableSearchDomain"`
+	Groups             []string         `yaml:"groups"`
+	Records            []manifestRecord `yaml:"records"`
+}
+
+type manifestRecord struct {
+	Name    string `yaml:"name"`
+	Type    string `yaml:"type"`
+	Content string `yaml:"content"`
+	TTL     *int   `yaml:"ttl"`
+}
+
+type manifestSetupKey struct {
+	Name       string   `yaml:"name"`
+	Type       *string  `yaml:"type"`
+	ExpiresIn  int      `yaml:"expiresIn"`
+	UsageLimit *int     `yaml:"usageLimit"`
+	Ephemeral  *bool    `yaml:"ephemeral"`
+	AutoGroups []string `yaml:"autoGroups"`
+}
+
+// parseManifest decodes a JSON or YAML manifest, which YAML parses alike, and
+// validates it. Every problem is reported with its document path.
+func parseManifest(text string) (manifestDocument, error) {
+	var doc manifestDocument
+
+	var root yaml.Node
+
+	err := yaml.Unmarshal([]byte(text), &root)
+	if err != nil {
+		return doc, fmt.Errorf("manifest is neither valid YAML nor JSON: %w", err)
+	}
+
+	if len(root.Content) == 0 {
+		return doc, errors.New("manifest document is empty")
+	}
+
+	errs := &manifestErrors{}
+	checkManifestNode(root.Content[0], reflect.TypeFor[manifestDocument](), "", errs)
+
+	if err = errs.err(); err != nil {
+		return doc, err
+	}
+
+	err = root.Content[0].Decode(&doc)
+	if err != nil {
+		return doc, fmt.Errorf("decoding manifest: %w", err)
+	}
+
+	validateManifest(doc, errs)
+
+	return doc, errs.err()
+}
+
+// manifestErrors collects the problems of a manifest, so all of them are
+// reported at once.
+type manifestErrors struct {
+	errs []error
+}
+
+func (m *manifestErrors) add(path, format string, args ...any) {
+	m.errs = append(m.errs, fmt.Errorf("manifest %s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (m *manifestErrors) err() error {
+	return errors.Join(m.errs...)
+}
+
+// checkManifestNode checks that a node has the shape of typ: known fields,
+// and mappings, sequences and scalars where expected.
+func checkManifestNode(node *yaml.Node, typ reflect.Type, path string, errs *manifestErrors) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	// An explicit null leaves the field unset, like an omitted key.
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() { //nolint:exhaustive
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			errs.add(manifestPath(path), "expected an object")
+
+			return
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+
+			field, ok := manifestField(typ, key)
+			if !ok {
+				errs.add(manifestPath(joinManifestPath(path, key)), "unknown field %q", key)
+
+				continue
+			}
+
+			checkManifestNode(node.Content[i+1], field.Type, joinManifestPath(path, key), errs)
+		}
+	case reflect.Slice:
+		if node.Kind != yaml.SequenceNode {
+			errs.add(manifestPath(path), "expected a list")
+
+			return
+		}
+
+		for i, item := range node.Content {
+			checkManifestNode(item, typ.Elem(), path+"["+strconv.Itoa(i)+"]", errs)
+		}
+	case reflect.Int:
+		var value int
+		if node.Kind != yaml.ScalarNode || node.Decode(&value) != nil {
+			errs.add(manifestPath(path), "expected an integer, got %q", node.Value)
+		}
+	case reflect.Bool:
+		var value bool
+		if node.Kind != yaml.ScalarNode || node.Decode(&value) != nil {
+			errs.add(manifestPath(path), "expected true or false, got %q", node.Value)
+		}
+	default:
+		if node.Kind != yaml.ScalarNode {
+			errs.add(manifestPath(path), "expected a string")
+		}
+	}
+}
+
+// manifestField finds the field of typ with the given YAML key.
+func manifestField(typ reflect.Type, key string) (reflect.StructField, bool) {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if strings.Split(field.Tag.Get("yaml"), ",")[0] == key {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false //nolint:exhaustruct
+}
+
+func joinManifestPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func manifestPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+
+	return path
+}
+
+// validateManifest checks required fields, value ranges and that every
+// cross-reference names an object of the document.
+func validateManifest(doc manifestDocument, errs *manifestErrors) { //nolint:funlen
+	groups := manifestNames(doc.Groups, "groups", func(g manifestGroup) string { return g.Name }, errs)
+	postureChecks := manifestNames(doc.PostureChecks, "postureChecks",
+		func(p manifestPostureCheck) string { return p.Name }, errs)
+
+	checkRefs := func(path string, names []string, known map[string]bool, kind string) {
+		for i, ref := range names {
+			if !known[ref] {
+				errs.add(fmt.Sprintf("%s[%d]", path, i), "unknown %s %q", kind, ref)
+			}
+		}
+	}
+
+	for i, check := range doc.PostureChecks {
+		err := validatePosture(manifestPosture(check))
+		if err != nil {
+			errs.add(fmt.Sprintf("postureChecks[%d]", i), "%v", err)
+		}
+	}
+
+	manifestNames(doc.Policies, "policies", func(p manifestPolicy) string { return p.Name }, errs)
+
+	for i, policy := range doc.Policies {
+		path := fmt.Sprintf("policies[%d]", i)
+		checkRefs(path+".postureChecks", policy.PostureChecks, postureChecks, "posture check")
+
+		if len(policy.Rules) == 0 {
+			errs.add(path+".rules", "at least one rule is required")
+		}
+
+		for j, rule := range policy.Rules {
+			rulePath := fmt.Sprintf("%s.rules[%d]", path, j)
+
+			if rule.Action != nil && !slices.Contains([]resource.RuleAction{resource.RuleActionAccept, resource.RuleActionDrop},
+				resource.RuleAction(*rule.Action)) {
+				errs.add(rulePath+".action", "must be accept or drop, got %q", *rule.Action)
+			}
+
+			protocol := manifestProtocol(rule)
+			if !slices.Contains([]resource.Protocol{
+				resource.ProtocolAll, resource.ProtocolTCP, resource.ProtocolUDP, resource.ProtocolIcmp,
+			}, protocol) {
+				errs.add(rulePath+".protocol", "must be all, tcp, udp or icmp, got %q", protocol)
+			} else if err := validateRulePorts(protocol, rule.Ports, manifestPortRanges(rule.PortRanges)); err != nil {
+				errs.add(rulePath, "%v", err)
+			}
+
+			if len(rule.Sources) == 0 {
+				errs.add(rulePath+".sources", "at least one group is required")
+			}
+
+			if len(rule.Destinations) == 0 {
+				errs.add(rulePath+".destinations", "at least one group is required")
+			}
+
+			checkRefs(rulePath+".sources", rule.Sources, groups, "group")
+			checkRefs(rulePath+".destinations", rule.Destinations, groups, "group")
+		}
+	}
+
+	manifestNames(doc.Networks, "networks", func(n manifestNetwork) string { return n.Name }, errs)
+
+	for i, network := range doc.Networks {
+		path := fmt.Sprintf("networks[%d]", i)
+		routerKeys := map[string]bool{}
+
+		for j, router := range network.Routers {
+			routerPath := fmt.Sprintf("%s.routers[%d]", path, j)
+
+			if (router.Peer == nil) == (len(router.PeerGroups) == 0) {
+				errs.add(routerPath, "exactly one of peer and peerGroups must be set")
+			}
+
+			if router.Metric != nil && (*router.Metric < 1 || *router.Metric > maxRouteMetric) {
+				errs.add(routerPath+".metric", "must be between 1 and %d, got %d", maxRouteMetric, *router.Metric)
+			}
+
+			checkRefs(routerPath+".peerGroups", router.PeerGroups, groups, "group")
+
+			key := manifestRouterKey(router)
+			if routerKeys[key] {
+				errs.add(routerPath, "duplicate router %s", key)
+			}
+
+			routerKeys[key] = true
+		}
+
+		manifestNames(network.Resources, path+".resources", func(r manifestNetworkResource) string { return r.Name }, errs)
+
+		for j, res := range network.Resources {
+			resourcePath := fmt.Sprintf("%s.resources[%d]", path, j)
+
+			if res.Address == "" {
+				errs.add(resourcePath+".address", "is required")
+			}
+
+			checkRefs(resourcePath+".groups", res.Groups, groups, "group")
+		}
+	}
+
+	manifestNames(doc.Routes, "routes", func(r manifestRoute) string { return r.Name }, errs)
+
+	for i, route := range doc.Routes {
+		path := fmt.Sprintf("routes[%d]", i)
+
+		if (route.Network == nil) == (len(route.Domains) == 0) {
+			errs.add(path, "exactly one of network and domains must be set")
+		} else if route.Network != nil {
+			if _, err := netip.ParsePrefix(*route.Network); err != nil {
+				errs.add(path+".network", "%q is not a CIDR", *route.Network)
+			}
+		}
+
+		if (route.Peer == nil) == (len(route.PeerGroups) == 0) {
+			errs.add(path, "exactly one of peer and peerGroups must be set")
+		}
+
+		if len(route.Groups) == 0 {
+			errs.add(path+".groups", "at least one group is required")
+		}
+
+		if route.Metric != nil && (*route.Metric < 1 || *route.Metric > maxRouteMetric) {
+			errs.add(path+".metric", "must be between 1 and %d, got %d", maxRouteMetric, *route.Metric)
+		}
+
+		checkRefs(path+".peerGroups", route.PeerGroups, groups, "group")
+		checkRefs(path+".groups", route.Groups, groups, "group")
+		checkRefs(path+".accessControlGroups", route.AccessControlGroups, groups, "group")
+	}
+
+	manifestNames(doc.DNS.NameserverGroups, "dns.nameserverGroups",
+		func(n manifestNameserverGroup) string { return n.Name }, errs)
+
+	for i, nameservers := range doc.DNS.NameserverGroups {
+		path := fmt.Sprintf("dns.nameserverGroups[%d]", i)
+
+		err := validateNameservers(nameservers.Nameservers, nameservers.Port)
+		if err != nil {
+			errs.add(path, "%v", err)
+		}
+
+		if len(nameservers.Groups) == 0 {
+			errs.add(path+".groups", "at least one group is required")
+		}
+
+		checkRefs(path+".groups", nameservers.Groups, groups, "group")
+	}
+
+	manifestNames(doc.DNS.Zones, "dns.zones", func(z manifestZone) string { return z.Name }, errs)
+
+	for i, zone := range doc.DNS.Zones {
+		validateManifestZone(zone, fmt.Sprintf("dns.zones[%d]", i), errs)
+		checkRefs(fmt.Sprintf("dns.zones[%d].groups", i), zone.Groups, groups, "group")
+	}
+
+	manifestNames(doc.SetupKeys, "setupKeys", func(k manifestSetupKey) string { return k.Name }, errs)
+
+	for i, key := range doc.SetupKeys {
+		path := fmt.Sprintf("setupKeys[%d]", i)
+
+		if key.Type != nil && !slices.Contains([]resource.SetupKeyType{resource.SetupKeyTypeReusable, resource.SetupKeyTypeOneOff},
+			resource.SetupKeyType(*key.Type)) {
+			errs.add(path+".type", "must be reusable or one-off, got %q", *key.Type)
+		}
+
+		if key.ExpiresIn < 0 {
+			errs.add(path+".expiresIn", "must be at least 0, got %d", key.ExpiresIn)
+		}
+
+		if key.UsageLimit != nil && *key.UsageLimit < 0 {
+			errs.add(path+".usageLimit", "must be at least 0, got %d", *key.UsageLimit)
+		}
+
+		checkRefs(path+".autoGroups", key.AutoGroups, groups, "group")
+	}
+}
+
+// validateManifestZone checks the domain and the records of a DNS zone.
+func validateManifestZone(zone manifestZone, path string, errs *manifestErrors) {
+	if zone.Domain == "" {
+		errs.add(path+".domain", "is required")
+
+		return
+	}
+
+	seen := map[string]bool{}
+
+	for i, record := range zone.Records {
+		recordPath := fmt.Sprintf("%s.records[%d]", path, i)
+
+		if record.Name == "" {
+			errs.add(recordPath+".name", "is required")
+		}
+
+		if record.TTL != nil && *record.TTL < 1 {
+			errs.add(recordPath+".ttl", "must be at least 1, got %d", *record.TTL)
+		}
+
+		if !isZoneFileRecordType(record.Type) {
+			errs.add(recordPath+".type", "must be A, AAAA or CNAME, got %q", record.Type)
+
+			continue
+		}
+
+		if resource.DNSRecordType(record.Type) == resource.DNSRecordTypeCNAME {
+			if record.Content == "" {
+				errs.add(recordPath+".content", "is required")
+			}
+		} else if _, err := parseRecordAddress(record.Type, record.Content); err != nil {
+			errs.add(recordPath+".content", "%v", err)
+		}
+
+		key := manifestRecordName(zone, record) + "/" + record.Type
+		if seen[key] {
+			errs.add(recordPath, "duplicate %s record %s", record.Type, manifestRecordName(zone, record))
+		}
+
+		seen[key] = true
+	}
+}
+
+// manifestNames checks that every item has a unique name and returns the set
+// of names.
+func manifestNames[T any](items []T, path string, name func(T) string, errs *manifestErrors) map[string]bool {
+	names := map[string]bool{}
+
+	for i, item := range items {
+		itemName := name(item)
+
+		switch {
+		case itemName == "":
+			errs.add(fmt.Sprintf("%s[%d].name", path, i), "is required")
+		case names[itemName]:
+			errs.add(fmt.Sprintf("%s[%d].name", path, i), "duplicate name %q", itemName)
+		}
+
+		names[itemName] = true
+	}
+
+	return names
+}
+
+// manifestPosture converts a manifest posture check to the requirements
+// postureChecks builds.
+func manifestPosture(check manifestPostureCheck) ZeroTrustPostureSpec {
+	return ZeroTrustPostureSpec{
+		MinClientVersion:     check.MinClientVersion,
+		AllowedCountries:     check.AllowedCountries,
+		BlockedCountries:     check.BlockedCountries,
+		AllowedNetworkRanges: check.AllowedNetworkRanges,
+		BlockedNetworkRanges: check.BlockedNetworkRanges,
+	}
+}
+
+// manifestProtocol returns the protocol of a rule, all unless set.
+func manifestProtocol(rule manifestPolicyRule) resource.Protocol {
+	if rule.Protocol == nil {
+		return resource.ProtocolAll
+	}
+
+	return resource.Protocol(*rule.Protocol)
+}
+
+func manifestPortRanges(ranges []manifestPortRange) []resource.RulePortRange {
+	converted := make([]resource.RulePortRange, len(ranges))
+	for i, portRange := range ranges {
+		converted[i] = resource.RulePortRange{Start: portRange.Start, End: portRange.End}
+	}
+
+	return converted
+}
+
+// manifestRouterKey names a network router after its peer or peer groups
+// rather than its position, so a reordered list keeps the same resources.
+func manifestRouterKey(router manifestNetworkRouter) string {
+	if router.Peer != nil {
+		return "peer-" + *router.Peer
+	}
+
+	return "groups-" + strings.Join(router.PeerGroups, "+")
+}
+
+// manifestRecordName returns the fully qualified name of a record. Names
+// outside the zone domain are taken relative to it.
+func manifestRecordName(zone manifestZone, record manifestRecord) string {
+	domain := strings.ToLower(strings.TrimSuffix(zone.Domain, "."))
+
+	name := strings.ToLower(strings.TrimSuffix(record.Name, "."))
+	if name == "@" {
+		return domain
+	}
+
+	if name != domain && !strings.HasSuffix(name, "."+domain) {
+		name += "." + domain
+	}
+
+	return name
+}
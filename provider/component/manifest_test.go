@@ -0,0 +1,252 @@
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const manifestYAML = `
+groups:
+  - name: admins
+    peers: [peer-1]
+  - name: servers
+postureChecks:
+  - name: recent
+    minClientVersion: 0.30.0
+policies:
+  - name: ssh
+    postureChecks: [recent]
+    rules:
+      - protocol: tcp
+        ports: ["22"]
+        sources: [admins]
+        destinations: [servers]
+networks:
+  - name: office
+    routers:
+      - peerGroups: [servers]
+        metric: 50
+    resources:
+      - name: lan
+        address: 10.0.0.0/24
+        groups: [servers]
+dns:
+  zones:
+    - name: corp
+      domain: corp.internal
+      groups: [admins]
+      records:
+        - {name: www, type: A, content: 10.0.0.1}
+        - {name: "@", type: AAAA, content: "fd00::1", ttl: 60}
+setupKeys:
+  - name: servers
+    expiresIn: 86400
+    autoGroups: [servers]
+`
+
+const manifestJSON = `{
+  "groups": [{"name": "admins", "peers": ["peer-1"]}, {"name": "servers"}],
+  "postureChecks": [{"name": "recent", "minClientVersion": "0.30.0"}],
+  "policies": [{
+    "name": "ssh",
+    "postureChecks": ["recent"],
+    "rules": [{"protocol": "tcp", "ports": ["22"], "sources": ["admins"], "destinations": ["servers"]}]
+  }],
+  "networks": [{
+    "name": "office",
+    "routers": [{"peerGroups": ["servers"], "metric": 50}],
+    "resources": [{"name": "lan", "address": "10.0.0.0/24", "groups": ["servers"]}]
+  }],
+  "dns": {"zones": [{
+    "name": "corp",
+    "domain": "corp.internal",
+    "groups": ["admins"],
+    "records": [
+      {"name": "www", "type": "A", "content": "10.0.0.1"},
+      {"name": "@", "type": "AAAA", "content": "fd00::1", "ttl": 60}
+    ]
+  }]},
+  "setupKeys": [{"name": "servers", "expiresIn": 86400, "autoGroups": ["servers"]}]
+}`
+
+func TestParseManifestYAMLAndJSON(t *testing.T) {
+	t.Parallel()
+
+	fromYAML, err := parseManifest(manifestYAML)
+	require.NoError(t, err, "YAML")
+
+	fromJSON, err := parseManifest(manifestJSON)
+	require.NoError(t, err, "JSON")
+	assert.Equal(t, fromYAML, fromJSON, "YAML and JSON decode differently")
+
+	rule := fromYAML.Policies[0].Rules[0]
+	assert.Equal(t, "tcp", *rule.Protocol)
+	assert.Equal(t, []string{"admins"}, rule.Sources)
+	assert.Equal(t, []string{"22"}, rule.Ports)
+
+	record := fromYAML.DNS.Zones[0].Records[1]
+	assert.Equal(t, "@", record.Name)
+	assert.Equal(t, 60, *record.TTL)
+}
+
+func TestParseManifestErrors(t *testing.T) {
+	t.Parallel()
+
+	policies := `
+groups: [{name: a}, {name: b}]
+policies:
+  - {name: p0, rules: [{sources: [a], destinations: [b]}]}
+  - {name: p1, rules: [{sources: [a], destinations: [b]}]}
+  - name: p2
+    rules:
+      - sources: [a, x]
+        destinations: [b]
+`
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "unknown group in YAML",
+			text: policies,
+			want: []string{`manifest policies[2].rules[0].sources[1]: unknown group "x"`},
+		},
+		{
+			name: "unknown group in JSON",
+			text: `{"groups": [{"name": "a"}], "routes": [{"name": "r", "network": "10.0.0.0/8", "peer": "p",
+				"groups": ["a"], "accessControlGroups": ["a", "b"]}]}`,
+			want: []string{`manifest routes[0].accessControlGroups[1]: unknown group "b"`},
+		},
+		{
+			name: "unknown posture check",
+			text: "groups: [{name: a}]\npolicies: [{name: p, postureChecks: [geo], rules: [{sources: [a], destinations: [a]}]}]",
+			want: []string{`manifest policies[0].postureChecks[0]: unknown posture check "geo"`},
+		},
+		{
+			name: "unknown fields",
+			text: "groups: [{name: a, peer: p}]\nsetupkeys: []",
+			want: []string{`manifest groups[0].peer: unknown field "peer"`, `manifest setupkeys: unknown field "setupkeys"`},
+		},
+		{
+			name: "wrong shapes",
+			text: "groups: {name: a}\nsetupKeys: [{name: k, expiresIn: soon, ephemeral: maybe}]\ndns: []",
+			want: []string{
+				"manifest groups: expected a list",
+				`manifest setupKeys[0].expiresIn: expected an integer, got "soon"`,
+				`manifest setupKeys[0].ephemeral: expected true or false, got "maybe"`,
+				"manifest dns: expected an object",
+			},
+		},
+		{
+			name: "nested value where a string is expected",
+			text: "groups: [{name: [a]}]",
+			want: []string{"manifest groups[0].name: expected a string"},
+		},
+		{
+			name: "not an object",
+			text: "[groups]",
+			want: []string{"manifest (root): expected an object"},
+		},
+		{
+			name: "every problem at once",
+			text: `
+groups: [{name: a}, {name: a}, {}]
+networks:
+  - name: office
+    routers: [{peer: p, peerGroups: [a]}, {peerGroups: [z], metric: 0}]
+    resources: [{name: lan}]
+dns:
+  zones:
+    - {name: corp, domain: corp.internal, records: [{name: www, type: MX, content: mail}, {name: db, type: A, content: "::1"}]}
+setupKeys: [{name: k, type: once, expiresIn: -1}]
+`,
+			want: []string{
+				`manifest groups[1].name: duplicate name "a"`,
+				"manifest groups[2].name: is required",
+				"manifest networks[0].routers[0]: exactly one of peer and peerGroups must be set",
+				"manifest networks[0].routers[1].metric: must be between 1 and 9999, got 0",
+				`manifest networks[0].routers[1].peerGroups[0]: unknown group "z"`,
+				"manifest networks[0].resources[0].address: is required",
+				`manifest dns.zones[0].records[0].type: must be A, AAAA or CNAME, got "MX"`,
+				`manifest dns.zones[0].records[1].content: A record value "::1" is not an IPv4 address`,
+				`manifest setupKeys[0].type: must be reusable or one-off, got "once"`,
+				"manifest setupKeys[0].expiresIn: must be at least 0, got -1",
+			},
+		},
+		{
+			name: "policy without rules or groups",
+			text: "policies: [{name: p}, {name: q, rules: [{protocol: tcp, ports: ['22']}]}]",
+			want: []string{
+				"manifest policies[0].rules: at least one rule is required",
+				"manifest policies[1].rules[0].sources: at least one group is required",
+				"manifest policies[1].rules[0].destinations: at least one group is required",
+			},
+		},
+		{
+			name: "duplicate record",
+			text: "dns: {zones: [{name: z, domain: corp.internal, records: [" +
+				"{name: www, type: A, content: 10.0.0.1}, {name: www.corp.internal., type: A, content: 10.0.0.2}]}]}",
+			want: []string{"manifest dns.zones[0].records[1]: duplicate A record www.corp.internal"},
+		},
+		{name: "empty", text: "", want: []string{"manifest document is empty"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseManifest(tt.text)
+			require.Error(t, err)
+			assert.Equal(t, tt.want, strings.Split(err.Error(), "\n"))
+		})
+	}
+}
+
+func TestParseManifestInvalidSyntax(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseManifest(`{"groups": [`)
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "manifest is neither valid YAML nor JSON: "), "error = %v", err)
+}
+
+func TestManifestDanglingReferenceRegistersNothing(t *testing.T) {
+	t.Parallel()
+
+	// The dangling reference comes last, after every object that could be created.
+	document := strings.Replace(manifestYAML, "autoGroups: [servers]", "autoGroups: [servers, x]", 1)
+	mocks := newComponentMocks()
+
+	_, err := construct(t.Context(), mocks, (&Manifest{}).Construct, "acct", ManifestArgs{Document: document})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `manifest setupKeys[0].autoGroups[1]: unknown group "x"`)
+	assert.Empty(t, mocks.resources)
+}
+
+func TestManifestWiring(t *testing.T) {
+	t.Parallel()
+
+	mocks := newComponentMocks()
+
+	_, err := construct(t.Context(), mocks, (&Manifest{}).Construct, "acct", ManifestArgs{Document: manifestYAML})
+	require.NoError(t, err)
+
+	policy := mocks.find(t, "acct-policy-ssh").inputs
+	rule := policy["rules"].ArrayValue()[0].ObjectValue()
+	assert.Equal(t, []string{"acct-group-admins-id"}, stringInputs(rule["sources"]))
+	assert.Equal(t, []string{"acct-group-servers-id"}, stringInputs(rule["destinations"]))
+	assert.Equal(t, []string{"acct-posture-recent-id"}, stringInputs(policy["postureChecks"]))
+
+	router := mocks.find(t, "acct-network-office-router-groups-servers").inputs
+	assert.Equal(t, "acct-network-office-id", router["networkID"].StringValue())
+	assert.InDelta(t, 50, router["metric"].NumberValue(), 0)
+
+	wantRecords := []string{"acct-zone-corp-record-corp.internal-AAAA", "acct-zone-corp-record-www.corp.internal-A"}
+	assert.Equal(t, wantRecords, mocks.names(tokenDNSRecord))
+	assert.Equal(t, []string{"acct-group-servers-id"}, stringInputs(mocks.find(t, "acct-setup-key-servers").inputs["autoGroups"]))
+}
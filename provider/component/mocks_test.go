@@ -0,0 +1,194 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/internals"
+	"github.com/stretchr/testify/require"
+)
+
+// registeredResource is a resource registered with componentMocks. parent,
+// dependsOn and aliases hold resource names rather than URNs.
+type registeredResource struct {
+	typ       string
+	name      string
+	inputs    resource.PropertyMap
+	parent    string
+	dependsOn []string
+	aliases   []string
+}
+
+// componentMocks records the resources a component registers and answers
+// function invokes from canned results keyed by token. invoked holds the
+// arguments of the last invoke of each token.
+type componentMocks struct {
+	mu        sync.Mutex
+	resources []registeredResource
+	invokes   map[string]map[string]any
+	invoked   map[string]resource.PropertyMap
+}
+
+func newComponentMocks() *componentMocks {
+	return &componentMocks{
+		mu:        sync.Mutex{},
+		resources: nil,
+		invokes:   map[string]map[string]any{},
+		invoked:   map[string]resource.PropertyMap{},
+	}
+}
+
+// NewResource records the resource and echoes its inputs as outputs.
+func (m *componentMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	registered := registeredResource{
+		typ: args.TypeToken, name: args.Name, inputs: args.Inputs, parent: "", dependsOn: nil, aliases: nil,
+	}
+
+	if rpc := args.RegisterRPC; rpc != nil {
+		registered.parent = urnName(rpc.GetParent())
+
+		for _, dep := range rpc.GetDependencies() {
+			registered.dependsOn = append(registered.dependsOn, urnName(dep))
+		}
+
+		slices.Sort(registered.dependsOn)
+
+		for _, alias := range rpc.GetAliases() {
+			if alias.GetSpec() != nil {
+				registered.aliases = append(registered.aliases, alias.GetSpec().GetName())
+			} else {
+				registered.aliases = append(registered.aliases, urnName(alias.GetUrn()))
+			}
+		}
+
+		for _, alias := range rpc.GetAliasURNs() {
+			registered.aliases = append(registered.aliases, urnName(alias))
+		}
+	}
+
+	m.resources = append(m.resources, registered)
+
+	return args.Name + "-id", args.Inputs, nil
+}
+
+// Call returns the canned result for the invoked function.
+func (m *componentMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.invoked[args.Token] = args.Args
+
+	result, ok := m.invokes[args.Token]
+	if !ok {
+		return nil, fmt.Errorf("unexpected invoke of %s", args.Token)
+	}
+
+	return resource.NewPropertyMapFromMap(result), nil
+}
+
+// names returns the names of the registered resources of type typ, sorted.
+func (m *componentMocks) names(typ string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var names []string
+
+	for _, res := range m.resources {
+		if res.typ == typ {
+			names = append(names, res.name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// find returns the registered resource named name.
+func (m *componentMocks) find(t *testing.T, name string) registeredResource {
+	t.Helper()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.resources))
+
+	for _, res := range m.resources {
+		if res.name == name {
+			return res
+		}
+
+		names = append(names, res.name)
+	}
+
+	require.Failf(t, "resource not registered", "no resource named %s; registered: %s", name, strings.Join(names, ", "))
+
+	return registeredResource{} //nolint:exhaustruct
+}
+
+// constructor is the Construct method of a component.
+type constructor[A, S any] func(ctx *pulumi.Context, name, typ string, args A, opts pulumi.ResourceOption) (*S, error)
+
+// construct runs component as name with args against mocks and returns its
+// state. ctx is the program's context, so values such as the provider clock
+// reach Construct.
+func construct[A, S any](ctx context.Context, mocks *componentMocks, component constructor[A, S], name string, args A) (*S, error) {
+	pctx, err := pulumi.NewContext(ctx, pulumi.RunInfo{Project: "project", Stack: "stack", Mocks: mocks}) //nolint:exhaustruct
+	if err != nil {
+		return nil, err
+	}
+
+	defer pctx.Close()
+
+	typ := "netbird:index:" + strings.TrimSuffix(reflect.TypeFor[S]().Name(), "State")
+
+	var state *S
+
+	err = pulumi.RunWithContext(pctx, func(ctx *pulumi.Context) error {
+		state, err = component(ctx, name, typ, args, nil)
+
+		return err
+	})
+
+	return state, err
+}
+
+// await returns the resolved value of a component output.
+func await[T any](t *testing.T, output pulumi.Output) T {
+	t.Helper()
+
+	result, err := internals.UnsafeAwaitOutput(context.Background(), output)
+	require.NoError(t, err)
+
+	value, ok := result.Value.(T)
+	require.True(t, ok, "output value %#v is not a %T", result.Value, value)
+
+	return value
+}
+
+// urnName returns the resource name at the end of a URN.
+func urnName(urn string) string {
+	return urn[strings.LastIndex(urn, "::")+len("::"):]
+}
+
+// stringInputs returns the string values of an array input.
+func stringInputs(value resource.PropertyValue) []string {
+	var values []string
+	for _, item := range value.ArrayValue() {
+		values = append(values, item.StringValue())
+	}
+
+	return values
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -1,8 +1,12 @@
 package component
 
 import (
+	"errors"
 	"fmt"
+	"net/netip"
+	"slices"
 
+	"github.com/mbrav/pulumi-netbird/provider/resource"
 	"github.com/pulumi/pulumi-go-provider/infer"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -39,16 +43,76 @@ func (s *NetworkSubnetSpec) Annotate(a infer.Annotator) {
 	a.Describe(&s.Name, "Display name for the subnet resource.")
 	a.Describe(&s.Address, "CIDR block for the subnet (e.g. 10.10.1.0/24).")
 	a.Describe(&s.Enabled, "Whether the subnet resource is enabled.")
-	a.Describe(&s.GroupIDs, "Group IDs that have access to this subnet.")
+	a.Describe(&s.GroupIDs, "IDs of the groups the subnet resource belongs to. With access per group, "+
+		"each of these groups is the destination of a generated policy.")
 	a.Describe(&s.Description, "Optional description for the subnet resource.")
 }
 
+// NetworkResourceSpec holds configuration for a single domain or host resource in a NetworkBundle.
+type NetworkResourceSpec struct {
+	Name        string   `pulumi:"name"`
+	Address     string   `pulumi:"address"`
+	Enabled     bool     `pulumi:"enabled"`
+	GroupIDs    []string `pulumi:"groupIDs"`
+	Description *string  `pulumi:"description,optional"`
+}
+
+// Annotate adds schema descriptions to NetworkResourceSpec fields.
+func (r *NetworkResourceSpec) Annotate(a infer.Annotator) {
+	a.Describe(&r.Name, "Display name for the resource. Unique across the subnets, domains, and hosts of the bundle.")
+	a.Describe(&r.Address, "Domain name (e.g. api.example.com or *.example.com) for domains, or an IP address for hosts.")
+	a.Describe(&r.Enabled, "Whether the resource is enabled.")
+	a.Describe(&r.GroupIDs, "IDs of the groups the resource belongs to. With access per group, "+
+		"each of these groups is the destination of a generated policy.")
+	a.Describe(&r.Description, "Optional description for the resource.")
+}
+
+// NetworkAccessScope selects what a NetworkBundle generates one policy for.
+type NetworkAccessScope string
+
+const (
+	// NetworkAccessScopeResource generates one policy per network resource.
+	NetworkAccessScopeResource NetworkAccessScope = "resource"
+	// NetworkAccessScopeGroup generates one policy per resource group.
+	NetworkAccessScopeGroup NetworkAccessScope = "group"
+)
+
+// Values returns the valid enum values for NetworkAccessScope.
+func (NetworkAccessScope) Values() []infer.EnumValue[NetworkAccessScope] {
+	return []infer.EnumValue[NetworkAccessScope]{
+		{Name: "resource", Value: NetworkAccessScopeResource, Description: "One policy per resource, targeting the resource itself."},
+		{Name: "group", Value: NetworkAccessScopeGroup, Description: "One policy per group the resources are in."},
+	}
+}
+
+// NetworkAccessSpec holds the access policies generated by a NetworkBundle.
+type NetworkAccessSpec struct {
+	Sources    []string                 `pulumi:"sources"`
+	Per        *NetworkAccessScope      `pulumi:"per,optional"`
+	Protocol   *resource.Protocol       `pulumi:"protocol,optional"`
+	Ports      []string                 `pulumi:"ports,optional"`
+	PortRanges []resource.RulePortRange `pulumi:"portRanges,optional"`
+}
+
+// Annotate adds schema descriptions to NetworkAccessSpec fields.
+func (n *NetworkAccessSpec) Annotate(a infer.Annotator) {
+	a.Describe(&n.Sources, "IDs of the groups whose peers may reach the resources.")
+	a.Describe(&n.Per, "Whether to generate one policy per resource or per resource group. Defaults to resource.")
+	a.Describe(&n.Protocol, "Protocol to accept. Defaults to all.")
+	a.Describe(&n.Ports, "Ports to accept. Only valid with protocol tcp or udp.")
+	a.Describe(&n.PortRanges, "Port ranges to accept. Only valid with protocol tcp or udp.")
+}
+
 // NetworkBundleArgs are the inputs for a NetworkBundle component.
 type NetworkBundleArgs struct {
-	Name        string              `pulumi:"name"`
-	Description *string             `pulumi:"description,optional"`
-	Router      NetworkRouterSpec   `pulumi:"router"`
-	Subnets     []NetworkSubnetSpec `pulumi:"subnets"`
+	Name        string                `pulumi:"name"`
+	Description *string               `pulumi:"description,optional"`
+	Router      *NetworkRouterSpec    `pulumi:"router,optional"`
+	Routers     []NetworkRouterSpec   `pulumi:"routers,optional"`
+	Subnets     []NetworkSubnetSpec   `pulumi:"subnets,optional"`
+	Domains     []NetworkResourceSpec `pulumi:"domains,optional"`
+	Hosts       []NetworkResourceSpec `pulumi:"hosts,optional"`
+	Access      *NetworkAccessSpec    `pulumi:"access,optional"`
 }
 
 // Annotate adds schema descriptions to NetworkBundleArgs fields.
@@ -56,23 +120,33 @@ func (n *NetworkBundleArgs) Annotate(a infer.Annotator) {
 	a.Describe(&n.Name, "Name of the overlay network.")
 	a.Describe(&n.Description, "Optional description for the network.")
 	a.Describe(&n.Router, "Router configuration attached to the network.")
+	a.Describe(&n.Routers, "Additional routers attached to the network. At least one of router and routers is required.")
 	a.Describe(&n.Subnets, "Subnet resources to attach to the network.")
+	a.Describe(&n.Domains, "Domain resources to attach to the network.")
+	a.Describe(&n.Hosts, "Host resources to attach to the network.")
+	a.Describe(&n.Access, "Optional access policies to generate for the resources.")
 }
 
 // NetworkBundleState holds the outputs of a NetworkBundle component.
 type NetworkBundleState struct {
 	pulumi.ResourceState
 
-	NetworkID pulumi.StringOutput      `pulumi:"networkId"`
-	RouterID  pulumi.StringOutput      `pulumi:"routerId"`
-	SubnetIDs pulumi.StringArrayOutput `pulumi:"subnetIds"`
+	NetworkID   pulumi.StringOutput      `pulumi:"networkId"`
+	RouterID    pulumi.StringOutput      `pulumi:"routerId"`
+	RouterIDs   pulumi.StringArrayOutput `pulumi:"routerIds"`
+	SubnetIDs   pulumi.StringArrayOutput `pulumi:"subnetIds"`
+	ResourceIDs pulumi.StringMapOutput   `pulumi:"resourceIds"`
+	PolicyIDs   pulumi.StringMapOutput   `pulumi:"policyIds"`
 }
 
 // Annotate adds schema descriptions to NetworkBundleState fields.
 func (s *NetworkBundleState) Annotate(a infer.Annotator) {
 	a.Describe(&s.NetworkID, "ID of the created Network resource.")
-	a.Describe(&s.RouterID, "ID of the created NetworkRouter resource.")
+	a.Describe(&s.RouterID, "ID of the first created NetworkRouter resource.")
+	a.Describe(&s.RouterIDs, "IDs of the created NetworkRouter resources, router first, then routers in declaration order.")
 	a.Describe(&s.SubnetIDs, "IDs of the created NetworkResource (subnet) resources, in declaration order.")
+	a.Describe(&s.ResourceIDs, "IDs of all created NetworkResource resources, keyed by resource name.")
+	a.Describe(&s.PolicyIDs, "IDs of the generated Policy resources, keyed by resource name or, with access.per group, by group ID.")
 }
 
 // NetworkBundle is the ComponentResource anchor for the NetworkBundle component.
@@ -86,15 +160,32 @@ func (*NetworkBundle) Construct(
 	return newNetworkBundle(ctx, name, typ, args, opts)
 }
 
-func newNetworkBundle(
+// bundleResource is a network resource of a NetworkBundle, whatever its kind.
+type bundleResource struct {
+	kind     resource.Type
+	name     string
+	address  string
+	enabled  bool
+	groupIDs []string
+	desc     *string
+}
+
+func newNetworkBundle( //nolint:funlen
 	ctx *pulumi.Context,
 	name, typ string,
 	args NetworkBundleArgs,
 	opts ...pulumi.ResourceOption,
 ) (*NetworkBundleState, error) {
+	resources := bundleResources(args)
+
+	err := validateNetworkBundle(args, resources)
+	if err != nil {
+		return nil, err
+	}
+
 	comp := &NetworkBundleState{} //nolint:exhaustruct
 
-	err := ctx.RegisterComponentResource(typ, name, comp, opts...)
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("registering NetworkBundle component: %w", err)
 	}
@@ -113,72 +204,322 @@ func newNetworkBundle(
 		return nil, fmt.Errorf("creating Network: %w", err)
 	}
 
-	routerInputs := pulumi.Map{
-		"networkID":  net.ID().ToStringOutput(),
-		"enabled":    pulumi.Bool(args.Router.Enabled),
-		"masquerade": pulumi.Bool(args.Router.Masquerade),
-		"metric":     pulumi.Int(args.Router.Metric),
-	}
+	// The single router keeps its original child name, so existing bundles
+	// do not replace it.
+	routerNames := []string{}
+	routerSpecs := []NetworkRouterSpec{}
 
-	if args.Router.PeerGroups != nil {
-		peerGroups := make(pulumi.StringArray, len(*args.Router.PeerGroups))
-		for j, pg := range *args.Router.PeerGroups {
-			peerGroups[j] = pulumi.String(pg)
-		}
-
-		routerInputs["peerGroups"] = peerGroups
+	if args.Router != nil {
+		routerNames = append(routerNames, name+"-router")
+		routerSpecs = append(routerSpecs, *args.Router)
 	}
 
-	if args.Router.Peer != nil {
-		routerInputs["peer"] = pulumi.String(*args.Router.Peer)
+	for i, spec := range args.Routers {
+		routerNames = append(routerNames, fmt.Sprintf("%s-router-%d", name, i))
+		routerSpecs = append(routerSpecs, spec)
 	}
 
-	var router pulumi.CustomResourceState
+	routerIDs := make(pulumi.StringArray, len(routerSpecs))
 
-	err = ctx.RegisterResource(tokenNetworkRouter, name+"-router", routerInputs, &router, pulumi.Parent(comp))
-	if err != nil {
-		return nil, fmt.Errorf("creating NetworkRouter: %w", err)
+	for i, spec := range routerSpecs {
+		var routerID pulumi.StringOutput
+
+		routerID, err = newBundleRouter(ctx, routerNames[i], net.ID().ToStringOutput(), spec, comp)
+		if err != nil {
+			return nil, err
+		}
+
+		routerIDs[i] = routerID
 	}
 
-	subnetIDs := make(pulumi.StringArray, len(args.Subnets))
+	subnetIDs := pulumi.StringArray{}
+	resourceIDs := pulumi.StringMap{}
 
-	for subnetIdx, subnet := range args.Subnets {
-		groupIDs := make(pulumi.StringArray, len(subnet.GroupIDs))
-		for j, gid := range subnet.GroupIDs {
+	for _, res := range resources {
+		groupIDs := make(pulumi.StringArray, len(res.groupIDs))
+		for j, gid := range res.groupIDs {
 			groupIDs[j] = pulumi.String(gid)
 		}
 
-		subnetInputs := pulumi.Map{
-			"name":      pulumi.String(subnet.Name),
+		resourceInputs := pulumi.Map{
+			"name":      pulumi.String(res.name),
 			"networkID": net.ID().ToStringOutput(),
-			"address":   pulumi.String(subnet.Address),
-			"enabled":   pulumi.Bool(subnet.Enabled),
+			"address":   pulumi.String(res.address),
+			"enabled":   pulumi.Bool(res.enabled),
 			"groupIDs":  groupIDs,
 		}
 
-		if subnet.Description != nil {
-			subnetInputs["description"] = pulumi.String(*subnet.Description)
+		if res.desc != nil {
+			resourceInputs["description"] = pulumi.String(*res.desc)
 		}
 
 		var sub pulumi.CustomResourceState
 
 		err = ctx.RegisterResource(
 			tokenNetworkResource,
-			name+"-subnet-"+subnet.Name,
-			subnetInputs,
+			name+"-"+string(res.kind)+"-"+res.name,
+			resourceInputs,
 			&sub,
 			pulumi.Parent(comp),
 		)
 		if err != nil {
-			return nil, fmt.Errorf("creating NetworkResource %q: %w", subnet.Name, err)
+			return nil, fmt.Errorf("creating NetworkResource %q: %w", res.name, err)
+		}
+
+		if res.kind == resource.ResourceTypeSubnet {
+			subnetIDs = append(subnetIDs, sub.ID().ToStringOutput())
 		}
 
-		subnetIDs[subnetIdx] = sub.ID().ToStringOutput()
+		resourceIDs[res.name] = sub.ID().ToStringOutput()
+	}
+
+	policyIDs := pulumi.StringMap{}
+
+	if args.Access != nil {
+		policyIDs, err = newBundlePolicies(ctx, name, args.Name, *args.Access, resources, resourceIDs, comp)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	comp.NetworkID = net.ID().ToStringOutput()
-	comp.RouterID = router.ID().ToStringOutput()
+	comp.RouterID = routerIDs[0].ToStringOutput()
+	comp.RouterIDs = routerIDs.ToStringArrayOutput()
 	comp.SubnetIDs = subnetIDs.ToStringArrayOutput()
+	comp.ResourceIDs = resourceIDs.ToStringMapOutput()
+	comp.PolicyIDs = policyIDs.ToStringMapOutput()
 
 	return comp, nil
 }
+
+// newBundleRouter creates one NetworkRouter of a NetworkBundle and returns its ID.
+func newBundleRouter(
+	ctx *pulumi.Context,
+	name string,
+	networkID pulumi.StringOutput,
+	spec NetworkRouterSpec,
+	parent pulumi.Resource,
+) (pulumi.StringOutput, error) {
+	routerInputs := pulumi.Map{
+		"networkID":  networkID,
+		"enabled":    pulumi.Bool(spec.Enabled),
+		"masquerade": pulumi.Bool(spec.Masquerade),
+		"metric":     pulumi.Int(spec.Metric),
+	}
+
+	if spec.PeerGroups != nil {
+		peerGroups := make(pulumi.StringArray, len(*spec.PeerGroups))
+		for j, pg := range *spec.PeerGroups {
+			peerGroups[j] = pulumi.String(pg)
+		}
+
+		routerInputs["peerGroups"] = peerGroups
+	}
+
+	if spec.Peer != nil {
+		routerInputs["peer"] = pulumi.String(*spec.Peer)
+	}
+
+	var router pulumi.CustomResourceState
+
+	err := ctx.RegisterResource(tokenNetworkRouter, name, routerInputs, &router, pulumi.Parent(parent))
+	if err != nil {
+		return pulumi.StringOutput{}, fmt.Errorf("creating NetworkRouter: %w", err)
+	}
+
+	return router.ID().ToStringOutput(), nil
+}
+
+// newBundlePolicies creates the access policies of a NetworkBundle, one per
+// resource or one per resource group, and returns their IDs by key.
+func newBundlePolicies(
+	ctx *pulumi.Context,
+	name, bundleName string,
+	access NetworkAccessSpec,
+	resources []bundleResource,
+	resourceIDs pulumi.StringMap,
+	parent pulumi.Resource,
+) (pulumi.StringMap, error) {
+	protocol := resource.ProtocolAll
+	if access.Protocol != nil {
+		protocol = *access.Protocol
+	}
+
+	rule := func(policyName string) pulumi.Map {
+		inputs := pulumi.Map{
+			"name":          pulumi.String(policyName),
+			"action":        pulumi.String(string(resource.RuleActionAccept)),
+			"enabled":       pulumi.Bool(true),
+			"bidirectional": pulumi.Bool(false),
+			"protocol":      pulumi.String(string(protocol)),
+			"sources":       pulumi.ToStringArray(access.Sources),
+		}
+		if len(access.Ports) > 0 {
+			inputs["ports"] = pulumi.ToStringArray(access.Ports)
+		}
+
+		if len(access.PortRanges) > 0 {
+			inputs["portRanges"] = portRangeInputs(access.PortRanges)
+		}
+
+		return inputs
+	}
+
+	type bundlePolicy struct {
+		key  string
+		rule pulumi.Map
+	}
+
+	var policies []bundlePolicy
+
+	if access.Per != nil && *access.Per == NetworkAccessScopeGroup {
+		for _, gid := range bundleGroupIDs(resources) {
+			policyRule := rule(bundleName + "-" + gid)
+			policyRule["destinations"] = pulumi.StringArray{pulumi.String(gid)}
+			policies = append(policies, bundlePolicy{key: gid, rule: policyRule})
+		}
+	} else {
+		for _, res := range resources {
+			policyRule := rule(bundleName + "-" + res.name)
+			policyRule["destinationResource"] = pulumi.Map{
+				"id":   resourceIDs[res.name],
+				"type": pulumi.String(string(res.kind)),
+			}
+			policies = append(policies, bundlePolicy{key: res.name, rule: policyRule})
+		}
+	}
+
+	policyIDs := pulumi.StringMap{}
+
+	for _, policy := range policies {
+		var created pulumi.CustomResourceState
+
+		err := ctx.RegisterResource(tokenPolicy, name+"-policy-"+policy.key, pulumi.Map{
+			"name":    policy.rule["name"],
+			"enabled": pulumi.Bool(true),
+			"rules":   pulumi.Array{policy.rule},
+		}, &created, pulumi.Parent(parent))
+		if err != nil {
+			return nil, fmt.Errorf("creating Policy for %s: %w", policy.key, err)
+		}
+
+		policyIDs[policy.key] = created.ID().ToStringOutput()
+	}
+
+	return policyIDs, nil
+}
+
+// bundleResources lists the subnets, domains, and hosts of a bundle in
+// declaration order.
+func bundleResources(args NetworkBundleArgs) []bundleResource {
+	resources := make([]bundleResource, 0, len(args.Subnets)+len(args.Domains)+len(args.Hosts))
+
+	for _, subnet := range args.Subnets {
+		resources = append(resources, bundleResource{
+			kind:     resource.ResourceTypeSubnet,
+			name:     subnet.Name,
+			address:  subnet.Address,
+			enabled:  subnet.Enabled,
+			groupIDs: subnet.GroupIDs,
+			desc:     subnet.Description,
+		})
+	}
+
+	for _, domain := range args.Domains {
+		resources = append(resources, bundleResource{
+			kind:     resource.ResourceTypeDomain,
+			name:     domain.Name,
+			address:  domain.Address,
+			enabled:  domain.Enabled,
+			groupIDs: domain.GroupIDs,
+			desc:     domain.Description,
+		})
+	}
+
+	for _, host := range args.Hosts {
+		resources = append(resources, bundleResource{
+			kind:     resource.ResourceTypeHost,
+			name:     host.Name,
+			address:  host.Address,
+			enabled:  host.Enabled,
+			groupIDs: host.GroupIDs,
+			desc:     host.Description,
+		})
+	}
+
+	return resources
+}
+
+// bundleGroupIDs returns the distinct groups of the resources, in order of
+// first appearance.
+func bundleGroupIDs(resources []bundleResource) []string {
+	var groupIDs []string
+
+	for _, res := range resources {
+		for _, gid := range res.groupIDs {
+			if !slices.Contains(groupIDs, gid) {
+				groupIDs = append(groupIDs, gid)
+			}
+		}
+	}
+
+	return groupIDs
+}
+
+// validateNetworkBundle rejects bundles that cannot be deployed.
+func validateNetworkBundle(args NetworkBundleArgs, resources []bundleResource) error {
+	if args.Router == nil && len(args.Routers) == 0 {
+		return errors.New("NetworkBundle requires router or routers")
+	}
+
+	seen := map[string]bool{}
+
+	for _, res := range resources {
+		if seen[res.name] {
+			return fmt.Errorf("duplicate NetworkBundle resource name %q", res.name)
+		}
+
+		seen[res.name] = true
+
+		switch res.kind {
+		case resource.ResourceTypeHost:
+			if _, err := netip.ParseAddr(res.address); err != nil {
+				return fmt.Errorf("host %q: address %q is not an IP address", res.name, res.address)
+			}
+		case resource.ResourceTypeDomain:
+			if _, err := netip.ParseAddr(res.address); err == nil {
+				return fmt.Errorf("domain %q: address %q is an IP address; use hosts instead", res.name, res.address)
+			}
+
+			if _, err := netip.ParsePrefix(res.address); err == nil {
+				return fmt.Errorf("domain %q: address %q is a CIDR; use subnets instead", res.name, res.address)
+			}
+		case resource.ResourceTypeSubnet:
+		}
+	}
+
+	if args.Access == nil {
+		return nil
+	}
+
+	if len(args.Access.Sources) == 0 {
+		return errors.New("access requires at least one source group")
+	}
+
+	if len(resources) == 0 {
+		return errors.New("access requires at least one subnet, domain, or host")
+	}
+
+	// Per-group policies target the resource groups, so without any there
+	// would be nothing to generate.
+	if args.Access.Per != nil && *args.Access.Per == NetworkAccessScopeGroup && len(bundleGroupIDs(resources)) == 0 {
+		return errors.New("access per group requires at least one resource with groupIDs")
+	}
+
+	protocol := resource.ProtocolAll
+	if args.Access.Protocol != nil {
+		protocol = *args.Access.Protocol
+	}
+
+	return validateRulePorts(protocol, args.Access.Ports, args.Access.PortRanges)
+}
@@ -0,0 +1,220 @@
+package component
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func networkBundleArgs() NetworkBundleArgs {
+	return NetworkBundleArgs{
+		Name:        "office",
+		Description: nil,
+		Router:      &NetworkRouterSpec{Enabled: true, Masquerade: true, Metric: 100, PeerGroups: &[]string{"routers"}, Peer: nil},
+		Routers:     nil,
+		Subnets: []NetworkSubnetSpec{
+			{Name: "lan", Address: "10.0.0.0/24", Enabled: true, GroupIDs: []string{"g-lan"}, Description: nil},
+			{Name: "voip", Address: "10.0.1.0/24", Enabled: true, GroupIDs: []string{"g-lan"}, Description: nil},
+		},
+		Domains: []NetworkResourceSpec{
+			{Name: "wiki", Address: "wiki.example.com", Enabled: true, GroupIDs: []string{"g-web"}, Description: nil},
+		},
+		Hosts: []NetworkResourceSpec{
+			{Name: "nas", Address: "10.0.0.10", Enabled: true, GroupIDs: []string{"g-lan"}, Description: nil},
+		},
+		Access: nil,
+	}
+}
+
+// TestNetworkBundleSingleRouterCompatibility checks that a bundle written
+// against the original schema keeps its child names and its routerId and
+// subnetIds outputs.
+func TestNetworkBundleSingleRouterCompatibility(t *testing.T) {
+	t.Parallel()
+
+	args := networkBundleArgs()
+	args.Domains, args.Hosts = nil, nil
+
+	mocks := newComponentMocks()
+
+	state, err := construct(t.Context(), mocks, (&NetworkBundle{}).Construct, "bundle", args)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bundle-router"}, mocks.names(tokenNetworkRouter))
+	assert.Equal(t, []string{"bundle-subnet-lan", "bundle-subnet-voip"}, mocks.names(tokenNetworkResource))
+	assert.Equal(t, "bundle-router-id", await[string](t, state.RouterID))
+	assert.Equal(t, []string{"bundle-subnet-lan-id", "bundle-subnet-voip-id"}, await[[]string](t, state.SubnetIDs))
+}
+
+func TestNetworkBundleOutputs(t *testing.T) {
+	t.Parallel()
+
+	args := networkBundleArgs()
+	args.Routers = []NetworkRouterSpec{{Enabled: true, Masquerade: false, Metric: 200, PeerGroups: nil, Peer: ptr("peer-2")}}
+	args.Access = &NetworkAccessSpec{Sources: []string{"staff"}, Per: nil, Protocol: nil, Ports: nil, PortRanges: nil}
+
+	mocks := newComponentMocks()
+
+	state, err := construct(t.Context(), mocks, (&NetworkBundle{}).Construct, "bundle", args)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bundle-router-id", "bundle-router-0-id"}, await[[]string](t, state.RouterIDs))
+	assert.Equal(t, "bundle-router-id", await[string](t, state.RouterID), "routerId is the first router")
+
+	// Domains and hosts are not subnets.
+	assert.Equal(t, []string{"bundle-subnet-lan-id", "bundle-subnet-voip-id"}, await[[]string](t, state.SubnetIDs))
+
+	wantResources := map[string]string{
+		"lan":  "bundle-subnet-lan-id",
+		"voip": "bundle-subnet-voip-id",
+		"wiki": "bundle-domain-wiki-id",
+		"nas":  "bundle-host-nas-id",
+	}
+	assert.Equal(t, wantResources, await[map[string]string](t, state.ResourceIDs))
+
+	wantPolicies := map[string]string{
+		"lan":  "bundle-policy-lan-id",
+		"voip": "bundle-policy-voip-id",
+		"wiki": "bundle-policy-wiki-id",
+		"nas":  "bundle-policy-nas-id",
+	}
+	assert.Equal(t, wantPolicies, await[map[string]string](t, state.PolicyIDs))
+
+	rule := mocks.find(t, "bundle-policy-wiki").inputs["rules"].ArrayValue()[0].ObjectValue()
+	destination := rule["destinationResource"].ObjectValue()
+	assert.Equal(t, "bundle-domain-wiki-id", destination["id"].StringValue())
+	assert.Equal(t, "domain", destination["type"].StringValue())
+}
+
+func TestNetworkBundlePolicyPerGroup(t *testing.T) {
+	t.Parallel()
+
+	args := networkBundleArgs()
+	args.Access = &NetworkAccessSpec{
+		Sources:    []string{"staff"},
+		Per:        ptr(NetworkAccessScopeGroup),
+		Protocol:   ptr(resource.ProtocolTCP),
+		Ports:      []string{"443"},
+		PortRanges: nil,
+	}
+
+	mocks := newComponentMocks()
+
+	state, err := construct(t.Context(), mocks, (&NetworkBundle{}).Construct, "bundle", args)
+	require.NoError(t, err)
+
+	want := map[string]string{"g-lan": "bundle-policy-g-lan-id", "g-web": "bundle-policy-g-web-id"}
+	assert.Equal(t, want, await[map[string]string](t, state.PolicyIDs))
+
+	rule := mocks.find(t, "bundle-policy-g-web").inputs["rules"].ArrayValue()[0].ObjectValue()
+	assert.Equal(t, []string{"g-web"}, stringInputs(rule["destinations"]))
+}
+
+// TestNetworkBundleReorderKeepsChildren checks that resources and their
+// policies are keyed by name, so reordering them keeps every child name.
+func TestNetworkBundleReorderKeepsChildren(t *testing.T) {
+	t.Parallel()
+
+	args := networkBundleArgs()
+	args.Access = &NetworkAccessSpec{Sources: []string{"staff"}, Per: nil, Protocol: nil, Ports: nil, PortRanges: nil}
+	before := newComponentMocks()
+
+	_, err := construct(t.Context(), before, (&NetworkBundle{}).Construct, "bundle", args)
+	require.NoError(t, err)
+
+	args.Subnets = slices.Clone(args.Subnets)
+	slices.Reverse(args.Subnets)
+	after := newComponentMocks()
+
+	_, err = construct(t.Context(), after, (&NetworkBundle{}).Construct, "bundle", args)
+	require.NoError(t, err)
+
+	for _, typ := range []string{tokenNetworkResource, tokenPolicy} {
+		assert.Equal(t, before.names(typ), after.names(typ), "%s children after reordering", typ)
+	}
+}
+
+func TestValidateNetworkBundle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		mutate func(args *NetworkBundleArgs)
+		errMsg string
+	}{
+		{name: "valid", mutate: func(*NetworkBundleArgs) {}},
+		{
+			name:   "routers only",
+			mutate: func(args *NetworkBundleArgs) { args.Routers, args.Router = []NetworkRouterSpec{*args.Router}, nil },
+		},
+		{name: "no router", mutate: func(args *NetworkBundleArgs) { args.Router = nil }, errMsg: "requires router or routers"},
+		{
+			name:   "name shared by a subnet and a host",
+			mutate: func(args *NetworkBundleArgs) { args.Hosts[0].Name = "lan" },
+			errMsg: `duplicate NetworkBundle resource name "lan"`,
+		},
+		{
+			name:   "host name",
+			mutate: func(args *NetworkBundleArgs) { args.Hosts[0].Address = "nas.example.com" },
+			errMsg: `host "nas": address "nas.example.com" is not an IP address`,
+		},
+		{
+			name:   "domain IP",
+			mutate: func(args *NetworkBundleArgs) { args.Domains[0].Address = "10.0.0.20" },
+			errMsg: `domain "wiki": address "10.0.0.20" is an IP address; use hosts instead`,
+		},
+		{
+			name:   "domain CIDR",
+			mutate: func(args *NetworkBundleArgs) { args.Domains[0].Address = "10.0.2.0/24" },
+			errMsg: `domain "wiki": address "10.0.2.0/24" is a CIDR; use subnets instead`,
+		},
+		{
+			name: "access without sources",
+			mutate: func(args *NetworkBundleArgs) {
+				args.Access = &NetworkAccessSpec{Sources: nil, Per: nil, Protocol: nil, Ports: nil, PortRanges: nil}
+			},
+			errMsg: "access requires at least one source group",
+		},
+		{
+			name: "access without resources",
+			mutate: func(args *NetworkBundleArgs) {
+				args.Subnets, args.Domains, args.Hosts = nil, nil, nil
+				args.Access = &NetworkAccessSpec{Sources: []string{"staff"}, Per: nil, Protocol: nil, Ports: nil, PortRanges: nil}
+			},
+			errMsg: "access requires at least one subnet, domain, or host",
+		},
+		{
+			name: "access per group without resource groups",
+			mutate: func(args *NetworkBundleArgs) {
+				args.Subnets, args.Domains, args.Hosts = []NetworkSubnetSpec{{
+					Name: "lan", Address: "10.0.0.0/24", Enabled: true, GroupIDs: nil, Description: nil,
+				}}, nil, nil
+				per := NetworkAccessScopeGroup
+				args.Access = &NetworkAccessSpec{Sources: []string{"staff"}, Per: &per, Protocol: nil, Ports: nil, PortRanges: nil}
+			},
+			errMsg: "access per group requires at least one resource with groupIDs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := networkBundleArgs()
+			args.Hosts = slices.Clone(args.Hosts)
+			args.Domains = slices.Clone(args.Domains)
+			tt.mutate(&args)
+
+			err := validateNetworkBundle(args, bundleResources(args))
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
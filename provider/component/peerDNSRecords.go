@@ -0,0 +1,298 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mbrav/pulumi-netbird/provider/function"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// peerDNSPlaceholder matches a {{placeholder}} of a PeerDNSRecords name template.
+var peerDNSPlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z]+)\s*\}\}`) //nolint:gochecknoglobals
+
+// peerDNSInvalidLabelChars matches the characters not allowed in a DNS label.
+var peerDNSInvalidLabelChars = regexp.MustCompile(`[^a-z0-9-]+`) //nolint:gochecknoglobals
+
+// PeerDNSRecordsArgs are the inputs for a PeerDNSRecords component.
+type PeerDNSRecordsArgs struct {
+	ZoneID       string `pulumi:"zoneId"`
+	GroupID      string `pulumi:"groupId"`
+	NameTemplate string `pulumi:"nameTemplate"`
+	TTL          *int   `pulumi:"ttl,optional"`
+	IPv6         *bool  `pulumi:"ipv6,optional"`
+}
+
+// Annotate adds schema descriptions to PeerDNSRecordsArgs fields.
+func (p *PeerDNSRecordsArgs) Annotate(a infer.Annotator) {
+	a.Describe(&p.ZoneID, "ID of the DNS zone the records are created in.")
+	a.Describe(&p.GroupID, "ID of the group whose peers get a record. Membership is resolved on every update, "+
+		"so peers joining or leaving the group add or remove records.")
+	a.Describe(&p.NameTemplate, "Record name template, e.g. {{hostname}}.corp.internal or {{dnsLabel}}. "+
+		"Supports {{hostname}}, {{dnsLabel}} (the peer's NetBird DNS label without the account domain), and {{name}}. "+
+		"Each value is turned into a single DNS label. Names outside the zone domain are taken relative to it.")
+	a.Describe(&p.TTL, "Time-to-live of the records in seconds. Defaults to 300.")
+	a.Describe(&p.IPv6, "Whether to also create AAAA records for peers with an IPv6 overlay address. Defaults to true.")
+}
+
+// PeerDNSRecordsState holds the outputs of a PeerDNSRecords component.
+type PeerDNSRecordsState struct {
+	pulumi.ResourceState
+
+	Names     pulumi.StringMapOutput `pulumi:"names"`
+	RecordIDs pulumi.StringMapOutput `pulumi:"recordIds"`
+}
+
+// Annotate adds schema descriptions to PeerDNSRecordsState fields.
+func (s *PeerDNSRecordsState) Annotate(a infer.Annotator) {
+	a.Describe(&s.Names, "Record name of each peer of the group, keyed by peer ID.")
+	a.Describe(&s.RecordIDs, "ID of each created DNSRecord, keyed by record name and type (e.g. db1.corp.internal/A).")
+}
+
+// PeerDNSRecords is the ComponentResource anchor for the PeerDNSRecords component.
+type PeerDNSRecords struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*PeerDNSRecords) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args PeerDNSRecordsArgs, opts pulumi.ResourceOption,
+) (*PeerDNSRecordsState, error) {
+	return newPeerDNSRecords(ctx, name, typ, args, opts)
+}
+
+func newPeerDNSRecords(
+	ctx *pulumi.Context,
+	name, typ string,
+	args PeerDNSRecordsArgs,
+	opts ...pulumi.ResourceOption,
+) (*PeerDNSRecordsState, error) {
+	err := validatePeerDNSRecords(args)
+	if err != nil {
+		return nil, err
+	}
+
+	records, names, err := resolvePeerDNSRecords(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &PeerDNSRecordsState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering PeerDNSRecords component: %w", err)
+	}
+
+	recordIDs := pulumi.StringMap{}
+
+	for _, rec := range records {
+		key := rec.Name + "/" + rec.Type
+
+		var record pulumi.CustomResourceState
+
+		// Records are keyed by name and type rather than by peer position, so
+		// a peer joining or leaving only adds or removes its own records.
+		err = ctx.RegisterResource(tokenDNSRecord, name+"-"+rec.Name+"-"+rec.Type, pulumi.Map{
+			"zoneID":  pulumi.String(args.ZoneID),
+			"name":    pulumi.String(rec.Name),
+			"type":    pulumi.String(rec.Type),
+			"content": pulumi.String(rec.Content),
+			"ttl":     pulumi.Int(rec.TTL),
+		}, &record, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating DNSRecord %s: %w", key, err)
+		}
+
+		recordIDs[key] = record.ID().ToStringOutput()
+	}
+
+	comp.Names = pulumi.ToStringMap(names).ToStringMapOutput()
+	comp.RecordIDs = recordIDs.ToStringMapOutput()
+
+	return comp, nil
+}
+
+// validatePeerDNSRecords rejects inputs that cannot be deployed.
+func validatePeerDNSRecords(args PeerDNSRecordsArgs) error {
+	if args.ZoneID == "" {
+		return errors.New("PeerDNSRecords requires a zoneId")
+	}
+
+	if args.GroupID == "" {
+		return errors.New("PeerDNSRecords requires a groupId")
+	}
+
+	if args.TTL != nil && *args.TTL < 1 {
+		return fmt.Errorf("ttl must be at least 1, got %d", *args.TTL)
+	}
+
+	if !peerDNSPlaceholder.MatchString(args.NameTemplate) {
+		return fmt.Errorf("nameTemplate %q contains no placeholder, so all peers would share one name", args.NameTemplate)
+	}
+
+	for _, match := range peerDNSPlaceholder.FindAllStringSubmatch(args.NameTemplate, -1) {
+		_, err := peerDNSPlaceholderValue(match[1], function.PeerSummary{}) //nolint:exhaustruct
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// peerDNSPeersArgs are the getPeers arguments of PeerDNSRecords. The Go SDK
+// skips embedded structs when marshaling invoke arguments, so the groupId
+// filter of function.GetPeersArgs would not be sent.
+type peerDNSPeersArgs struct {
+	GroupID string `pulumi:"groupId"`
+}
+
+// peerDNSZonesResult is the part of the getDNSZones result PeerDNSRecords
+// reads. function.DNSZoneSummary embeds the zone state, whose fields the Go
+// SDK would leave empty when unmarshaling the result.
+type peerDNSZonesResult struct {
+	Zones []peerDNSZone `pulumi:"zones"`
+}
+
+// peerDNSZone is a DNS zone listed by getDNSZones.
+type peerDNSZone struct {
+	ID     string `pulumi:"id"`
+	Domain string `pulumi:"domain"`
+}
+
+// resolvePeerDNSRecords lists the peers of the group and returns their
+// records, sorted by name and type, and the record name of each peer.
+func resolvePeerDNSRecords(ctx *pulumi.Context, args PeerDNSRecordsArgs) ([]DNSRecordSpec, map[string]string, error) {
+	var zones peerDNSZonesResult
+
+	err := ctx.Invoke(tokenGetDNSZones, function.GetDNSZonesArgs{
+		ListArgs: function.ListArgs{NameRegex: nil, GroupID: nil, Enabled: nil},
+	}, &zones)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing DNS zones failed: %w", err)
+	}
+
+	zoneIndex := slices.IndexFunc(zones.Zones, func(z peerDNSZone) bool { return z.ID == args.ZoneID })
+	if zoneIndex < 0 {
+		return nil, nil, fmt.Errorf("DNS zone %s not found", args.ZoneID)
+	}
+
+	domain := strings.ToLower(strings.TrimSuffix(zones.Zones[zoneIndex].Domain, "."))
+
+	var peers function.GetPeersResult
+
+	err = ctx.Invoke(tokenGetPeers, peerDNSPeersArgs{GroupID: args.GroupID}, &peers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing peers of group %s failed: %w", args.GroupID, err)
+	}
+
+	ttl := defaultRecordTTL
+	if args.TTL != nil {
+		ttl = *args.TTL
+	}
+
+	ipv6 := args.IPv6 == nil || *args.IPv6
+	names := map[string]string{}
+	owners := map[string]string{}
+
+	var records []DNSRecordSpec
+
+	for _, peer := range peers.Peers {
+		recordName, err := renderPeerDNSName(args.NameTemplate, domain, peer)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if owner, taken := owners[recordName]; taken {
+			return nil, nil, fmt.Errorf("peers %s and %s both map to record name %s; use a more specific nameTemplate",
+				owner, peer.ID, recordName)
+		}
+
+		owners[recordName] = peer.ID
+		names[peer.ID] = recordName
+
+		records = append(records, DNSRecordSpec{
+			Name:    recordName,
+			Type:    string(resource.DNSRecordTypeA),
+			Content: peer.IP,
+			TTL:     ttl,
+		})
+
+		if !ipv6 || peer.IPv6 == nil || *peer.IPv6 == "" {
+			continue
+		}
+
+		addr, err := netip.ParseAddr(*peer.IPv6)
+		if err != nil {
+			return nil, nil, fmt.Errorf("peer %s has an invalid IPv6 address %q: %w", peer.ID, *peer.IPv6, err)
+		}
+
+		records = append(records, DNSRecordSpec{
+			Name:    recordName,
+			Type:    string(resource.DNSRecordTypeAAAA),
+			Content: addr.String(),
+			TTL:     ttl,
+		})
+	}
+
+	// Sorting keeps the registration order independent of the API's peer order.
+	slices.SortFunc(records, func(a, b DNSRecordSpec) int {
+		return strings.Compare(a.Name+"/"+a.Type, b.Name+"/"+b.Type)
+	})
+
+	return records, names, nil
+}
+
+// renderPeerDNSName fills the name template for a peer and makes the result a
+// fully qualified name within the zone domain.
+func renderPeerDNSName(template, domain string, peer function.PeerSummary) (string, error) {
+	var renderErr error
+
+	rendered := peerDNSPlaceholder.ReplaceAllStringFunc(template, func(match string) string {
+		value, err := peerDNSPlaceholderValue(peerDNSPlaceholder.FindStringSubmatch(match)[1], peer)
+		if err != nil {
+			renderErr = err
+
+			return ""
+		}
+
+		label := strings.Trim(peerDNSInvalidLabelChars.ReplaceAllString(strings.ToLower(value), "-"), "-")
+		if label == "" {
+			renderErr = fmt.Errorf("peer %s has no usable value for %s", peer.ID, match)
+		}
+
+		return label
+	})
+	if renderErr != nil {
+		return "", renderErr
+	}
+
+	rendered = strings.ToLower(strings.TrimSuffix(rendered, "."))
+	if rendered != domain && !strings.HasSuffix(rendered, "."+domain) {
+		rendered += "." + domain
+	}
+
+	return rendered, nil
+}
+
+// peerDNSPlaceholderValue returns the peer value a template placeholder stands for.
+func peerDNSPlaceholderValue(placeholder string, peer function.PeerSummary) (string, error) {
+	switch placeholder {
+	case "hostname":
+		return peer.Hostname, nil
+	case "dnsLabel":
+		label, _, _ := strings.Cut(peer.DNSLabel, ".")
+
+		return label, nil
+	case "name":
+		return peer.Name, nil
+	default:
+		return "", fmt.Errorf("unknown nameTemplate placeholder {{%s}}; use hostname, dnsLabel, or name", placeholder)
+	}
+}
@@ -0,0 +1,173 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func peerDNSRecordsArgs() PeerDNSRecordsArgs {
+	return PeerDNSRecordsArgs{ZoneID: "zone-1", GroupID: "g-db", NameTemplate: "{{hostname}}", TTL: nil, IPv6: nil}
+}
+
+func dnsPeer(id, hostname, ip, ipv6 string) map[string]any {
+	peer := map[string]any{
+		"id": id, "name": id, "ip": ip, "dnsLabel": hostname + ".netbird.cloud",
+		"hostname": hostname, "connected": true, "groups": []any{"g-db"},
+	}
+	if ipv6 != "" {
+		peer["ipv6"] = ipv6
+	}
+
+	return peer
+}
+
+// peerDNSRecordsMocks returns mocks whose getPeers invoke lists peers as the
+// group members.
+func peerDNSRecordsMocks(peers ...map[string]any) *componentMocks {
+	members := make([]any, 0, len(peers))
+	for _, peer := range peers {
+		members = append(members, peer)
+	}
+
+	mocks := newComponentMocks()
+	mocks.invokes[tokenGetDNSZones] = map[string]any{"zones": []any{
+		map[string]any{"id": "zone-0", "domain": "other.internal"},
+		map[string]any{"id": "zone-1", "domain": "Corp.Internal."},
+	}}
+	mocks.invokes[tokenGetPeers] = map[string]any{"peers": members}
+
+	return mocks
+}
+
+func TestPeerDNSRecordsMembership(t *testing.T) {
+	t.Parallel()
+
+	args := peerDNSRecordsArgs()
+	db1 := dnsPeer("p1", "db1", "100.64.0.1", "fd00::1")
+	db2 := dnsPeer("p2", "db2", "100.64.0.2", "")
+	db3 := dnsPeer("p3", "DB_3", "100.64.0.3", "")
+
+	before := peerDNSRecordsMocks(db1, db2)
+
+	state, err := construct(t.Context(), before, (&PeerDNSRecords{}).Construct, "db", args)
+	require.NoError(t, err)
+	assert.Equal(t, "g-db", before.invoked[tokenGetPeers]["groupId"].StringValue())
+
+	want := []string{"db-db1.corp.internal-A", "db-db1.corp.internal-AAAA", "db-db2.corp.internal-A"}
+	require.Equal(t, want, before.names(tokenDNSRecord))
+	assert.Equal(t, map[string]string{"p1": "db1.corp.internal", "p2": "db2.corp.internal"}, await[map[string]string](t, state.Names))
+
+	aaaa := before.find(t, "db-db1.corp.internal-AAAA").inputs
+	assert.Equal(t, "fd00::1", aaaa["content"].StringValue())
+	assert.Equal(t, "zone-1", aaaa["zoneID"].StringValue())
+	assert.InDelta(t, 300, aaaa["ttl"].NumberValue(), 0)
+
+	// db2 leaves and db3 joins; the API also returns the peers in another order.
+	after := peerDNSRecordsMocks(db3, db1)
+
+	state, err = construct(t.Context(), after, (&PeerDNSRecords{}).Construct, "db", args)
+	require.NoError(t, err)
+
+	want = []string{"db-db-3.corp.internal-A", "db-db1.corp.internal-A", "db-db1.corp.internal-AAAA"}
+	assert.Equal(t, want, after.names(tokenDNSRecord), "records after the change")
+	assert.Equal(t, map[string]string{"p1": "db1.corp.internal", "p3": "db-3.corp.internal"}, await[map[string]string](t, state.Names))
+
+	// An empty group keeps the component but removes every record.
+	empty := peerDNSRecordsMocks()
+
+	state, err = construct(t.Context(), empty, (&PeerDNSRecords{}).Construct, "db", args)
+	require.NoError(t, err)
+	assert.Empty(t, empty.names(tokenDNSRecord))
+	assert.Empty(t, await[map[string]string](t, state.Names))
+}
+
+func TestPeerDNSRecordsTemplates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "relative to the zone", template: "{{hostname}}.db", want: "db1.db.corp.internal"},
+		{name: "absolute in the zone", template: "{{ hostname }}.Corp.Internal.", want: "db1.corp.internal"},
+		{name: "dns label", template: "{{dnsLabel}}", want: "db1.corp.internal"},
+		{name: "peer name", template: "peer-{{name}}", want: "peer-p1.corp.internal"},
+		{name: "outside the zone", template: "{{hostname}}.example.com", want: "db1.example.com.corp.internal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := peerDNSRecordsArgs()
+			args.NameTemplate = tt.template
+			args.IPv6, args.TTL = ptr(false), ptr(60)
+
+			mocks := peerDNSRecordsMocks(dnsPeer("p1", "db1", "100.64.0.1", "fd00::1"))
+
+			state, err := construct(t.Context(), mocks, (&PeerDNSRecords{}).Construct, "db", args)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"p1": tt.want}, await[map[string]string](t, state.Names))
+			assert.Equal(t, []string{"db-" + tt.want + "-A"}, mocks.names(tokenDNSRecord), "only the A record")
+		})
+	}
+}
+
+func TestPeerDNSRecordsErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		mutate func(args *PeerDNSRecordsArgs)
+		peers  []map[string]any
+		errMsg string
+	}{
+		{
+			name:   "no placeholder",
+			mutate: func(args *PeerDNSRecordsArgs) { args.NameTemplate = "db.corp.internal" },
+			errMsg: `nameTemplate "db.corp.internal" contains no placeholder`,
+		},
+		{
+			name:   "unknown placeholder",
+			mutate: func(args *PeerDNSRecordsArgs) { args.NameTemplate = "{{ip}}" },
+			errMsg: "unknown nameTemplate placeholder {{ip}}",
+		},
+		{name: "zero TTL", mutate: func(args *PeerDNSRecordsArgs) { args.TTL = ptr(0) }, errMsg: "ttl must be at least 1, got 0"},
+		{
+			name:   "unknown zone",
+			mutate: func(args *PeerDNSRecordsArgs) { args.ZoneID = "zone-9" },
+			errMsg: "DNS zone zone-9 not found",
+		},
+		{
+			name:   "peers sharing a name",
+			mutate: func(*PeerDNSRecordsArgs) {},
+			peers:  []map[string]any{dnsPeer("p1", "db", "100.64.0.1", ""), dnsPeer("p2", "DB", "100.64.0.2", "")},
+			errMsg: "peers p1 and p2 both map to record name db.corp.internal",
+		},
+		{
+			name:   "peer without a usable hostname",
+			mutate: func(*PeerDNSRecordsArgs) {},
+			peers:  []map[string]any{dnsPeer("p1", "--", "100.64.0.1", "")},
+			errMsg: "peer p1 has no usable value for {{hostname}}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := peerDNSRecordsArgs()
+			tt.mutate(&args)
+
+			mocks := peerDNSRecordsMocks(tt.peers...)
+
+			_, err := construct(t.Context(), mocks, (&PeerDNSRecords{}).Construct, "db", args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+			assert.Empty(t, mocks.names(tokenDNSRecord))
+		})
+	}
+}
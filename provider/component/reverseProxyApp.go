@@ -0,0 +1,379 @@
+package component
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/mbrav/pulumi-netbird/provider/function"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// ReverseProxyAppAuthPreset selects the authentication of a ReverseProxyApp.
+type ReverseProxyAppAuthPreset string
+
+const (
+	// ReverseProxyAppAuthBearer requires NetBird SSO, optionally limited to groups.
+	ReverseProxyAppAuthBearer ReverseProxyAppAuthPreset = "bearer"
+	// ReverseProxyAppAuthPIN requires a PIN.
+	ReverseProxyAppAuthPIN ReverseProxyAppAuthPreset = "pin"
+	// ReverseProxyAppAuthPassword requires a password.
+	ReverseProxyAppAuthPassword ReverseProxyAppAuthPreset = "password"
+)
+
+// Values returns the valid enum values for ReverseProxyAppAuthPreset.
+func (ReverseProxyAppAuthPreset) Values() []infer.EnumValue[ReverseProxyAppAuthPreset] {
+	return []infer.EnumValue[ReverseProxyAppAuthPreset]{
+		{Name: "bearer", Value: ReverseProxyAppAuthBearer, Description: "NetBird SSO, optionally limited to groups."},
+		{Name: "pin", Value: ReverseProxyAppAuthPIN, Description: "A PIN shared with the users."},
+		{Name: "password", Value: ReverseProxyAppAuthPassword, Description: "A password shared with the users."},
+	}
+}
+
+// ReverseProxyAppAuth holds the authentication preset of a ReverseProxyApp.
+type ReverseProxyAppAuth struct {
+	Preset   ReverseProxyAppAuthPreset `pulumi:"preset"`
+	Groups   []string                  `pulumi:"groups,optional"`
+	Pin      *string                   `provider:"secret" pulumi:"pin,optional"`
+	Password *string                   `provider:"secret" pulumi:"password,optional"`
+}
+
+// Annotate adds schema descriptions to ReverseProxyAppAuth fields.
+func (r *ReverseProxyAppAuth) Annotate(a infer.Annotator) {
+	a.Describe(&r.Preset, "Authentication method: bearer, pin, or password.")
+	a.Describe(&r.Groups, "IDs of the groups whose users may sign in with the bearer preset. Empty allows every user.")
+	a.Describe(&r.Pin, "PIN required by the pin preset.")
+	a.Describe(&r.Password, "Password required by the password preset.")
+}
+
+// ReverseProxyAppTarget holds one backend of a ReverseProxyApp.
+type ReverseProxyAppTarget struct {
+	Peer            *string                              `pulumi:"peer,optional"`
+	NetworkResource *string                              `pulumi:"networkResource,optional"`
+	ResourceType    *resource.ReverseProxyTargetType     `pulumi:"resourceType,optional"`
+	Host            *string                              `pulumi:"host,optional"`
+	Port            int                                  `pulumi:"port"`
+	Protocol        *resource.ReverseProxyTargetProtocol `pulumi:"protocol,optional"`
+	Path            *string                              `pulumi:"path,optional"`
+}
+
+// Annotate adds schema descriptions to ReverseProxyAppTarget fields.
+func (t *ReverseProxyAppTarget) Annotate(a infer.Annotator) {
+	a.Describe(&t.Peer, "ID of the peer serving the app. Conflicts with networkResource.")
+	a.Describe(&t.NetworkResource, "ID of the network resource serving the app. Conflicts with peer.")
+	a.Describe(&t.ResourceType, "Type of the network resource: host, subnet, or domain. Defaults to host.")
+	a.Describe(&t.Host, "Backend IP or domain. Required for host and domain network resources.")
+	a.Describe(&t.Port, "Backend port.")
+	a.Describe(&t.Protocol, "Protocol to reach the backend with: http or https. Defaults to http.")
+	a.Describe(&t.Path, "Optional URL path prefix routed to this backend.")
+}
+
+// ReverseProxyAppArgs are the inputs for a ReverseProxyApp component.
+type ReverseProxyAppArgs struct {
+	Name         string                  `pulumi:"name"`
+	Subdomain    *string                 `pulumi:"subdomain,optional"`
+	Domain       *string                 `pulumi:"domain,optional"`
+	Cluster      *string                 `pulumi:"cluster,optional"`
+	Targets      []ReverseProxyAppTarget `pulumi:"targets"`
+	AccessGroups []string                `pulumi:"accessGroups,optional"`
+	Auth         *ReverseProxyAppAuth    `pulumi:"auth,optional"`
+}
+
+// Annotate adds schema descriptions to ReverseProxyAppArgs fields.
+func (r *ReverseProxyAppArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Name, "Name of the reverse proxy service.")
+	a.Describe(&r.Subdomain, "Subdomain label of the app. Required without domain.")
+	a.Describe(&r.Domain, "Optional custom domain. When set, a ReverseProxyDomain is created for it on the cluster. "+
+		"Without it, the app is served under the cluster's own domain.")
+	a.Describe(&r.Cluster, "Address of the proxy cluster to use. When omitted, an online cluster is picked: "+
+		"clusters of the account before shared ones, then the lowest address. Proxy counts are ignored, "+
+		"so the choice stays put while proxies come and go.")
+	a.Describe(&r.Targets, "Backends of the app. At least one is required.")
+	a.Describe(&r.AccessGroups, "IDs of the groups allowed to reach the app.")
+	a.Describe(&r.Auth, "Optional authentication preset.")
+}
+
+// ReverseProxyAppState holds the outputs of a ReverseProxyApp component.
+type ReverseProxyAppState struct {
+	pulumi.ResourceState
+
+	URL       pulumi.StringOutput    `pulumi:"url"`
+	Cluster   pulumi.StringOutput    `pulumi:"cluster"`
+	ServiceID pulumi.StringOutput    `pulumi:"serviceId"`
+	DomainID  pulumi.StringPtrOutput `pulumi:"domainId,optional"`
+}
+
+// Annotate adds schema descriptions to ReverseProxyAppState fields.
+func (s *ReverseProxyAppState) Annotate(a infer.Annotator) {
+	a.Describe(&s.URL, "Public URL of the app.")
+	a.Describe(&s.Cluster, "Address of the proxy cluster serving the app.")
+	a.Describe(&s.ServiceID, "ID of the created ReverseProxyService.")
+	a.Describe(&s.DomainID, "ID of the created ReverseProxyDomain. Unset without domain.")
+}
+
+// ReverseProxyApp is the ComponentResource anchor for the ReverseProxyApp component.
+type ReverseProxyApp struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*ReverseProxyApp) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args ReverseProxyAppArgs, opts pulumi.ResourceOption,
+) (*ReverseProxyAppState, error) {
+	return newReverseProxyApp(ctx, name, typ, args, opts)
+}
+
+func newReverseProxyApp( //nolint:funlen
+	ctx *pulumi.Context,
+	name, typ string,
+	args ReverseProxyAppArgs,
+	opts ...pulumi.ResourceOption,
+) (*ReverseProxyAppState, error) {
+	err := validateReverseProxyApp(args)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &ReverseProxyAppState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering ReverseProxyApp component: %w", err)
+	}
+
+	cluster, err := reverseProxyAppCluster(ctx, args.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	baseDomain := cluster
+	if args.Domain != nil {
+		baseDomain = *args.Domain
+	}
+
+	fqdn := baseDomain
+	if args.Subdomain != nil {
+		fqdn = *args.Subdomain + "." + baseDomain
+	}
+
+	serviceOpts := []pulumi.ResourceOption{pulumi.Parent(comp)}
+	comp.DomainID = unsetStringPtr()
+
+	if args.Domain != nil {
+		var domain pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenReverseProxyDomain, name+"-domain", pulumi.Map{
+			"domain":        pulumi.String(*args.Domain),
+			"targetCluster": pulumi.String(cluster),
+		}, &domain, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating ReverseProxyDomain: %w", err)
+		}
+
+		serviceOpts = append(serviceOpts, pulumi.DependsOn([]pulumi.Resource{&domain}))
+		comp.DomainID = domain.ID().ToStringOutput().ToStringPtrOutput()
+	}
+
+	targets := make(pulumi.Array, len(args.Targets))
+	for i, target := range args.Targets {
+		targets[i] = reverseProxyAppTarget(target)
+	}
+
+	serviceInputs := pulumi.Map{
+		"name":    pulumi.String(args.Name),
+		"domain":  pulumi.String(fqdn),
+		"enabled": pulumi.Bool(true),
+		"mode":    pulumi.String(string(resource.ReverseProxyServiceModeHTTP)),
+		"targets": targets,
+	}
+	if len(args.AccessGroups) > 0 {
+		serviceInputs["accessGroups"] = pulumi.ToStringArray(args.AccessGroups)
+	}
+
+	if args.Auth != nil {
+		serviceInputs["auth"] = reverseProxyAppAuth(*args.Auth)
+	}
+
+	var service pulumi.CustomResourceState
+
+	err = ctx.RegisterResource(tokenReverseProxyService, name+"-service", serviceInputs, &service, serviceOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating ReverseProxyService: %w", err)
+	}
+
+	comp.URL = pulumi.String("https://" + fqdn).ToStringOutput()
+	comp.Cluster = pulumi.String(cluster).ToStringOutput()
+	comp.ServiceID = service.ID().ToStringOutput()
+
+	return comp, nil
+}
+
+// reverseProxyAppCluster returns the pinned cluster, or picks one of the
+// account's online clusters.
+func reverseProxyAppCluster(ctx *pulumi.Context, pinned *string) (string, error) {
+	if pinned != nil {
+		return *pinned, nil
+	}
+
+	var result function.GetReverseProxyClustersResult
+
+	err := ctx.Invoke(tokenGetReverseProxyClusters, function.GetReverseProxyClustersArgs{Type: nil}, &result)
+	if err != nil {
+		return "", fmt.Errorf("listing reverse proxy clusters failed: %w", err)
+	}
+
+	cluster, ok := pickProxyCluster(result.Clusters)
+	if !ok {
+		return "", errors.New("no online reverse proxy cluster is available; set cluster to pin one")
+	}
+
+	return cluster, nil
+}
+
+// pickProxyCluster returns the address of the preferred online cluster:
+// clusters of the account before shared ones, then the lowest address. The
+// number of connected proxies is left out on purpose: it changes between
+// runs, and a different pick would move the app to another URL.
+func pickProxyCluster(clusters []function.ProxyClusterSummary) (string, bool) {
+	online := slices.DeleteFunc(slices.Clone(clusters), func(c function.ProxyClusterSummary) bool { return !c.Online })
+	if len(online) == 0 {
+		return "", false
+	}
+
+	slices.SortFunc(online, func(a, b function.ProxyClusterSummary) int {
+		if (a.Type == "account") != (b.Type == "account") {
+			if a.Type == "account" {
+				return -1
+			}
+
+			return 1
+		}
+
+		return cmp.Compare(a.Address, b.Address)
+	})
+
+	return online[0].Address, true
+}
+
+// reverseProxyAppTarget converts a target spec to ReverseProxyService target inputs.
+func reverseProxyAppTarget(target ReverseProxyAppTarget) pulumi.Map {
+	protocol := resource.ReverseProxyTargetProtocolHTTP
+	if target.Protocol != nil {
+		protocol = *target.Protocol
+	}
+
+	inputs := pulumi.Map{
+		"enabled":  pulumi.Bool(true),
+		"port":     pulumi.Int(target.Port),
+		"protocol": pulumi.String(string(protocol)),
+	}
+
+	if target.Peer != nil {
+		inputs["targetId"] = pulumi.String(*target.Peer)
+		inputs["targetType"] = pulumi.String(string(resource.ReverseProxyTargetTypePeer))
+	} else {
+		resourceType := resource.ReverseProxyTargetTypeHost
+		if target.ResourceType != nil {
+			resourceType = *target.ResourceType
+		}
+
+		inputs["targetId"] = pulumi.String(*target.NetworkResource)
+		inputs["targetType"] = pulumi.String(string(resourceType))
+	}
+
+	if target.Host != nil {
+		inputs["host"] = pulumi.String(*target.Host)
+	}
+
+	if target.Path != nil {
+		inputs["path"] = pulumi.String(*target.Path)
+	}
+
+	return inputs
+}
+
+// reverseProxyAppAuth converts an auth preset to ReverseProxyService auth inputs.
+func reverseProxyAppAuth(auth ReverseProxyAppAuth) pulumi.Map {
+	switch auth.Preset {
+	case ReverseProxyAppAuthPIN:
+		return pulumi.Map{"pinAuth": pulumi.Map{
+			"enabled": pulumi.Bool(true),
+			"pin":     pulumi.ToSecret(pulumi.String(*auth.Pin)),
+		}}
+	case ReverseProxyAppAuthPassword:
+		return pulumi.Map{"passwordAuth": pulumi.Map{
+			"enabled":  pulumi.Bool(true),
+			"password": pulumi.ToSecret(pulumi.String(*auth.Password)),
+		}}
+	case ReverseProxyAppAuthBearer:
+	}
+
+	bearer := pulumi.Map{"enabled": pulumi.Bool(true)}
+	if len(auth.Groups) > 0 {
+		bearer["distributionGroups"] = pulumi.ToStringArray(auth.Groups)
+	}
+
+	return pulumi.Map{"bearerAuth": bearer}
+}
+
+// validateReverseProxyApp rejects apps that cannot be deployed.
+func validateReverseProxyApp(args ReverseProxyAppArgs) error { //nolint:cyclop
+	if args.Domain == nil && args.Subdomain == nil {
+		return errors.New("ReverseProxyApp requires subdomain when no custom domain is set")
+	}
+
+	if len(args.Targets) == 0 {
+		return errors.New("ReverseProxyApp requires at least one target")
+	}
+
+	for i, target := range args.Targets {
+		if (target.Peer == nil) == (target.NetworkResource == nil) {
+			return fmt.Errorf("targets[%d]: exactly one of peer and networkResource must be set", i)
+		}
+
+		if target.Peer != nil && target.ResourceType != nil {
+			return fmt.Errorf("targets[%d]: resourceType only applies to networkResource", i)
+		}
+
+		if target.NetworkResource != nil {
+			resourceType := resource.ReverseProxyTargetTypeHost
+			if target.ResourceType != nil {
+				resourceType = *target.ResourceType
+			}
+
+			switch resourceType {
+			case resource.ReverseProxyTargetTypeHost, resource.ReverseProxyTargetTypeDomain:
+				if target.Host == nil {
+					return fmt.Errorf("targets[%d]: host is required for %s network resources", i, resourceType)
+				}
+			case resource.ReverseProxyTargetTypeSubnet:
+			case resource.ReverseProxyTargetTypePeer, resource.ReverseProxyTargetTypeCluster:
+				return fmt.Errorf("targets[%d]: resourceType must be host, subnet, or domain, not %s", i, resourceType)
+			}
+		}
+	}
+
+	if args.Auth == nil {
+		return nil
+	}
+
+	switch args.Auth.Preset {
+	case ReverseProxyAppAuthPIN:
+		if args.Auth.Pin == nil {
+			return errors.New("auth preset pin requires pin")
+		}
+	case ReverseProxyAppAuthPassword:
+		if args.Auth.Password == nil {
+			return errors.New("auth preset password requires password")
+		}
+	case ReverseProxyAppAuthBearer:
+	}
+
+	if args.Auth.Preset != ReverseProxyAppAuthBearer && len(args.Auth.Groups) > 0 {
+		return fmt.Errorf("auth groups only apply to the bearer preset, not %s", args.Auth.Preset)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package component
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/provider/function"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func proxyCluster(address, typ string, online bool, connected int) function.ProxyClusterSummary {
+	return function.ProxyClusterSummary{
+		ID:                  address + "-id",
+		Address:             address,
+		Type:                typ,
+		Online:              online,
+		ConnectedProxies:    connected,
+		Private:             false,
+		RequireSubdomain:    false,
+		SupportsCrowdsec:    false,
+		SupportsCustomPorts: false,
+	}
+}
+
+func TestPickProxyCluster(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		clusters []function.ProxyClusterSummary
+		want     string
+	}{
+		{name: "no clusters"},
+		{
+			name:     "all offline",
+			clusters: []function.ProxyClusterSummary{proxyCluster("a.proxy", "account", false, 3)},
+		},
+		{
+			name: "account before shared",
+			clusters: []function.ProxyClusterSummary{
+				proxyCluster("shared.proxy", "shared", true, 10),
+				proxyCluster("own.proxy", "account", true, 1),
+			},
+			want: "own.proxy",
+		},
+		{
+			name: "offline account cluster skipped",
+			clusters: []function.ProxyClusterSummary{
+				proxyCluster("own.proxy", "account", false, 5),
+				proxyCluster("shared.proxy", "shared", true, 1),
+			},
+			want: "shared.proxy",
+		},
+		{
+			name: "connected proxies ignored",
+			clusters: []function.ProxyClusterSummary{
+				proxyCluster("c.proxy", "account", true, 9),
+				proxyCluster("a.proxy", "account", true, 1),
+				proxyCluster("b.proxy", "account", true, 4),
+			},
+			want: "a.proxy",
+		},
+		{
+			name: "lowest shared address",
+			clusters: []function.ProxyClusterSummary{
+				proxyCluster("c.proxy", "shared", true, 2),
+				proxyCluster("a.proxy", "shared", true, 0),
+				proxyCluster("b.proxy", "shared", true, 2),
+			},
+			want: "a.proxy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := slices.Clone(tt.clusters)
+
+			got, ok := pickProxyCluster(tt.clusters)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, input, tt.clusters, "pickProxyCluster reordered its input")
+		})
+	}
+}
+
+func reverseProxyAppArgs() ReverseProxyAppArgs {
+	return ReverseProxyAppArgs{
+		Name:      "grafana",
+		Subdomain: ptr("grafana"),
+		Domain:    nil,
+		Cluster:   nil,
+		Targets: []ReverseProxyAppTarget{{
+			Peer: ptr("peer-1"), NetworkResource: nil, ResourceType: nil, Host: nil, Port: 3000, Protocol: nil, Path: nil,
+		}},
+		AccessGroups: nil,
+		Auth:         nil,
+	}
+}
+
+func TestReverseProxyAppPicksCluster(t *testing.T) {
+	t.Parallel()
+
+	mocks := newComponentMocks()
+	mocks.invokes[tokenGetReverseProxyClusters] = map[string]any{"clusters": []any{
+		map[string]any{"address": "shared.proxy", "type": "shared", "online": true, "connectedProxies": 9},
+		map[string]any{"address": "own.proxy", "type": "account", "online": true, "connectedProxies": 1},
+	}}
+
+	state, err := construct(t.Context(), mocks, (&ReverseProxyApp{}).Construct, "app", reverseProxyAppArgs())
+	require.NoError(t, err)
+	assert.Empty(t, mocks.names(tokenReverseProxyDomain), "domains without a custom domain")
+	assert.Nil(t, await[*string](t, state.DomainID))
+	assert.Equal(t, "grafana.own.proxy", mocks.find(t, "app-service").inputs["domain"].StringValue())
+}
+
+func TestReverseProxyAppURLIgnoresProxyCounts(t *testing.T) {
+	t.Parallel()
+
+	for _, counts := range [][2]int{{5, 1}, {0, 7}} {
+		mocks := newComponentMocks()
+		mocks.invokes[tokenGetReverseProxyClusters] = map[string]any{"clusters": []any{
+			map[string]any{"address": "b.proxy", "type": "account", "online": true, "connectedProxies": counts[0]},
+			map[string]any{"address": "a.proxy", "type": "account", "online": true, "connectedProxies": counts[1]},
+		}}
+
+		_, err := construct(t.Context(), mocks, (&ReverseProxyApp{}).Construct, "app", reverseProxyAppArgs())
+		require.NoError(t, err)
+		assert.Equal(t, "grafana.a.proxy", mocks.find(t, "app-service").inputs["domain"].StringValue(), "connected proxies %v", counts)
+	}
+}
+
+func TestReverseProxyAppCustomDomain(t *testing.T) {
+	t.Parallel()
+
+	// A pinned cluster needs no invoke; componentMocks fails any it gets.
+	mocks := newComponentMocks()
+	args := reverseProxyAppArgs()
+	args.Cluster, args.Domain = ptr("eu.proxy"), ptr("apps.example.com")
+
+	_, err := construct(t.Context(), mocks, (&ReverseProxyApp{}).Construct, "app", args)
+	require.NoError(t, err)
+	assert.Equal(t, "eu.proxy", mocks.find(t, "app-domain").inputs["targetCluster"].StringValue())
+
+	service := mocks.find(t, "app-service")
+	assert.Equal(t, "grafana.apps.example.com", service.inputs["domain"].StringValue())
+	assert.Equal(t, []string{"app-domain"}, service.dependsOn)
+}
+
+func TestReverseProxyAppWithoutOnlineCluster(t *testing.T) {
+	t.Parallel()
+
+	mocks := newComponentMocks()
+	mocks.invokes[tokenGetReverseProxyClusters] = map[string]any{"clusters": []any{
+		map[string]any{"address": "own.proxy", "type": "account", "online": false, "connectedProxies": 0},
+	}}
+
+	_, err := construct(t.Context(), mocks, (&ReverseProxyApp{}).Construct, "app", reverseProxyAppArgs())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no online reverse proxy cluster")
+	assert.Empty(t, mocks.names(tokenReverseProxyService))
+}
@@ -0,0 +1,285 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/internals"
+)
+
+// rotationDateFormat names the rotation period a token was issued for.
+const rotationDateFormat = "2006-01-02"
+
+// ServiceAccountTokenSpec holds the configuration of one token in a ServiceAccount.
+type ServiceAccountTokenSpec struct {
+	Name             string `pulumi:"name"`
+	ExpiresIn        int    `pulumi:"expiresIn"`
+	RotationInterval *int   `pulumi:"rotationInterval,optional"`
+}
+
+// Annotate adds schema descriptions to ServiceAccountTokenSpec fields.
+func (t *ServiceAccountTokenSpec) Annotate(a infer.Annotator) {
+	a.Describe(&t.Name, "Name of the token, unique within the service account. Rotated tokens get the start date of their rotation period appended.")
+	a.Describe(&t.ExpiresIn, "Token lifetime in days. With rotationInterval it must be at least twice the interval, "+
+		"so a token outlives the period it overlaps with its successor.")
+	a.Describe(&t.RotationInterval, "Optional rotation interval in days. When set, a new token is issued at the start of every interval "+
+		"and the previous one, if it exists, is kept for one more interval before it is deleted.")
+}
+
+// ServiceAccountArgs are the inputs for a ServiceAccount component.
+type ServiceAccountArgs struct {
+	Name       string                    `pulumi:"name"`
+	Role       *string                   `pulumi:"role,optional"`
+	AutoGroups []string                  `pulumi:"autoGroups,optional"`
+	GroupName  *string                   `pulumi:"groupName,optional"`
+	Tokens     []ServiceAccountTokenSpec `pulumi:"tokens"`
+}
+
+// Annotate adds schema descriptions to ServiceAccountArgs fields.
+func (s *ServiceAccountArgs) Annotate(a infer.Annotator) {
+	a.Describe(&s.Name, "Name of the service user.")
+	a.Describe(&s.Role, "NetBird account role of the service user. Defaults to user.")
+	a.Describe(&s.AutoGroups, "IDs of existing groups the service user's peers are automatically assigned to.")
+	a.Describe(&s.GroupName, "Optional name of a group to create for the service account. It is added to the auto-groups.")
+	a.Describe(&s.Tokens, "Personal access tokens to issue for the service user. At least one is required.")
+}
+
+// ServiceAccountState holds the outputs of a ServiceAccount component.
+type ServiceAccountState struct {
+	pulumi.ResourceState
+
+	UserID   pulumi.StringOutput    `pulumi:"userId"`
+	GroupID  pulumi.StringPtrOutput `pulumi:"groupId,optional"`
+	Tokens   pulumi.StringMapOutput `provider:"secret" pulumi:"tokens"`
+	TokenIDs pulumi.StringMapOutput `pulumi:"tokenIds"`
+}
+
+// Annotate adds schema descriptions to ServiceAccountState fields.
+func (s *ServiceAccountState) Annotate(a infer.Annotator) {
+	a.Describe(&s.UserID, "ID of the created service user.")
+	a.Describe(&s.GroupID, "ID of the created group. Unset without groupName.")
+	a.Describe(&s.Tokens, "Plaintext value of the current token, keyed by token name. Secret.")
+	a.Describe(&s.TokenIDs, "ID of the current token, keyed by token name.")
+}
+
+// ServiceAccount is the ComponentResource anchor for the ServiceAccount component.
+type ServiceAccount struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*ServiceAccount) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args ServiceAccountArgs, opts pulumi.ResourceOption,
+) (*ServiceAccountState, error) {
+	return newServiceAccount(ctx, name, typ, args, config.Now(ctx.Context()), opts)
+}
+
+// tokenResource captures the outputs of a Token child resource.
+type tokenResource struct {
+	pulumi.CustomResourceState
+
+	Token pulumi.StringOutput `pulumi:"token"`
+}
+
+func newServiceAccount(
+	ctx *pulumi.Context,
+	name, typ string,
+	args ServiceAccountArgs,
+	now time.Time,
+	opts ...pulumi.ResourceOption,
+) (*ServiceAccountState, error) {
+	err := validateServiceAccountTokens(args.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &ServiceAccountState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering ServiceAccount component: %w", err)
+	}
+
+	autoGroups := make(pulumi.StringArray, 0, len(args.AutoGroups)+1)
+	for _, gid := range args.AutoGroups {
+		autoGroups = append(autoGroups, pulumi.String(gid))
+	}
+
+	comp.GroupID = unsetStringPtr()
+
+	if args.GroupName != nil {
+		var group pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenGroup, name+"-group", pulumi.Map{
+			"name": pulumi.String(*args.GroupName),
+		}, &group, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating Group: %w", err)
+		}
+
+		autoGroups = append(autoGroups, group.ID().ToStringOutput())
+		comp.GroupID = group.ID().ToStringOutput().ToStringPtrOutput()
+	}
+
+	role := "user"
+	if args.Role != nil {
+		role = *args.Role
+	}
+
+	var user pulumi.CustomResourceState
+
+	err = ctx.RegisterResource(tokenUser, name+"-user", pulumi.Map{
+		"name":          pulumi.String(args.Name),
+		"role":          pulumi.String(role),
+		"isServiceUser": pulumi.Bool(true),
+		"autoGroups":    autoGroups,
+	}, &user, pulumi.Parent(comp))
+	if err != nil {
+		return nil, fmt.Errorf("creating User: %w", err)
+	}
+
+	existing, err := existingTokenNames(ctx, args.Tokens, user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := pulumi.StringMap{}
+	tokenIDs := pulumi.StringMap{}
+
+	for _, spec := range args.Tokens {
+		for _, tokenName := range rotatedTokenNames(spec, now, existing) {
+			var token tokenResource
+
+			err = ctx.RegisterResource(tokenToken, name+"-token-"+tokenName, pulumi.Map{
+				"userId":    user.ID().ToStringOutput(),
+				"name":      pulumi.String(tokenName),
+				"expiresIn": pulumi.Int(spec.ExpiresIn),
+			}, &token, pulumi.Parent(comp))
+			if err != nil {
+				return nil, fmt.Errorf("creating Token %q: %w", tokenName, err)
+			}
+
+			// Names are ordered oldest first, so the current token wins.
+			tokens[spec.Name] = token.Token
+			tokenIDs[spec.Name] = token.ID().ToStringOutput()
+		}
+	}
+
+	comp.UserID = user.ID().ToStringOutput()
+	comp.Tokens = pulumi.ToSecret(tokens.ToStringMapOutput()).(pulumi.StringMapOutput) //nolint:forcetypeassert
+	comp.TokenIDs = tokenIDs.ToStringMapOutput()
+
+	return comp, nil
+}
+
+// validateServiceAccountTokens rejects token specs that cannot be deployed.
+func validateServiceAccountTokens(specs []ServiceAccountTokenSpec) error {
+	if len(specs) == 0 {
+		return errors.New("ServiceAccount requires at least one token")
+	}
+
+	seen := map[string]bool{}
+
+	for _, spec := range specs {
+		if seen[spec.Name] {
+			return fmt.Errorf("duplicate ServiceAccount token name %q", spec.Name)
+		}
+
+		seen[spec.Name] = true
+
+		if spec.RotationInterval == nil {
+			continue
+		}
+
+		if *spec.RotationInterval <= 0 {
+			return fmt.Errorf("token %q: rotationInterval must be greater than 0", spec.Name)
+		}
+
+		if spec.ExpiresIn < 2**spec.RotationInterval {
+			return fmt.Errorf("token %q: expiresIn (%d days) must be at least twice rotationInterval (%d days)",
+				spec.Name, spec.ExpiresIn, *spec.RotationInterval)
+		}
+	}
+
+	return nil
+}
+
+// rotatedTokenNames returns the names of the tokens that should exist at now,
+// oldest first. Without a rotation interval that is the spec name itself;
+// otherwise it is the token of the current rotation period, preceded by the
+// one of the previous period if that already exists, so the old token keeps
+// working for an interval after its successor is issued. When a period ends,
+// the next deployment creates the new token before deleting the one that
+// dropped out.
+func rotatedTokenNames(spec ServiceAccountTokenSpec, now time.Time, existing map[string]bool) []string {
+	if spec.RotationInterval == nil {
+		return []string{spec.Name}
+	}
+
+	period := time.Duration(*spec.RotationInterval) * 24 * time.Hour
+	current := spec.Name + "-" + now.UTC().Truncate(period).Format(rotationDateFormat)
+	previous := spec.Name + "-" + now.UTC().Truncate(period).Add(-period).Format(rotationDateFormat)
+
+	if !existing[previous] {
+		return []string{current}
+	}
+
+	return []string{previous, current}
+}
+
+// serviceAccountTokensArgs are the getTokens arguments of ServiceAccount. The
+// Go SDK skips embedded structs when marshaling invoke arguments, so only the
+// userId filter is declared.
+type serviceAccountTokensArgs struct {
+	UserID string `pulumi:"userId"`
+}
+
+// serviceAccountTokensResult is the part of the getTokens result ServiceAccount
+// reads. function.TokenSummary embeds the token state, whose fields the Go SDK
+// would leave empty when unmarshaling the result.
+type serviceAccountTokensResult struct {
+	Tokens []serviceAccountToken `pulumi:"tokens"`
+}
+
+// serviceAccountToken is a token listed by getTokens.
+type serviceAccountToken struct {
+	Name string `pulumi:"name"`
+}
+
+// existingTokenNames returns the names of the tokens the service user already
+// has. It is only needed for rotated tokens. A user that is being created,
+// whose ID is unknown during preview, has none.
+func existingTokenNames(ctx *pulumi.Context, specs []ServiceAccountTokenSpec, userID pulumi.IDOutput) (map[string]bool, error) {
+	names := map[string]bool{}
+
+	if !slices.ContainsFunc(specs, func(spec ServiceAccountTokenSpec) bool { return spec.RotationInterval != nil }) {
+		return names, nil
+	}
+
+	resolved, err := internals.UnsafeAwaitOutput(ctx.Context(), userID)
+	if err != nil {
+		return nil, fmt.Errorf("resolving the service user ID failed: %w", err)
+	}
+
+	id, ok := resolved.Value.(pulumi.ID)
+	if !resolved.Known || !ok || id == "" {
+		return names, nil
+	}
+
+	var result serviceAccountTokensResult
+
+	err = ctx.Invoke(tokenGetTokens, serviceAccountTokensArgs{UserID: string(id)}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("listing tokens of the service user failed: %w", err)
+	}
+
+	for _, token := range result.Tokens {
+		names[token.Name] = true
+	}
+
+	return names, nil
+}
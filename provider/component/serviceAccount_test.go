@@ -0,0 +1,162 @@
+package component
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatedTokenNames(t *testing.T) {
+	t.Parallel()
+
+	// Weekly periods start on Mondays, counted from the zero time.
+	weekly := ServiceAccountTokenSpec{Name: "ci", ExpiresIn: 14, RotationInterval: ptr(7)}
+	berlin := time.FixedZone("CEST", 2*60*60)
+
+	existing := map[string]bool{"ci-2026-02-23": true, "ci-2026-03-02": true}
+
+	tests := []struct {
+		name     string
+		spec     ServiceAccountTokenSpec
+		now      time.Time
+		existing map[string]bool
+		want     []string
+	}{
+		{
+			name:     "no rotation",
+			spec:     ServiceAccountTokenSpec{Name: "ci", ExpiresIn: 30, RotationInterval: nil},
+			now:      time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC),
+			existing: nil,
+			want:     []string{"ci"},
+		},
+		{
+			name:     "first deployment",
+			spec:     weekly,
+			now:      time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC),
+			existing: nil,
+			want:     []string{"ci-2026-03-02"},
+		},
+		{
+			name:     "mid period",
+			spec:     weekly,
+			now:      time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC),
+			existing: existing,
+			want:     []string{"ci-2026-02-23", "ci-2026-03-02"},
+		},
+		{
+			name:     "just before boundary",
+			spec:     weekly,
+			now:      time.Date(2026, 3, 8, 23, 59, 59, 0, time.UTC),
+			existing: existing,
+			want:     []string{"ci-2026-02-23", "ci-2026-03-02"},
+		},
+		{
+			name:     "at boundary",
+			spec:     weekly,
+			now:      time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+			existing: existing,
+			want:     []string{"ci-2026-03-02", "ci-2026-03-09"},
+		},
+		{
+			name:     "previous period skipped",
+			spec:     weekly,
+			now:      time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC),
+			existing: existing,
+			want:     []string{"ci-2026-03-16"},
+		},
+		{
+			name:     "local time before the UTC boundary",
+			spec:     weekly,
+			now:      time.Date(2026, 3, 9, 1, 0, 0, 0, berlin),
+			existing: existing,
+			want:     []string{"ci-2026-02-23", "ci-2026-03-02"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, rotatedTokenNames(tt.spec, tt.now, tt.existing))
+		})
+	}
+}
+
+func TestServiceAccountWiring(t *testing.T) {
+	t.Parallel()
+
+	args := ServiceAccountArgs{
+		Name:       "ci",
+		Role:       ptr("admin"),
+		AutoGroups: []string{"g-ci"},
+		GroupName:  ptr("ci-bots"),
+		Tokens:     []ServiceAccountTokenSpec{{Name: "deploy", ExpiresIn: 30, RotationInterval: nil}},
+	}
+	mocks := newComponentMocks()
+
+	state, err := construct(t.Context(), mocks, (&ServiceAccount{}).Construct, "sa", args)
+	require.NoError(t, err)
+	assert.Equal(t, "ci-bots", mocks.find(t, "sa-group").inputs["name"].StringValue())
+
+	user := mocks.find(t, "sa-user").inputs
+	assert.Equal(t, "admin", user["role"].StringValue())
+	assert.True(t, user["isServiceUser"].BoolValue())
+	assert.Equal(t, []string{"g-ci", "sa-group-id"}, stringInputs(user["autoGroups"]))
+
+	token := mocks.find(t, "sa-token-deploy").inputs
+	assert.Equal(t, "sa-user-id", token["userId"].StringValue())
+	assert.Equal(t, "deploy", token["name"].StringValue())
+	assert.Equal(t, "sa-group-id", *await[*string](t, state.GroupID))
+	assert.Equal(t, map[string]string{"deploy": "sa-token-deploy-id"}, await[map[string]string](t, state.TokenIDs))
+}
+
+// TestServiceAccountTokenOverlap deploys a weekly rotated token across
+// period boundaries, each time with the tokens the previous run left, and
+// checks which Token children exist after each run.
+func TestServiceAccountTokenOverlap(t *testing.T) {
+	t.Parallel()
+
+	args := ServiceAccountArgs{
+		Name:       "ci",
+		Role:       nil,
+		AutoGroups: nil,
+		GroupName:  nil,
+		Tokens:     []ServiceAccountTokenSpec{{Name: "deploy", ExpiresIn: 14, RotationInterval: ptr(7)}},
+	}
+
+	deploy := func(now time.Time, existing ...string) []string {
+		t.Helper()
+
+		tokens := []any{}
+		for _, name := range existing {
+			tokens = append(tokens, map[string]any{"name": name})
+		}
+
+		mocks := newComponentMocks()
+		mocks.invokes[tokenGetTokens] = map[string]any{"tokens": tokens}
+		ctx := config.WithClock(t.Context(), func() time.Time { return now })
+
+		_, err := construct(ctx, mocks, (&ServiceAccount{}).Construct, "sa", args)
+		require.NoError(t, err, "Construct at %s", now)
+		assert.Equal(t, "sa-user-id", mocks.invoked[tokenGetTokens]["userId"].StringValue())
+
+		return mocks.names(tokenToken)
+	}
+
+	// The first deployment issues only the current token.
+	first := deploy(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC))
+	assert.Equal(t, []string{"sa-token-deploy-2026-03-02"}, first)
+
+	// Crossing the boundary keeps the 03-02 token under the same name and
+	// adds its successor.
+	next := deploy(time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), "deploy-2026-03-02")
+	assert.Equal(t, []string{"sa-token-deploy-2026-03-02", "sa-token-deploy-2026-03-09"}, next)
+
+	// One more period retires the 03-02 token, which overlapped with its
+	// successor for exactly one interval.
+	later := deploy(time.Date(2026, 3, 16, 12, 0, 0, 0, time.UTC), "deploy-2026-03-02", "deploy-2026-03-09")
+	assert.Equal(t, []string{"sa-token-deploy-2026-03-09", "sa-token-deploy-2026-03-16"}, later)
+}
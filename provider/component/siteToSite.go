@@ -0,0 +1,351 @@
+package component
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// SiteSpec holds the configuration of one site of a SiteToSite link.
+type SiteSpec struct {
+	Name       string   `pulumi:"name"`
+	Peer       *string  `pulumi:"peer,optional"`
+	PeerGroup  *string  `pulumi:"peerGroup,optional"`
+	CIDRs      []string `pulumi:"cidrs"`
+	Masquerade *bool    `pulumi:"masquerade,optional"`
+	Metric     *int     `pulumi:"metric,optional"`
+}
+
+// Annotate adds schema descriptions to SiteSpec fields.
+func (s *SiteSpec) Annotate(a infer.Annotator) {
+	a.Describe(&s.Name, "Name of the site, used in the names of its network, groups and policy.")
+	a.Describe(&s.Peer, "ID of the routing peer of the site. Conflicts with peerGroup.")
+	a.Describe(&s.PeerGroup, "ID of the group of routing peers of the site. Conflicts with peer.")
+	a.Describe(&s.CIDRs, "LAN subnets of the site. They must not overlap with the other site's.")
+	a.Describe(&s.Masquerade, "Whether the router masquerades traffic into the site's LAN. Defaults to true. "+
+		"Without masquerading, the LAN needs a route back to the other site through the routing peer.")
+	a.Describe(&s.Metric, "Route metric of the router; lower values have higher priority. Defaults to 9999.")
+}
+
+// SiteToSiteArgs are the inputs for a SiteToSite component.
+type SiteToSiteArgs struct {
+	Name        string   `pulumi:"name"`
+	Description *string  `pulumi:"description,optional"`
+	SiteA       SiteSpec `pulumi:"siteA"`
+	SiteB       SiteSpec `pulumi:"siteB"`
+}
+
+// Annotate adds schema descriptions to SiteToSiteArgs fields.
+func (s *SiteToSiteArgs) Annotate(a infer.Annotator) {
+	a.Describe(&s.Name, "Name of the link, used as a prefix for the names of the created resources.")
+	a.Describe(&s.Description, "Optional description for the networks and the policies.")
+	a.Describe(&s.SiteA, "First site of the link.")
+	a.Describe(&s.SiteB, "Second site of the link.")
+}
+
+// SiteToSiteState holds the outputs of a SiteToSite component.
+type SiteToSiteState struct {
+	pulumi.ResourceState
+
+	NetworkIDs       pulumi.StringMapOutput `pulumi:"networkIds"`
+	RouterIDs        pulumi.StringMapOutput `pulumi:"routerIds"`
+	ResourceIDs      pulumi.StringMapOutput `pulumi:"resourceIds"`
+	RoutingGroupIDs  pulumi.StringMapOutput `pulumi:"routingGroupIds"`
+	ResourceGroupIDs pulumi.StringMapOutput `pulumi:"resourceGroupIds"`
+	PolicyIDs        pulumi.StringMapOutput `pulumi:"policyIds"`
+}
+
+// Annotate adds schema descriptions to SiteToSiteState fields.
+func (s *SiteToSiteState) Annotate(a infer.Annotator) {
+	a.Describe(&s.NetworkIDs, "ID of the created Network of each site, keyed by site name.")
+	a.Describe(&s.RouterIDs, "ID of the created NetworkRouter of each site, keyed by site name.")
+	a.Describe(&s.ResourceIDs, "ID of the created NetworkResource of each subnet, keyed by CIDR.")
+	a.Describe(&s.RoutingGroupIDs, "ID of the group of routing peers of each site, keyed by site name. "+
+		"This is peerGroup, or a created Group holding peer.")
+	a.Describe(&s.ResourceGroupIDs, "ID of the created Group of the subnets of each site, keyed by site name.")
+	a.Describe(&s.PolicyIDs, "ID of the created Policy that lets a site reach the other site, keyed by the name of the source site.")
+}
+
+// SiteToSite is the ComponentResource anchor for the SiteToSite component.
+type SiteToSite struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*SiteToSite) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args SiteToSiteArgs, opts pulumi.ResourceOption,
+) (*SiteToSiteState, error) {
+	return newSiteToSite(ctx, name, typ, args, opts)
+}
+
+// siteResources holds the IDs of the children created for one site.
+type siteResources struct {
+	routingGroupID  pulumi.StringOutput
+	resourceGroupID pulumi.StringOutput
+}
+
+func newSiteToSite(
+	ctx *pulumi.Context,
+	name, typ string,
+	args SiteToSiteArgs,
+	opts ...pulumi.ResourceOption,
+) (*SiteToSiteState, error) {
+	err := validateSiteToSite(args)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &SiteToSiteState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering SiteToSite component: %w", err)
+	}
+
+	outputs := siteToSiteOutputs{
+		networkIDs:       pulumi.StringMap{},
+		routerIDs:        pulumi.StringMap{},
+		resourceIDs:      pulumi.StringMap{},
+		routingGroupIDs:  pulumi.StringMap{},
+		resourceGroupIDs: pulumi.StringMap{},
+	}
+
+	siteA, err := newSite(ctx, name, args.Name, args.Description, args.SiteA, outputs, comp)
+	if err != nil {
+		return nil, err
+	}
+
+	siteB, err := newSite(ctx, name, args.Name, args.Description, args.SiteB, outputs, comp)
+	if err != nil {
+		return nil, err
+	}
+
+	policyIDs := pulumi.StringMap{}
+
+	for _, link := range []struct {
+		from, to         SiteSpec
+		source, resource siteResources
+	}{
+		{args.SiteA, args.SiteB, siteA, siteB},
+		{args.SiteB, args.SiteA, siteB, siteA},
+	} {
+		policyName := args.Name + "-" + link.from.Name + "-to-" + link.to.Name
+		childName := name + "-" + link.from.Name + "-to-" + link.to.Name
+
+		policyInputs := pulumi.Map{
+			"name":    pulumi.String(policyName),
+			"enabled": pulumi.Bool(true),
+			"rules": pulumi.Array{pulumi.Map{
+				"name":          pulumi.String(policyName),
+				"action":        pulumi.String(string(resource.RuleActionAccept)),
+				"enabled":       pulumi.Bool(true),
+				"bidirectional": pulumi.Bool(false),
+				"protocol":      pulumi.String(string(resource.ProtocolAll)),
+				"sources":       pulumi.StringArray{link.source.routingGroupID},
+				"destinations":  pulumi.StringArray{link.resource.resourceGroupID},
+			}},
+		}
+		if args.Description != nil {
+			policyInputs["description"] = pulumi.String(*args.Description)
+		}
+
+		var policy pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenPolicy, childName+"-policy", policyInputs, &policy, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating Policy from site %q: %w", link.from.Name, err)
+		}
+
+		policyIDs[link.from.Name] = policy.ID().ToStringOutput()
+	}
+
+	comp.NetworkIDs = outputs.networkIDs.ToStringMapOutput()
+	comp.RouterIDs = outputs.routerIDs.ToStringMapOutput()
+	comp.ResourceIDs = outputs.resourceIDs.ToStringMapOutput()
+	comp.RoutingGroupIDs = outputs.routingGroupIDs.ToStringMapOutput()
+	comp.ResourceGroupIDs = outputs.resourceGroupIDs.ToStringMapOutput()
+	comp.PolicyIDs = policyIDs.ToStringMapOutput()
+
+	return comp, nil
+}
+
+// siteToSiteOutputs collects the child IDs of both sites.
+type siteToSiteOutputs struct {
+	networkIDs       pulumi.StringMap
+	routerIDs        pulumi.StringMap
+	resourceIDs      pulumi.StringMap
+	routingGroupIDs  pulumi.StringMap
+	resourceGroupIDs pulumi.StringMap
+}
+
+// newSite creates the groups, network, router and subnet resources of one
+// site and records their IDs in outputs.
+func newSite( //nolint:funlen
+	ctx *pulumi.Context,
+	name, linkName string,
+	description *string,
+	site SiteSpec,
+	outputs siteToSiteOutputs,
+	parent pulumi.Resource,
+) (siteResources, error) {
+	prefix := name + "-" + site.Name
+	namePrefix := linkName + "-" + site.Name
+
+	var resourceGroup pulumi.CustomResourceState
+
+	err := ctx.RegisterResource(tokenGroup, prefix+"-resources", pulumi.Map{
+		"name": pulumi.String(namePrefix + "-resources"),
+	}, &resourceGroup, pulumi.Parent(parent))
+	if err != nil {
+		return siteResources{}, fmt.Errorf("creating Group for site %q: %w", site.Name, err)
+	}
+
+	resourceGroupID := resourceGroup.ID().ToStringOutput()
+
+	var routingGroupID pulumi.StringOutput
+
+	if site.PeerGroup != nil {
+		routingGroupID = pulumi.String(*site.PeerGroup).ToStringOutput()
+	} else {
+		var routingGroup pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenGroup, prefix+"-routers", pulumi.Map{
+			"name":  pulumi.String(namePrefix + "-routers"),
+			"peers": pulumi.StringArray{pulumi.String(*site.Peer)},
+		}, &routingGroup, pulumi.Parent(parent))
+		if err != nil {
+			return siteResources{}, fmt.Errorf("creating routing Group for site %q: %w", site.Name, err)
+		}
+
+		routingGroupID = routingGroup.ID().ToStringOutput()
+	}
+
+	networkInputs := pulumi.Map{
+		"name": pulumi.String(namePrefix),
+	}
+	if description != nil {
+		networkInputs["description"] = pulumi.String(*description)
+	}
+
+	var net pulumi.CustomResourceState
+
+	err = ctx.RegisterResource(tokenNetwork, prefix+"-network", networkInputs, &net, pulumi.Parent(parent))
+	if err != nil {
+		return siteResources{}, fmt.Errorf("creating Network for site %q: %w", site.Name, err)
+	}
+
+	masquerade := site.Masquerade == nil || *site.Masquerade
+
+	metric := defaultRouteMetric
+	if site.Metric != nil {
+		metric = *site.Metric
+	}
+
+	routerInputs := pulumi.Map{
+		"networkID":  net.ID().ToStringOutput(),
+		"enabled":    pulumi.Bool(true),
+		"masquerade": pulumi.Bool(masquerade),
+		"metric":     pulumi.Int(metric),
+	}
+	if site.PeerGroup != nil {
+		routerInputs["peerGroups"] = pulumi.StringArray{pulumi.String(*site.PeerGroup)}
+	} else {
+		routerInputs["peer"] = pulumi.String(*site.Peer)
+	}
+
+	var router pulumi.CustomResourceState
+
+	err = ctx.RegisterResource(tokenNetworkRouter, prefix+"-router", routerInputs, &router, pulumi.Parent(parent))
+	if err != nil {
+		return siteResources{}, fmt.Errorf("creating NetworkRouter for site %q: %w", site.Name, err)
+	}
+
+	for _, cidr := range site.CIDRs {
+		var sub pulumi.CustomResourceState
+
+		// Subnets are keyed by CIDR, so reordering or removing one keeps the others.
+		err = ctx.RegisterResource(tokenNetworkResource, prefix+"-subnet-"+childKey(cidr), pulumi.Map{
+			"name":      pulumi.String(cidr),
+			"networkID": net.ID().ToStringOutput(),
+			"address":   pulumi.String(cidr),
+			"enabled":   pulumi.Bool(true),
+			"groupIDs":  pulumi.StringArray{resourceGroupID},
+		}, &sub, pulumi.Parent(parent))
+		if err != nil {
+			return siteResources{}, fmt.Errorf("creating NetworkResource %q for site %q: %w", cidr, site.Name, err)
+		}
+
+		outputs.resourceIDs[cidr] = sub.ID().ToStringOutput()
+	}
+
+	outputs.networkIDs[site.Name] = net.ID().ToStringOutput()
+	outputs.routerIDs[site.Name] = router.ID().ToStringOutput()
+	outputs.routingGroupIDs[site.Name] = routingGroupID
+	outputs.resourceGroupIDs[site.Name] = resourceGroupID
+
+	return siteResources{routingGroupID: routingGroupID, resourceGroupID: resourceGroupID}, nil
+}
+
+// validateSiteToSite rejects links that cannot be deployed. It runs before
+// any child is registered, so invalid CIDRs never reach the API.
+func validateSiteToSite(args SiteToSiteArgs) error {
+	if args.SiteA.Name == args.SiteB.Name {
+		return fmt.Errorf("sites must have different names, both are %q", args.SiteA.Name)
+	}
+
+	if args.SiteA.PeerGroup != nil && args.SiteB.PeerGroup != nil && *args.SiteA.PeerGroup == *args.SiteB.PeerGroup {
+		return fmt.Errorf("sites %q and %q share the peer group %q", args.SiteA.Name, args.SiteB.Name, *args.SiteA.PeerGroup)
+	}
+
+	prefixesA, err := sitePrefixes(args.SiteA)
+	if err != nil {
+		return err
+	}
+
+	prefixesB, err := sitePrefixes(args.SiteB)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range prefixesA {
+		for _, b := range prefixesB {
+			if a.Overlaps(b) {
+				return fmt.Errorf("site %q CIDR %s overlaps with site %q CIDR %s", args.SiteA.Name, a, args.SiteB.Name, b)
+			}
+		}
+	}
+
+	return nil
+}
+
+// sitePrefixes validates a site and parses its CIDRs.
+func sitePrefixes(site SiteSpec) ([]netip.Prefix, error) {
+	if (site.Peer == nil) == (site.PeerGroup == nil) {
+		return nil, fmt.Errorf("site %q: exactly one of peer and peerGroup must be set", site.Name)
+	}
+
+	if len(site.CIDRs) == 0 {
+		return nil, fmt.Errorf("site %q requires at least one CIDR", site.Name)
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(site.CIDRs))
+
+	for _, cidr := range site.CIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("site %q: invalid CIDR %q: %w", site.Name, cidr, err)
+		}
+
+		for _, other := range prefixes {
+			if prefix.Overlaps(other) {
+				return nil, fmt.Errorf("site %q: CIDR %s overlaps with %s", site.Name, prefix, other)
+			}
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	return prefixes, nil
+}
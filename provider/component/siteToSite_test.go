@@ -0,0 +1,107 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func siteToSiteArgs(cidrsA, cidrsB []string) SiteToSiteArgs {
+	return SiteToSiteArgs{
+		Name:        "link",
+		Description: nil,
+		SiteA:       SiteSpec{Name: "a", Peer: ptr("peer-a"), PeerGroup: nil, CIDRs: cidrsA, Masquerade: nil, Metric: nil},
+		SiteB:       SiteSpec{Name: "b", Peer: nil, PeerGroup: ptr("routers-b"), CIDRs: cidrsB, Masquerade: nil, Metric: nil},
+	}
+}
+
+func TestValidateSiteToSiteCIDRs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		cidrsA []string
+		cidrsB []string
+		want   string
+	}{
+		{"IPv4 neighbours", []string{"10.0.0.0/24"}, []string{"10.0.1.0/24"}, ""},
+		{"IPv4 nested", []string{"10.0.0.0/16"}, []string{"10.0.5.0/24"}, `site "a" CIDR 10.0.0.0/16 overlaps with site "b" CIDR 10.0.5.0/24`},
+		{"IPv4 nested other way", []string{"10.0.5.0/24"}, []string{"10.0.0.0/16"}, `site "a" CIDR 10.0.5.0/24 overlaps with site "b" CIDR 10.0.0.0/16`},
+		{"IPv4 identical", []string{"192.168.1.0/24"}, []string{"192.168.1.0/24"}, "overlaps"},
+		{"IPv4 host bits set", []string{"10.0.0.1/24"}, []string{"10.0.0.128/25"}, "overlaps"},
+		{"IPv4 second CIDR overlaps", []string{"10.1.0.0/16", "10.2.0.0/16"}, []string{"10.3.0.0/16", "10.2.128.0/17"},
+			`site "a" CIDR 10.2.0.0/16 overlaps with site "b" CIDR 10.2.128.0/17`},
+		{"IPv6 neighbours", []string{"fd00:1::/64"}, []string{"fd00:1:0:1::/64"}, ""},
+		{"IPv6 nested", []string{"fd00::/48"}, []string{"fd00:0:0:1::/64"}, "overlaps"},
+		{"IPv6 identical", []string{"fd00:1::/64"}, []string{"fd00:1::/64"}, "overlaps"},
+		{"mixed families", []string{"10.0.0.0/8", "fd00::/8"}, []string{"fe80::/10", "172.16.0.0/12"}, ""},
+		{"overlap within a site", []string{"10.0.0.0/16", "10.0.1.0/24"}, []string{"10.1.0.0/16"},
+			`site "a": CIDR 10.0.1.0/24 overlaps with 10.0.0.0/16`},
+		{"invalid CIDR", []string{"10.0.0.0/33"}, []string{"10.1.0.0/16"}, `site "a": invalid CIDR "10.0.0.0/33"`},
+		{"no CIDRs", []string{"10.0.0.0/16"}, nil, `site "b" requires at least one CIDR`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateSiteToSite(siteToSiteArgs(tt.cidrsA, tt.cidrsB))
+			if tt.want == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestValidateSiteToSiteSites(t *testing.T) {
+	t.Parallel()
+
+	args := siteToSiteArgs([]string{"10.0.0.0/24"}, []string{"10.0.1.0/24"})
+	args.SiteB.Name = "a"
+
+	err := validateSiteToSite(args)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `sites must have different names, both are "a"`)
+
+	args = siteToSiteArgs([]string{"10.0.0.0/24"}, []string{"10.0.1.0/24"})
+	args.SiteA.Peer, args.SiteA.PeerGroup = nil, ptr("routers-b")
+
+	err = validateSiteToSite(args)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `share the peer group "routers-b"`)
+
+	args = siteToSiteArgs([]string{"10.0.0.0/24"}, []string{"10.0.1.0/24"})
+	args.SiteA.PeerGroup = ptr("routers-a")
+
+	err = validateSiteToSite(args)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `site "a": exactly one of peer and peerGroup must be set`)
+}
+
+// TestSiteToSiteSubnetsKeyedByCIDR checks that subnets are keyed by CIDR, so
+// reordering them keeps every child name, and that IPv6 CIDRs make valid names.
+func TestSiteToSiteSubnetsKeyedByCIDR(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"link-a-subnet-10.1.0.0/16", "link-a-subnet-fd00-1--/64", "link-b-subnet-10.2.0.0/16"}
+	before := newComponentMocks()
+
+	_, err := construct(t.Context(), before, (&SiteToSite{}).Construct, "link",
+		siteToSiteArgs([]string{"10.1.0.0/16", "fd00:1::/64"}, []string{"10.2.0.0/16"}))
+	require.NoError(t, err)
+	assert.Equal(t, want, before.names(tokenNetworkResource))
+
+	after := newComponentMocks()
+
+	_, err = construct(t.Context(), after, (&SiteToSite{}).Construct, "link",
+		siteToSiteArgs([]string{"fd00:1::/64", "10.1.0.0/16"}, []string{"10.2.0.0/16"}))
+	require.NoError(t, err)
+	assert.Equal(t, want, after.names(tokenNetworkResource), "subnets after reordering")
+	assert.Equal(t, "fd00:1::/64", after.find(t, "link-a-subnet-fd00-1--/64").inputs["address"].StringValue())
+}
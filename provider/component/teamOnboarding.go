@@ -0,0 +1,296 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// TeamMemberSpec holds a user created as a member of a TeamOnboarding team.
+type TeamMemberSpec struct {
+	Email string  `pulumi:"email"`
+	Name  *string `pulumi:"name,optional"`
+	Role  *string `pulumi:"role,optional"`
+}
+
+// Annotate adds schema descriptions to TeamMemberSpec fields.
+func (m *TeamMemberSpec) Annotate(a infer.Annotator) {
+	a.Describe(&m.Email, "Email address of the user to invite.")
+	a.Describe(&m.Name, "Optional display name of the user.")
+	a.Describe(&m.Role, "NetBird account role of the user. Defaults to user.")
+}
+
+// TeamDNSSpec holds the nameservers distributed to a TeamOnboarding team.
+type TeamDNSSpec struct {
+	Nameservers []string `pulumi:"nameservers"`
+	Port        *int     `pulumi:"port,optional"`
+	Domains     []string `pulumi:"domains,optional"`
+}
+
+// Annotate adds schema descriptions to TeamDNSSpec fields.
+func (d *TeamDNSSpec) Annotate(a infer.Annotator) {
+	a.Describe(&d.Nameservers, "IP addresses of the nameservers.")
+	a.Describe(&d.Port, "UDP port of the nameservers. Defaults to 53.")
+	a.Describe(&d.Domains, "Domains resolved with the nameservers. Empty makes them the primary nameservers of the team.")
+}
+
+// TeamOnboardingArgs are the inputs for a TeamOnboarding component.
+type TeamOnboardingArgs struct {
+	Team                string           `pulumi:"team"`
+	SharedServiceGroups []string         `pulumi:"sharedServiceGroups,optional"`
+	SetupKeyExpiresIn   int              `pulumi:"setupKeyExpiresIn"`
+	SetupKeyUsageLimit  *int             `pulumi:"setupKeyUsageLimit,optional"`
+	Members             []TeamMemberSpec `pulumi:"members,optional"`
+	ExistingMembers     []string         `pulumi:"existingMembers,optional"`
+	DNS                 *TeamDNSSpec     `pulumi:"dns,optional"`
+}
+
+// Annotate adds schema descriptions to TeamOnboardingArgs fields.
+func (t *TeamOnboardingArgs) Annotate(a infer.Annotator) {
+	a.Describe(&t.Team, "Name of the team, used as the name of its group.")
+	a.Describe(&t.SharedServiceGroups, "IDs of the groups of shared services the team may reach. Each gets its own policy.")
+	a.Describe(&t.SetupKeyExpiresIn, "Lifetime of the team's reusable setup key in seconds.")
+	a.Describe(&t.SetupKeyUsageLimit, "Maximum number of peers the setup key may enroll. Defaults to 0, which is unlimited.")
+	a.Describe(&t.Members, "Users to invite as team members. Their auto-groups include the team group. "+
+		"The users are created by the component, so they must not exist yet; use existingMembers for those that do.")
+	a.Describe(&t.ExistingMembers, "IDs of existing users to add to the team. The team group is added to their auto-groups "+
+		"and the groups they already have are kept. Removing a user from the list removes only the team group.")
+	a.Describe(&t.DNS, "Optional nameservers distributed to the team's peers.")
+}
+
+// TeamOnboardingState holds the outputs of a TeamOnboarding component.
+type TeamOnboardingState struct {
+	pulumi.ResourceState
+
+	GroupID           pulumi.StringOutput    `pulumi:"groupId"`
+	SetupKeyID        pulumi.StringOutput    `pulumi:"setupKeyId"`
+	SetupKey          pulumi.StringOutput    `provider:"secret" pulumi:"setupKey"`
+	PolicyIDs         pulumi.StringMapOutput `pulumi:"policyIds"`
+	MemberIDs         pulumi.StringMapOutput `pulumi:"memberIds"`
+	MembershipIDs     pulumi.StringMapOutput `pulumi:"membershipIds"`
+	NameserverGroupID pulumi.StringPtrOutput `pulumi:"nameserverGroupId,optional"`
+}
+
+// Annotate adds schema descriptions to TeamOnboardingState fields.
+func (s *TeamOnboardingState) Annotate(a infer.Annotator) {
+	a.Describe(&s.GroupID, "ID of the created team Group.")
+	a.Describe(&s.SetupKeyID, "ID of the created SetupKey.")
+	a.Describe(&s.SetupKey, "Plaintext value of the team's setup key. Secret.")
+	a.Describe(&s.PolicyIDs, "ID of the created Policy for each shared service group, keyed by group ID.")
+	a.Describe(&s.MemberIDs, "ID of the created User of each member, keyed by email.")
+	a.Describe(&s.MembershipIDs, "ID of the UserGroupMembership of each existing member, keyed by user ID.")
+	a.Describe(&s.NameserverGroupID, "ID of the created DNS nameserver group. Unset without dns.")
+}
+
+// TeamOnboarding is the ComponentResource anchor for the TeamOnboarding component.
+type TeamOnboarding struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*TeamOnboarding) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args TeamOnboardingArgs, opts pulumi.ResourceOption,
+) (*TeamOnboardingState, error) {
+	return newTeamOnboarding(ctx, name, typ, args, opts)
+}
+
+// setupKeyResource captures the outputs of a SetupKey child resource.
+type setupKeyResource struct {
+	pulumi.CustomResourceState
+
+	Key pulumi.StringOutput `pulumi:"key"`
+}
+
+func newTeamOnboarding( //nolint:funlen
+	ctx *pulumi.Context,
+	name, typ string,
+	args TeamOnboardingArgs,
+	opts ...pulumi.ResourceOption,
+) (*TeamOnboardingState, error) {
+	err := validateTeamOnboarding(args)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &TeamOnboardingState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering TeamOnboarding component: %w", err)
+	}
+
+	var group pulumi.CustomResourceState
+
+	err = ctx.RegisterResource(tokenGroup, name+"-group", pulumi.Map{
+		"name": pulumi.String(args.Team),
+	}, &group, pulumi.Parent(comp))
+	if err != nil {
+		return nil, fmt.Errorf("creating Group: %w", err)
+	}
+
+	groupID := group.ID().ToStringOutput()
+
+	usageLimit := 0
+	if args.SetupKeyUsageLimit != nil {
+		usageLimit = *args.SetupKeyUsageLimit
+	}
+
+	var setupKey setupKeyResource
+
+	err = ctx.RegisterResource(tokenSetupKey, name+"-setup-key", pulumi.Map{
+		"name":       pulumi.String(args.Team),
+		"type":       pulumi.String(string(resource.SetupKeyTypeReusable)),
+		"expiresIn":  pulumi.Int(args.SetupKeyExpiresIn),
+		"autoGroups": pulumi.StringArray{groupID},
+		"usageLimit": pulumi.Int(usageLimit),
+	}, &setupKey, pulumi.Parent(comp))
+	if err != nil {
+		return nil, fmt.Errorf("creating SetupKey: %w", err)
+	}
+
+	policyIDs := pulumi.StringMap{}
+
+	for _, serviceGroup := range args.SharedServiceGroups {
+		var policy pulumi.CustomResourceState
+
+		// Children are keyed by group ID, so reordering the list replaces nothing.
+		err = ctx.RegisterResource(tokenPolicy, name+"-policy-"+serviceGroup, pulumi.Map{
+			"name":    pulumi.String(args.Team + "-" + serviceGroup),
+			"enabled": pulumi.Bool(true),
+			"rules": pulumi.Array{pulumi.Map{
+				"name":          pulumi.String(args.Team + "-" + serviceGroup),
+				"action":        pulumi.String(string(resource.RuleActionAccept)),
+				"enabled":       pulumi.Bool(true),
+				"bidirectional": pulumi.Bool(false),
+				"protocol":      pulumi.String(string(resource.ProtocolAll)),
+				"sources":       pulumi.StringArray{groupID},
+				"destinations":  pulumi.StringArray{pulumi.String(serviceGroup)},
+			}},
+		}, &policy, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating Policy for group %s: %w", serviceGroup, err)
+		}
+
+		policyIDs[serviceGroup] = policy.ID().ToStringOutput()
+	}
+
+	memberIDs := pulumi.StringMap{}
+
+	for _, member := range args.Members {
+		role := "user"
+		if member.Role != nil {
+			role = *member.Role
+		}
+
+		userInputs := pulumi.Map{
+			"email":      pulumi.String(member.Email),
+			"role":       pulumi.String(role),
+			"autoGroups": pulumi.StringArray{groupID},
+		}
+		if member.Name != nil {
+			userInputs["name"] = pulumi.String(*member.Name)
+		}
+
+		var user pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenUser, name+"-member-"+member.Email, userInputs, &user, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating User %s: %w", member.Email, err)
+		}
+
+		memberIDs[member.Email] = user.ID().ToStringOutput()
+	}
+
+	membershipIDs := pulumi.StringMap{}
+
+	for _, userID := range args.ExistingMembers {
+		var membership pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenUserGroupMembership, name+"-existing-member-"+userID, pulumi.Map{
+			"userId":  pulumi.String(userID),
+			"groupId": groupID,
+		}, &membership, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("adding user %s to the team: %w", userID, err)
+		}
+
+		membershipIDs[userID] = membership.ID().ToStringOutput()
+	}
+
+	comp.NameserverGroupID = unsetStringPtr()
+
+	if args.DNS != nil {
+		var nameserverGroup pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenDNS, name+"-dns", pulumi.Map{
+			"name":                 pulumi.String(args.Team),
+			"description":          pulumi.String("Nameservers of team " + args.Team),
+			"domains":              pulumi.ToStringArray(args.DNS.Domains),
+			"enabled":              pulumi.Bool(true),
+			"groups":               pulumi.StringArray{groupID},
+			"primary":              pulumi.Bool(len(args.DNS.Domains) == 0),
+			"nameservers":          nameserverInputs(args.DNS.Nameservers, args.DNS.Port),
+			"searchDomainsEnabled": pulumi.Bool(false),
+		}, &nameserverGroup, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating DNS nameserver group: %w", err)
+		}
+
+		comp.NameserverGroupID = nameserverGroup.ID().ToStringOutput().ToStringPtrOutput()
+	}
+
+	comp.GroupID = groupID
+	comp.SetupKeyID = setupKey.ID().ToStringOutput()
+	comp.SetupKey = pulumi.ToSecret(setupKey.Key).(pulumi.StringOutput) //nolint:forcetypeassert
+	comp.PolicyIDs = policyIDs.ToStringMapOutput()
+	comp.MemberIDs = memberIDs.ToStringMapOutput()
+	comp.MembershipIDs = membershipIDs.ToStringMapOutput()
+
+	return comp, nil
+}
+
+// validateTeamOnboarding rejects teams that cannot be deployed.
+func validateTeamOnboarding(args TeamOnboardingArgs) error {
+	if args.Team == "" {
+		return errors.New("TeamOnboarding requires a team name")
+	}
+
+	if args.SetupKeyExpiresIn < 0 {
+		return fmt.Errorf("setupKeyExpiresIn must be at least 0, got %d", args.SetupKeyExpiresIn)
+	}
+
+	if args.SetupKeyUsageLimit != nil && *args.SetupKeyUsageLimit < 0 {
+		return fmt.Errorf("setupKeyUsageLimit must be at least 0, got %d", *args.SetupKeyUsageLimit)
+	}
+
+	for i, group := range args.SharedServiceGroups {
+		if slices.Contains(args.SharedServiceGroups[:i], group) {
+			return fmt.Errorf("duplicate shared service group %q", group)
+		}
+	}
+
+	for i, member := range args.Members {
+		if slices.ContainsFunc(args.Members[:i], func(m TeamMemberSpec) bool { return m.Email == member.Email }) {
+			return fmt.Errorf("duplicate member %q", member.Email)
+		}
+	}
+
+	for i, userID := range args.ExistingMembers {
+		if userID == "" {
+			return errors.New("existingMembers must not contain an empty user ID")
+		}
+
+		if slices.Contains(args.ExistingMembers[:i], userID) {
+			return fmt.Errorf("duplicate existing member %q", userID)
+		}
+	}
+
+	if args.DNS == nil {
+		return nil
+	}
+
+	return validateNameservers(args.DNS.Nameservers, args.DNS.Port)
+}
@@ -0,0 +1,198 @@
+package component
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func teamOnboardingArgs() TeamOnboardingArgs {
+	return TeamOnboardingArgs{
+		Team:                "payments",
+		SharedServiceGroups: []string{"svc-git", "svc-ci"},
+		SetupKeyExpiresIn:   86400,
+		SetupKeyUsageLimit:  nil,
+		Members: []TeamMemberSpec{
+			{Email: "ana@example.com", Name: ptr("Ana"), Role: nil},
+			{Email: "bo@example.com", Name: nil, Role: ptr("admin")},
+		},
+		ExistingMembers: []string{"user-cy", "user-dee"},
+		DNS:             nil,
+	}
+}
+
+func TestTeamOnboardingWiring(t *testing.T) {
+	t.Parallel()
+
+	mocks := newComponentMocks()
+
+	state, err := construct(t.Context(), mocks, (&TeamOnboarding{}).Construct, "team", teamOnboardingArgs())
+	require.NoError(t, err)
+
+	setupKey := mocks.find(t, "team-setup-key")
+	assert.Equal(t, []string{"team-group-id"}, stringInputs(setupKey.inputs["autoGroups"]))
+	assert.InDelta(t, 0, setupKey.inputs["usageLimit"].NumberValue(), 0)
+
+	for _, serviceGroup := range []string{"svc-git", "svc-ci"} {
+		rule := mocks.find(t, "team-policy-"+serviceGroup).inputs["rules"].ArrayValue()[0].ObjectValue()
+
+		assert.Equal(t, []string{"team-group-id"}, stringInputs(rule["sources"]), "%s sources", serviceGroup)
+		assert.Equal(t, []string{serviceGroup}, stringInputs(rule["destinations"]), "%s destinations", serviceGroup)
+	}
+
+	ana := mocks.find(t, "team-member-ana@example.com").inputs
+	assert.Equal(t, "user", ana["role"].StringValue())
+	assert.Equal(t, "Ana", ana["name"].StringValue())
+
+	bo := mocks.find(t, "team-member-bo@example.com").inputs
+	assert.False(t, bo.HasValue("name"), "bo has a name")
+	assert.Equal(t, "admin", bo["role"].StringValue())
+	assert.Equal(t, []string{"team-group-id"}, stringInputs(bo["autoGroups"]))
+
+	// Existing users get a membership instead of a User, so their other
+	// auto-groups are left alone.
+	cy := mocks.find(t, "team-existing-member-user-cy").inputs
+	assert.Equal(t, "user-cy", cy["userId"].StringValue())
+	assert.Equal(t, "team-group-id", cy["groupId"].StringValue())
+	assert.Equal(t, []string{"team-member-ana@example.com", "team-member-bo@example.com"}, mocks.names(tokenUser))
+
+	assert.Empty(t, mocks.names(tokenDNS), "nameserver groups without dns")
+	assert.Nil(t, await[*string](t, state.NameserverGroupID))
+}
+
+func TestTeamOnboardingDNS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		domains     []string
+		wantPrimary bool
+	}{
+		{name: "primary", domains: nil, wantPrimary: true},
+		{name: "match domains", domains: []string{"corp.example.com"}, wantPrimary: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := teamOnboardingArgs()
+			args.DNS = &TeamDNSSpec{Nameservers: []string{"10.0.0.53"}, Port: nil, Domains: tt.domains}
+
+			mocks := newComponentMocks()
+
+			_, err := construct(t.Context(), mocks, (&TeamOnboarding{}).Construct, "team", args)
+			require.NoError(t, err)
+
+			dns := mocks.find(t, "team-dns").inputs
+			assert.Equal(t, tt.wantPrimary, dns["primary"].BoolValue())
+			assert.Equal(t, []string{"team-group-id"}, stringInputs(dns["groups"]))
+
+			nameserver := dns["nameservers"].ArrayValue()[0].ObjectValue()
+			assert.Equal(t, "10.0.0.53", nameserver["ip"].StringValue())
+			assert.InDelta(t, defaultNameserverPort, nameserver["port"].NumberValue(), 0)
+		})
+	}
+}
+
+// TestTeamOnboardingReorderKeepsChildren checks that children are keyed by
+// group ID, email and user ID, so reordering the lists keeps every child name.
+func TestTeamOnboardingReorderKeepsChildren(t *testing.T) {
+	t.Parallel()
+
+	before := newComponentMocks()
+
+	_, err := construct(t.Context(), before, (&TeamOnboarding{}).Construct, "team", teamOnboardingArgs())
+	require.NoError(t, err)
+
+	args := teamOnboardingArgs()
+	slices.Reverse(args.SharedServiceGroups)
+	slices.Reverse(args.Members)
+	slices.Reverse(args.ExistingMembers)
+	after := newComponentMocks()
+
+	_, err = construct(t.Context(), after, (&TeamOnboarding{}).Construct, "team", args)
+	require.NoError(t, err)
+
+	for _, typ := range []string{tokenPolicy, tokenUser, tokenUserGroupMembership} {
+		assert.Equal(t, before.names(typ), after.names(typ), "%s children after reordering", typ)
+	}
+}
+
+func TestValidateTeamOnboarding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		mutate func(args *TeamOnboardingArgs)
+		errMsg string
+	}{
+		{name: "valid", mutate: func(*TeamOnboardingArgs) {}},
+		{name: "no team", mutate: func(args *TeamOnboardingArgs) { args.Team = "" }, errMsg: "requires a team name"},
+		{
+			name:   "negative expiry",
+			mutate: func(args *TeamOnboardingArgs) { args.SetupKeyExpiresIn = -1 },
+			errMsg: "setupKeyExpiresIn must be at least 0, got -1",
+		},
+		{
+			name:   "negative usage limit",
+			mutate: func(args *TeamOnboardingArgs) { args.SetupKeyUsageLimit = ptr(-2) },
+			errMsg: "setupKeyUsageLimit must be at least 0, got -2",
+		},
+		{
+			name:   "duplicate service group",
+			mutate: func(args *TeamOnboardingArgs) { args.SharedServiceGroups = append(args.SharedServiceGroups, "svc-git") },
+			errMsg: `duplicate shared service group "svc-git"`,
+		},
+		{
+			name: "duplicate member",
+			mutate: func(args *TeamOnboardingArgs) {
+				args.Members = append(args.Members, TeamMemberSpec{Email: "ana@example.com", Name: nil, Role: nil})
+			},
+			errMsg: `duplicate member "ana@example.com"`,
+		},
+		{
+			name:   "duplicate existing member",
+			mutate: func(args *TeamOnboardingArgs) { args.ExistingMembers = append(args.ExistingMembers, "user-cy") },
+			errMsg: `duplicate existing member "user-cy"`,
+		},
+		{
+			name:   "empty existing member",
+			mutate: func(args *TeamOnboardingArgs) { args.ExistingMembers = []string{""} },
+			errMsg: "existingMembers must not contain an empty user ID",
+		},
+		{
+			name:   "dns without nameservers",
+			mutate: func(args *TeamOnboardingArgs) { args.DNS = &TeamDNSSpec{Nameservers: nil, Port: nil, Domains: nil} },
+			errMsg: "dns requires at least one nameserver",
+		},
+		{
+			name: "dns port out of range",
+			mutate: func(args *TeamOnboardingArgs) {
+				args.DNS = &TeamDNSSpec{Nameservers: []string{"10.0.0.53"}, Port: ptr(70000), Domains: nil}
+			},
+			errMsg: "dns port 70000 must be between 1 and 65535",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := teamOnboardingArgs()
+			tt.mutate(&args)
+
+			err := validateTeamOnboarding(args)
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// ZeroTrustGroupSpec holds the configuration of a group created by a ZeroTrustSegment.
+type ZeroTrustGroupSpec struct {
+	Name  string   `pulumi:"name"`
+	Peers []string `pulumi:"peers,optional"`
+}
+
+// Annotate adds schema descriptions to ZeroTrustGroupSpec fields.
+func (g *ZeroTrustGroupSpec) Annotate(a infer.Annotator) {
+	a.Describe(&g.Name, "Name of the group.")
+	a.Describe(&g.Peers, "IDs of the peers to add to the group.")
+}
+
+// ZeroTrustPostureSpec holds the posture requirements of a ZeroTrustSegment.
+type ZeroTrustPostureSpec struct {
+	MinClientVersion     *string  `pulumi:"minClientVersion,optional"`
+	AllowedCountries     []string `pulumi:"allowedCountries,optional"`
+	BlockedCountries     []string `pulumi:"blockedCountries,optional"`
+	AllowedNetworkRanges []string `pulumi:"allowedNetworkRanges,optional"`
+	BlockedNetworkRanges []string `pulumi:"blockedNetworkRanges,optional"`
+}
+
+// Annotate adds schema descriptions to ZeroTrustPostureSpec fields.
+func (s *ZeroTrustPostureSpec) Annotate(a infer.Annotator) {
+	a.Describe(&s.MinClientVersion, "Minimum NetBird client version of source peers.")
+	a.Describe(&s.AllowedCountries, "ISO 3166-1 alpha-2 codes of the countries source peers may connect from. "+
+		"Conflicts with blockedCountries.")
+	a.Describe(&s.BlockedCountries, "ISO 3166-1 alpha-2 codes of the countries source peers may not connect from. "+
+		"Conflicts with allowedCountries.")
+	a.Describe(&s.AllowedNetworkRanges, "CIDR ranges a source peer's local network must be in. Conflicts with blockedNetworkRanges.")
+	a.Describe(&s.BlockedNetworkRanges, "CIDR ranges a source peer's local network must not be in. Conflicts with allowedNetworkRanges.")
+}
+
+// ZeroTrustSegmentArgs are the inputs for a ZeroTrustSegment component.
+type ZeroTrustSegmentArgs struct {
+	Name          string                   `pulumi:"name"`
+	Description   *string                  `pulumi:"description,optional"`
+	Source        ZeroTrustGroupSpec       `pulumi:"source"`
+	Destination   ZeroTrustGroupSpec       `pulumi:"destination"`
+	Protocols     []resource.Protocol      `pulumi:"protocols"`
+	Ports         []string                 `pulumi:"ports,optional"`
+	PortRanges    []resource.RulePortRange `pulumi:"portRanges,optional"`
+	Bidirectional *bool                    `pulumi:"bidirectional,optional"`
+	Posture       *ZeroTrustPostureSpec    `pulumi:"posture,optional"`
+}
+
+// Annotate adds schema descriptions to ZeroTrustSegmentArgs fields.
+func (z *ZeroTrustSegmentArgs) Annotate(a infer.Annotator) {
+	a.Describe(&z.Name, "Name of the segment, used for the posture check and the policy.")
+	a.Describe(&z.Description, "Optional description for the posture check and the policy.")
+	a.Describe(&z.Source, "Group of the peers allowed to reach the segment.")
+	a.Describe(&z.Destination, "Group of the peers that make up the segment.")
+	a.Describe(&z.Protocols, "Protocols to accept. The policy gets one rule per protocol.")
+	a.Describe(&z.Ports, "Ports to accept. Only valid when every protocol is tcp or udp.")
+	a.Describe(&z.PortRanges, "Port ranges to accept. Only valid when every protocol is tcp or udp.")
+	a.Describe(&z.Bidirectional, "Whether the destination may also initiate connections to the source. Defaults to false.")
+	a.Describe(&z.Posture, "Optional posture requirements for the source peers. When set, a posture check is created "+
+		"and attached to the policy.")
+}
+
+// ZeroTrustSegmentState holds the outputs of a ZeroTrustSegment component.
+type ZeroTrustSegmentState struct {
+	pulumi.ResourceState
+
+	SourceGroupID      pulumi.StringOutput    `pulumi:"sourceGroupId"`
+	DestinationGroupID pulumi.StringOutput    `pulumi:"destinationGroupId"`
+	PostureCheckID     pulumi.StringPtrOutput `pulumi:"postureCheckId,optional"`
+	PolicyID           pulumi.StringOutput    `pulumi:"policyId"`
+}
+
+// Annotate adds schema descriptions to ZeroTrustSegmentState fields.
+func (s *ZeroTrustSegmentState) Annotate(a infer.Annotator) {
+	a.Describe(&s.SourceGroupID, "ID of the created source Group.")
+	a.Describe(&s.DestinationGroupID, "ID of the created destination Group.")
+	a.Describe(&s.PostureCheckID, "ID of the created PostureCheck. Unset without posture.")
+	a.Describe(&s.PolicyID, "ID of the created Policy.")
+}
+
+// ZeroTrustSegment is the ComponentResource anchor for the ZeroTrustSegment component.
+type ZeroTrustSegment struct{}
+
+// Construct implements infer.ComponentResource and creates the child resources.
+func (*ZeroTrustSegment) Construct(
+	ctx *pulumi.Context, name, typ string,
+	args ZeroTrustSegmentArgs, opts pulumi.ResourceOption,
+) (*ZeroTrustSegmentState, error) {
+	return newZeroTrustSegment(ctx, name, typ, args, opts)
+}
+
+func newZeroTrustSegment(
+	ctx *pulumi.Context,
+	name, typ string,
+	args ZeroTrustSegmentArgs,
+	opts ...pulumi.ResourceOption,
+) (*ZeroTrustSegmentState, error) {
+	err := validateZeroTrustSegment(args)
+	if err != nil {
+		return nil, err
+	}
+
+	comp := &ZeroTrustSegmentState{} //nolint:exhaustruct
+
+	err = ctx.RegisterComponentResource(typ, name, comp, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("registering ZeroTrustSegment component: %w", err)
+	}
+
+	sourceID, err := newSegmentGroup(ctx, name+"-source", args.Source, comp)
+	if err != nil {
+		return nil, err
+	}
+
+	destinationID, err := newSegmentGroup(ctx, name+"-destination", args.Destination, comp)
+	if err != nil {
+		return nil, err
+	}
+
+	policyInputs := pulumi.Map{
+		"name":    pulumi.String(args.Name),
+		"enabled": pulumi.Bool(true),
+		"rules":   segmentRules(args, sourceID, destinationID),
+	}
+	if args.Description != nil {
+		policyInputs["description"] = pulumi.String(*args.Description)
+	}
+
+	comp.PostureCheckID = unsetStringPtr()
+
+	if args.Posture != nil {
+		postureInputs := pulumi.Map{
+			"name":   pulumi.String(args.Name),
+			"checks": postureChecks(*args.Posture),
+		}
+		if args.Description != nil {
+			postureInputs["description"] = pulumi.String(*args.Description)
+		}
+
+		var postureCheck pulumi.CustomResourceState
+
+		err = ctx.RegisterResource(tokenPostureCheck, name+"-posture", postureInputs, &postureCheck, pulumi.Parent(comp))
+		if err != nil {
+			return nil, fmt.Errorf("creating PostureCheck: %w", err)
+		}
+
+		policyInputs["postureChecks"] = pulumi.StringArray{postureCheck.ID().ToStringOutput()}
+		comp.PostureCheckID = postureCheck.ID().ToStringOutput().ToStringPtrOutput()
+	}
+
+	var policy pulumi.CustomResourceState
+
+	err = ctx.RegisterResource(tokenPolicy, name+"-policy", policyInputs, &policy, pulumi.Parent(comp))
+	if err != nil {
+		return nil, fmt.Errorf("creating Policy: %w", err)
+	}
+
+	comp.SourceGroupID = sourceID
+	comp.DestinationGroupID = destinationID
+	comp.PolicyID = policy.ID().ToStringOutput()
+
+	return comp, nil
+}
+
+// newSegmentGroup creates one of the groups of a segment and returns its ID.
+func newSegmentGroup(
+	ctx *pulumi.Context,
+	name string,
+	spec ZeroTrustGroupSpec,
+	parent pulumi.Resource,
+) (pulumi.StringOutput, error) {
+	groupInputs := pulumi.Map{
+		"name": pulumi.String(spec.Name),
+	}
+	if len(spec.Peers) > 0 {
+		groupInputs["peers"] = pulumi.ToStringArray(spec.Peers)
+	}
+
+	var group pulumi.CustomResourceState
+
+	err := ctx.RegisterResource(tokenGroup, name, groupInputs, &group, pulumi.Parent(parent))
+	if err != nil {
+		return pulumi.StringOutput{}, fmt.Errorf("creating Group %q: %w", spec.Name, err)
+	}
+
+	return group.ID().ToStringOutput(), nil
+}
+
+// segmentRules builds one accept rule per protocol from the source to the
+// destination group.
+func segmentRules(args ZeroTrustSegmentArgs, sourceID, destinationID pulumi.StringOutput) pulumi.Array {
+	bidirectional := args.Bidirectional != nil && *args.Bidirectional
+	rules := make(pulumi.Array, 0, len(args.Protocols))
+
+	for _, protocol := range args.Protocols {
+		rule := pulumi.Map{
+			"name":          pulumi.String(args.Name + "-" + string(protocol)),
+			"action":        pulumi.String(string(resource.RuleActionAccept)),
+			"enabled":       pulumi.Bool(true),
+			"bidirectional": pulumi.Bool(bidirectional),
+			"protocol":      pulumi.String(string(protocol)),
+			"sources":       pulumi.StringArray{sourceID},
+			"destinations":  pulumi.StringArray{destinationID},
+		}
+		if len(args.Ports) > 0 {
+			rule["ports"] = pulumi.ToStringArray(args.Ports)
+		}
+
+		if len(args.PortRanges) > 0 {
+			rule["portRanges"] = portRangeInputs(args.PortRanges)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// postureChecks converts the posture requirements to PostureCheck checks.
+func postureChecks(spec ZeroTrustPostureSpec) pulumi.Map {
+	checks := pulumi.Map{}
+
+	if spec.MinClientVersion != nil {
+		checks["nbVersionCheck"] = pulumi.Map{"minVersion": pulumi.String(*spec.MinClientVersion)}
+	}
+
+	countries, action := spec.AllowedCountries, resource.PostureGeoLocationActionAllow
+	if len(spec.BlockedCountries) > 0 {
+		countries, action = spec.BlockedCountries, resource.PostureGeoLocationActionDeny
+	}
+
+	if len(countries) > 0 {
+		locations := make(pulumi.Array, len(countries))
+		for i, country := range countries {
+			locations[i] = pulumi.Map{"countryCode": pulumi.String(country)}
+		}
+
+		checks["geoLocationCheck"] = pulumi.Map{
+			"action":    pulumi.String(string(action)),
+			"locations": locations,
+		}
+	}
+
+	ranges, rangeAction := spec.AllowedNetworkRanges, resource.PosturePeerNetworkRangeActionAllow
+	if len(spec.BlockedNetworkRanges) > 0 {
+		ranges, rangeAction = spec.BlockedNetworkRanges, resource.PosturePeerNetworkRangeActionDeny
+	}
+
+	if len(ranges) > 0 {
+		checks["peerNetworkRangeCheck"] = pulumi.Map{
+			"action": pulumi.String(string(rangeAction)),
+			"ranges": pulumi.ToStringArray(ranges),
+		}
+	}
+
+	return checks
+}
+
+// validateZeroTrustSegment rejects segments that cannot be deployed.
+func validateZeroTrustSegment(args ZeroTrustSegmentArgs) error {
+	if len(args.Protocols) == 0 {
+		return errors.New("ZeroTrustSegment requires at least one protocol")
+	}
+
+	for i, protocol := range args.Protocols {
+		if slices.Contains(args.Protocols[:i], protocol) {
+			return fmt.Errorf("duplicate ZeroTrustSegment protocol %q", protocol)
+		}
+
+		if err := validateRulePorts(protocol, args.Ports, args.PortRanges); err != nil {
+			return err
+		}
+	}
+
+	if args.Source.Name == args.Destination.Name {
+		return fmt.Errorf("source and destination groups must have different names, both are %q", args.Source.Name)
+	}
+
+	if args.Posture == nil {
+		return nil
+	}
+
+	return validatePosture(*args.Posture)
+}
+
+// validatePosture rejects posture requirements postureChecks cannot build.
+func validatePosture(posture ZeroTrustPostureSpec) error {
+	if len(posture.AllowedCountries) > 0 && len(posture.BlockedCountries) > 0 {
+		return errors.New("posture allowedCountries and blockedCountries cannot be combined")
+	}
+
+	if len(posture.AllowedNetworkRanges) > 0 && len(posture.BlockedNetworkRanges) > 0 {
+		return errors.New("posture allowedNetworkRanges and blockedNetworkRanges cannot be combined")
+	}
+
+	if posture.MinClientVersion == nil && len(posture.AllowedCountries) == 0 && len(posture.BlockedCountries) == 0 &&
+		len(posture.AllowedNetworkRanges) == 0 && len(posture.BlockedNetworkRanges) == 0 {
+		return errors.New("posture requires at least one requirement")
+	}
+
+	return nil
+}
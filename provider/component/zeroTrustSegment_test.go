@@ -0,0 +1,111 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zeroTrustSegmentArgs() ZeroTrustSegmentArgs {
+	return ZeroTrustSegmentArgs{
+		Name:          "db",
+		Description:   nil,
+		Source:        ZeroTrustGroupSpec{Name: "apps", Peers: []string{"peer-1"}},
+		Destination:   ZeroTrustGroupSpec{Name: "databases", Peers: nil},
+		Protocols:     []resource.Protocol{resource.ProtocolTCP, resource.ProtocolUDP},
+		Ports:         []string{"5432"},
+		PortRanges:    nil,
+		Bidirectional: nil,
+		Posture:       nil,
+	}
+}
+
+func TestZeroTrustSegmentWiring(t *testing.T) {
+	t.Parallel()
+
+	args := zeroTrustSegmentArgs()
+	args.Posture = &ZeroTrustPostureSpec{
+		MinClientVersion:     ptr("0.30.0"),
+		AllowedCountries:     nil,
+		BlockedCountries:     nil,
+		AllowedNetworkRanges: nil,
+		BlockedNetworkRanges: nil,
+	}
+	mocks := newComponentMocks()
+
+	_, err := construct(t.Context(), mocks, (&ZeroTrustSegment{}).Construct, "seg", args)
+	require.NoError(t, err)
+
+	for _, name := range []string{"seg-source", "seg-destination", "seg-posture", "seg-policy"} {
+		assert.Equal(t, "seg", mocks.find(t, name).parent, "%s parent", name)
+	}
+
+	// The policy is registered after, and depends on, the groups and the posture check it references.
+	policy := mocks.find(t, "seg-policy")
+	assert.Equal(t, []string{"seg-destination", "seg-posture", "seg-source"}, policy.dependsOn)
+	assert.Equal(t, []string{"seg-posture-id"}, stringInputs(policy.inputs["postureChecks"]))
+
+	rules := policy.inputs["rules"].ArrayValue()
+	require.Len(t, rules, 2, "one rule per protocol")
+
+	for _, rule := range rules {
+		fields := rule.ObjectValue()
+		protocol := fields["protocol"].StringValue()
+
+		assert.Equal(t, []string{"seg-source-id"}, stringInputs(fields["sources"]), "%s rule sources", protocol)
+		assert.Equal(t, []string{"seg-destination-id"}, stringInputs(fields["destinations"]), "%s rule destinations", protocol)
+		assert.Equal(t, []string{"5432"}, stringInputs(fields["ports"]), "%s rule ports", protocol)
+	}
+
+	assert.Equal(t, []string{"peer-1"}, stringInputs(mocks.find(t, "seg-source").inputs["peers"]))
+}
+
+func TestZeroTrustSegmentWithoutPosture(t *testing.T) {
+	t.Parallel()
+
+	mocks := newComponentMocks()
+
+	state, err := construct(t.Context(), mocks, (&ZeroTrustSegment{}).Construct, "seg", zeroTrustSegmentArgs())
+	require.NoError(t, err)
+	assert.Empty(t, mocks.names(tokenPostureCheck))
+
+	policy := mocks.find(t, "seg-policy")
+	assert.False(t, policy.inputs.HasValue("postureChecks"), "policy references a posture check")
+	assert.Equal(t, []string{"seg-destination", "seg-source"}, policy.dependsOn)
+	assert.Nil(t, await[*string](t, state.PostureCheckID))
+}
+
+func TestValidateZeroTrustSegment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		mutate func(args *ZeroTrustSegmentArgs)
+		want   string
+	}{
+		{"no protocols", func(a *ZeroTrustSegmentArgs) { a.Protocols = nil }, "at least one protocol"},
+		{"duplicate protocol", func(a *ZeroTrustSegmentArgs) {
+			a.Protocols = []resource.Protocol{resource.ProtocolTCP, resource.ProtocolTCP}
+		}, `duplicate ZeroTrustSegment protocol "tcp"`},
+		{"ports without port protocol", func(a *ZeroTrustSegmentArgs) {
+			a.Protocols = []resource.Protocol{resource.ProtocolIcmp}
+		}, "require protocol tcp or udp"},
+		{"same group names", func(a *ZeroTrustSegmentArgs) { a.Destination.Name = "apps" }, "different names"},
+		{"empty posture", func(a *ZeroTrustSegmentArgs) { a.Posture = &ZeroTrustPostureSpec{} }, "at least one requirement"}, //nolint:exhaustruct
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := zeroTrustSegmentArgs()
+			tt.mutate(&args)
+
+			err := validateZeroTrustSegment(args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
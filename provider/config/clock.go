@@ -0,0 +1,26 @@
+package config
+
+import (
+	"context"
+	"time"
+)
+
+// clockKey carries a replacement time source on the context.
+type clockKey struct{}
+
+// WithClock returns a context whose Now reports now() instead of the wall
+// clock. Tests use it to drive time-based behaviour such as credential
+// rotation without waiting for real time to pass.
+func WithClock(ctx context.Context, now func() time.Time) context.Context {
+	return context.WithValue(ctx, clockKey{}, now)
+}
+
+// Now returns the current time according to the clock on ctx, falling back
+// to the wall clock.
+func Now(ctx context.Context) time.Time {
+	if now, ok := ctx.Value(clockKey{}).(func() time.Time); ok {
+		return now()
+	}
+
+	return time.Now()
+}
@@ -8,6 +8,24 @@ var (
 	ErrMissingNetBirdToken = errors.New("NetBird token is missing from provider configuration")
 	ErrMissingNetBirdURL   = errors.New("NetBird URL is missing from provider configuration")
 	ErrNilProviderConfig   = errors.New("provider configuration is nil")
+	ErrUnsupportedFeature  = errors.New("feature not supported by the NetBird management server")
+
+	ErrConflictingTokenSources = errors.New("tokenCommand and tokenFile are mutually exclusive")
+	ErrInvalidTokenLifetime    = errors.New("tokenLifetime must be a non-negative duration such as 15m")
+	ErrTokenSource             = errors.New("obtaining NetBird token failed")
+	ErrInvalidOperationTimeout = errors.New("operation timeouts must be positive durations such as 5m")
+	ErrInvalidEdition          = errors.New("edition must be cloud or self-hosted")
+)
+
+// Configure validation errors.
+var (
+	ErrNetBirdUnreachable   = errors.New("NetBird management server is unreachable")
+	ErrNetBirdTLS           = errors.New("TLS connection to NetBird management server failed")
+	ErrNetBirdUnauthorized  = errors.New("NetBird token was rejected (401): it is invalid, expired, or revoked")
+	ErrNetBirdForbidden     = errors.New("NetBird token is not permitted to access the management API (403)")
+	ErrNetBirdNoAccount     = errors.New("NetBird token is not associated with any account")
+	ErrNetBirdAccountAccess = errors.New("NetBird token has no access to the selected account")
+	ErrUnknownProfile       = errors.New("unknown NetBird credential profile")
 )
 
 // Resource Errors.
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Identity describes the principal behind the configured token.
+type Identity struct {
+	AccountID string
+	UserID    string
+	UserName  string
+	UserEmail string
+	Role      string
+}
+
+// displayName prefers the email, then the name, then the raw user ID.
+func (i Identity) displayName() string {
+	switch {
+	case i.UserEmail != "":
+		return i.UserEmail
+	case i.UserName != "":
+		return i.UserName
+	default:
+		return i.UserID
+	}
+}
+
+// GetIdentity retrieves the identity resolved during Configure. It is empty
+// when skipConfigureValidation is set.
+func GetIdentity(ctx context.Context) (Identity, error) {
+	config := infer.GetConfig[*Config](ctx)
+	if config == nil {
+		return Identity{}, ErrNilProviderConfig
+	}
+
+	return config.identity, nil
+}
+
+// resolveIdentity validates the token by fetching the calling user, then
+// resolves the account that user belongs to, or confirms access to the
+// selected account. Both are small GET requests; failures are translated
+// into actionable configuration errors.
+func resolveIdentity(ctx context.Context, client *rest.Client, managementURL, accountID string) (Identity, error) {
+	user, err := client.Users.Current(ctx)
+	if err != nil {
+		return Identity{}, classifyConfigureError(managementURL, err)
+	}
+
+	accounts, err := client.Accounts.List(ctx)
+	if err != nil {
+		return Identity{}, classifyConfigureError(managementURL, err)
+	}
+
+	if len(accounts) == 0 {
+		return Identity{}, fmt.Errorf("%w (user %s)", ErrNetBirdNoAccount, user.Id)
+	}
+
+	resolved := accounts[0].Id
+
+	if accountID != "" {
+		if !slices.ContainsFunc(accounts, func(a nbapi.Account) bool { return a.Id == accountID }) {
+			return Identity{}, fmt.Errorf("%w: %s", ErrNetBirdAccountAccess, accountID)
+		}
+
+		resolved = accountID
+	}
+
+	return Identity{
+		AccountID: resolved,
+		UserID:    user.Id,
+		UserName:  user.Name,
+		UserEmail: user.Email,
+		Role:      user.Role,
+	}, nil
+}
+
+// classifyConfigureError maps transport and HTTP failures onto the specific
+// Configure errors so users can tell a wrong URL from a revoked token.
+func classifyConfigureError(managementURL string, err error) error {
+	var apiErr *rest.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%w: %s", ErrNetBirdUnauthorized, apiErr.Message)
+		case http.StatusForbidden:
+			return fmt.Errorf("%w: %s", ErrNetBirdForbidden, apiErr.Message)
+		default:
+			return fmt.Errorf("validating NetBird configuration against %s failed with HTTP %d: %w",
+				managementURL, apiErr.StatusCode, err)
+		}
+	}
+
+	if isTLSError(err) {
+		return fmt.Errorf("%w (%s): %w", ErrNetBirdTLS, managementURL, err)
+	}
+
+	var opErr *net.OpError
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &opErr) || errors.As(err, &dnsErr) || errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w (%s): %w", ErrNetBirdUnreachable, managementURL, err)
+	}
+
+	return fmt.Errorf("validating NetBird configuration against %s failed, check that url points at the management API: %w",
+		managementURL, err)
+}
+
+// isTLSError reports whether err stems from certificate verification or a
+// TLS handshake, e.g. speaking HTTPS to a plain HTTP port.
+func isTLSError(err error) bool {
+	var verifyErr *tls.CertificateVerificationError
+
+	var unknownAuthority x509.UnknownAuthorityError
+
+	var hostnameErr x509.HostnameError
+
+	var invalidErr x509.CertificateInvalidError
+
+	var recordErr tls.RecordHeaderError
+
+	return errors.As(err, &verifyErr) ||
+		errors.As(err, &unknownAuthority) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr) ||
+		errors.As(err, &recordErr)
+}
@@ -2,6 +2,9 @@ package config
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/netbirdio/netbird/shared/management/client/rest"
 	p "github.com/pulumi/pulumi-go-provider"
@@ -12,15 +15,81 @@ import (
 type Config struct {
 	NetBirdURL   string `pulumi:"url"`
 	NetBirdToken string `provider:"secret" pulumi:"token"`
+	// SkipConfigureValidation disables all network calls made by Configure.
+	SkipConfigureValidation bool `pulumi:"skipConfigureValidation,optional"`
+	// AccountID selects the account to operate on for tokens with access to several accounts.
+	AccountID string `pulumi:"accountId,optional"`
+	// Profile names a credential profile in ProfilesFile that supplies url, token and accountId.
+	Profile      string `pulumi:"profile,optional"`
+	ProfilesFile string `pulumi:"profilesFile,optional"`
+	// TokenCommand and TokenFile supply the token at runtime instead of NetBirdToken.
+	TokenCommand  string `pulumi:"tokenCommand,optional"`
+	TokenFile     string `pulumi:"tokenFile,optional"`
+	TokenLifetime string `pulumi:"tokenLifetime,optional"`
+	// Per-operation timeouts applied to resources without customTimeouts.
+	CreateTimeout string `pulumi:"createTimeout,optional"`
+	ReadTimeout   string `pulumi:"readTimeout,optional"`
+	UpdateTimeout string `pulumi:"updateTimeout,optional"`
+	DeleteTimeout string `pulumi:"deleteTimeout,optional"`
+	// Edition overrides the edition derived from NetBirdURL.
+	Edition string `pulumi:"edition,optional"`
+
+	// serverInfo and identity are resolved once in Configure and never written to state.
+	serverInfo ServerInfo
+	identity   Identity
+	// tokens is set when the token comes from TokenCommand or TokenFile.
+	tokens *tokenSource
+	// timeouts holds the parsed operation timeouts that override the defaults.
+	timeouts map[Operation]time.Duration
 }
 
+// configureTimeout bounds the calls Configure makes to the management server.
+const configureTimeout = 30 * time.Second
+
 // Annotate provider configuration.
 func (c *Config) Annotate(a infer.Annotator) {
 	a.Describe(&c.NetBirdURL, "URL to Netbird API, example: https://api.netbird.io")
 	a.Describe(&c.NetBirdToken, "Netbird API Token")
+	a.Describe(&c.SkipConfigureValidation, "Skip validating the token and URL against the management server during provider "+
+		"configuration, e.g. for offline previews. Server version detection is skipped as well.")
+	a.Describe(&c.AccountID, "ID of the NetBird account to manage, for tokens with access to several accounts (e.g. MSP tenants). "+
+		"Resources record the account they belong to; moving a resource to another account replaces it.")
+	a.Describe(&c.Profile, "Name of a credential profile in profilesFile. The profile's url, token, and accountId "+
+		"take precedence over url and token; an explicit accountId takes precedence over the profile's.")
+	a.Describe(&c.ProfilesFile, "Path to the YAML credential profiles file. Defaults to netbird/pulumi-profiles.yaml "+
+		"in the user config directory (e.g. ~/.config/netbird/pulumi-profiles.yaml).")
+	a.Describe(&c.TokenCommand, "Command whose standard output is used as the API token, e.g. a call to a local secrets manager. "+
+		"Run through the system shell; takes precedence over token. The result is cached for tokenLifetime and "+
+		"refreshed when the server rejects it with 401.")
+	a.Describe(&c.TokenFile, "Path to a file containing the API token, re-read after tokenLifetime or on 401. "+
+		"Takes precedence over token; mutually exclusive with tokenCommand.")
+	a.Describe(&c.TokenLifetime, "How long a token from tokenCommand or tokenFile is reused, as a Go duration (e.g. 15m, 1h). "+
+		"Defaults to 15m; 0s fetches a token for every request.")
+	a.Describe(&c.CreateTimeout, "Timeout for creating a resource, as a Go duration (e.g. 10m). Defaults to 5m. "+
+		"A resource's customTimeouts option takes precedence.")
+	a.Describe(&c.ReadTimeout, "Timeout for reading (refreshing or importing) a resource, as a Go duration. Defaults to 2m.")
+	a.Describe(&c.UpdateTimeout, "Timeout for updating a resource, as a Go duration. Defaults to 5m. "+
+		"A resource's customTimeouts option takes precedence.")
+	a.Describe(&c.DeleteTimeout, "Timeout for deleting a resource, as a Go duration. Defaults to 5m. "+
+		"A resource's customTimeouts option takes precedence.")
+	a.Describe(&c.Edition, "NetBird edition of the management server: cloud or self-hosted. "+
+		"Takes precedence over detection from url, which treats only api.netbird.io as cloud. "+
+		"Set it when NetBird Cloud is reached through a proxy or a custom domain.")
 
 	a.SetDefault(&c.NetBirdURL, "https://api.netbird.io", "NETBIRD_URL")
 	a.SetDefault(&c.NetBirdToken, "", "NETBIRD_TOKEN")
+	a.SetDefault(&c.SkipConfigureValidation, false, "NETBIRD_SKIP_CONFIGURE_VALIDATION")
+	a.SetDefault(&c.AccountID, "", "NETBIRD_ACCOUNT_ID")
+	a.SetDefault(&c.Profile, "", "NETBIRD_PROFILE")
+	a.SetDefault(&c.ProfilesFile, "", "NETBIRD_PROFILES_FILE")
+	a.SetDefault(&c.TokenCommand, "", "NETBIRD_TOKEN_COMMAND")
+	a.SetDefault(&c.TokenFile, "", "NETBIRD_TOKEN_FILE")
+	a.SetDefault(&c.TokenLifetime, "", "NETBIRD_TOKEN_LIFETIME")
+	a.SetDefault(&c.CreateTimeout, "", "NETBIRD_CREATE_TIMEOUT")
+	a.SetDefault(&c.ReadTimeout, "", "NETBIRD_READ_TIMEOUT")
+	a.SetDefault(&c.UpdateTimeout, "", "NETBIRD_UPDATE_TIMEOUT")
+	a.SetDefault(&c.DeleteTimeout, "", "NETBIRD_DELETE_TIMEOUT")
+	a.SetDefault(&c.Edition, "", "NETBIRD_EDITION")
 }
 
 // Configure validates the provider configuration.
@@ -28,7 +97,24 @@ func (c *Config) Configure(ctx context.Context) error {
 	p.GetLogger(ctx).Debugf("Configure:Config")
 	// p.GetLogger(ctx).Debugf("Config netbirdToken=%s, netbirdUrl=%s", c.NetBirdUrl, c.NetBirdToken)
 
-	if c.NetBirdToken == "" {
+	if c.Profile != "" {
+		err := c.applyProfile()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := c.configureTokenSource()
+	if err != nil {
+		return err
+	}
+
+	err = c.parseOperationTimeouts()
+	if err != nil {
+		return err
+	}
+
+	if c.NetBirdToken == "" && c.tokens == nil {
 		return ErrMissingNetBirdToken
 	}
 
@@ -36,6 +122,46 @@ func (c *Config) Configure(ctx context.Context) error {
 		return ErrMissingNetBirdURL
 	}
 
+	edition, err := resolveEdition(c.Edition, c.NetBirdURL)
+	if err != nil {
+		return err
+	}
+
+	c.serverInfo = ServerInfo{Version: "", Edition: edition, Legacy: false}
+
+	if c.SkipConfigureValidation {
+		p.GetLogger(ctx).Infof("Configure: skipping token and connectivity validation")
+
+		return nil
+	}
+
+	ctx = context.WithValue(ctx, operationKey{}, operationTimeout{operation: operationConfigure, timeout: configureTimeout})
+
+	ctx, cancel := context.WithTimeout(ctx, configureTimeout)
+	defer cancel()
+
+	if c.tokens != nil {
+		_, err = c.tokens.Token(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	client := c.newClient()
+
+	identity, err := resolveIdentity(ctx, client, c.NetBirdURL, c.AccountID)
+	if err != nil {
+		return err
+	}
+
+	c.identity = identity
+	p.GetLogger(ctx).Infof("Configure: authenticated to %s as %s (role %s) in account %s",
+		c.NetBirdURL, identity.displayName(), identity.Role, identity.AccountID)
+
+	c.serverInfo = detectServerInfo(ctx, client, edition)
+	p.GetLogger(ctx).Debugf("Configure:ServerInfo version=%s, edition=%s, legacy=%t",
+		c.serverInfo.Version, c.serverInfo.Edition, c.serverInfo.Legacy)
+
 	return nil
 }
 
@@ -46,7 +172,7 @@ func GetNetBirdClient(ctx context.Context) (*rest.Client, error) {
 		return nil, ErrNilProviderConfig
 	}
 
-	if config.NetBirdToken == "" {
+	if config.NetBirdToken == "" && config.tokens == nil {
 		return nil, ErrMissingNetBirdToken
 	}
 
@@ -54,9 +180,105 @@ func GetNetBirdClient(ctx context.Context) (*rest.Client, error) {
 		return nil, ErrMissingNetBirdURL
 	}
 
-	client := rest.NewWithBearerToken(config.NetBirdURL, config.NetBirdToken)
+	return config.newClient(), nil
+}
+
+// newClient builds a REST client from the configured URL and token, scoped
+// to the selected account when one is configured. Tokens from tokenCommand or
+// tokenFile are attached per request so refreshes reach existing clients, and
+// deadline errors are reported with the API call that timed out.
+func (c *Config) newClient() *rest.Client {
+	var httpClient rest.HttpClient = http.DefaultClient
+	if c.tokens != nil {
+		httpClient = &tokenSourceClient{source: c.tokens, base: http.DefaultClient}
+	}
+
+	client := rest.NewWithOptions(
+		rest.WithManagementURL(c.NetBirdURL),
+		rest.WithBearerToken(c.NetBirdToken),
+		rest.WithHttpClient(&timeoutClient{base: httpClient}),
+	)
+
+	if c.AccountID != "" {
+		return client.Impersonate(c.AccountID)
+	}
+
+	return client
+}
+
+// configureTokenSource validates tokenCommand, tokenFile, and tokenLifetime
+// and sets up the token source when either is configured.
+func (c *Config) configureTokenSource() error {
+	c.tokens = nil
+
+	if c.TokenCommand == "" && c.TokenFile == "" {
+		return nil
+	}
+
+	if c.TokenCommand != "" && c.TokenFile != "" {
+		return ErrConflictingTokenSources
+	}
+
+	lifetime := defaultTokenLifetime
+
+	if c.TokenLifetime != "" {
+		parsed, err := time.ParseDuration(c.TokenLifetime)
+		if err != nil || parsed < 0 {
+			return fmt.Errorf("%w: %q", ErrInvalidTokenLifetime, c.TokenLifetime)
+		}
+
+		lifetime = parsed
+	}
+
+	c.tokens = newTokenSource(c.TokenCommand, c.TokenFile, lifetime)
+
+	return nil
+}
+
+// applyProfile overlays the selected credential profile onto the configuration.
+func (c *Config) applyProfile() error {
+	profile, err := loadProfile(c.ProfilesFile, c.Profile)
+	if err != nil {
+		return err
+	}
+
+	if profile.URL != "" {
+		c.NetBirdURL = profile.URL
+	}
+
+	if profile.Token != "" {
+		c.NetBirdToken = profile.Token
+	}
+
+	if profile.TokenCommand != "" {
+		c.TokenCommand = profile.TokenCommand
+	}
+
+	if profile.TokenFile != "" {
+		c.TokenFile = profile.TokenFile
+	}
+
+	if c.AccountID == "" {
+		c.AccountID = profile.AccountID
+	}
+
+	return nil
+}
+
+// GetAccountID returns the account the provider operates on: the configured
+// accountId, or the token's own account resolved during Configure. It is
+// empty when neither is known (skipConfigureValidation without accountId).
+func GetAccountID(ctx context.Context) (string, error) {
+	config := infer.GetConfig[*Config](ctx)
+	if config == nil {
+		return "", ErrNilProviderConfig
+	}
+
+	if config.AccountID != "" {
+		return config.AccountID, nil
+	}
 
-	return client, nil
+	return config.identity.AccountID, nil
 }
 
 // GetNetBirdURL retrieves the NetBird URL from the provider configuration in the given context.
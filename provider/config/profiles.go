@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Profile is a named set of credentials loaded from the profiles file.
+type Profile struct {
+	URL          string `yaml:"url"`
+	Token        string `yaml:"token"`
+	TokenCommand string `yaml:"tokenCommand"`
+	TokenFile    string `yaml:"tokenFile"`
+	AccountID    string `yaml:"accountId"`
+}
+
+// profilesDocument is the on-disk layout of the profiles file:
+//
+//	profiles:
+//	  customer-a:
+//	    url: https://api.netbird.io
+//	    token: nbp_...
+//	    accountId: cu8...
+type profilesDocument struct {
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// defaultProfilesFile returns $XDG_CONFIG_HOME/netbird/pulumi-profiles.yaml
+// (or the platform equivalent).
+func defaultProfilesFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("resolving user config directory: %w", err)
+	}
+
+	return filepath.Join(dir, "netbird", "pulumi-profiles.yaml"), nil
+}
+
+// loadProfile reads the named profile from path, falling back to the default
+// profiles file when path is empty.
+func loadProfile(path, name string) (Profile, error) {
+	if path == "" {
+		var err error
+
+		path, err = defaultProfilesFile()
+		if err != nil {
+			return Profile{}, err
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Profile{}, fmt.Errorf("reading profiles file %s: %w", path, err)
+	}
+
+	var doc profilesDocument
+
+	err = yaml.Unmarshal(data, &doc)
+	if err != nil {
+		return Profile{}, fmt.Errorf("parsing profiles file %s: %w", path, err)
+	}
+
+	profile, ok := doc.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("%w: %q not found in %s", ErrUnknownProfile, name, path)
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,187 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"golang.org/x/mod/semver"
+)
+
+// cloudAPIHost is the hostname of the NetBird Cloud management API.
+const cloudAPIHost = "api.netbird.io"
+
+// Edition identifies the NetBird distribution behind the management URL.
+type Edition string
+
+const (
+	// EditionCloud is the hosted NetBird Cloud service.
+	EditionCloud Edition = "cloud"
+	// EditionSelfHosted is a self-hosted management server.
+	EditionSelfHosted Edition = "self-hosted"
+)
+
+// ServerInfo describes the management server detected during Configure.
+// An empty Version means the server did not report one. Legacy marks servers
+// without the version endpoint, which predate every version-gated feature;
+// otherwise an empty Version means detection failed.
+type ServerInfo struct {
+	Version string
+	Edition Edition
+	Legacy  bool
+}
+
+// Capability describes a provider feature that only some servers support.
+type Capability struct {
+	Name       string
+	MinVersion string
+	CloudOnly  bool
+}
+
+// Capabilities gated by Check. MinVersion is the first management server
+// release exposing the API the feature relies on.
+//
+//nolint:gochecknoglobals // immutable lookup table
+var (
+	CapabilityPeerApproval       = Capability{Name: "peer approval (approvalRequired)", MinVersion: "", CloudOnly: true}
+	CapabilityIngressPeers       = Capability{Name: "ingress peers", MinVersion: "", CloudOnly: true}
+	CapabilityIdentityProviders  = Capability{Name: "identity providers", MinVersion: "0.62.0", CloudOnly: false}
+	CapabilityDNSZones           = Capability{Name: "DNS zones", MinVersion: "0.63.0", CloudOnly: false}
+	CapabilityReverseProxy       = Capability{Name: "reverse proxy", MinVersion: "0.65.0", CloudOnly: false}
+	CapabilityReverseProxyDomain = Capability{Name: "reverse proxy custom domains", MinVersion: "0.65.0", CloudOnly: false}
+)
+
+// AllCapabilities returns every gated capability, in a stable order.
+func AllCapabilities() []Capability {
+	return []Capability{
+		CapabilityPeerApproval,
+		CapabilityIngressPeers,
+		CapabilityIdentityProviders,
+		CapabilityDNSZones,
+		CapabilityReverseProxy,
+		CapabilityReverseProxyDomain,
+	}
+}
+
+// Supports reports whether the server offers the given capability. It returns
+// nil when support cannot be ruled out, e.g. when version detection failed or
+// the server runs a development build.
+func (s ServerInfo) Supports(c Capability) error {
+	if c.CloudOnly && s.Edition == EditionSelfHosted {
+		return fmt.Errorf("%s is only available on NetBird Cloud: %w", c.Name, ErrUnsupportedFeature)
+	}
+
+	// NetBird Cloud always runs the latest release.
+	if c.MinVersion == "" || s.Edition == EditionCloud {
+		return nil
+	}
+
+	if s.Legacy {
+		return fmt.Errorf("%s requires NetBird management server v%s or newer, "+
+			"server predates it as it does not report its version: %w", c.Name, c.MinVersion, ErrUnsupportedFeature)
+	}
+
+	current := canonicalVersion(s.Version)
+	if current == "" {
+		return nil
+	}
+
+	if semver.Compare(current, canonicalVersion(c.MinVersion)) < 0 {
+		return fmt.Errorf("%s requires NetBird management server v%s or newer, server runs v%s: %w",
+			c.Name, c.MinVersion, strings.TrimPrefix(s.Version, "v"), ErrUnsupportedFeature)
+	}
+
+	return nil
+}
+
+// GetServerInfo retrieves the server information detected during Configure.
+func GetServerInfo(ctx context.Context) (ServerInfo, error) {
+	config := infer.GetConfig[*Config](ctx)
+	if config == nil {
+		return ServerInfo{}, ErrNilProviderConfig
+	}
+
+	return config.serverInfo, nil
+}
+
+// detectServerInfo queries the management server once for its version. A
+// missing version endpoint marks the server as legacy. Other detection
+// failures are not fatal: the version is left empty and capability checks are
+// skipped.
+func detectServerInfo(ctx context.Context, client *rest.Client, edition Edition) ServerInfo {
+	info := ServerInfo{
+		Version: "",
+		Edition: edition,
+		Legacy:  false,
+	}
+
+	resp, err := client.NewRequest(ctx, "GET", "/api/instance/version", nil, nil)
+	if err != nil {
+		if rest.IsNotFound(err) {
+			p.GetLogger(ctx).Debugf("Configure:ServerInfo version endpoint not available, assuming an older server")
+
+			info.Legacy = true
+		} else {
+			p.GetLogger(ctx).Warningf("Configure:ServerInfo unable to detect server version: %v", err)
+		}
+
+		return info
+	}
+	defer resp.Body.Close()
+
+	var version nbapi.InstanceVersionInfo
+
+	err = json.NewDecoder(resp.Body).Decode(&version)
+	if err != nil {
+		p.GetLogger(ctx).Warningf("Configure:ServerInfo unable to decode server version: %v", err)
+
+		return info
+	}
+
+	info.Version = strings.TrimPrefix(version.ManagementCurrentVersion, "v")
+
+	return info
+}
+
+// resolveEdition returns the configured edition, or derives it from the
+// management URL when none is configured.
+func resolveEdition(configured, managementURL string) (Edition, error) {
+	switch Edition(configured) {
+	case "":
+		return editionFromURL(managementURL), nil
+	case EditionCloud, EditionSelfHosted:
+		return Edition(configured), nil
+	default:
+		return "", fmt.Errorf("%w, got %q", ErrInvalidEdition, configured)
+	}
+}
+
+// editionFromURL treats the NetBird Cloud API host as cloud and everything else as self-hosted.
+func editionFromURL(managementURL string) Edition {
+	parsed, err := url.Parse(managementURL)
+	if err == nil && strings.EqualFold(parsed.Hostname(), cloudAPIHost) {
+		return EditionCloud
+	}
+
+	return EditionSelfHosted
+}
+
+// canonicalVersion converts "0.65.1" or "v0.65.1" into the "v"-prefixed form
+// expected by x/mod/semver. It returns "" for anything that is not semver.
+func canonicalVersion(version string) string {
+	if version == "" {
+		return ""
+	}
+
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+
+	return semver.Canonical(version)
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Operation is a resource lifecycle operation with its own timeout.
+type Operation string
+
+// Resource operations.
+const (
+	OperationCreate Operation = "create"
+	OperationRead   Operation = "read"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+
+	// operationConfigure labels the validation calls made by Configure.
+	operationConfigure Operation = "configure"
+)
+
+// defaultOperationTimeouts bound each operation when neither a Pulumi
+// customTimeouts option nor provider configuration overrides them.
+var defaultOperationTimeouts = map[Operation]time.Duration{
+	OperationCreate: 5 * time.Minute,
+	OperationRead:   2 * time.Minute,
+	OperationUpdate: 5 * time.Minute,
+	OperationDelete: 5 * time.Minute,
+}
+
+// operationKey carries the active operation and its timeout to the HTTP layer.
+type operationKey struct{}
+
+type operationTimeout struct {
+	operation Operation
+	timeout   time.Duration
+}
+
+// WithOperationTimeout bounds ctx for a resource operation. A deadline that
+// is already set (Pulumi applies customTimeouts to Create, Update, and
+// Delete this way) takes precedence over the provider's configured or
+// default timeout.
+func WithOperationTimeout(ctx context.Context, op Operation) (context.Context, context.CancelFunc) {
+	if deadline, ok := ctx.Deadline(); ok {
+		ctx = context.WithValue(ctx, operationKey{}, operationTimeout{operation: op, timeout: time.Until(deadline).Round(time.Second)})
+
+		return context.WithCancel(ctx)
+	}
+
+	timeout := defaultOperationTimeouts[op]
+
+	if config := infer.GetConfig[*Config](ctx); config != nil {
+		if configured, ok := config.timeouts[op]; ok {
+			timeout = configured
+		}
+	}
+
+	ctx = context.WithValue(ctx, operationKey{}, operationTimeout{operation: op, timeout: timeout})
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// TimeoutError reports an API call that did not complete within the
+// operation's timeout.
+type TimeoutError struct {
+	Operation Operation
+	Method    string
+	Path      string
+	Timeout   time.Duration
+}
+
+// OutcomeUnknown reports whether the server may have applied the request
+// even though no response arrived. Only reads are known to be side-effect free.
+func (e *TimeoutError) OutcomeUnknown() bool {
+	return e.Method != http.MethodGet && e.Method != http.MethodHead
+}
+
+// Error implements the error interface.
+func (e *TimeoutError) Error() string {
+	msg := fmt.Sprintf("%s %s timed out after %s during %s", e.Method, e.Path, e.Timeout, e.Operation)
+	if e.OutcomeUnknown() {
+		return msg + "; the outcome on the server is unknown, run `pulumi refresh` before retrying"
+	}
+
+	return msg + "; no changes were made on the server"
+}
+
+// Unwrap lets callers match the error with errors.Is(err, context.DeadlineExceeded).
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// timeoutClient turns deadline errors into a TimeoutError naming the API call.
+type timeoutClient struct {
+	base rest.HttpClient
+}
+
+// Do implements rest.HttpClient.
+func (c *timeoutClient) Do(req *http.Request) (*http.Response, error) {
+	resp, err := c.base.Do(req)
+	if err == nil {
+		return resp, nil
+	}
+
+	if errors.Is(req.Context().Err(), context.DeadlineExceeded) {
+		active, _ := req.Context().Value(operationKey{}).(operationTimeout)
+
+		return nil, &TimeoutError{
+			Operation: active.operation,
+			Method:    req.Method,
+			Path:      req.URL.Path,
+			Timeout:   active.timeout,
+		}
+	}
+
+	return nil, err //nolint:wrapcheck // passed through unchanged so callers see the transport error
+}
+
+// parseOperationTimeouts validates the per-operation timeout settings.
+func (c *Config) parseOperationTimeouts() error {
+	c.timeouts = map[Operation]time.Duration{}
+
+	for op, value := range map[Operation]string{
+		OperationCreate: c.CreateTimeout,
+		OperationRead:   c.ReadTimeout,
+		OperationUpdate: c.UpdateTimeout,
+		OperationDelete: c.DeleteTimeout,
+	} {
+		if value == "" {
+			continue
+		}
+
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("%w: %sTimeout %q", ErrInvalidOperationTimeout, op, value)
+		}
+
+		c.timeouts[op] = timeout
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultTokenLifetime is how long a token obtained from tokenCommand or
+// tokenFile is reused before the source is consulted again.
+const defaultTokenLifetime = 15 * time.Minute
+
+// tokenSource obtains the bearer token from a credential helper command or a
+// file instead of static configuration. The token is cached for lifetime and
+// refreshed early when the server rejects it.
+type tokenSource struct {
+	command  string
+	file     string
+	lifetime time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	fetchedAt time.Time
+}
+
+// newTokenSource returns a token source for the configured command or file.
+func newTokenSource(command, file string, lifetime time.Duration) *tokenSource {
+	return &tokenSource{
+		command:   command,
+		file:      file,
+		lifetime:  lifetime,
+		now:       time.Now,
+		mu:        sync.Mutex{},
+		token:     "",
+		fetchedAt: time.Time{},
+	}
+}
+
+// Token returns the cached token, fetching a fresh one once the cache expired.
+func (s *tokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Sub(s.fetchedAt) < s.lifetime {
+		return s.token, nil
+	}
+
+	token, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = token
+	s.fetchedAt = s.now()
+
+	return token, nil
+}
+
+// Invalidate drops the cached token if it is still the rejected one, so the
+// next call to Token consults the source again.
+func (s *tokenSource) Invalidate(rejected string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == rejected {
+		s.token = ""
+	}
+}
+
+// fetch runs the credential helper or reads the token file.
+func (s *tokenSource) fetch(ctx context.Context) (string, error) {
+	var (
+		raw    []byte
+		origin string
+	)
+
+	if s.command != "" {
+		origin = "tokenCommand"
+
+		var stderr bytes.Buffer
+
+		cmd := shellCommand(ctx, s.command)
+		cmd.Stderr = &stderr
+
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("%w: tokenCommand failed: %w: %s", ErrTokenSource, err, strings.TrimSpace(stderr.String()))
+		}
+
+		raw = out
+	} else {
+		origin = "tokenFile " + s.file
+
+		data, err := os.ReadFile(s.file)
+		if err != nil {
+			return "", fmt.Errorf("%w: reading tokenFile: %w", ErrTokenSource, err)
+		}
+
+		raw = data
+	}
+
+	token := strings.TrimSpace(string(raw))
+	if token == "" {
+		return "", fmt.Errorf("%w: %s returned an empty token", ErrTokenSource, origin)
+	}
+
+	return token, nil
+}
+
+// shellCommand runs command through the platform shell so credential helpers
+// can use pipes and arguments as they would on the command line.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
+
+// tokenSourceClient authenticates each request with the current token from
+// source and retries once with a fresh token when the server answers 401.
+type tokenSourceClient struct {
+	source *tokenSource
+	base   *http.Client
+}
+
+// Do implements rest.HttpClient.
+func (c *tokenSourceClient) Do(req *http.Request) (*http.Response, error) {
+	token, err := c.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(req, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The token may have been revoked or rotated before its cache lifetime
+	// ended; fetch a new one and replay the request if the body allows it.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	c.source.Invalidate(token)
+
+	fresh, err := c.source.Token(req.Context())
+	if err != nil || fresh == token {
+		return resp, nil //nolint:nilerr // surface the original 401 rather than the refresh failure
+	}
+
+	_ = resp.Body.Close()
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("replaying request body: %w", err)
+		}
+	}
+
+	return c.send(retry, fresh)
+}
+
+// send issues req with token as the bearer credential.
+func (c *tokenSourceClient) send(req *http.Request, token string) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.base.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %w", err)
+	}
+
+	return resp, nil
+}
@@ -6,12 +6,40 @@ import "github.com/pulumi/pulumi-go-provider/infer"
 // All returns all registered provider functions.
 func All() []infer.InferredFunction {
 	return []infer.InferredFunction{
+		infer.Function(&GetAccessiblePeers{}),
 		infer.Function(&GetCountries{}),
 		infer.Function(&GetCountryCities{}),
+		infer.Function(&GetDNSRecords{}),
+		infer.Function(&GetDNSZones{}),
+		infer.Function(&GetGroups{}),
+		infer.Function(&GetIdentityProviders{}),
+		infer.Function(&GetNameserverGroups{}),
+		infer.Function(&GetNetworkResources{}),
+		infer.Function(&GetNetworkRouters{}),
+		infer.Function(&GetNetworks{}),
 		infer.Function(&GetPeers{}),
+		infer.Function(&GetPendingPeers{}),
+		infer.Function(&GetPolicies{}),
+		infer.Function(&GetPostureChecks{}),
 		infer.Function(&GetReverseProxyClusters{}),
+		infer.Function(&GetReverseProxyDomains{}),
+		infer.Function(&GetReverseProxyServices{}),
+		infer.Function(&GetRoutes{}),
+		infer.Function(&GetServerInfo{}),
+		infer.Function(&GetSetupKeys{}),
+		infer.Function(&GetSetupKeyUsage{}),
+		infer.Function(&GetTokens{}),
+		infer.Function(&GetUsers{}),
+		infer.Function(&LookupDNSZone{}),
 		infer.Function(&LookupGroup{}),
+		infer.Function(&LookupIdentityProvider{}),
+		infer.Function(&LookupNameserverGroup{}),
+		infer.Function(&LookupNetwork{}),
+		infer.Function(&LookupNetworkResource{}),
 		infer.Function(&LookupPeer{}),
+		infer.Function(&LookupPolicy{}),
+		infer.Function(&LookupPostureCheck{}),
+		infer.Function(&LookupReverseProxyService{}),
 		infer.Function(&LookupRoute{}),
 		infer.Function(&LookupSetupKey{}),
 		infer.Function(&LookupUser{}),
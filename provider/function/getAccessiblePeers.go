@@ -0,0 +1,236 @@
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetAccessiblePeers lists the peers a given peer can reach.
+type GetAccessiblePeers struct{}
+
+// Annotate describes the function.
+func (f *GetAccessiblePeers) Annotate(a infer.Annotator) {
+	a.Describe(f, "List the peers a NetBird peer can reach according to the account's policies, as computed by the management server. "+
+		"With includeResources, also list the network resources and routes distributed to the peer.")
+}
+
+// GetAccessiblePeersArgs are the inputs for GetAccessiblePeers.
+type GetAccessiblePeersArgs struct {
+	PeerID           *string `pulumi:"peerId,optional"`
+	Name             *string `pulumi:"name,optional"`
+	IncludeResources *bool   `pulumi:"includeResources,optional"`
+}
+
+// Annotate provides field descriptions for GetAccessiblePeersArgs.
+func (a *GetAccessiblePeersArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.PeerID, "ID of the peer to query. Exactly one of peerId or name must be set.")
+	ann.Describe(&a.Name, "Name of the peer to query. Exactly one of peerId or name must be set.")
+	ann.Describe(&a.IncludeResources, "Whether to also list the network resources and routes the peer receives. Defaults to false.")
+}
+
+// GetAccessiblePeersResult is the output of GetAccessiblePeers.
+type GetAccessiblePeersResult struct {
+	PeerID    string                   `pulumi:"peerId"`
+	Peers     []PeerSummary            `pulumi:"peers"`
+	Resources []NetworkResourceSummary `pulumi:"resources,optional"`
+	Routes    []RouteSummary           `pulumi:"routes,optional"`
+}
+
+// Annotate provides field descriptions for GetAccessiblePeersResult.
+func (r *GetAccessiblePeersResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.PeerID, "The ID of the queried peer.")
+	ann.Describe(&r.Peers, "The peers the queried peer can reach.")
+	ann.Describe(&r.Resources,
+		"Network resources the peer is granted access to by an enabled policy rule. Only set with includeResources.")
+	ann.Describe(&r.Routes,
+		"Enabled routes distributed to one of the peer's groups, excluding routes the peer itself routes. Only set with includeResources.")
+}
+
+// Invoke resolves the peer and lists what it can reach.
+func (f *GetAccessiblePeers) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetAccessiblePeersArgs],
+) (infer.FunctionResponse[GetAccessiblePeersResult], error) {
+	if (req.Input.PeerID == nil) == (req.Input.Name == nil) {
+		return infer.FunctionResponse[GetAccessiblePeersResult]{}, errors.New("exactly one of peerId or name must be set")
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetAccessiblePeersResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	peer, err := accessiblePeersSubject(ctx, client, req.Input)
+	if err != nil {
+		return infer.FunctionResponse[GetAccessiblePeersResult]{}, err
+	}
+
+	apiPeers, err := client.Peers.ListAccessiblePeers(ctx, peer.Id)
+	if err != nil {
+		return infer.FunctionResponse[GetAccessiblePeersResult]{}, fmt.Errorf("listing peers accessible from %s failed: %w", peer.Id, err)
+	}
+
+	peers := make([]PeerSummary, len(apiPeers))
+	for i := range apiPeers {
+		peers[i] = peerSummaryFromAPI(&apiPeers[i])
+	}
+
+	result := GetAccessiblePeersResult{
+		PeerID:    peer.Id,
+		Peers:     peers,
+		Resources: nil,
+		Routes:    nil,
+	}
+
+	if req.Input.IncludeResources != nil && *req.Input.IncludeResources {
+		groups := peerSummaryFromAPI(peer).Groups
+
+		result.Resources, err = accessibleResources(ctx, client, peer.Id, groups)
+		if err != nil {
+			return infer.FunctionResponse[GetAccessiblePeersResult]{}, err
+		}
+
+		result.Routes, err = accessibleRoutes(ctx, client, peer.Id, groups)
+		if err != nil {
+			return infer.FunctionResponse[GetAccessiblePeersResult]{}, err
+		}
+	}
+
+	return infer.FunctionResponse[GetAccessiblePeersResult]{
+		Output: result,
+	}, nil
+}
+
+// accessiblePeersSubject fetches the queried peer by ID or looks it up by name.
+func accessiblePeersSubject(ctx context.Context, client *rest.Client, args GetAccessiblePeersArgs) (*nbapi.Peer, error) {
+	if args.PeerID != nil {
+		peer, err := client.Peers.Get(ctx, *args.PeerID)
+		if err != nil {
+			return nil, fmt.Errorf("reading peer %s failed: %w", *args.PeerID, err)
+		}
+
+		return peer, nil
+	}
+
+	peers, err := client.Peers.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing peers failed: %w", err)
+	}
+
+	return lookupOne(peers, fmt.Sprintf("peer %q", *args.Name),
+		func(peer *nbapi.Peer) bool { return peer.Name == *args.Name },
+		func(peer *nbapi.Peer) string { return peer.Id })
+}
+
+// accessibleResources returns the enabled network resources that an enabled
+// policy rule grants the peer access to, either as a direct destination or
+// through one of the resource's groups.
+func accessibleResources(ctx context.Context, client *rest.Client, peerID string, groups []string) ([]NetworkResourceSummary, error) {
+	policies, err := client.Policies.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing policies failed: %w", err)
+	}
+
+	var destGroups, destResources []string
+
+	for _, policy := range policies {
+		if !policy.Enabled {
+			continue
+		}
+
+		for _, rule := range policy.Rules {
+			if !rule.Enabled || !ruleAppliesTo(rule, peerID, groups) {
+				continue
+			}
+
+			for _, group := range derefGroups(rule.Destinations) {
+				destGroups = append(destGroups, group.Id)
+			}
+
+			if rule.DestinationResource != nil {
+				destResources = append(destResources, rule.DestinationResource.Id)
+			}
+		}
+	}
+
+	networkIDs, err := listNetworkIDs(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]NetworkResourceSummary, 0)
+
+	for _, networkID := range networkIDs {
+		apiResources, err := client.Networks.Resources(networkID).List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing resources of network %s failed: %w", networkID, err)
+		}
+
+		for i := range apiResources {
+			state := resource.NetworkResourceStateFromAPI(ctx, networkID, &apiResources[i])
+
+			reachable := slices.Contains(destResources, apiResources[i].Id) ||
+				slices.ContainsFunc(state.GroupIDs, func(id string) bool { return slices.Contains(destGroups, id) })
+
+			if !state.Enabled || !reachable {
+				continue
+			}
+
+			resources = append(resources, NetworkResourceSummary{
+				NetworkResourceState: state,
+				ID:                   apiResources[i].Id,
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+// ruleAppliesTo reports whether the peer is a source of the rule, either
+// through one of its groups or as the rule's source resource.
+func ruleAppliesTo(rule nbapi.PolicyRule, peerID string, groups []string) bool {
+	if rule.SourceResource != nil && rule.SourceResource.Id == peerID {
+		return true
+	}
+
+	return slices.ContainsFunc(derefGroups(rule.Sources), func(group nbapi.GroupMinimum) bool { return slices.Contains(groups, group.Id) })
+}
+
+// accessibleRoutes returns the enabled routes distributed to one of the
+// peer's groups, excluding routes the peer itself is the routing peer for.
+func accessibleRoutes(ctx context.Context, client *rest.Client, peerID string, groups []string) ([]RouteSummary, error) {
+	apiRoutes, err := client.Routes.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing routes failed: %w", err)
+	}
+
+	routes := make([]RouteSummary, 0)
+
+	for i := range apiRoutes {
+		item := &apiRoutes[i]
+
+		switch {
+		case !item.Enabled:
+			continue
+		case item.Peer != nil && *item.Peer == peerID:
+			continue
+		case !slices.ContainsFunc(item.Groups, func(id string) bool { return slices.Contains(groups, id) }):
+			continue
+		}
+
+		routes = append(routes, RouteSummary{
+			RouteState: resource.RouteStateFromAPI(ctx, item),
+			ID:         item.Id,
+		})
+	}
+
+	return routes, nil
+}
@@ -0,0 +1,123 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetDNSRecords lists DNS records.
+type GetDNSRecords struct{}
+
+// Annotate describes the function.
+func (f *GetDNSRecords) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird DNS records of one zone, or of every zone when zoneId is unset, optionally filtered by a name regex.")
+}
+
+// GetDNSRecordsArgs are the inputs for GetDNSRecords.
+type GetDNSRecordsArgs struct {
+	ListArgs
+
+	ZoneID *string `pulumi:"zoneId,optional"`
+}
+
+// Annotate provides field descriptions for GetDNSRecordsArgs.
+func (a *GetDNSRecordsArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.ZoneID, "Optional DNS zone ID. When unset, records of all zones are returned.")
+}
+
+// DNSRecordSummary is a NetBird DNS record with its ID.
+type DNSRecordSummary struct {
+	resource.DNSRecordState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for DNSRecordSummary.
+func (s *DNSRecordSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The DNS record ID.")
+}
+
+// GetDNSRecordsResult is the output of GetDNSRecords.
+type GetDNSRecordsResult struct {
+	Records []DNSRecordSummary `pulumi:"records"`
+}
+
+// Annotate provides field descriptions for GetDNSRecordsResult.
+func (r *GetDNSRecordsResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Records, "The DNS records matching the filter criteria.")
+}
+
+// dnsRecordKind describes the objects listed by getDNSRecords. Records are
+// listed per zone, so it has no list function.
+var dnsRecordKind = listKind[nbapi.DNSRecord]{ //nolint:gochecknoglobals
+	function: "getDNSRecords",
+	plural:   "DNS records",
+	list:     nil,
+	name:     func(item *nbapi.DNSRecord) string { return item.Name },
+	groups:   nil,
+	enabled:  nil,
+}
+
+// Invoke lists DNS records, applying the optional filters.
+func (f *GetDNSRecords) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetDNSRecordsArgs],
+) (infer.FunctionResponse[GetDNSRecordsResult], error) {
+	match, err := dnsRecordKind.filter(req.Input.ListArgs)
+	if err != nil {
+		return infer.FunctionResponse[GetDNSRecordsResult]{}, err
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetDNSRecordsResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	var zoneIDs []string
+
+	if req.Input.ZoneID != nil {
+		zoneIDs = []string{*req.Input.ZoneID}
+	} else {
+		zones, err := client.DNSZones.ListZones(ctx)
+		if err != nil {
+			return infer.FunctionResponse[GetDNSRecordsResult]{}, fmt.Errorf("listing DNS zones failed: %w", err)
+		}
+
+		for _, zone := range zones {
+			zoneIDs = append(zoneIDs, zone.Id)
+		}
+	}
+
+	records := make([]DNSRecordSummary, 0)
+
+	for _, zoneID := range zoneIDs {
+		apiRecords, err := client.DNSZones.ListRecords(ctx, zoneID)
+		if err != nil {
+			return infer.FunctionResponse[GetDNSRecordsResult]{}, fmt.Errorf("listing records of DNS zone %s failed: %w", zoneID, err)
+		}
+
+		for i := range apiRecords {
+			item := &apiRecords[i]
+
+			if !match(item) {
+				continue
+			}
+
+			records = append(records, DNSRecordSummary{
+				DNSRecordState: resource.DNSRecordStateFromAPI(ctx, zoneID, item),
+				ID:             item.Id,
+			})
+		}
+	}
+
+	return infer.FunctionResponse[GetDNSRecordsResult]{
+		Output: GetDNSRecordsResult{
+			Records: records,
+		},
+	}, nil
+}
@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetDNSZones lists DNS zones.
+type GetDNSZones struct{}
+
+// Annotate describes the function.
+func (f *GetDNSZones) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird DNS zones, optionally filtered by a name regex, a distribution group, and the enabled state.")
+}
+
+// GetDNSZonesArgs are the inputs for GetDNSZones.
+type GetDNSZonesArgs struct {
+	ListArgs
+}
+
+// DNSZoneSummary is a NetBird DNS zone with its ID.
+type DNSZoneSummary struct {
+	resource.DNSZoneState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for DNSZoneSummary.
+func (s *DNSZoneSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The DNS zone ID.")
+}
+
+// GetDNSZonesResult is the output of GetDNSZones.
+type GetDNSZonesResult struct {
+	Zones []DNSZoneSummary `pulumi:"zones"`
+}
+
+// Annotate provides field descriptions for GetDNSZonesResult.
+func (r *GetDNSZonesResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Zones, "The DNS zones matching the filter criteria.")
+}
+
+// dnsZoneKind describes the objects listed by getDNSZones.
+var dnsZoneKind = listKind[nbapi.Zone]{ //nolint:gochecknoglobals
+	function: "getDNSZones",
+	plural:   "DNS zones",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.Zone, error) {
+		return client.DNSZones.ListZones(ctx)
+	},
+	name:    func(item *nbapi.Zone) string { return item.Name },
+	groups:  func(item *nbapi.Zone) []string { return item.DistributionGroups },
+	enabled: func(item *nbapi.Zone) bool { return item.Enabled },
+}
+
+// Invoke lists DNS zones, applying the optional filters.
+func (f *GetDNSZones) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetDNSZonesArgs],
+) (infer.FunctionResponse[GetDNSZonesResult], error) {
+	zones, err := listResources(ctx, dnsZoneKind, req.Input.ListArgs, nil, func(item *nbapi.Zone) DNSZoneSummary {
+		return DNSZoneSummary{
+			DNSZoneState: resource.DNSZoneStateFromAPI(ctx, item),
+			ID:           item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetDNSZonesResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetDNSZonesResult]{
+		Output: GetDNSZonesResult{
+			Zones: zones,
+		},
+	}, nil
+}
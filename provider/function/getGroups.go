@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetGroups lists groups.
+type GetGroups struct{}
+
+// Annotate describes the function.
+func (f *GetGroups) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird groups with their peers and resources, optionally filtered by a name regex.")
+}
+
+// GetGroupsArgs are the inputs for GetGroups.
+type GetGroupsArgs struct {
+	ListArgs
+}
+
+// GroupSummary is a NetBird group with its ID.
+type GroupSummary struct {
+	resource.GroupState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for GroupSummary.
+func (s *GroupSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The group ID.")
+}
+
+// GetGroupsResult is the output of GetGroups.
+type GetGroupsResult struct {
+	Groups []GroupSummary `pulumi:"groups"`
+}
+
+// Annotate provides field descriptions for GetGroupsResult.
+func (r *GetGroupsResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Groups, "The groups matching the filter criteria.")
+}
+
+// groupKind describes the objects listed by getGroups.
+var groupKind = listKind[nbapi.Group]{ //nolint:gochecknoglobals
+	function: "getGroups",
+	plural:   "groups",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.Group, error) {
+		return client.Groups.List(ctx)
+	},
+	name:    func(item *nbapi.Group) string { return item.Name },
+	groups:  nil,
+	enabled: nil,
+}
+
+// Invoke lists groups, applying the optional filters.
+func (f *GetGroups) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetGroupsArgs],
+) (infer.FunctionResponse[GetGroupsResult], error) {
+	groups, err := listResources(ctx, groupKind, req.Input.ListArgs, nil, func(item *nbapi.Group) GroupSummary {
+		return GroupSummary{
+			GroupState: resource.GroupStateFromAPI(ctx, item),
+			ID:         item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetGroupsResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetGroupsResult]{
+		Output: GetGroupsResult{
+			Groups: groups,
+		},
+	}, nil
+}
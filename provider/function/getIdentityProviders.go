@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetIdentityProviders lists identity providers.
+type GetIdentityProviders struct{}
+
+// Annotate describes the function.
+func (f *GetIdentityProviders) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird identity providers, optionally filtered by a name regex. Client secrets are never returned by the API and are left empty.")
+}
+
+// GetIdentityProvidersArgs are the inputs for GetIdentityProviders.
+type GetIdentityProvidersArgs struct {
+	ListArgs
+}
+
+// IdentityProviderSummary is a NetBird identity provider with its ID.
+type IdentityProviderSummary struct {
+	resource.IdentityProviderState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for IdentityProviderSummary.
+func (s *IdentityProviderSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The identity provider ID.")
+}
+
+// GetIdentityProvidersResult is the output of GetIdentityProviders.
+type GetIdentityProvidersResult struct {
+	IdentityProviders []IdentityProviderSummary `pulumi:"identityProviders"`
+}
+
+// Annotate provides field descriptions for GetIdentityProvidersResult.
+func (r *GetIdentityProvidersResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.IdentityProviders, "The identity providers matching the filter criteria.")
+}
+
+// identityProviderKind describes the objects listed by getIdentityProviders.
+var identityProviderKind = listKind[nbapi.IdentityProvider]{ //nolint:gochecknoglobals
+	function: "getIdentityProviders",
+	plural:   "identity providers",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.IdentityProvider, error) {
+		return client.IdentityProviders.List(ctx)
+	},
+	name:    func(item *nbapi.IdentityProvider) string { return item.Name },
+	groups:  nil,
+	enabled: nil,
+}
+
+// Invoke lists identity providers, applying the optional filters.
+func (f *GetIdentityProviders) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetIdentityProvidersArgs],
+) (infer.FunctionResponse[GetIdentityProvidersResult], error) {
+	providers, err := listResources(ctx, identityProviderKind, req.Input.ListArgs, nil, func(item *nbapi.IdentityProvider) IdentityProviderSummary {
+		return IdentityProviderSummary{
+			IdentityProviderState: resource.IdentityProviderStateFromAPI(ctx, item),
+			ID:                    derefString(item.Id),
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetIdentityProvidersResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetIdentityProvidersResult]{
+		Output: GetIdentityProvidersResult{
+			IdentityProviders: providers,
+		},
+	}, nil
+}
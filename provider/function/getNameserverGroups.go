@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetNameserverGroups lists nameserver groups.
+type GetNameserverGroups struct{}
+
+// Annotate describes the function.
+func (f *GetNameserverGroups) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird DNS nameserver groups, optionally filtered by a name regex, a distribution group, and the enabled state.")
+}
+
+// GetNameserverGroupsArgs are the inputs for GetNameserverGroups.
+type GetNameserverGroupsArgs struct {
+	ListArgs
+}
+
+// NameserverGroupSummary is a NetBird nameserver group with its ID.
+type NameserverGroupSummary struct {
+	resource.DNSState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for NameserverGroupSummary.
+func (s *NameserverGroupSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The nameserver group ID.")
+}
+
+// GetNameserverGroupsResult is the output of GetNameserverGroups.
+type GetNameserverGroupsResult struct {
+	NameserverGroups []NameserverGroupSummary `pulumi:"nameserverGroups"`
+}
+
+// Annotate provides field descriptions for GetNameserverGroupsResult.
+func (r *GetNameserverGroupsResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.NameserverGroups, "The nameserver groups matching the filter criteria.")
+}
+
+// nameserverGroupKind describes the objects listed by getNameserverGroups.
+var nameserverGroupKind = listKind[nbapi.NameserverGroup]{ //nolint:gochecknoglobals
+	function: "getNameserverGroups",
+	plural:   "nameserver groups",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.NameserverGroup, error) {
+		return client.DNS.ListNameserverGroups(ctx)
+	},
+	name:    func(item *nbapi.NameserverGroup) string { return item.Name },
+	groups:  func(item *nbapi.NameserverGroup) []string { return item.Groups },
+	enabled: func(item *nbapi.NameserverGroup) bool { return item.Enabled },
+}
+
+// Invoke lists nameserver groups, applying the optional filters.
+func (f *GetNameserverGroups) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetNameserverGroupsArgs],
+) (infer.FunctionResponse[GetNameserverGroupsResult], error) {
+	nameserverGroups, err := listResources(ctx, nameserverGroupKind, req.Input.ListArgs, nil, func(item *nbapi.NameserverGroup) NameserverGroupSummary {
+		return NameserverGroupSummary{
+			DNSState: resource.DNSStateFromAPI(ctx, item),
+			ID:       item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetNameserverGroupsResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetNameserverGroupsResult]{
+		Output: GetNameserverGroupsResult{
+			NameserverGroups: nameserverGroups,
+		},
+	}, nil
+}
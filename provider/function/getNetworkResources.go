@@ -0,0 +1,139 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetNetworkResources lists network resources.
+type GetNetworkResources struct{}
+
+// Annotate describes the function.
+func (f *GetNetworkResources) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird network resources of one network, or of every network when networkId is unset, "+
+		"optionally filtered by a name regex, an assigned group, and the enabled state.")
+}
+
+// GetNetworkResourcesArgs are the inputs for GetNetworkResources.
+type GetNetworkResourcesArgs struct {
+	ListArgs
+
+	NetworkID *string `pulumi:"networkId,optional"`
+}
+
+// Annotate provides field descriptions for GetNetworkResourcesArgs.
+func (a *GetNetworkResourcesArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.NetworkID, "Optional network ID. When unset, resources of all networks are returned.")
+}
+
+// NetworkResourceSummary is a NetBird network resource with its ID.
+type NetworkResourceSummary struct {
+	resource.NetworkResourceState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for NetworkResourceSummary.
+func (s *NetworkResourceSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The network resource ID.")
+}
+
+// GetNetworkResourcesResult is the output of GetNetworkResources.
+type GetNetworkResourcesResult struct {
+	Resources []NetworkResourceSummary `pulumi:"resources"`
+}
+
+// Annotate provides field descriptions for GetNetworkResourcesResult.
+func (r *GetNetworkResourcesResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Resources, "The network resources matching the filter criteria.")
+}
+
+// networkResourceKind describes the objects listed by getNetworkResources.
+// Resources are listed per network, so it has no list function.
+var networkResourceKind = listKind[nbapi.NetworkResource]{ //nolint:gochecknoglobals
+	function: "getNetworkResources",
+	plural:   "network resources",
+	list:     nil,
+	name:     func(item *nbapi.NetworkResource) string { return item.Name },
+	groups: func(item *nbapi.NetworkResource) []string {
+		ids := make([]string, len(item.Groups))
+		for i, group := range item.Groups {
+			ids[i] = group.Id
+		}
+
+		return ids
+	},
+	enabled: func(item *nbapi.NetworkResource) bool { return item.Enabled },
+}
+
+// Invoke lists network resources, applying the optional filters.
+func (f *GetNetworkResources) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetNetworkResourcesArgs],
+) (infer.FunctionResponse[GetNetworkResourcesResult], error) {
+	match, err := networkResourceKind.filter(req.Input.ListArgs)
+	if err != nil {
+		return infer.FunctionResponse[GetNetworkResourcesResult]{}, err
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetNetworkResourcesResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	networkIDs, err := listNetworkIDs(ctx, client, req.Input.NetworkID)
+	if err != nil {
+		return infer.FunctionResponse[GetNetworkResourcesResult]{}, err
+	}
+
+	resources := make([]NetworkResourceSummary, 0)
+
+	for _, networkID := range networkIDs {
+		apiResources, err := client.Networks.Resources(networkID).List(ctx)
+		if err != nil {
+			return infer.FunctionResponse[GetNetworkResourcesResult]{}, fmt.Errorf("listing resources of network %s failed: %w", networkID, err)
+		}
+
+		for i := range apiResources {
+			if !match(&apiResources[i]) {
+				continue
+			}
+
+			resources = append(resources, NetworkResourceSummary{
+				NetworkResourceState: resource.NetworkResourceStateFromAPI(ctx, networkID, &apiResources[i]),
+				ID:                   apiResources[i].Id,
+			})
+		}
+	}
+
+	return infer.FunctionResponse[GetNetworkResourcesResult]{
+		Output: GetNetworkResourcesResult{
+			Resources: resources,
+		},
+	}, nil
+}
+
+// listNetworkIDs returns the given network ID, or the IDs of all networks when unset.
+func listNetworkIDs(ctx context.Context, client *rest.Client, networkID *string) ([]string, error) {
+	if networkID != nil {
+		return []string{*networkID}, nil
+	}
+
+	networks, err := client.Networks.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing networks failed: %w", err)
+	}
+
+	ids := make([]string, len(networks))
+	for i, network := range networks {
+		ids[i] = network.Id
+	}
+
+	return ids, nil
+}
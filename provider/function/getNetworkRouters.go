@@ -0,0 +1,114 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetNetworkRouters lists network routers.
+type GetNetworkRouters struct{}
+
+// Annotate describes the function.
+func (f *GetNetworkRouters) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird network routers of one network, or of every network when networkId is unset, "+
+		"optionally filtered by a peer group and the enabled state. Routers have no name, so nameRegex is not supported.")
+}
+
+// GetNetworkRoutersArgs are the inputs for GetNetworkRouters.
+type GetNetworkRoutersArgs struct {
+	ListArgs
+
+	NetworkID *string `pulumi:"networkId,optional"`
+}
+
+// Annotate provides field descriptions for GetNetworkRoutersArgs.
+func (a *GetNetworkRoutersArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.NetworkID, "Optional network ID. When unset, routers of all networks are returned.")
+}
+
+// NetworkRouterSummary is a NetBird network router with its ID.
+type NetworkRouterSummary struct {
+	resource.NetworkRouterState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for NetworkRouterSummary.
+func (s *NetworkRouterSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The network router ID.")
+}
+
+// GetNetworkRoutersResult is the output of GetNetworkRouters.
+type GetNetworkRoutersResult struct {
+	Routers []NetworkRouterSummary `pulumi:"routers"`
+}
+
+// Annotate provides field descriptions for GetNetworkRoutersResult.
+func (r *GetNetworkRoutersResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Routers, "The network routers matching the filter criteria.")
+}
+
+// networkRouterKind describes the objects listed by getNetworkRouters. Routers
+// are listed per network and have no name, so it has no list or name function.
+var networkRouterKind = listKind[nbapi.NetworkRouter]{ //nolint:gochecknoglobals
+	function: "getNetworkRouters",
+	plural:   "network routers",
+	list:     nil,
+	name:     nil,
+	groups:   func(item *nbapi.NetworkRouter) []string { return derefSlice(item.PeerGroups) },
+	enabled:  func(item *nbapi.NetworkRouter) bool { return item.Enabled },
+}
+
+// Invoke lists network routers, applying the optional filters.
+func (f *GetNetworkRouters) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetNetworkRoutersArgs],
+) (infer.FunctionResponse[GetNetworkRoutersResult], error) {
+	match, err := networkRouterKind.filter(req.Input.ListArgs)
+	if err != nil {
+		return infer.FunctionResponse[GetNetworkRoutersResult]{}, err
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetNetworkRoutersResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	networkIDs, err := listNetworkIDs(ctx, client, req.Input.NetworkID)
+	if err != nil {
+		return infer.FunctionResponse[GetNetworkRoutersResult]{}, err
+	}
+
+	routers := make([]NetworkRouterSummary, 0)
+
+	for _, networkID := range networkIDs {
+		apiRouters, err := client.Networks.Routers(networkID).List(ctx)
+		if err != nil {
+			return infer.FunctionResponse[GetNetworkRoutersResult]{}, fmt.Errorf("listing routers of network %s failed: %w", networkID, err)
+		}
+
+		for i := range apiRouters {
+			item := &apiRouters[i]
+
+			if !match(item) {
+				continue
+			}
+
+			routers = append(routers, NetworkRouterSummary{
+				NetworkRouterState: resource.NetworkRouterStateFromAPI(ctx, networkID, item),
+				ID:                 item.Id,
+			})
+		}
+	}
+
+	return infer.FunctionResponse[GetNetworkRoutersResult]{
+		Output: GetNetworkRoutersResult{
+			Routers: routers,
+		},
+	}, nil
+}
@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetNetworks lists networks.
+type GetNetworks struct{}
+
+// Annotate describes the function.
+func (f *GetNetworks) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird networks, optionally filtered by a name regex.")
+}
+
+// GetNetworksArgs are the inputs for GetNetworks.
+type GetNetworksArgs struct {
+	ListArgs
+}
+
+// NetworkSummary is a NetBird network with its ID.
+type NetworkSummary struct {
+	resource.NetworkState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for NetworkSummary.
+func (s *NetworkSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The network ID.")
+}
+
+// GetNetworksResult is the output of GetNetworks.
+type GetNetworksResult struct {
+	Networks []NetworkSummary `pulumi:"networks"`
+}
+
+// Annotate provides field descriptions for GetNetworksResult.
+func (r *GetNetworksResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Networks, "The networks matching the filter criteria.")
+}
+
+// networkKind describes the objects listed by getNetworks.
+var networkKind = listKind[nbapi.Network]{ //nolint:gochecknoglobals
+	function: "getNetworks",
+	plural:   "networks",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.Network, error) {
+		return client.Networks.List(ctx)
+	},
+	name:    func(item *nbapi.Network) string { return item.Name },
+	groups:  nil,
+	enabled: nil,
+}
+
+// Invoke lists networks, applying the optional filters.
+func (f *GetNetworks) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetNetworksArgs],
+) (infer.FunctionResponse[GetNetworksResult], error) {
+	networks, err := listResources(ctx, networkKind, req.Input.ListArgs, nil, func(item *nbapi.Network) NetworkSummary {
+		return NetworkSummary{
+			NetworkState: resource.NetworkStateFromAPI(ctx, item),
+			ID:           item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetNetworksResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetNetworksResult]{
+		Output: GetNetworksResult{
+			Networks: networks,
+		},
+	}, nil
+}
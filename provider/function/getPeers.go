@@ -2,10 +2,9 @@ package function
 
 import (
 	"context"
-	"fmt"
-	"slices"
 
-	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -14,17 +13,12 @@ type GetPeers struct{}
 
 // Annotate describes the function.
 func (f *GetPeers) Annotate(a infer.Annotator) {
-	a.Describe(f, "List all NetBird peers, optionally filtered to those belonging to a specific group ID.")
+	a.Describe(f, "List all NetBird peers, optionally filtered by a name regex and to those belonging to a specific group ID.")
 }
 
 // GetPeersArgs are the inputs for GetPeers.
 type GetPeersArgs struct {
-	GroupID *string `pulumi:"groupId,optional"`
-}
-
-// Annotate provides field descriptions for GetPeersArgs.
-func (a *GetPeersArgs) Annotate(ann infer.Annotator) {
-	ann.Describe(&a.GroupID, "Optional group ID to filter peers. When set, only peers that belong to this group are returned.")
+	ListArgs
 }
 
 // PeerSummary is a brief summary of a NetBird peer.
@@ -32,6 +26,7 @@ type PeerSummary struct {
 	ID        string   `pulumi:"id"`
 	Name      string   `pulumi:"name"`
 	IP        string   `pulumi:"ip"`
+	IPv6      *string  `pulumi:"ipv6,optional"`
 	DNSLabel  string   `pulumi:"dnsLabel"`
 	Connected bool     `pulumi:"connected"`
 	Hostname  string   `pulumi:"hostname"`
@@ -43,6 +38,7 @@ func (p *PeerSummary) Annotate(ann infer.Annotator) {
 	ann.Describe(&p.ID, "The peer ID.")
 	ann.Describe(&p.Name, "The peer name.")
 	ann.Describe(&p.IP, "The WireGuard IP address assigned to the peer.")
+	ann.Describe(&p.IPv6, "The IPv6 overlay address assigned to the peer, if any.")
 	ann.Describe(&p.DNSLabel, "The DNS label used to form the peer's FQDN.")
 	ann.Describe(&p.Connected, "Whether the peer is currently connected to the management server.")
 	ann.Describe(&p.Hostname, "The OS hostname of the machine.")
@@ -59,43 +55,23 @@ func (r *GetPeersResult) Annotate(ann infer.Annotator) {
 	ann.Describe(&r.Peers, "The list of peers matching the filter criteria.")
 }
 
-// Invoke lists peers, applying an optional group filter.
-func (f *GetPeers) Invoke(ctx context.Context, req infer.FunctionRequest[GetPeersArgs]) (infer.FunctionResponse[GetPeersResult], error) {
-	client, err := config.GetNetBirdClient(ctx)
-	if err != nil {
-		return infer.FunctionResponse[GetPeersResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
-	}
+// peerKind describes the objects listed by getPeers.
+var peerKind = listKind[nbapi.Peer]{ //nolint:gochecknoglobals
+	function: "getPeers",
+	plural:   "peers",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.Peer, error) {
+		return client.Peers.List(ctx)
+	},
+	name:    func(item *nbapi.Peer) string { return item.Name },
+	groups:  func(item *nbapi.Peer) []string { return peerSummaryFromAPI(item).Groups },
+	enabled: nil,
+}
 
-	apiPeers, err := client.Peers.List(ctx)
+// Invoke lists peers, applying the optional filters.
+func (f *GetPeers) Invoke(ctx context.Context, req infer.FunctionRequest[GetPeersArgs]) (infer.FunctionResponse[GetPeersResult], error) {
+	peers, err := listResources(ctx, peerKind, req.Input.ListArgs, nil, peerSummaryFromAPI)
 	if err != nil {
-		return infer.FunctionResponse[GetPeersResult]{}, fmt.Errorf("listing peers failed: %w", err)
-	}
-
-	peers := make([]PeerSummary, 0, len(apiPeers))
-
-	for _, peer := range apiPeers {
-		groups := make([]string, len(peer.Groups))
-		for i, g := range peer.Groups {
-			groups[i] = g.Id
-		}
-
-		if req.Input.GroupID != nil {
-			matched := slices.Contains(groups, *req.Input.GroupID)
-
-			if !matched {
-				continue
-			}
-		}
-
-		peers = append(peers, PeerSummary{
-			ID:        peer.Id,
-			Name:      peer.Name,
-			IP:        peer.Ip,
-			DNSLabel:  peer.DnsLabel,
-			Connected: peer.Connected,
-			Hostname:  peer.Hostname,
-			Groups:    groups,
-		})
+		return infer.FunctionResponse[GetPeersResult]{}, err
 	}
 
 	return infer.FunctionResponse[GetPeersResult]{
@@ -104,3 +80,22 @@ func (f *GetPeers) Invoke(ctx context.Context, req infer.FunctionRequest[GetPeer
 		},
 	}, nil
 }
+
+// peerSummaryFromAPI converts an API peer to a PeerSummary.
+func peerSummaryFromAPI(peer *nbapi.Peer) PeerSummary {
+	groups := make([]string, len(peer.Groups))
+	for i, g := range peer.Groups {
+		groups[i] = g.Id
+	}
+
+	return PeerSummary{
+		ID:        peer.Id,
+		Name:      peer.Name,
+		IP:        peer.Ip,
+		IPv6:      peer.Ipv6,
+		DNSLabel:  peer.DnsLabel,
+		Connected: peer.Connected,
+		Hostname:  peer.Hostname,
+		Groups:    groups,
+	}
+}
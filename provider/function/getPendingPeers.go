@@ -0,0 +1,111 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetPendingPeers lists peers waiting for approval.
+type GetPendingPeers struct{}
+
+// Annotate describes the function.
+func (f *GetPendingPeers) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird peers that are pending approval, optionally filtered to a group ID. "+
+		"Peers only become pending when peer approval is enabled for the account (NetBird Cloud).")
+}
+
+// GetPendingPeersArgs are the inputs for GetPendingPeers.
+type GetPendingPeersArgs struct {
+	GroupID *string `pulumi:"groupId,optional"`
+}
+
+// Annotate provides field descriptions for GetPendingPeersArgs.
+func (a *GetPendingPeersArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.GroupID, "Optional group ID to filter peers. When set, only pending peers that belong to this group are returned.")
+}
+
+// PendingPeer describes a peer awaiting approval.
+type PendingPeer struct {
+	ID           string   `pulumi:"id"`
+	Name         string   `pulumi:"name"`
+	Hostname     string   `pulumi:"hostname"`
+	OS           string   `pulumi:"os"`
+	ConnectionIP string   `pulumi:"connectionIp"`
+	Groups       []string `pulumi:"groups"`
+	LastSeen     string   `pulumi:"lastSeen"`
+}
+
+// Annotate provides field descriptions for PendingPeer.
+func (p *PendingPeer) Annotate(ann infer.Annotator) {
+	ann.Describe(&p.ID, "The peer ID.")
+	ann.Describe(&p.Name, "The peer name.")
+	ann.Describe(&p.Hostname, "The OS hostname of the machine.")
+	ann.Describe(&p.OS, "The peer's operating system and version.")
+	ann.Describe(&p.ConnectionIP, "The public IP address the peer connected from.")
+	ann.Describe(&p.Groups, "IDs of groups the peer belongs to.")
+	ann.Describe(&p.LastSeen, "When the peer was last seen by the management server (RFC 3339).")
+}
+
+// GetPendingPeersResult is the output of GetPendingPeers.
+type GetPendingPeersResult struct {
+	Peers []PendingPeer `pulumi:"peers"`
+}
+
+// Annotate provides field descriptions for GetPendingPeersResult.
+func (r *GetPendingPeersResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Peers, "The peers awaiting approval.")
+}
+
+// Invoke lists pending peers, applying an optional group filter.
+func (f *GetPendingPeers) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetPendingPeersArgs],
+) (infer.FunctionResponse[GetPendingPeersResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetPendingPeersResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	apiPeers, err := client.Peers.List(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetPendingPeersResult]{}, fmt.Errorf("listing peers failed: %w", err)
+	}
+
+	peers := make([]PendingPeer, 0)
+
+	for _, peer := range apiPeers {
+		if !peer.ApprovalRequired {
+			continue
+		}
+
+		groups := make([]string, len(peer.Groups))
+		for i, g := range peer.Groups {
+			groups[i] = g.Id
+		}
+
+		if req.Input.GroupID != nil && !slices.Contains(groups, *req.Input.GroupID) {
+			continue
+		}
+
+		peers = append(peers, PendingPeer{
+			ID:           peer.Id,
+			Name:         peer.Name,
+			Hostname:     peer.Hostname,
+			OS:           peer.Os,
+			ConnectionIP: peer.ConnectionIp,
+			Groups:       groups,
+			LastSeen:     peer.LastSeen.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return infer.FunctionResponse[GetPendingPeersResult]{
+		Output: GetPendingPeersResult{
+			Peers: peers,
+		},
+	}, nil
+}
@@ -0,0 +1,102 @@
+package function
+
+import (
+	"context"
+	"slices"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetPolicies lists policies.
+type GetPolicies struct{}
+
+// Annotate describes the function.
+func (f *GetPolicies) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird access control policies, optionally filtered by a name regex, a group used as a rule source or destination, and the enabled state.")
+}
+
+// GetPoliciesArgs are the inputs for GetPolicies.
+type GetPoliciesArgs struct {
+	ListArgs
+}
+
+// PolicySummary is a NetBird policy with its ID.
+type PolicySummary struct {
+	resource.PolicyState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for PolicySummary.
+func (s *PolicySummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The policy ID.")
+}
+
+// GetPoliciesResult is the output of GetPolicies.
+type GetPoliciesResult struct {
+	Policies []PolicySummary `pulumi:"policies"`
+}
+
+// Annotate provides field descriptions for GetPoliciesResult.
+func (r *GetPoliciesResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Policies, "The policies matching the filter criteria.")
+}
+
+// policyKind describes the objects listed by getPolicies.
+var policyKind = listKind[nbapi.Policy]{ //nolint:gochecknoglobals
+	function: "getPolicies",
+	plural:   "policies",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.Policy, error) {
+		return client.Policies.List(ctx)
+	},
+	name:    func(item *nbapi.Policy) string { return item.Name },
+	groups:  policyGroupIDs,
+	enabled: func(item *nbapi.Policy) bool { return item.Enabled },
+}
+
+// Invoke lists policies, applying the optional filters.
+func (f *GetPolicies) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetPoliciesArgs],
+) (infer.FunctionResponse[GetPoliciesResult], error) {
+	policies, err := listResources(ctx, policyKind, req.Input.ListArgs, nil, func(item *nbapi.Policy) PolicySummary {
+		return PolicySummary{
+			PolicyState: resource.PolicyStateFromAPI(ctx, item),
+			ID:          derefString(item.Id),
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetPoliciesResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetPoliciesResult]{
+		Output: GetPoliciesResult{
+			Policies: policies,
+		},
+	}, nil
+}
+
+// policyGroupIDs returns the IDs of the groups used as a rule source or destination.
+func policyGroupIDs(policy *nbapi.Policy) []string {
+	var groups []string
+
+	for _, rule := range policy.Rules {
+		for _, group := range slices.Concat(derefGroups(rule.Sources), derefGroups(rule.Destinations)) {
+			groups = append(groups, group.Id)
+		}
+	}
+
+	return groups
+}
+
+// derefGroups returns the groups behind an optional rule source or destination list.
+func derefGroups(groups *[]nbapi.GroupMinimum) []nbapi.GroupMinimum {
+	if groups == nil {
+		return nil
+	}
+
+	return *groups
+}
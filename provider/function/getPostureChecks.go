@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetPostureChecks lists posture checks.
+type GetPostureChecks struct{}
+
+// Annotate describes the function.
+func (f *GetPostureChecks) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird posture checks, optionally filtered by a name regex.")
+}
+
+// GetPostureChecksArgs are the inputs for GetPostureChecks.
+type GetPostureChecksArgs struct {
+	ListArgs
+}
+
+// PostureCheckSummary is a NetBird posture check with its ID.
+type PostureCheckSummary struct {
+	resource.PostureCheckState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for PostureCheckSummary.
+func (s *PostureCheckSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The posture check ID.")
+}
+
+// GetPostureChecksResult is the output of GetPostureChecks.
+type GetPostureChecksResult struct {
+	PostureChecks []PostureCheckSummary `pulumi:"postureChecks"`
+}
+
+// Annotate provides field descriptions for GetPostureChecksResult.
+func (r *GetPostureChecksResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.PostureChecks, "The posture checks matching the filter criteria.")
+}
+
+// postureCheckKind describes the objects listed by getPostureChecks.
+var postureCheckKind = listKind[nbapi.PostureCheck]{ //nolint:gochecknoglobals
+	function: "getPostureChecks",
+	plural:   "posture checks",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.PostureCheck, error) {
+		return client.PostureChecks.List(ctx)
+	},
+	name:    func(item *nbapi.PostureCheck) string { return item.Name },
+	groups:  nil,
+	enabled: nil,
+}
+
+// Invoke lists posture checks, applying the optional filters.
+func (f *GetPostureChecks) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetPostureChecksArgs],
+) (infer.FunctionResponse[GetPostureChecksResult], error) {
+	checks, err := listResources(ctx, postureCheckKind, req.Input.ListArgs, nil, func(item *nbapi.PostureCheck) PostureCheckSummary {
+		return PostureCheckSummary{
+			PostureCheckState: resource.PostureCheckStateFromAPI(ctx, item),
+			ID:                item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetPostureChecksResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetPostureChecksResult]{
+		Output: GetPostureChecksResult{
+			PostureChecks: checks,
+		},
+	}, nil
+}
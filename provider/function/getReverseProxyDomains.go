@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetReverseProxyDomains lists reverse proxy domains.
+type GetReverseProxyDomains struct{}
+
+// Annotate describes the function.
+func (f *GetReverseProxyDomains) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird reverse proxy domains, optionally filtered by a regex on the domain name.")
+}
+
+// GetReverseProxyDomainsArgs are the inputs for GetReverseProxyDomains.
+type GetReverseProxyDomainsArgs struct {
+	ListArgs
+}
+
+// ReverseProxyDomainSummary is a NetBird reverse proxy domain with its ID.
+type ReverseProxyDomainSummary struct {
+	resource.ReverseProxyDomainState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for ReverseProxyDomainSummary.
+func (s *ReverseProxyDomainSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The reverse proxy domain ID.")
+}
+
+// GetReverseProxyDomainsResult is the output of GetReverseProxyDomains.
+type GetReverseProxyDomainsResult struct {
+	Domains []ReverseProxyDomainSummary `pulumi:"domains"`
+}
+
+// Annotate provides field descriptions for GetReverseProxyDomainsResult.
+func (r *GetReverseProxyDomainsResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Domains, "The reverse proxy domains matching the filter criteria.")
+}
+
+// reverseProxyDomainKind describes the objects listed by getReverseProxyDomains.
+var reverseProxyDomainKind = listKind[nbapi.ReverseProxyDomain]{ //nolint:gochecknoglobals
+	function: "getReverseProxyDomains",
+	plural:   "reverse proxy domains",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.ReverseProxyDomain, error) {
+		return client.ReverseProxyDomains.List(ctx)
+	},
+	name:    func(item *nbapi.ReverseProxyDomain) string { return item.Domain },
+	groups:  nil,
+	enabled: nil,
+}
+
+// Invoke lists reverse proxy domains, applying the optional filters.
+func (f *GetReverseProxyDomains) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetReverseProxyDomainsArgs],
+) (infer.FunctionResponse[GetReverseProxyDomainsResult], error) {
+	domains, err := listResources(ctx, reverseProxyDomainKind, req.Input.ListArgs, nil, func(item *nbapi.ReverseProxyDomain) ReverseProxyDomainSummary {
+		return ReverseProxyDomainSummary{
+			ReverseProxyDomainState: resource.ReverseProxyDomainStateFromAPI(ctx, item),
+			ID:                      item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetReverseProxyDomainsResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetReverseProxyDomainsResult]{
+		Output: GetReverseProxyDomainsResult{
+			Domains: domains,
+		},
+	}, nil
+}
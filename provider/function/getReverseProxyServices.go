@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetReverseProxyServices lists reverse proxy services.
+type GetReverseProxyServices struct{}
+
+// Annotate describes the function.
+func (f *GetReverseProxyServices) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird reverse proxy services, optionally filtered by a name regex, an access group, and the enabled state.")
+}
+
+// GetReverseProxyServicesArgs are the inputs for GetReverseProxyServices.
+type GetReverseProxyServicesArgs struct {
+	ListArgs
+}
+
+// ReverseProxyServiceSummary is a NetBird reverse proxy service with its ID.
+type ReverseProxyServiceSummary struct {
+	resource.ReverseProxyServiceState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for ReverseProxyServiceSummary.
+func (s *ReverseProxyServiceSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The reverse proxy service ID.")
+}
+
+// GetReverseProxyServicesResult is the output of GetReverseProxyServices.
+type GetReverseProxyServicesResult struct {
+	Services []ReverseProxyServiceSummary `pulumi:"services"`
+}
+
+// Annotate provides field descriptions for GetReverseProxyServicesResult.
+func (r *GetReverseProxyServicesResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Services, "The reverse proxy services matching the filter criteria.")
+}
+
+// reverseProxyServiceKind describes the objects listed by getReverseProxyServices.
+var reverseProxyServiceKind = listKind[nbapi.Service]{ //nolint:gochecknoglobals
+	function: "getReverseProxyServices",
+	plural:   "reverse proxy services",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.Service, error) {
+		return client.ReverseProxyServices.List(ctx)
+	},
+	name:    func(item *nbapi.Service) string { return item.Name },
+	groups:  func(item *nbapi.Service) []string { return derefSlice(item.AccessGroups) },
+	enabled: func(item *nbapi.Service) bool { return item.Enabled },
+}
+
+// Invoke lists reverse proxy services, applying the optional filters.
+func (f *GetReverseProxyServices) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetReverseProxyServicesArgs],
+) (infer.FunctionResponse[GetReverseProxyServicesResult], error) {
+	services, err := listResources(ctx, reverseProxyServiceKind, req.Input.ListArgs, nil, func(item *nbapi.Service) ReverseProxyServiceSummary {
+		return ReverseProxyServiceSummary{
+			ReverseProxyServiceState: resource.ReverseProxyServiceStateFromAPI(ctx, item),
+			ID:                       item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetReverseProxyServicesResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetReverseProxyServicesResult]{
+		Output: GetReverseProxyServicesResult{
+			Services: services,
+		},
+	}, nil
+}
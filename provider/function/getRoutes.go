@@ -0,0 +1,84 @@
+package function
+
+import (
+	"context"
+	"slices"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetRoutes lists routes.
+type GetRoutes struct{}
+
+// Annotate describes the function.
+func (f *GetRoutes) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird network routes, optionally filtered by a regex on the network identifier, a distribution, peer, "+
+		"or access control group, and the enabled state. Routes have no name, so nameRegex matches the networkId "+
+		"(the route's network identifier), not its description.")
+}
+
+// GetRoutesArgs are the inputs for GetRoutes.
+type GetRoutesArgs struct {
+	ListArgs
+}
+
+// RouteSummary is a NetBird route with its ID.
+type RouteSummary struct {
+	resource.RouteState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for RouteSummary.
+func (s *RouteSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The route ID.")
+}
+
+// GetRoutesResult is the output of GetRoutes.
+type GetRoutesResult struct {
+	Routes []RouteSummary `pulumi:"routes"`
+}
+
+// Annotate provides field descriptions for GetRoutesResult.
+func (r *GetRoutesResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Routes, "The routes matching the filter criteria.")
+}
+
+// routeKind describes the objects listed by getRoutes.
+var routeKind = listKind[nbapi.Route]{ //nolint:gochecknoglobals
+	function: "getRoutes",
+	plural:   "routes",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.Route, error) {
+		return client.Routes.List(ctx)
+	},
+	name: func(item *nbapi.Route) string { return item.NetworkId },
+	groups: func(item *nbapi.Route) []string {
+		return slices.Concat(item.Groups, derefSlice(item.PeerGroups), derefSlice(item.AccessControlGroups))
+	},
+	enabled: func(item *nbapi.Route) bool { return item.Enabled },
+}
+
+// Invoke lists routes, applying the optional filters.
+func (f *GetRoutes) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetRoutesArgs],
+) (infer.FunctionResponse[GetRoutesResult], error) {
+	routes, err := listResources(ctx, routeKind, req.Input.ListArgs, nil, func(item *nbapi.Route) RouteSummary {
+		return RouteSummary{
+			RouteState: resource.RouteStateFromAPI(ctx, item),
+			ID:         item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetRoutesResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetRoutesResult]{
+		Output: GetRoutesResult{
+			Routes: routes,
+		},
+	}, nil
+}
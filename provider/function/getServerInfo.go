@@ -0,0 +1,123 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetServerInfo reports the management server and identity resolved by the provider.
+type GetServerInfo struct{}
+
+// Annotate describes the function.
+func (f *GetServerInfo) Annotate(a infer.Annotator) {
+	a.Describe(f, "Return the NetBird management server version and edition detected when the provider "+
+		"was configured, the account and user behind the token, and the version-gated features the server supports.")
+}
+
+// GetServerInfoArgs are the inputs for GetServerInfo (none).
+type GetServerInfoArgs struct{}
+
+// CapabilitySummary reports whether a version-gated feature is available.
+type CapabilitySummary struct {
+	Name       string  `pulumi:"name"`
+	MinVersion *string `pulumi:"minVersion,optional"`
+	CloudOnly  bool    `pulumi:"cloudOnly"`
+	Supported  bool    `pulumi:"supported"`
+	Reason     *string `pulumi:"reason,optional"`
+}
+
+// Annotate provides field descriptions for CapabilitySummary.
+func (c *CapabilitySummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&c.Name, "Human-readable feature name.")
+	ann.Describe(&c.MinVersion, "Minimum management server version required by the feature, if any.")
+	ann.Describe(&c.CloudOnly, "Whether the feature is only available on NetBird Cloud.")
+	ann.Describe(&c.Supported, "Whether the configured server supports the feature. Unknown versions are assumed to support it.")
+	ann.Describe(&c.Reason, "Why the feature is unsupported. Unset when supported.")
+}
+
+// GetServerInfoResult is the output of GetServerInfo.
+type GetServerInfoResult struct {
+	URL          string              `pulumi:"url"`
+	Version      *string             `pulumi:"version,optional"`
+	Edition      string              `pulumi:"edition"`
+	Capabilities []CapabilitySummary `pulumi:"capabilities"`
+	AccountID    *string             `pulumi:"accountId,optional"`
+	UserID       *string             `pulumi:"userId,optional"`
+	UserRole     *string             `pulumi:"userRole,optional"`
+}
+
+// Annotate provides field descriptions for GetServerInfoResult.
+func (r *GetServerInfoResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.URL, "The configured management API URL.")
+	ann.Describe(&r.Version, "Management server version. Unset when the server does not report one.")
+	ann.Describe(&r.Edition, "Server edition: 'cloud' or 'self-hosted'.")
+	ann.Describe(&r.Capabilities, "Version-gated features and whether the server supports them.")
+	ann.Describe(&r.AccountID, "ID of the account the token belongs to. Unset when skipConfigureValidation is enabled.")
+	ann.Describe(&r.UserID, "ID of the user the token was issued for. Unset when skipConfigureValidation is enabled.")
+	ann.Describe(&r.UserRole, "Account role of the token's user. Unset when skipConfigureValidation is enabled.")
+}
+
+// Invoke returns the server information cached during Configure.
+func (f *GetServerInfo) Invoke(
+	ctx context.Context,
+	_ infer.FunctionRequest[GetServerInfoArgs],
+) (infer.FunctionResponse[GetServerInfoResult], error) {
+	managementURL, err := config.GetNetBirdURL(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetServerInfoResult]{}, fmt.Errorf("error getting NetBird URL: %w", err)
+	}
+
+	info, err := config.GetServerInfo(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetServerInfoResult]{}, fmt.Errorf("error getting server info: %w", err)
+	}
+
+	identity, err := config.GetIdentity(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetServerInfoResult]{}, fmt.Errorf("error getting identity: %w", err)
+	}
+
+	capabilities := make([]CapabilitySummary, 0, len(config.AllCapabilities()))
+
+	for _, capability := range config.AllCapabilities() {
+		summary := CapabilitySummary{
+			Name:       capability.Name,
+			MinVersion: optionalString(capability.MinVersion),
+			CloudOnly:  capability.CloudOnly,
+			Supported:  true,
+			Reason:     nil,
+		}
+
+		if supportErr := info.Supports(capability); supportErr != nil {
+			reason := supportErr.Error()
+			summary.Supported = false
+			summary.Reason = &reason
+		}
+
+		capabilities = append(capabilities, summary)
+	}
+
+	return infer.FunctionResponse[GetServerInfoResult]{
+		Output: GetServerInfoResult{
+			URL:          managementURL,
+			Version:      optionalString(info.Version),
+			Edition:      string(info.Edition),
+			Capabilities: capabilities,
+			AccountID:    optionalString(identity.AccountID),
+			UserID:       optionalString(identity.UserID),
+			UserRole:     optionalString(identity.Role),
+		},
+	}, nil
+}
+
+// optionalString returns nil for an empty string so unset values stay unset in outputs.
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,121 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetSetupKeyUsage reports how a setup key has been used.
+type GetSetupKeyUsage struct{}
+
+// Annotate describes the function.
+func (f *GetSetupKeyUsage) Annotate(a infer.Annotator) {
+	a.Describe(f, "Report how a NetBird setup key has been used, including the peers that joined with it. "+
+		"Peers are taken from the account's audit events, so the provider token must be allowed to read them.")
+}
+
+// GetSetupKeyUsageArgs are the inputs for GetSetupKeyUsage.
+type GetSetupKeyUsageArgs struct {
+	SetupKeyID string `pulumi:"setupKeyId"`
+}
+
+// Annotate provides field descriptions for GetSetupKeyUsageArgs.
+func (a *GetSetupKeyUsageArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.SetupKeyID, "The ID of the setup key to report on.")
+}
+
+// SetupKeyEnrolledPeer is a peer that joined with a setup key.
+type SetupKeyEnrolledPeer struct {
+	PeerID     string `pulumi:"peerId"`
+	Name       string `pulumi:"name"`
+	EnrolledAt string `pulumi:"enrolledAt"`
+	Exists     bool   `pulumi:"exists"`
+}
+
+// Annotate provides field descriptions for SetupKeyEnrolledPeer.
+func (e *SetupKeyEnrolledPeer) Annotate(ann infer.Annotator) {
+	ann.Describe(&e.PeerID, "The ID of the peer.")
+	ann.Describe(&e.Name, "The peer name when it enrolled.")
+	ann.Describe(&e.EnrolledAt, "When the peer enrolled, in RFC3339 format.")
+	ann.Describe(&e.Exists, "Whether the peer still exists.")
+}
+
+// GetSetupKeyUsageResult is the output of GetSetupKeyUsage.
+type GetSetupKeyUsageResult struct {
+	SetupKeyID string                 `pulumi:"setupKeyId"`
+	Name       string                 `pulumi:"name"`
+	State      string                 `pulumi:"state"`
+	Valid      bool                   `pulumi:"valid"`
+	Revoked    bool                   `pulumi:"revoked"`
+	UsedTimes  int                    `pulumi:"usedTimes"`
+	LastUsed   string                 `pulumi:"lastUsed"`
+	Peers      []SetupKeyEnrolledPeer `pulumi:"peers"`
+}
+
+// Annotate provides field descriptions for GetSetupKeyUsageResult.
+func (r *GetSetupKeyUsageResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.SetupKeyID, "The NetBird setup key ID.")
+	ann.Describe(&r.Name, "The setup key name.")
+	ann.Describe(&r.State, "The setup key state: 'valid', 'overused', 'expired', or 'revoked'.")
+	ann.Describe(&r.Valid, "Whether the key can currently enroll peers.")
+	ann.Describe(&r.Revoked, "Whether the setup key has been revoked.")
+	ann.Describe(&r.UsedTimes, "How many times the key was used to enroll a peer.")
+	ann.Describe(&r.LastUsed, "Timestamp of last key use in RFC3339 format.")
+	ann.Describe(&r.Peers, "The peers that enrolled with the key, oldest first, including peers that were deleted since.")
+}
+
+// Invoke reads the setup key and the peers enrolled with it.
+func (f *GetSetupKeyUsage) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetSetupKeyUsageArgs],
+) (infer.FunctionResponse[GetSetupKeyUsageResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetSetupKeyUsageResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	key, err := client.SetupKeys.Get(ctx, req.Input.SetupKeyID)
+	if err != nil {
+		return infer.FunctionResponse[GetSetupKeyUsageResult]{}, fmt.Errorf("reading setup key %s failed: %w", req.Input.SetupKeyID, err)
+	}
+
+	enrollments, err := resource.ListSetupKeyEnrollments(ctx, client, key.Id)
+	if err != nil {
+		return infer.FunctionResponse[GetSetupKeyUsageResult]{}, err
+	}
+
+	apiPeers, err := client.Peers.List(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetSetupKeyUsageResult]{}, fmt.Errorf("listing peers failed: %w", err)
+	}
+
+	peers := make([]SetupKeyEnrolledPeer, len(enrollments))
+	for i, enrollment := range enrollments {
+		peers[i] = SetupKeyEnrolledPeer{
+			PeerID:     enrollment.PeerID,
+			Name:       enrollment.PeerName,
+			EnrolledAt: enrollment.EnrolledAt.Format(time.RFC3339),
+			Exists:     slices.ContainsFunc(apiPeers, func(peer nbapi.Peer) bool { return peer.Id == enrollment.PeerID }),
+		}
+	}
+
+	return infer.FunctionResponse[GetSetupKeyUsageResult]{
+		Output: GetSetupKeyUsageResult{
+			SetupKeyID: key.Id,
+			Name:       key.Name,
+			State:      key.State,
+			Valid:      key.Valid,
+			Revoked:    key.Revoked,
+			UsedTimes:  key.UsedTimes,
+			LastUsed:   key.LastUsed.Format("2006-01-02T15:04:05Z07:00"),
+			Peers:      peers,
+		},
+	}, nil
+}
@@ -0,0 +1,92 @@
+package function
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetSetupKeys lists setup keys.
+type GetSetupKeys struct{}
+
+// Annotate describes the function.
+func (f *GetSetupKeys) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird setup keys, optionally filtered by a name regex, an auto-assigned group, "+
+		"the enabled (not revoked) state, and whether the key is still valid.")
+}
+
+// GetSetupKeysArgs are the inputs for GetSetupKeys.
+type GetSetupKeysArgs struct {
+	ListArgs
+
+	Valid *bool `pulumi:"valid,optional"`
+}
+
+// Annotate provides field descriptions for GetSetupKeysArgs.
+func (a *GetSetupKeysArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Valid, "Optional validity. When set, only keys with this valid state are returned; "+
+		"a key is valid while it is not revoked, expired, or used up. The enabled filter only checks revocation.")
+}
+
+// SetupKeySummary is a NetBird setup key with its ID.
+type SetupKeySummary struct {
+	resource.SetupKeyState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for SetupKeySummary.
+func (s *SetupKeySummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The setup key ID.")
+}
+
+// GetSetupKeysResult is the output of GetSetupKeys.
+type GetSetupKeysResult struct {
+	SetupKeys []SetupKeySummary `pulumi:"setupKeys"`
+}
+
+// Annotate provides field descriptions for GetSetupKeysResult.
+func (r *GetSetupKeysResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.SetupKeys, "The setup keys matching the filter criteria.")
+}
+
+// setupKeyKind describes the objects listed by getSetupKeys.
+var setupKeyKind = listKind[nbapi.SetupKey]{ //nolint:gochecknoglobals
+	function: "getSetupKeys",
+	plural:   "setup keys",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.SetupKey, error) {
+		return client.SetupKeys.List(ctx)
+	},
+	name:    func(item *nbapi.SetupKey) string { return item.Name },
+	groups:  func(item *nbapi.SetupKey) []string { return item.AutoGroups },
+	enabled: func(item *nbapi.SetupKey) bool { return !item.Revoked },
+}
+
+// Invoke lists setup keys, applying the optional filters.
+func (f *GetSetupKeys) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetSetupKeysArgs],
+) (infer.FunctionResponse[GetSetupKeysResult], error) {
+	valid := func(item *nbapi.SetupKey) bool {
+		return req.Input.Valid == nil || item.Valid == *req.Input.Valid
+	}
+
+	setupKeys, err := listResources(ctx, setupKeyKind, req.Input.ListArgs, valid, func(item *nbapi.SetupKey) SetupKeySummary {
+		return SetupKeySummary{
+			SetupKeyState: resource.SetupKeyStateFromAPI(ctx, item),
+			ID:            item.Id,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetSetupKeysResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetSetupKeysResult]{
+		Output: GetSetupKeysResult{
+			SetupKeys: setupKeys,
+		},
+	}, nil
+}
@@ -0,0 +1,126 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetTokens lists personal access tokens.
+type GetTokens struct{}
+
+// Annotate describes the function.
+func (f *GetTokens) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird personal access tokens of one user, or of every user when userId is unset, "+
+		"optionally filtered by a name regex. Token values are only returned on creation and are never included.")
+}
+
+// GetTokensArgs are the inputs for GetTokens.
+type GetTokensArgs struct {
+	ListArgs
+
+	UserID *string `pulumi:"userId,optional"`
+}
+
+// Annotate provides field descriptions for GetTokensArgs.
+func (a *GetTokensArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.UserID, "Optional user ID. When unset, tokens of all users are returned.")
+}
+
+// TokenSummary is a NetBird personal access token with its ID.
+type TokenSummary struct {
+	resource.TokenState
+
+	ID string `pulumi:"id"`
+}
+
+// Annotate provides field descriptions for TokenSummary.
+func (s *TokenSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&s.ID, "The token ID.")
+}
+
+// GetTokensResult is the output of GetTokens.
+type GetTokensResult struct {
+	Tokens []TokenSummary `pulumi:"tokens"`
+}
+
+// Annotate provides field descriptions for GetTokensResult.
+func (r *GetTokensResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Tokens, "The tokens matching the filter criteria.")
+}
+
+// tokenKind describes the objects listed by getTokens. Tokens are listed per
+// user, so it has no list function.
+var tokenKind = listKind[nbapi.PersonalAccessToken]{ //nolint:gochecknoglobals
+	function: "getTokens",
+	plural:   "tokens",
+	list:     nil,
+	name:     func(item *nbapi.PersonalAccessToken) string { return item.Name },
+	groups:   nil,
+	enabled:  nil,
+}
+
+// Invoke lists tokens, applying the optional filters.
+func (f *GetTokens) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetTokensArgs],
+) (infer.FunctionResponse[GetTokensResult], error) {
+	match, err := tokenKind.filter(req.Input.ListArgs)
+	if err != nil {
+		return infer.FunctionResponse[GetTokensResult]{}, err
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[GetTokensResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	var userIDs []string
+
+	if req.Input.UserID != nil {
+		userIDs = []string{*req.Input.UserID}
+	} else {
+		users, err := client.Users.List(ctx)
+		if err != nil {
+			return infer.FunctionResponse[GetTokensResult]{}, fmt.Errorf("listing users failed: %w", err)
+		}
+
+		for _, user := range users {
+			userIDs = append(userIDs, user.Id)
+		}
+	}
+
+	tokens := make([]TokenSummary, 0)
+
+	for _, userID := range userIDs {
+		apiTokens, err := client.Tokens.List(ctx, userID)
+		if err != nil {
+			return infer.FunctionResponse[GetTokensResult]{}, fmt.Errorf("listing tokens of user %s failed: %w", userID, err)
+		}
+
+		for _, pat := range apiTokens {
+			if !match(&pat) {
+				continue
+			}
+
+			// The API does not report the requested lifetime; derive it in days.
+			expiresIn := int(math.Round(pat.ExpirationDate.Sub(pat.CreatedAt).Hours() / 24))
+
+			tokens = append(tokens, TokenSummary{
+				TokenState: resource.TokenStateFromAPI(ctx, userID, expiresIn, pat),
+				ID:         pat.Id,
+			})
+		}
+	}
+
+	return infer.FunctionResponse[GetTokensResult]{
+		Output: GetTokensResult{
+			Tokens: tokens,
+		},
+	}, nil
+}
@@ -0,0 +1,117 @@
+package function
+
+import (
+	"context"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetUsers lists users, optionally filtered by status and role.
+type GetUsers struct{}
+
+// Annotate describes the function.
+func (f *GetUsers) Annotate(a infer.Annotator) {
+	a.Describe(f, "List NetBird users, optionally filtered by status (active, blocked, invited), role, a name regex, "+
+		"an auto-assigned group, and the enabled (not blocked) state. Service users are excluded unless includeServiceUsers is set.")
+}
+
+// GetUsersArgs are the inputs for GetUsers.
+type GetUsersArgs struct {
+	ListArgs
+
+	Status              *resource.UserStatus `pulumi:"status,optional"`
+	Role                *string              `pulumi:"role,optional"`
+	IncludeServiceUsers *bool                `pulumi:"includeServiceUsers,optional"`
+}
+
+// Annotate provides field descriptions for GetUsersArgs.
+func (a *GetUsersArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Status, "Only return users with this status.")
+	ann.Describe(&a.Role, "Only return users with this account role (e.g. owner, admin, user).")
+	ann.Describe(&a.IncludeServiceUsers, "Whether to include service users. Defaults to false.")
+}
+
+// UserSummary is a NetBird user with its ID.
+type UserSummary struct {
+	resource.UserState
+
+	ID        string  `pulumi:"id"`
+	LastLogin *string `pulumi:"lastLogin,optional"`
+}
+
+// Annotate provides field descriptions for UserSummary.
+func (u *UserSummary) Annotate(ann infer.Annotator) {
+	ann.Describe(&u.ID, "The NetBird user ID.")
+	ann.Describe(&u.LastLogin, "When the user last logged in (RFC 3339). Unset if the user never logged in.")
+}
+
+// GetUsersResult is the output of GetUsers.
+type GetUsersResult struct {
+	Users []UserSummary `pulumi:"users"`
+}
+
+// Annotate provides field descriptions for GetUsersResult.
+func (r *GetUsersResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.Users, "The users matching the filter criteria.")
+}
+
+// userKind describes the objects listed by getUsers.
+var userKind = listKind[nbapi.User]{ //nolint:gochecknoglobals
+	function: "getUsers",
+	plural:   "users",
+	list: func(ctx context.Context, client *rest.Client) ([]nbapi.User, error) {
+		return client.Users.List(ctx)
+	},
+	name:    func(item *nbapi.User) string { return item.Name },
+	groups:  func(item *nbapi.User) []string { return item.AutoGroups },
+	enabled: func(item *nbapi.User) bool { return !item.IsBlocked },
+}
+
+// Invoke lists users, applying the optional filters.
+func (f *GetUsers) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetUsersArgs],
+) (infer.FunctionResponse[GetUsersResult], error) {
+	includeServiceUsers := req.Input.IncludeServiceUsers != nil && *req.Input.IncludeServiceUsers
+
+	keep := func(user *nbapi.User) bool {
+		isServiceUser := user.IsServiceUser != nil && *user.IsServiceUser
+
+		switch {
+		case isServiceUser && !includeServiceUsers:
+			return false
+		case req.Input.Status != nil && resource.UserStatus(user.Status) != *req.Input.Status:
+			return false
+		default:
+			return req.Input.Role == nil || user.Role == *req.Input.Role
+		}
+	}
+
+	users, err := listResources(ctx, userKind, req.Input.ListArgs, keep, func(user *nbapi.User) UserSummary {
+		var lastLogin *string
+
+		if user.LastLogin != nil && !user.LastLogin.IsZero() {
+			formatted := user.LastLogin.UTC().Format(time.RFC3339)
+			lastLogin = &formatted
+		}
+
+		return UserSummary{
+			UserState: resource.UserStateFromAPI(ctx, user),
+			ID:        user.Id,
+			LastLogin: lastLogin,
+		}
+	})
+	if err != nil {
+		return infer.FunctionResponse[GetUsersResult]{}, err
+	}
+
+	return infer.FunctionResponse[GetUsersResult]{
+		Output: GetUsersResult{
+			Users: users,
+		},
+	}, nil
+}
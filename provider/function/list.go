@@ -0,0 +1,192 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// ListArgs are the filters shared by the get* collection functions. All set
+// filters must match; a filter the listed kind has no notion of is rejected
+// rather than silently matching nothing.
+type ListArgs struct {
+	NameRegex *string `pulumi:"nameRegex,optional"`
+	GroupID   *string `pulumi:"groupId,optional"`
+	Enabled   *bool   `pulumi:"enabled,optional"`
+}
+
+// Annotate provides field descriptions for ListArgs.
+func (a *ListArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.NameRegex, "Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.")
+	ann.Describe(&a.GroupID, "Optional group ID. When set, only objects that reference this group are returned.")
+	ann.Describe(&a.Enabled, "Optional enabled state. When set, only objects with this enabled state are returned.")
+}
+
+// listFilter is the compiled form of ListArgs.
+type listFilter struct {
+	name    *regexp.Regexp
+	groupID *string
+	enabled *bool
+}
+
+// listSupport declares which ListArgs filters a collection function honours.
+type listSupport struct {
+	name    bool
+	groups  bool
+	enabled bool
+}
+
+// newListFilter compiles args for the named function, rejecting filters the
+// listed kind does not support.
+func newListFilter(function string, args ListArgs, support listSupport) (listFilter, error) {
+	if args.NameRegex != nil && !support.name {
+		return listFilter{}, fmt.Errorf("%s does not support the nameRegex filter", function)
+	}
+
+	if args.GroupID != nil && !support.groups {
+		return listFilter{}, fmt.Errorf("%s does not support the groupId filter", function)
+	}
+
+	if args.Enabled != nil && !support.enabled {
+		return listFilter{}, fmt.Errorf("%s does not support the enabled filter", function)
+	}
+
+	filter := listFilter{
+		name:    nil,
+		groupID: args.GroupID,
+		enabled: args.Enabled,
+	}
+
+	if args.NameRegex != nil {
+		re, err := regexp.Compile(*args.NameRegex)
+		if err != nil {
+			return listFilter{}, fmt.Errorf("invalid nameRegex %q: %w", *args.NameRegex, err)
+		}
+
+		filter.name = re
+	}
+
+	return filter, nil
+}
+
+// match reports whether an object with the given name, referenced group IDs,
+// and enabled state passes the filter.
+func (f listFilter) match(name string, groups []string, enabled bool) bool {
+	if f.name != nil && !f.name.MatchString(name) {
+		return false
+	}
+
+	if f.groupID != nil && !slices.Contains(groups, *f.groupID) {
+		return false
+	}
+
+	return f.enabled == nil || *f.enabled == enabled
+}
+
+// listKind describes one kind of object listed by a get* function: how to
+// fetch it and which of its fields the ListArgs filters read. A nil accessor
+// means the kind has no such notion, so the matching filter is rejected.
+type listKind[T any] struct {
+	function string
+	plural   string
+	list     func(ctx context.Context, client *rest.Client) ([]T, error)
+	name     func(item *T) string
+	groups   func(item *T) []string
+	enabled  func(item *T) bool
+}
+
+// filter compiles args into a predicate over items of the kind.
+func (k listKind[T]) filter(args ListArgs) (func(item *T) bool, error) {
+	filter, err := newListFilter(k.function, args, listSupport{
+		name:    k.name != nil,
+		groups:  k.groups != nil,
+		enabled: k.enabled != nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return func(item *T) bool {
+		var (
+			name    string
+			groups  []string
+			enabled bool
+		)
+
+		if k.name != nil {
+			name = k.name(item)
+		}
+
+		if k.groups != nil {
+			groups = k.groups(item)
+		}
+
+		if k.enabled != nil {
+			enabled = k.enabled(item)
+		}
+
+		return filter.match(name, groups, enabled)
+	}, nil
+}
+
+// listResources fetches every object of kind, keeps those that match args and
+// keep (when set), and converts them with summarize.
+func listResources[T, S any](
+	ctx context.Context,
+	kind listKind[T],
+	args ListArgs,
+	keep func(item *T) bool,
+	summarize func(item *T) S,
+) ([]S, error) {
+	match, err := kind.filter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := kind.list(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("listing %s failed: %w", kind.plural, err)
+	}
+
+	summaries := make([]S, 0, len(items))
+
+	for i := range items {
+		item := &items[i]
+
+		if !match(item) || (keep != nil && !keep(item)) {
+			continue
+		}
+
+		summaries = append(summaries, summarize(item))
+	}
+
+	return summaries, nil
+}
+
+// derefSlice returns the slice behind an optional list field.
+func derefSlice(values *[]string) []string {
+	if values == nil {
+		return nil
+	}
+
+	return *values
+}
+
+// derefString returns the value of an optional API ID, or "" when unset.
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
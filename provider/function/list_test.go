@@ -0,0 +1,136 @@
+package function
+
+import (
+	"testing"
+
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// kindMatcher compiles args for a kind and reports whether its sample hit and
+// miss items pass the filter.
+type kindMatcher func(args ListArgs) (hit, miss bool, err error)
+
+func matchKind[T any](kind listKind[T], hit, miss T) kindMatcher {
+	return func(args ListArgs) (bool, bool, error) {
+		match, err := kind.filter(args)
+		if err != nil {
+			return false, false, err
+		}
+
+		return match(&hit), match(&miss), nil
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// runKindMatchers checks that args keeps the hit item and drops the miss item
+// of every kind.
+func runKindMatchers(t *testing.T, args ListArgs, kinds map[string]kindMatcher) {
+	t.Helper()
+
+	for name, matcher := range kinds {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			hit, miss, err := matcher(args)
+			require.NoError(t, err)
+			assert.True(t, hit, "hit item filtered out")
+			assert.False(t, miss, "miss item kept")
+		})
+	}
+}
+
+func TestListKindNameFilter(t *testing.T) {
+	t.Parallel()
+
+	runKindMatchers(t, ListArgs{NameRegex: ptr("^web-"), GroupID: nil, Enabled: nil}, map[string]kindMatcher{
+		"groups":            matchKind(groupKind, nbapi.Group{Name: "web-1"}, nbapi.Group{Name: "db"}),
+		"identityProviders": matchKind(identityProviderKind, nbapi.IdentityProvider{Name: "web-sso"}, nbapi.IdentityProvider{Name: "okta"}),
+		"nameserverGroups":  matchKind(nameserverGroupKind, nbapi.NameserverGroup{Name: "web-dns"}, nbapi.NameserverGroup{Name: "corp"}),
+		"networks":          matchKind(networkKind, nbapi.Network{Name: "web-net"}, nbapi.Network{Name: "lab"}),
+		"peers":             matchKind(peerKind, nbapi.Peer{Name: "web-a"}, nbapi.Peer{Name: "laptop"}),
+		"policies":          matchKind(policyKind, nbapi.Policy{Name: "web-in"}, nbapi.Policy{Name: "ssh"}),
+		"postureChecks":     matchKind(postureCheckKind, nbapi.PostureCheck{Name: "web-os"}, nbapi.PostureCheck{Name: "geo"}),
+		"reverseProxyDomains": matchKind(reverseProxyDomainKind,
+			nbapi.ReverseProxyDomain{Domain: "web-proxy.example.com"}, nbapi.ReverseProxyDomain{Domain: "example.com"}),
+		"reverseProxyServices": matchKind(reverseProxyServiceKind, nbapi.Service{Name: "web-app"}, nbapi.Service{Name: "grafana"}),
+		"dnsZones":             matchKind(dnsZoneKind, nbapi.Zone{Name: "web-zone"}, nbapi.Zone{Name: "corp"}),
+		"dnsRecords":           matchKind(dnsRecordKind, nbapi.DNSRecord{Name: "web-1.corp"}, nbapi.DNSRecord{Name: "db.corp"}),
+		"networkResources":     matchKind(networkResourceKind, nbapi.NetworkResource{Name: "web-db"}, nbapi.NetworkResource{Name: "db"}),
+		"tokens":               matchKind(tokenKind, nbapi.PersonalAccessToken{Name: "web-ci"}, nbapi.PersonalAccessToken{Name: "ops"}),
+		"setupKeys":            matchKind(setupKeyKind, nbapi.SetupKey{Name: "web-servers"}, nbapi.SetupKey{Name: "laptops"}),
+		"users":                matchKind(userKind, nbapi.User{Name: "web-admin"}, nbapi.User{Name: "alice"}),
+		// Routes have no name; nameRegex matches the network identifier.
+		"routes": matchKind(routeKind,
+			nbapi.Route{NetworkId: "web-lan", Description: "office"}, nbapi.Route{NetworkId: "lan", Description: "web-office"}),
+	})
+}
+
+func TestListKindGroupFilter(t *testing.T) {
+	t.Parallel()
+
+	groups := func(ids ...string) *[]string { return &ids }
+
+	runKindMatchers(t, ListArgs{NameRegex: nil, GroupID: ptr("g1"), Enabled: nil}, map[string]kindMatcher{
+		"nameserverGroups": matchKind(nameserverGroupKind, nbapi.NameserverGroup{Groups: []string{"g1"}}, nbapi.NameserverGroup{Groups: []string{"g2"}}),
+		"peers": matchKind(peerKind,
+			nbapi.Peer{Groups: []nbapi.GroupMinimum{{Id: "g1"}}}, nbapi.Peer{Groups: []nbapi.GroupMinimum{{Id: "g2"}}}),
+		"policies": matchKind(policyKind,
+			nbapi.Policy{Rules: []nbapi.PolicyRule{{Destinations: &[]nbapi.GroupMinimum{{Id: "g1"}}}}},
+			nbapi.Policy{Rules: []nbapi.PolicyRule{{Sources: &[]nbapi.GroupMinimum{{Id: "g2"}}}}}),
+		"reverseProxyServices": matchKind(reverseProxyServiceKind, nbapi.Service{AccessGroups: groups("g1")}, nbapi.Service{}),
+		"dnsZones":             matchKind(dnsZoneKind, nbapi.Zone{DistributionGroups: []string{"g1"}}, nbapi.Zone{}),
+		"networkResources": matchKind(networkResourceKind,
+			nbapi.NetworkResource{Groups: []nbapi.GroupMinimum{{Id: "g1"}}}, nbapi.NetworkResource{}),
+		"networkRouters": matchKind(networkRouterKind, nbapi.NetworkRouter{PeerGroups: groups("g1")}, nbapi.NetworkRouter{}),
+		"routes":         matchKind(routeKind, nbapi.Route{AccessControlGroups: groups("g1")}, nbapi.Route{Groups: []string{"g2"}}),
+		"setupKeys":      matchKind(setupKeyKind, nbapi.SetupKey{AutoGroups: []string{"g1"}}, nbapi.SetupKey{}),
+		"users":          matchKind(userKind, nbapi.User{AutoGroups: []string{"g1"}}, nbapi.User{}),
+	})
+}
+
+func TestListKindEnabledFilter(t *testing.T) {
+	t.Parallel()
+
+	runKindMatchers(t, ListArgs{NameRegex: nil, GroupID: nil, Enabled: ptr(true)}, map[string]kindMatcher{
+		"nameserverGroups":     matchKind(nameserverGroupKind, nbapi.NameserverGroup{Enabled: true}, nbapi.NameserverGroup{}),
+		"policies":             matchKind(policyKind, nbapi.Policy{Enabled: true}, nbapi.Policy{}),
+		"reverseProxyServices": matchKind(reverseProxyServiceKind, nbapi.Service{Enabled: true}, nbapi.Service{}),
+		"dnsZones":             matchKind(dnsZoneKind, nbapi.Zone{Enabled: true}, nbapi.Zone{}),
+		"networkResources":     matchKind(networkResourceKind, nbapi.NetworkResource{Enabled: true}, nbapi.NetworkResource{}),
+		"networkRouters":       matchKind(networkRouterKind, nbapi.NetworkRouter{Enabled: true}, nbapi.NetworkRouter{}),
+		"routes":               matchKind(routeKind, nbapi.Route{Enabled: true}, nbapi.Route{}),
+		// A setup key is enabled until revoked, even once it has expired.
+		"setupKeys": matchKind(setupKeyKind, nbapi.SetupKey{Valid: false, Revoked: false}, nbapi.SetupKey{Valid: true, Revoked: true}),
+		"users":     matchKind(userKind, nbapi.User{IsBlocked: false}, nbapi.User{IsBlocked: true}),
+	})
+}
+
+func TestListKindUnsupportedFilters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		matcher kindMatcher
+		args    ListArgs
+		want    string
+	}{
+		{"groups by group", matchKind(groupKind, nbapi.Group{}, nbapi.Group{}), ListArgs{GroupID: ptr("g1")}, "getGroups does not support the groupId filter"},
+		{"peers by enabled", matchKind(peerKind, nbapi.Peer{}, nbapi.Peer{}), ListArgs{Enabled: ptr(true)}, "getPeers does not support the enabled filter"},
+		{"routers by name", matchKind(networkRouterKind, nbapi.NetworkRouter{}, nbapi.NetworkRouter{}), ListArgs{NameRegex: ptr("edge")}, "getNetworkRouters does not support the nameRegex filter"},
+		{"tokens by enabled", matchKind(tokenKind, nbapi.PersonalAccessToken{}, nbapi.PersonalAccessToken{}), ListArgs{Enabled: ptr(false)}, "getTokens does not support the enabled filter"},
+		{"invalid regex", matchKind(routeKind, nbapi.Route{}, nbapi.Route{}), ListArgs{NameRegex: ptr("(")}, "invalid nameRegex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := tt.matcher(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
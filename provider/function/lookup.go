@@ -0,0 +1,33 @@
+package function
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lookupOne returns the single item for which matches reports true. what
+// describes the lookup key in errors, e.g. `group "devops"`. When several
+// items match, the error lists their IDs instead of picking one arbitrarily.
+func lookupOne[T any](items []T, what string, matches func(*T) bool, id func(*T) string) (*T, error) {
+	var found []*T
+
+	for i := range items {
+		if matches(&items[i]) {
+			found = append(found, &items[i])
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%s not found", what)
+	case 1:
+		return found[0], nil
+	default:
+		ids := make([]string, len(found))
+		for i, item := range found {
+			ids[i] = id(item)
+		}
+
+		return nil, fmt.Errorf("%s is ambiguous: %d matches (IDs %s)", what, len(found), strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,72 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LookupDNSZone looks up an existing NetBird DNS zone by domain.
+type LookupDNSZone struct{}
+
+// Annotate describes the function.
+func (f *LookupDNSZone) Annotate(a infer.Annotator) {
+	a.Describe(f, "Look up an existing NetBird DNS zone by domain and return it in full. "+
+		"Fails when no DNS zone or more than one DNS zone matches.")
+}
+
+// LookupDNSZoneArgs are the inputs for LookupDNSZone.
+type LookupDNSZoneArgs struct {
+	Domain string `pulumi:"domain"`
+}
+
+// Annotate provides field descriptions for LookupDNSZoneArgs.
+func (a *LookupDNSZoneArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Domain, "The domain of the DNS zone to look up.")
+}
+
+// LookupDNSZoneResult is the output of LookupDNSZone.
+type LookupDNSZoneResult struct {
+	resource.DNSZoneState
+
+	ID string `pulumi:"zoneId"`
+}
+
+// Annotate provides field descriptions for LookupDNSZoneResult.
+func (r *LookupDNSZoneResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.ID, "The DNS zone ID.")
+}
+
+// Invoke looks up a DNS zone by domain.
+func (f *LookupDNSZone) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[LookupDNSZoneArgs],
+) (infer.FunctionResponse[LookupDNSZoneResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupDNSZoneResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := client.DNSZones.ListZones(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupDNSZoneResult]{}, fmt.Errorf("listing DNS zones failed: %w", err)
+	}
+
+	item, err := lookupOne(items, fmt.Sprintf("DNS zone with domain %q", req.Input.Domain),
+		func(item *nbapi.Zone) bool { return item.Domain == req.Input.Domain },
+		func(item *nbapi.Zone) string { return item.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupDNSZoneResult]{}, err
+	}
+
+	return infer.FunctionResponse[LookupDNSZoneResult]{
+		Output: LookupDNSZoneResult{
+			DNSZoneState: resource.DNSZoneStateFromAPI(ctx, item),
+			ID:           item.Id,
+		},
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/mbrav/pulumi-netbird/provider/config"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -70,35 +71,34 @@ func (f *LookupGroup) Invoke(ctx context.Context, req infer.FunctionRequest[Look
 		return infer.FunctionResponse[LookupGroupResult]{}, fmt.Errorf("listing groups failed: %w", err)
 	}
 
-	for _, group := range groups {
-		if group.Name != req.Input.Name {
-			continue
-		}
+	group, err := lookupOne(groups, fmt.Sprintf("group %q", req.Input.Name),
+		func(group *nbapi.Group) bool { return group.Name == req.Input.Name },
+		func(group *nbapi.Group) string { return group.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupGroupResult]{}, err
+	}
 
-		peers := make([]string, len(group.Peers))
-		for i, p := range group.Peers {
-			peers[i] = p.Id
-		}
+	peers := make([]string, len(group.Peers))
+	for i, p := range group.Peers {
+		peers[i] = p.Id
+	}
 
-		resources := make([]ResourceRef, len(group.Resources))
-		for i, r := range group.Resources {
-			resources[i] = ResourceRef{
-				ID:   r.Id,
-				Type: string(r.Type),
-			}
+	resources := make([]ResourceRef, len(group.Resources))
+	for i, r := range group.Resources {
+		resources[i] = ResourceRef{
+			ID:   r.Id,
+			Type: string(r.Type),
 		}
-
-		return infer.FunctionResponse[LookupGroupResult]{
-			Output: LookupGroupResult{
-				ID:             group.Id,
-				Name:           group.Name,
-				PeersCount:     group.PeersCount,
-				ResourcesCount: group.ResourcesCount,
-				Peers:          peers,
-				Resources:      resources,
-			},
-		}, nil
 	}
 
-	return infer.FunctionResponse[LookupGroupResult]{}, fmt.Errorf("group %q not found", req.Input.Name)
+	return infer.FunctionResponse[LookupGroupResult]{
+		Output: LookupGroupResult{
+			ID:             group.Id,
+			Name:           group.Name,
+			PeersCount:     group.PeersCount,
+			ResourcesCount: group.ResourcesCount,
+			Peers:          peers,
+			Resources:      resources,
+		},
+	}, nil
 }
@@ -0,0 +1,72 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LookupIdentityProvider looks up an existing NetBird identity provider by name.
+type LookupIdentityProvider struct{}
+
+// Annotate describes the function.
+func (f *LookupIdentityProvider) Annotate(a infer.Annotator) {
+	a.Describe(f, "Look up an existing NetBird identity provider by name and return it in full. "+
+		"Fails when no identity provider or more than one identity provider matches.")
+}
+
+// LookupIdentityProviderArgs are the inputs for LookupIdentityProvider.
+type LookupIdentityProviderArgs struct {
+	Name string `pulumi:"name"`
+}
+
+// Annotate provides field descriptions for LookupIdentityProviderArgs.
+func (a *LookupIdentityProviderArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Name, "The name of the identity provider to look up.")
+}
+
+// LookupIdentityProviderResult is the output of LookupIdentityProvider.
+type LookupIdentityProviderResult struct {
+	resource.IdentityProviderState
+
+	ID string `pulumi:"identityProviderId"`
+}
+
+// Annotate provides field descriptions for LookupIdentityProviderResult.
+func (r *LookupIdentityProviderResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.ID, "The identity provider ID.")
+}
+
+// Invoke looks up a identity provider by name.
+func (f *LookupIdentityProvider) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[LookupIdentityProviderArgs],
+) (infer.FunctionResponse[LookupIdentityProviderResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupIdentityProviderResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := client.IdentityProviders.List(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupIdentityProviderResult]{}, fmt.Errorf("listing identity providers failed: %w", err)
+	}
+
+	item, err := lookupOne(items, fmt.Sprintf("identity provider %q", req.Input.Name),
+		func(item *nbapi.IdentityProvider) bool { return item.Name == req.Input.Name },
+		func(item *nbapi.IdentityProvider) string { return derefString(item.Id) })
+	if err != nil {
+		return infer.FunctionResponse[LookupIdentityProviderResult]{}, err
+	}
+
+	return infer.FunctionResponse[LookupIdentityProviderResult]{
+		Output: LookupIdentityProviderResult{
+			IdentityProviderState: resource.IdentityProviderStateFromAPI(ctx, item),
+			ID:                    derefString(item.Id),
+		},
+	}, nil
+}
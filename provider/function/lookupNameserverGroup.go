@@ -0,0 +1,72 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LookupNameserverGroup looks up an existing NetBird nameserver group by name.
+type LookupNameserverGroup struct{}
+
+// Annotate describes the function.
+func (f *LookupNameserverGroup) Annotate(a infer.Annotator) {
+	a.Describe(f, "Look up an existing NetBird nameserver group by name and return it in full. "+
+		"Fails when no nameserver group or more than one nameserver group matches.")
+}
+
+// LookupNameserverGroupArgs are the inputs for LookupNameserverGroup.
+type LookupNameserverGroupArgs struct {
+	Name string `pulumi:"name"`
+}
+
+// Annotate provides field descriptions for LookupNameserverGroupArgs.
+func (a *LookupNameserverGroupArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Name, "The name of the nameserver group to look up.")
+}
+
+// LookupNameserverGroupResult is the output of LookupNameserverGroup.
+type LookupNameserverGroupResult struct {
+	resource.DNSState
+
+	ID string `pulumi:"nameserverGroupId"`
+}
+
+// Annotate provides field descriptions for LookupNameserverGroupResult.
+func (r *LookupNameserverGroupResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.ID, "The nameserver group ID.")
+}
+
+// Invoke looks up a nameserver group by name.
+func (f *LookupNameserverGroup) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[LookupNameserverGroupArgs],
+) (infer.FunctionResponse[LookupNameserverGroupResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupNameserverGroupResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := client.DNS.ListNameserverGroups(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupNameserverGroupResult]{}, fmt.Errorf("listing nameserver groups failed: %w", err)
+	}
+
+	item, err := lookupOne(items, fmt.Sprintf("nameserver group %q", req.Input.Name),
+		func(item *nbapi.NameserverGroup) bool { return item.Name == req.Input.Name },
+		func(item *nbapi.NameserverGroup) string { return item.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupNameserverGroupResult]{}, err
+	}
+
+	return infer.FunctionResponse[LookupNameserverGroupResult]{
+		Output: LookupNameserverGroupResult{
+			DNSState: resource.DNSStateFromAPI(ctx, item),
+			ID:       item.Id,
+		},
+	}, nil
+}
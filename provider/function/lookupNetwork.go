@@ -0,0 +1,72 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LookupNetwork looks up an existing NetBird network by name.
+type LookupNetwork struct{}
+
+// Annotate describes the function.
+func (f *LookupNetwork) Annotate(a infer.Annotator) {
+	a.Describe(f, "Look up an existing NetBird network by name and return it in full. "+
+		"Fails when no network or more than one network matches.")
+}
+
+// LookupNetworkArgs are the inputs for LookupNetwork.
+type LookupNetworkArgs struct {
+	Name string `pulumi:"name"`
+}
+
+// Annotate provides field descriptions for LookupNetworkArgs.
+func (a *LookupNetworkArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Name, "The name of the network to look up.")
+}
+
+// LookupNetworkResult is the output of LookupNetwork.
+type LookupNetworkResult struct {
+	resource.NetworkState
+
+	ID string `pulumi:"networkId"`
+}
+
+// Annotate provides field descriptions for LookupNetworkResult.
+func (r *LookupNetworkResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.ID, "The network ID.")
+}
+
+// Invoke looks up a network by name.
+func (f *LookupNetwork) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[LookupNetworkArgs],
+) (infer.FunctionResponse[LookupNetworkResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupNetworkResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := client.Networks.List(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupNetworkResult]{}, fmt.Errorf("listing networks failed: %w", err)
+	}
+
+	item, err := lookupOne(items, fmt.Sprintf("network %q", req.Input.Name),
+		func(item *nbapi.Network) bool { return item.Name == req.Input.Name },
+		func(item *nbapi.Network) string { return item.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupNetworkResult]{}, err
+	}
+
+	return infer.FunctionResponse[LookupNetworkResult]{
+		Output: LookupNetworkResult{
+			NetworkState: resource.NetworkStateFromAPI(ctx, item),
+			ID:           item.Id,
+		},
+	}, nil
+}
@@ -0,0 +1,116 @@
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LookupNetworkResource looks up an existing NetBird network resource by name or address.
+type LookupNetworkResource struct{}
+
+// Annotate describes the function.
+func (f *LookupNetworkResource) Annotate(a infer.Annotator) {
+	a.Describe(f, "Look up an existing NetBird network resource within a network by name, address, or both, "+
+		"and return it in full. Fails when no resource or more than one resource matches.")
+}
+
+// LookupNetworkResourceArgs are the inputs for LookupNetworkResource.
+type LookupNetworkResourceArgs struct {
+	NetworkID string  `pulumi:"networkId"`
+	Name      *string `pulumi:"name,optional"`
+	Address   *string `pulumi:"address,optional"`
+}
+
+// Annotate provides field descriptions for LookupNetworkResourceArgs.
+func (a *LookupNetworkResourceArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.NetworkID, "The ID of the network the resource belongs to.")
+	ann.Describe(&a.Name, "The name of the resource to look up. At least one of name or address is required.")
+	ann.Describe(&a.Address, "The address (CIDR, IP, or domain) of the resource to look up. At least one of name or address is required.")
+}
+
+// LookupNetworkResourceResult is the output of LookupNetworkResource. It
+// mirrors NetworkResourceState field by field rather than embedding it, so
+// the network ID output is networkId like the input instead of the
+// resource's networkID.
+type LookupNetworkResourceResult struct {
+	resource.AccountScope
+
+	ID          string   `pulumi:"resourceId"`
+	Name        string   `pulumi:"name"`
+	Description *string  `pulumi:"description,optional"`
+	NetworkID   string   `pulumi:"networkId"`
+	Address     string   `pulumi:"address"`
+	Enabled     bool     `pulumi:"enabled"`
+	GroupIDs    []string `pulumi:"groupIDs"`
+}
+
+// Annotate provides field descriptions for LookupNetworkResourceResult.
+func (r *LookupNetworkResourceResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.ID, "The network resource ID.")
+	ann.Describe(&r.Name, "Name of the network resource.")
+	ann.Describe(&r.Description, "Optional description of the resource.")
+	ann.Describe(&r.NetworkID, "ID of the network the resource belongs to.")
+	ann.Describe(&r.Address, "CIDR or IP address block assigned to the resource.")
+	ann.Describe(&r.Enabled, "Whether the resource is enabled.")
+	ann.Describe(&r.GroupIDs, "List of group IDs associated with this resource.")
+}
+
+// Invoke looks up a network resource by name and/or address.
+func (f *LookupNetworkResource) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[LookupNetworkResourceArgs],
+) (infer.FunctionResponse[LookupNetworkResourceResult], error) {
+	if req.Input.Name == nil && req.Input.Address == nil {
+		return infer.FunctionResponse[LookupNetworkResourceResult]{}, errors.New("one of name or address must be set")
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupNetworkResourceResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := client.Networks.Resources(req.Input.NetworkID).List(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupNetworkResourceResult]{}, fmt.Errorf("listing network resources failed: %w", err)
+	}
+
+	what := fmt.Sprintf("network resource in network %q", req.Input.NetworkID)
+	if req.Input.Name != nil {
+		what += fmt.Sprintf(" with name %q", *req.Input.Name)
+	}
+
+	if req.Input.Address != nil {
+		what += fmt.Sprintf(" with address %q", *req.Input.Address)
+	}
+
+	item, err := lookupOne(items, what,
+		func(item *nbapi.NetworkResource) bool {
+			return (req.Input.Name == nil || item.Name == *req.Input.Name) &&
+				(req.Input.Address == nil || item.Address == *req.Input.Address)
+		},
+		func(item *nbapi.NetworkResource) string { return item.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupNetworkResourceResult]{}, err
+	}
+
+	state := resource.NetworkResourceStateFromAPI(ctx, req.Input.NetworkID, item)
+
+	return infer.FunctionResponse[LookupNetworkResourceResult]{
+		Output: LookupNetworkResourceResult{
+			AccountScope: state.AccountScope,
+			ID:           item.Id,
+			Name:         state.Name,
+			Description:  state.Description,
+			NetworkID:    state.NetworkID,
+			Address:      state.Address,
+			Enabled:      state.Enabled,
+			GroupIDs:     state.GroupIDs,
+		},
+	}, nil
+}
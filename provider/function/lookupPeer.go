@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/mbrav/pulumi-netbird/provider/config"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -62,29 +63,28 @@ func (f *LookupPeer) Invoke(ctx context.Context, req infer.FunctionRequest[Looku
 		return infer.FunctionResponse[LookupPeerResult]{}, fmt.Errorf("listing peers failed: %w", err)
 	}
 
-	for _, peer := range peers {
-		if peer.Name != req.Input.Name {
-			continue
-		}
-
-		groups := make([]string, len(peer.Groups))
-		for i, g := range peer.Groups {
-			groups[i] = g.Id
-		}
+	peer, err := lookupOne(peers, fmt.Sprintf("peer %q", req.Input.Name),
+		func(peer *nbapi.Peer) bool { return peer.Name == req.Input.Name },
+		func(peer *nbapi.Peer) string { return peer.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupPeerResult]{}, err
+	}
 
-		return infer.FunctionResponse[LookupPeerResult]{
-			Output: LookupPeerResult{
-				ID:        peer.Id,
-				Name:      peer.Name,
-				IP:        peer.Ip,
-				DNSLabel:  peer.DnsLabel,
-				Connected: peer.Connected,
-				Hostname:  peer.Hostname,
-				OS:        peer.Os,
-				Groups:    groups,
-			},
-		}, nil
+	groups := make([]string, len(peer.Groups))
+	for i, g := range peer.Groups {
+		groups[i] = g.Id
 	}
 
-	return infer.FunctionResponse[LookupPeerResult]{}, fmt.Errorf("peer %q not found", req.Input.Name)
+	return infer.FunctionResponse[LookupPeerResult]{
+		Output: LookupPeerResult{
+			ID:        peer.Id,
+			Name:      peer.Name,
+			IP:        peer.Ip,
+			DNSLabel:  peer.DnsLabel,
+			Connected: peer.Connected,
+			Hostname:  peer.Hostname,
+			OS:        peer.Os,
+			Groups:    groups,
+		},
+	}, nil
 }
@@ -0,0 +1,72 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LookupPolicy looks up an existing NetBird policy by name.
+type LookupPolicy struct{}
+
+// Annotate describes the function.
+func (f *LookupPolicy) Annotate(a infer.Annotator) {
+	a.Describe(f, "Look up an existing NetBird policy by name and return it in full. "+
+		"Fails when no policy or more than one policy matches.")
+}
+
+// LookupPolicyArgs are the inputs for LookupPolicy.
+type LookupPolicyArgs struct {
+	Name string `pulumi:"name"`
+}
+
+// Annotate provides field descriptions for LookupPolicyArgs.
+func (a *LookupPolicyArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Name, "The name of the policy to look up.")
+}
+
+// LookupPolicyResult is the output of LookupPolicy.
+type LookupPolicyResult struct {
+	resource.PolicyState
+
+	ID string `pulumi:"policyId"`
+}
+
+// Annotate provides field descriptions for LookupPolicyResult.
+func (r *LookupPolicyResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.ID, "The policy ID.")
+}
+
+// Invoke looks up a policy by name.
+func (f *LookupPolicy) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[LookupPolicyArgs],
+) (infer.FunctionResponse[LookupPolicyResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupPolicyResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := client.Policies.List(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupPolicyResult]{}, fmt.Errorf("listing policies failed: %w", err)
+	}
+
+	item, err := lookupOne(items, fmt.Sprintf("policy %q", req.Input.Name),
+		func(item *nbapi.Policy) bool { return item.Name == req.Input.Name },
+		func(item *nbapi.Policy) string { return derefString(item.Id) })
+	if err != nil {
+		return infer.FunctionResponse[LookupPolicyResult]{}, err
+	}
+
+	return infer.FunctionResponse[LookupPolicyResult]{
+		Output: LookupPolicyResult{
+			PolicyState: resource.PolicyStateFromAPI(ctx, item),
+			ID:          derefString(item.Id),
+		},
+	}, nil
+}
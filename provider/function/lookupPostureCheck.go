@@ -0,0 +1,72 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LookupPostureCheck looks up an existing NetBird posture check by name.
+type LookupPostureCheck struct{}
+
+// Annotate describes the function.
+func (f *LookupPostureCheck) Annotate(a infer.Annotator) {
+	a.Describe(f, "Look up an existing NetBird posture check by name and return it in full. "+
+		"Fails when no posture check or more than one posture check matches.")
+}
+
+// LookupPostureCheckArgs are the inputs for LookupPostureCheck.
+type LookupPostureCheckArgs struct {
+	Name string `pulumi:"name"`
+}
+
+// Annotate provides field descriptions for LookupPostureCheckArgs.
+func (a *LookupPostureCheckArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Name, "The name of the posture check to look up.")
+}
+
+// LookupPostureCheckResult is the output of LookupPostureCheck.
+type LookupPostureCheckResult struct {
+	resource.PostureCheckState
+
+	ID string `pulumi:"postureCheckId"`
+}
+
+// Annotate provides field descriptions for LookupPostureCheckResult.
+func (r *LookupPostureCheckResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.ID, "The posture check ID.")
+}
+
+// Invoke looks up a posture check by name.
+func (f *LookupPostureCheck) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[LookupPostureCheckArgs],
+) (infer.FunctionResponse[LookupPostureCheckResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupPostureCheckResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := client.PostureChecks.List(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupPostureCheckResult]{}, fmt.Errorf("listing posture checks failed: %w", err)
+	}
+
+	item, err := lookupOne(items, fmt.Sprintf("posture check %q", req.Input.Name),
+		func(item *nbapi.PostureCheck) bool { return item.Name == req.Input.Name },
+		func(item *nbapi.PostureCheck) string { return item.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupPostureCheckResult]{}, err
+	}
+
+	return infer.FunctionResponse[LookupPostureCheckResult]{
+		Output: LookupPostureCheckResult{
+			PostureCheckState: resource.PostureCheckStateFromAPI(ctx, item),
+			ID:                item.Id,
+		},
+	}, nil
+}
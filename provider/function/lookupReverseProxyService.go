@@ -0,0 +1,72 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/provider/resource"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// LookupReverseProxyService looks up an existing NetBird reverse proxy service by domain.
+type LookupReverseProxyService struct{}
+
+// Annotate describes the function.
+func (f *LookupReverseProxyService) Annotate(a infer.Annotator) {
+	a.Describe(f, "Look up an existing NetBird reverse proxy service by domain and return it in full. "+
+		"Fails when no reverse proxy service or more than one reverse proxy service matches.")
+}
+
+// LookupReverseProxyServiceArgs are the inputs for LookupReverseProxyService.
+type LookupReverseProxyServiceArgs struct {
+	Domain string `pulumi:"domain"`
+}
+
+// Annotate provides field descriptions for LookupReverseProxyServiceArgs.
+func (a *LookupReverseProxyServiceArgs) Annotate(ann infer.Annotator) {
+	ann.Describe(&a.Domain, "The domain of the reverse proxy service to look up.")
+}
+
+// LookupReverseProxyServiceResult is the output of LookupReverseProxyService.
+type LookupReverseProxyServiceResult struct {
+	resource.ReverseProxyServiceState
+
+	ID string `pulumi:"serviceId"`
+}
+
+// Annotate provides field descriptions for LookupReverseProxyServiceResult.
+func (r *LookupReverseProxyServiceResult) Annotate(ann infer.Annotator) {
+	ann.Describe(&r.ID, "The reverse proxy service ID.")
+}
+
+// Invoke looks up a reverse proxy service by domain.
+func (f *LookupReverseProxyService) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[LookupReverseProxyServiceArgs],
+) (infer.FunctionResponse[LookupReverseProxyServiceResult], error) {
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupReverseProxyServiceResult]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	items, err := client.ReverseProxyServices.List(ctx)
+	if err != nil {
+		return infer.FunctionResponse[LookupReverseProxyServiceResult]{}, fmt.Errorf("listing reverse proxy services failed: %w", err)
+	}
+
+	item, err := lookupOne(items, fmt.Sprintf("reverse proxy service with domain %q", req.Input.Domain),
+		func(item *nbapi.Service) bool { return item.Domain == req.Input.Domain },
+		func(item *nbapi.Service) string { return item.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupReverseProxyServiceResult]{}, err
+	}
+
+	return infer.FunctionResponse[LookupReverseProxyServiceResult]{
+		Output: LookupReverseProxyServiceResult{
+			ReverseProxyServiceState: resource.ReverseProxyServiceStateFromAPI(ctx, item),
+			ID:                       item.Id,
+		},
+	}, nil
+}
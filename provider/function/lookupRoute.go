@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/mbrav/pulumi-netbird/provider/config"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -28,16 +29,16 @@ func (a *LookupRouteArgs) Annotate(ann infer.Annotator) {
 
 // LookupRouteResult is the output of LookupRoute.
 type LookupRouteResult struct {
-	ID          string    `pulumi:"routeId"`
-	Description string    `pulumi:"description"`
-	Network     string    `pulumi:"network"`
-	Domains     []string  `pulumi:"domains"`
-	Enabled     bool      `pulumi:"enabled"`
-	Masquerade  bool      `pulumi:"masquerade"`
-	Metric      int       `pulumi:"metric"`
-	Peer        *string   `pulumi:"peer,optional"`
-	PeerGroups  []string  `pulumi:"peerGroups"`
-	Groups      []string  `pulumi:"groups"`
+	ID          string   `pulumi:"routeId"`
+	Description string   `pulumi:"description"`
+	Network     string   `pulumi:"network"`
+	Domains     []string `pulumi:"domains"`
+	Enabled     bool     `pulumi:"enabled"`
+	Masquerade  bool     `pulumi:"masquerade"`
+	Metric      int      `pulumi:"metric"`
+	Peer        *string  `pulumi:"peer,optional"`
+	PeerGroups  []string `pulumi:"peerGroups"`
+	Groups      []string `pulumi:"groups"`
 }
 
 // Annotate provides field descriptions for LookupRouteResult.
@@ -66,36 +67,35 @@ func (f *LookupRoute) Invoke(ctx context.Context, req infer.FunctionRequest[Look
 		return infer.FunctionResponse[LookupRouteResult]{}, fmt.Errorf("listing routes failed: %w", err)
 	}
 
-	for _, route := range routes {
-		if route.Network == nil || *route.Network != req.Input.Network {
-			continue
-		}
-
-		domains := []string{}
-		if route.Domains != nil {
-			domains = *route.Domains
-		}
+	route, err := lookupOne(routes, fmt.Sprintf("route with network %q", req.Input.Network),
+		func(route *nbapi.Route) bool { return route.Network != nil && *route.Network == req.Input.Network },
+		func(route *nbapi.Route) string { return route.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupRouteResult]{}, err
+	}
 
-		peerGroups := []string{}
-		if route.PeerGroups != nil {
-			peerGroups = *route.PeerGroups
-		}
+	domains := []string{}
+	if route.Domains != nil {
+		domains = *route.Domains
+	}
 
-		return infer.FunctionResponse[LookupRouteResult]{
-			Output: LookupRouteResult{
-				ID:          route.Id,
-				Description: route.Description,
-				Network:     *route.Network,
-				Domains:     domains,
-				Enabled:     route.Enabled,
-				Masquerade:  route.Masquerade,
-				Metric:      route.Metric,
-				Peer:        route.Peer,
-				PeerGroups:  peerGroups,
-				Groups:      route.Groups,
-			},
-		}, nil
+	peerGroups := []string{}
+	if route.PeerGroups != nil {
+		peerGroups = *route.PeerGroups
 	}
 
-	return infer.FunctionResponse[LookupRouteResult]{}, fmt.Errorf("route with network %q not found", req.Input.Network)
+	return infer.FunctionResponse[LookupRouteResult]{
+		Output: LookupRouteResult{
+			ID:          route.Id,
+			Description: route.Description,
+			Network:     *route.Network,
+			Domains:     domains,
+			Enabled:     route.Enabled,
+			Masquerade:  route.Masquerade,
+			Metric:      route.Metric,
+			Peer:        route.Peer,
+			PeerGroups:  peerGroups,
+			Groups:      route.Groups,
+		},
+	}, nil
 }
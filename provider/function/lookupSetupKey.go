@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/mbrav/pulumi-netbird/provider/config"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -29,16 +30,16 @@ func (a *LookupSetupKeyArgs) Annotate(ann infer.Annotator) {
 
 // LookupSetupKeyResult is the output of LookupSetupKey.
 type LookupSetupKeyResult struct {
-	ID           string   `pulumi:"setupKeyId"`
-	Name         string   `pulumi:"name"`
-	Type         string   `pulumi:"type"`
-	State        string   `pulumi:"state"`
-	Revoked      bool     `pulumi:"revoked"`
-	Ephemeral    bool     `pulumi:"ephemeral"`
-	UsageLimit   int      `pulumi:"usageLimit"`
-	AutoGroups   []string `pulumi:"autoGroups"`
-	Expires      string   `pulumi:"expires"`
-	LastUsed     string   `pulumi:"lastUsed"`
+	ID         string   `pulumi:"setupKeyId"`
+	Name       string   `pulumi:"name"`
+	Type       string   `pulumi:"type"`
+	State      string   `pulumi:"state"`
+	Revoked    bool     `pulumi:"revoked"`
+	Ephemeral  bool     `pulumi:"ephemeral"`
+	UsageLimit int      `pulumi:"usageLimit"`
+	AutoGroups []string `pulumi:"autoGroups"`
+	Expires    string   `pulumi:"expires"`
+	LastUsed   string   `pulumi:"lastUsed"`
 }
 
 // Annotate provides field descriptions for LookupSetupKeyResult.
@@ -67,29 +68,28 @@ func (f *LookupSetupKey) Invoke(ctx context.Context, req infer.FunctionRequest[L
 		return infer.FunctionResponse[LookupSetupKeyResult]{}, fmt.Errorf("listing setup keys failed: %w", err)
 	}
 
-	for _, key := range keys {
-		if key.Name != req.Input.Name {
-			continue
-		}
-
-		autoGroups := slices.Clone(key.AutoGroups)
-		slices.Sort(autoGroups)
-
-		return infer.FunctionResponse[LookupSetupKeyResult]{
-			Output: LookupSetupKeyResult{
-				ID:         key.Id,
-				Name:       key.Name,
-				Type:       key.Type,
-				State:      key.State,
-				Revoked:    key.Revoked,
-				Ephemeral:  key.Ephemeral,
-				UsageLimit: key.UsageLimit,
-				AutoGroups: autoGroups,
-				Expires:    key.Expires.Format("2006-01-02T15:04:05Z07:00"),
-				LastUsed:   key.LastUsed.Format("2006-01-02T15:04:05Z07:00"),
-			},
-		}, nil
+	key, err := lookupOne(keys, fmt.Sprintf("setup key %q", req.Input.Name),
+		func(key *nbapi.SetupKey) bool { return key.Name == req.Input.Name },
+		func(key *nbapi.SetupKey) string { return key.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupSetupKeyResult]{}, err
 	}
 
-	return infer.FunctionResponse[LookupSetupKeyResult]{}, fmt.Errorf("setup key %q not found", req.Input.Name)
+	autoGroups := slices.Clone(key.AutoGroups)
+	slices.Sort(autoGroups)
+
+	return infer.FunctionResponse[LookupSetupKeyResult]{
+		Output: LookupSetupKeyResult{
+			ID:         key.Id,
+			Name:       key.Name,
+			Type:       key.Type,
+			State:      key.State,
+			Revoked:    key.Revoked,
+			Ephemeral:  key.Ephemeral,
+			UsageLimit: key.UsageLimit,
+			AutoGroups: autoGroups,
+			Expires:    key.Expires.Format("2006-01-02T15:04:05Z07:00"),
+			LastUsed:   key.LastUsed.Format("2006-01-02T15:04:05Z07:00"),
+		},
+	}, nil
 }
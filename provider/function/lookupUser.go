@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/mbrav/pulumi-netbird/provider/config"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -59,25 +60,24 @@ func (f *LookupUser) Invoke(ctx context.Context, req infer.FunctionRequest[Looku
 		return infer.FunctionResponse[LookupUserResult]{}, fmt.Errorf("listing users failed: %w", err)
 	}
 
-	for _, user := range users {
-		if user.Email != req.Input.Email {
-			continue
-		}
-
-		autoGroups := slices.Clone(user.AutoGroups)
-		slices.Sort(autoGroups)
-
-		return infer.FunctionResponse[LookupUserResult]{
-			Output: LookupUserResult{
-				ID:         user.Id,
-				Name:       user.Name,
-				Email:      user.Email,
-				Role:       user.Role,
-				IsBlocked:  user.IsBlocked,
-				AutoGroups: autoGroups,
-			},
-		}, nil
+	user, err := lookupOne(users, fmt.Sprintf("user with email %q", req.Input.Email),
+		func(user *nbapi.User) bool { return user.Email == req.Input.Email },
+		func(user *nbapi.User) string { return user.Id })
+	if err != nil {
+		return infer.FunctionResponse[LookupUserResult]{}, err
 	}
 
-	return infer.FunctionResponse[LookupUserResult]{}, fmt.Errorf("user with email %q not found", req.Input.Email)
+	autoGroups := slices.Clone(user.AutoGroups)
+	slices.Sort(autoGroups)
+
+	return infer.FunctionResponse[LookupUserResult]{
+		Output: LookupUserResult{
+			ID:         user.Id,
+			Name:       user.Name,
+			Email:      user.Email,
+			Role:       user.Role,
+			IsBlocked:  user.IsBlocked,
+			AutoGroups: autoGroups,
+		},
+	}, nil
 }
@@ -0,0 +1,62 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// AccountScope records the NetBird account a resource belongs to. It is
+// embedded in every resource state so that pointing a resource at another
+// account (via the provider's accountId or profile) plans a replacement.
+type AccountScope struct {
+	AccountID *string `pulumi:"accountId,optional"`
+}
+
+// Annotate provides documentation for AccountScope fields.
+func (s *AccountScope) Annotate(a infer.Annotator) {
+	a.Describe(&s.AccountID, "ID of the NetBird account the resource belongs to. "+
+		"Changing the provider's account forces a replacement.")
+}
+
+// currentAccountScope returns the account the configured provider operates on.
+// The account is unknown when validation is skipped without an explicit accountId.
+func currentAccountScope(ctx context.Context) AccountScope {
+	accountID, err := config.GetAccountID(ctx)
+	if err != nil || accountID == "" {
+		return AccountScope{AccountID: nil}
+	}
+
+	return AccountScope{AccountID: &accountID}
+}
+
+// diffAccountScope marks the resource for replacement when it was created in a
+// different account than the one the provider now targets. State written
+// before accounts were recorded, or an unknown current account, never forces
+// a replacement.
+func diffAccountScope(ctx context.Context, state AccountScope, diff map[string]p.PropertyDiff) {
+	current := currentAccountScope(ctx)
+	if state.AccountID == nil || current.AccountID == nil {
+		return
+	}
+
+	if *state.AccountID != *current.AccountID {
+		diff["accountId"] = p.PropertyDiff{
+			InputDiff: false,
+			Kind:      p.UpdateReplace,
+		}
+	}
+}
+
+// readAccountScope keeps the account recorded in state on refresh so that a
+// changed provider account still surfaces in Diff. Imports and state written
+// before accounts were recorded adopt the current account.
+func readAccountScope(ctx context.Context, state AccountScope) AccountScope {
+	if state.AccountID != nil {
+		return state
+	}
+
+	return currentAccountScope(ctx)
+}
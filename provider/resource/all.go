@@ -15,11 +15,13 @@ func All() []infer.InferredResource {
 		infer.Resource(&Group{}),
 		infer.Resource(&IdentityProvider{}),
 		infer.Resource(&IngressPeer{}),
+		infer.Resource(&IngressPortAllocation{}),
 		infer.Resource(&Network{}),
 		infer.Resource(&NetworkResource{}),
 		infer.Resource(&NetworkRouter{}),
 		infer.Resource(&OktaScimIDP{}),
 		infer.Resource(&Peer{}),
+		infer.Resource(&PeerApproval{}),
 		infer.Resource(&Policy{}),
 		infer.Resource(&PostureCheck{}),
 		infer.Resource(&ReverseProxyDomain{}),
@@ -29,5 +31,7 @@ func All() []infer.InferredResource {
 		infer.Resource(&SetupKey{}),
 		infer.Resource(&Token{}),
 		infer.Resource(&User{}),
+		infer.Resource(&UserGroupMembership{}),
+		infer.Resource(&UserInvite{}),
 	}
 }
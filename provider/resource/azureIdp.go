@@ -65,6 +65,8 @@ func (a *AzureIDPArgs) Annotate(ann infer.Annotator) {
 
 // AzureIDPState represents the output state of an Azure IdP integration.
 type AzureIDPState struct {
+	AccountScope
+
 	ClientID          string    `pulumi:"clientId"`
 	ClientSecret      string    `provider:"secret"                   pulumi:"clientSecret"`
 	TenantID          string    `pulumi:"tenantId"`
@@ -95,10 +97,13 @@ func (a *AzureIDPState) Annotate(ann infer.Annotator) {
 func (*AzureIDP) Create(ctx context.Context, req infer.CreateRequest[AzureIDPArgs]) (infer.CreateResponse[AzureIDPState], error) {
 	p.GetLogger(ctx).Debugf("Create:AzureIDP clientId=%s tenantId=%s", req.Inputs.ClientID, req.Inputs.TenantID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[AzureIDPState]{
 			ID:     "preview",
-			Output: azureIDPStateFromArgs(req.Inputs, nil),
+			Output: azureIDPStateFromArgs(ctx, req.Inputs, nil),
 		}, nil
 	}
 
@@ -123,7 +128,7 @@ func (*AzureIDP) Create(ctx context.Context, req infer.CreateRequest[AzureIDPArg
 
 	return infer.CreateResponse[AzureIDPState]{
 		ID:     strconv.FormatInt(created.Id, 10),
-		Output: azureIDPStateFromAPI(req.Inputs.ClientSecret, *created),
+		Output: azureIDPStateFromAPI(ctx, req.Inputs.ClientSecret, *created),
 	}, nil
 }
 
@@ -131,6 +136,9 @@ func (*AzureIDP) Create(ctx context.Context, req infer.CreateRequest[AzureIDPArg
 func (*AzureIDP) Read(ctx context.Context, req infer.ReadRequest[AzureIDPArgs, AzureIDPState]) (infer.ReadResponse[AzureIDPArgs, AzureIDPState], error) {
 	p.GetLogger(ctx).Debugf("Read:AzureIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[AzureIDPArgs, AzureIDPState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -149,6 +157,9 @@ func (*AzureIDP) Read(ctx context.Context, req infer.ReadRequest[AzureIDPArgs, A
 		return infer.ReadResponse[AzureIDPArgs, AzureIDPState]{}, fmt.Errorf("reading Azure IdP integration failed: %w", err)
 	}
 
+	state := azureIDPStateFromAPI(ctx, req.State.ClientSecret, *idp)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
+
 	return infer.ReadResponse[AzureIDPArgs, AzureIDPState]{
 		ID: req.ID,
 		Inputs: AzureIDPArgs{
@@ -162,7 +173,7 @@ func (*AzureIDP) Read(ctx context.Context, req infer.ReadRequest[AzureIDPArgs, A
 			UserGroupPrefixes: &idp.UserGroupPrefixes,
 			SyncInterval:      &idp.SyncInterval,
 		},
-		State: azureIDPStateFromAPI(req.State.ClientSecret, *idp),
+		State: state,
 	}, nil
 }
 
@@ -170,9 +181,12 @@ func (*AzureIDP) Read(ctx context.Context, req infer.ReadRequest[AzureIDPArgs, A
 func (*AzureIDP) Update(ctx context.Context, req infer.UpdateRequest[AzureIDPArgs, AzureIDPState]) (infer.UpdateResponse[AzureIDPState], error) {
 	p.GetLogger(ctx).Debugf("Update:AzureIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[AzureIDPState]{
-			Output: azureIDPStateFromArgs(req.Inputs, req.State.LastSyncedAt),
+			Output: azureIDPStateFromArgs(ctx, req.Inputs, req.State.LastSyncedAt),
 		}, nil
 	}
 
@@ -200,7 +214,7 @@ func (*AzureIDP) Update(ctx context.Context, req infer.UpdateRequest[AzureIDPArg
 	}
 
 	return infer.UpdateResponse[AzureIDPState]{
-		Output: azureIDPStateFromAPI(req.Inputs.ClientSecret, *updated),
+		Output: azureIDPStateFromAPI(ctx, req.Inputs.ClientSecret, *updated),
 	}, nil
 }
 
@@ -208,6 +222,9 @@ func (*AzureIDP) Update(ctx context.Context, req infer.UpdateRequest[AzureIDPArg
 func (*AzureIDP) Delete(ctx context.Context, req infer.DeleteRequest[AzureIDPState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:AzureIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -227,6 +244,8 @@ func (*AzureIDP) Diff(ctx context.Context, req infer.DiffRequest[AzureIDPArgs, A
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.ClientID != req.State.ClientID {
 		diff["clientId"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
 	}
@@ -320,8 +339,9 @@ func (*AzureIDP) WireDependencies(field infer.FieldSelector, args *AzureIDPArgs,
 	field.OutputField(&state.SyncInterval).DependsOn(field.InputField(&args.SyncInterval))
 }
 
-func azureIDPStateFromArgs(args AzureIDPArgs, lastSyncedAt *string) AzureIDPState {
+func azureIDPStateFromArgs(ctx context.Context, args AzureIDPArgs, lastSyncedAt *string) AzureIDPState {
 	return AzureIDPState{
+		AccountScope:      currentAccountScope(ctx),
 		ClientID:          args.ClientID,
 		ClientSecret:      args.ClientSecret,
 		TenantID:          args.TenantID,
@@ -335,10 +355,11 @@ func azureIDPStateFromArgs(args AzureIDPArgs, lastSyncedAt *string) AzureIDPStat
 	}
 }
 
-func azureIDPStateFromAPI(clientSecret string, idp nbapi.AzureIntegration) AzureIDPState {
+func azureIDPStateFromAPI(ctx context.Context, clientSecret string, idp nbapi.AzureIntegration) AzureIDPState {
 	lastSynced := idp.LastSyncedAt.Format(idpTimeFormat)
 
 	return AzureIDPState{
+		AccountScope:      currentAccountScope(ctx),
 		ClientID:          idp.ClientId,
 		ClientSecret:      clientSecret,
 		TenantID:          idp.TenantId,
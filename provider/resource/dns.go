@@ -45,6 +45,8 @@ func (n *DNSArgs) Annotate(annotator infer.Annotator) {
 
 // DNSState represents the output state of a network resource.
 type DNSState struct {
+	AccountScope
+
 	Name                 string       `pulumi:"name"`
 	Description          string       `pulumi:"description"`
 	Domains              []string     `pulumi:"domains"`
@@ -101,10 +103,14 @@ func (NameserverNsType) Values() []infer.EnumValue[NameserverNsType] {
 func (*DNS) Create(ctx context.Context, req infer.CreateRequest[DNSArgs]) (infer.CreateResponse[DNSState], error) {
 	p.GetLogger(ctx).Debugf("Create:DNS name=%s, description=%s", req.Inputs.Name, req.Inputs.Description)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[DNSState]{
 			ID: "preview",
 			Output: DNSState{
+				AccountScope:         currentAccountScope(ctx),
 				Name:                 req.Inputs.Name,
 				Description:          req.Inputs.Description,
 				Domains:              req.Inputs.Domains,
@@ -143,6 +149,7 @@ func (*DNS) Create(ctx context.Context, req infer.CreateRequest[DNSArgs]) (infer
 	return infer.CreateResponse[DNSState]{
 		ID: created.Id,
 		Output: DNSState{
+			AccountScope:         currentAccountScope(ctx),
 			Name:                 created.Name,
 			Description:          created.Description,
 			Domains:              created.Domains,
@@ -159,6 +166,9 @@ func (*DNS) Create(ctx context.Context, req infer.CreateRequest[DNSArgs]) (infer
 func (*DNS) Read(ctx context.Context, req infer.ReadRequest[DNSArgs, DNSState]) (infer.ReadResponse[DNSArgs, DNSState], error) {
 	p.GetLogger(ctx).Debugf("Read:DNS[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[DNSArgs, DNSState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -207,6 +217,7 @@ func (*DNS) Read(ctx context.Context, req infer.ReadRequest[DNSArgs, DNSState])
 			SearchDomainsEnabled: group.SearchDomainsEnabled,
 		},
 		State: DNSState{
+			AccountScope:         readAccountScope(ctx, req.State.AccountScope),
 			Name:                 group.Name,
 			Description:          group.Description,
 			Domains:              domains,
@@ -223,9 +234,13 @@ func (*DNS) Read(ctx context.Context, req infer.ReadRequest[DNSArgs, DNSState])
 func (*DNS) Update(ctx context.Context, req infer.UpdateRequest[DNSArgs, DNSState]) (infer.UpdateResponse[DNSState], error) {
 	p.GetLogger(ctx).Debugf("Update:DNS[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[DNSState]{
 			Output: DNSState{
+				AccountScope:         currentAccountScope(ctx),
 				Name:                 req.Inputs.Name,
 				Description:          req.Inputs.Description,
 				Domains:              req.Inputs.Domains,
@@ -259,6 +274,7 @@ func (*DNS) Update(ctx context.Context, req infer.UpdateRequest[DNSArgs, DNSStat
 
 	return infer.UpdateResponse[DNSState]{
 		Output: DNSState{
+			AccountScope:         currentAccountScope(ctx),
 			Name:                 updated.Name,
 			Description:          updated.Description,
 			Enabled:              updated.Enabled,
@@ -303,6 +319,9 @@ func fromAPINameservers(in []nbapi.Nameserver) []Nameserver {
 func (*DNS) Delete(ctx context.Context, req infer.DeleteRequest[DNSState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:DNS[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -322,6 +341,8 @@ func (*DNS) Diff(ctx context.Context, req infer.DiffRequest[DNSArgs, DNSState])
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{
 			InputDiff: false,
@@ -475,3 +496,24 @@ func (*DNS) WireDependencies(field infer.FieldSelector, args *DNSArgs, state *DN
 	field.OutputField(&state.Nameservers).DependsOn(field.InputField(&args.Nameservers))
 	field.OutputField(&state.SearchDomainsEnabled).DependsOn(field.InputField(&args.SearchDomainsEnabled))
 }
+
+// DNSStateFromAPI builds a DNSState from an API NameserverGroup response.
+func DNSStateFromAPI(ctx context.Context, group *nbapi.NameserverGroup) DNSState {
+	domains := slices.Clone(group.Domains)
+	slices.Sort(domains)
+
+	groups := slices.Clone(group.Groups)
+	slices.Sort(groups)
+
+	return DNSState{
+		AccountScope:         currentAccountScope(ctx),
+		Name:                 group.Name,
+		Description:          group.Description,
+		Domains:              domains,
+		Enabled:              group.Enabled,
+		Groups:               groups,
+		Primary:              group.Primary,
+		Nameservers:          fromAPINameservers(group.Nameservers),
+		SearchDomainsEnabled: group.SearchDomainsEnabled,
+	}
+}
@@ -38,6 +38,8 @@ func (d *DNSRecordArgs) Annotate(annotator infer.Annotator) {
 
 // DNSRecordState represents the output state of a DNS record resource.
 type DNSRecordState struct {
+	AccountScope
+
 	ZoneID  string        `pulumi:"zoneID"`
 	Name    string        `pulumi:"name"`
 	Content string        `pulumi:"content"`
@@ -80,15 +82,19 @@ func (DNSRecordType) Values() []infer.EnumValue[DNSRecordType] {
 func (*DNSRecord) Create(ctx context.Context, req infer.CreateRequest[DNSRecordArgs]) (infer.CreateResponse[DNSRecordState], error) {
 	p.GetLogger(ctx).Debugf("Create:DNSRecord name=%s, zone_id=%s", req.Inputs.Name, req.Inputs.ZoneID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[DNSRecordState]{
 			ID: "preview",
 			Output: DNSRecordState{
-				ZoneID:  req.Inputs.ZoneID,
-				Name:    req.Inputs.Name,
-				Content: req.Inputs.Content,
-				TTL:     req.Inputs.TTL,
-				Type:    req.Inputs.Type,
+				AccountScope: currentAccountScope(ctx),
+				ZoneID:       req.Inputs.ZoneID,
+				Name:         req.Inputs.Name,
+				Content:      req.Inputs.Content,
+				TTL:          req.Inputs.TTL,
+				Type:         req.Inputs.Type,
 			},
 		}, nil
 	}
@@ -111,11 +117,12 @@ func (*DNSRecord) Create(ctx context.Context, req infer.CreateRequest[DNSRecordA
 	return infer.CreateResponse[DNSRecordState]{
 		ID: record.Id,
 		Output: DNSRecordState{
-			ZoneID:  req.Inputs.ZoneID,
-			Name:    record.Name,
-			Content: record.Content,
-			TTL:     record.Ttl,
-			Type:    DNSRecordType(record.Type),
+			AccountScope: currentAccountScope(ctx),
+			ZoneID:       req.Inputs.ZoneID,
+			Name:         record.Name,
+			Content:      record.Content,
+			TTL:          record.Ttl,
+			Type:         DNSRecordType(record.Type),
 		},
 	}, nil
 }
@@ -124,6 +131,9 @@ func (*DNSRecord) Create(ctx context.Context, req infer.CreateRequest[DNSRecordA
 func (*DNSRecord) Read(ctx context.Context, req infer.ReadRequest[DNSRecordArgs, DNSRecordState]) (infer.ReadResponse[DNSRecordArgs, DNSRecordState], error) {
 	p.GetLogger(ctx).Debugf("Read:DNSRecord[%s] zone_id=%s", req.ID, req.State.ZoneID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[DNSRecordArgs, DNSRecordState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -144,11 +154,12 @@ func (*DNSRecord) Read(ctx context.Context, req infer.ReadRequest[DNSRecordArgs,
 			Type:    DNSRecordType(record.Type),
 		},
 		State: DNSRecordState{
-			ZoneID:  req.State.ZoneID,
-			Name:    record.Name,
-			Content: record.Content,
-			TTL:     record.Ttl,
-			Type:    DNSRecordType(record.Type),
+			AccountScope: readAccountScope(ctx, req.State.AccountScope),
+			ZoneID:       req.State.ZoneID,
+			Name:         record.Name,
+			Content:      record.Content,
+			TTL:          record.Ttl,
+			Type:         DNSRecordType(record.Type),
 		},
 	}, nil
 }
@@ -157,14 +168,18 @@ func (*DNSRecord) Read(ctx context.Context, req infer.ReadRequest[DNSRecordArgs,
 func (*DNSRecord) Update(ctx context.Context, req infer.UpdateRequest[DNSRecordArgs, DNSRecordState]) (infer.UpdateResponse[DNSRecordState], error) {
 	p.GetLogger(ctx).Debugf("Update:DNSRecord[%s] zone_id=%s", req.ID, req.State.ZoneID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[DNSRecordState]{
 			Output: DNSRecordState{
-				ZoneID:  req.Inputs.ZoneID,
-				Name:    req.Inputs.Name,
-				Content: req.Inputs.Content,
-				TTL:     req.Inputs.TTL,
-				Type:    req.Inputs.Type,
+				AccountScope: currentAccountScope(ctx),
+				ZoneID:       req.Inputs.ZoneID,
+				Name:         req.Inputs.Name,
+				Content:      req.Inputs.Content,
+				TTL:          req.Inputs.TTL,
+				Type:         req.Inputs.Type,
 			},
 		}, nil
 	}
@@ -186,11 +201,12 @@ func (*DNSRecord) Update(ctx context.Context, req infer.UpdateRequest[DNSRecordA
 
 	return infer.UpdateResponse[DNSRecordState]{
 		Output: DNSRecordState{
-			ZoneID:  req.State.ZoneID,
-			Name:    record.Name,
-			Content: record.Content,
-			TTL:     record.Ttl,
-			Type:    DNSRecordType(record.Type),
+			AccountScope: currentAccountScope(ctx),
+			ZoneID:       req.State.ZoneID,
+			Name:         record.Name,
+			Content:      record.Content,
+			TTL:          record.Ttl,
+			Type:         DNSRecordType(record.Type),
 		},
 	}, nil
 }
@@ -199,6 +215,9 @@ func (*DNSRecord) Update(ctx context.Context, req infer.UpdateRequest[DNSRecordA
 func (*DNSRecord) Delete(ctx context.Context, req infer.DeleteRequest[DNSRecordState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:DNSRecord[%s] zone_id=%s", req.ID, req.State.ZoneID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -218,6 +237,8 @@ func (*DNSRecord) Diff(ctx context.Context, req infer.DiffRequest[DNSRecordArgs,
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.ZoneID != req.State.ZoneID {
 		diff["zoneID"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
 	}
@@ -252,6 +273,7 @@ func (*DNSRecord) Check(ctx context.Context, req infer.CheckRequest) (infer.Chec
 	p.GetLogger(ctx).Debugf("Check:DNSRecord old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
 
 	args, failures, err := infer.DefaultCheck[DNSRecordArgs](ctx, req.NewInputs)
+	failures = append(failures, checkCapability(ctx, "zoneID", config.CapabilityDNSZones)...)
 
 	if isBlank(args.ZoneID) {
 		failures = append(failures, p.CheckFailure{
@@ -295,3 +317,16 @@ func (*DNSRecord) WireDependencies(field infer.FieldSelector, args *DNSRecordArg
 	field.OutputField(&state.TTL).DependsOn(field.InputField(&args.TTL))
 	field.OutputField(&state.Type).DependsOn(field.InputField(&args.Type))
 }
+
+// DNSRecordStateFromAPI builds a DNSRecordState from an API DNSRecord response.
+// The API omits the parent zone, so it is passed in.
+func DNSRecordStateFromAPI(ctx context.Context, zoneID string, record *nbapi.DNSRecord) DNSRecordState {
+	return DNSRecordState{
+		AccountScope: currentAccountScope(ctx),
+		ZoneID:       zoneID,
+		Name:         record.Name,
+		Content:      record.Content,
+		TTL:          record.Ttl,
+		Type:         DNSRecordType(record.Type),
+	}
+}
@@ -30,6 +30,8 @@ func (d *DNSSettingsArgs) Annotate(annotator infer.Annotator) {
 
 // DNSSettingsState represents the output state of the DNS settings resource.
 type DNSSettingsState struct {
+	AccountScope
+
 	DisabledManagementGroups []string `pulumi:"disabledManagementGroups"`
 }
 
@@ -43,10 +45,14 @@ func (d *DNSSettingsState) Annotate(annotator infer.Annotator) {
 func (*DNSSettings) Create(ctx context.Context, req infer.CreateRequest[DNSSettingsArgs]) (infer.CreateResponse[DNSSettingsState], error) {
 	p.GetLogger(ctx).Debugf("Create:DNSSettings disabledGroups=%v", req.Inputs.DisabledManagementGroups)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[DNSSettingsState]{
 			ID: "dns-settings",
 			Output: DNSSettingsState{
+				AccountScope:             currentAccountScope(ctx),
 				DisabledManagementGroups: req.Inputs.DisabledManagementGroups,
 			},
 		}, nil
@@ -67,6 +73,7 @@ func (*DNSSettings) Create(ctx context.Context, req infer.CreateRequest[DNSSetti
 	return infer.CreateResponse[DNSSettingsState]{
 		ID: "dns-settings",
 		Output: DNSSettingsState{
+			AccountScope:             currentAccountScope(ctx),
 			DisabledManagementGroups: updated.DisabledManagementGroups,
 		},
 	}, nil
@@ -76,6 +83,9 @@ func (*DNSSettings) Create(ctx context.Context, req infer.CreateRequest[DNSSetti
 func (*DNSSettings) Read(ctx context.Context, req infer.ReadRequest[DNSSettingsArgs, DNSSettingsState]) (infer.ReadResponse[DNSSettingsArgs, DNSSettingsState], error) {
 	p.GetLogger(ctx).Debugf("Read:DNSSettings[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[DNSSettingsArgs, DNSSettingsState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -92,6 +102,7 @@ func (*DNSSettings) Read(ctx context.Context, req infer.ReadRequest[DNSSettingsA
 			DisabledManagementGroups: settings.DisabledManagementGroups,
 		},
 		State: DNSSettingsState{
+			AccountScope:             readAccountScope(ctx, req.State.AccountScope),
 			DisabledManagementGroups: settings.DisabledManagementGroups,
 		},
 	}, nil
@@ -101,9 +112,13 @@ func (*DNSSettings) Read(ctx context.Context, req infer.ReadRequest[DNSSettingsA
 func (*DNSSettings) Update(ctx context.Context, req infer.UpdateRequest[DNSSettingsArgs, DNSSettingsState]) (infer.UpdateResponse[DNSSettingsState], error) {
 	p.GetLogger(ctx).Debugf("Update:DNSSettings[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[DNSSettingsState]{
 			Output: DNSSettingsState{
+				AccountScope:             currentAccountScope(ctx),
 				DisabledManagementGroups: req.Inputs.DisabledManagementGroups,
 			},
 		}, nil
@@ -123,6 +138,7 @@ func (*DNSSettings) Update(ctx context.Context, req infer.UpdateRequest[DNSSetti
 
 	return infer.UpdateResponse[DNSSettingsState]{
 		Output: DNSSettingsState{
+			AccountScope:             currentAccountScope(ctx),
 			DisabledManagementGroups: updated.DisabledManagementGroups,
 		},
 	}, nil
@@ -132,6 +148,9 @@ func (*DNSSettings) Update(ctx context.Context, req infer.UpdateRequest[DNSSetti
 func (*DNSSettings) Delete(ctx context.Context, req infer.DeleteRequest[DNSSettingsState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:DNSSettings[%s] (no-op, singleton resource)", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	return infer.DeleteResponse{}, nil
 }
 
@@ -141,6 +160,8 @@ func (*DNSSettings) Diff(ctx context.Context, req infer.DiffRequest[DNSSettingsA
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if !equalSlice(req.Inputs.DisabledManagementGroups, req.State.DisabledManagementGroups) {
 		diff["disabledManagementGroups"] = p.PropertyDiff{
 			InputDiff: false,
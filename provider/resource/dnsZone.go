@@ -38,6 +38,8 @@ func (d *DNSZoneArgs) Annotate(annotator infer.Annotator) {
 
 // DNSZoneState represents the output state of a DNS zone resource.
 type DNSZoneState struct {
+	AccountScope
+
 	Name               string   `pulumi:"name"`
 	Domain             string   `pulumi:"domain"`
 	Enabled            bool     `pulumi:"enabled"`
@@ -58,12 +60,16 @@ func (d *DNSZoneState) Annotate(annotator infer.Annotator) {
 func (*DNSZone) Create(ctx context.Context, req infer.CreateRequest[DNSZoneArgs]) (infer.CreateResponse[DNSZoneState], error) {
 	p.GetLogger(ctx).Debugf("Create:DNSZone name=%s, domain=%s", req.Inputs.Name, req.Inputs.Domain)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	distGroups := sortedStrings(req.Inputs.DistributionGroups)
 
 	if req.DryRun {
 		return infer.CreateResponse[DNSZoneState]{
 			ID: "preview",
 			Output: DNSZoneState{
+				AccountScope:       currentAccountScope(ctx),
 				Name:               req.Inputs.Name,
 				Domain:             req.Inputs.Domain,
 				Enabled:            req.Inputs.Enabled,
@@ -94,6 +100,7 @@ func (*DNSZone) Create(ctx context.Context, req infer.CreateRequest[DNSZoneArgs]
 	return infer.CreateResponse[DNSZoneState]{
 		ID: zone.Id,
 		Output: DNSZoneState{
+			AccountScope:       currentAccountScope(ctx),
 			Name:               zone.Name,
 			Domain:             zone.Domain,
 			Enabled:            zone.Enabled,
@@ -107,6 +114,9 @@ func (*DNSZone) Create(ctx context.Context, req infer.CreateRequest[DNSZoneArgs]
 func (*DNSZone) Read(ctx context.Context, req infer.ReadRequest[DNSZoneArgs, DNSZoneState]) (infer.ReadResponse[DNSZoneArgs, DNSZoneState], error) {
 	p.GetLogger(ctx).Debugf("Read:DNSZone[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[DNSZoneArgs, DNSZoneState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -135,6 +145,7 @@ func (*DNSZone) Read(ctx context.Context, req infer.ReadRequest[DNSZoneArgs, DNS
 			DistributionGroups: zone.DistributionGroups,
 		},
 		State: DNSZoneState{
+			AccountScope:       readAccountScope(ctx, req.State.AccountScope),
 			Name:               zone.Name,
 			Domain:             zone.Domain,
 			Enabled:            zone.Enabled,
@@ -148,11 +159,15 @@ func (*DNSZone) Read(ctx context.Context, req infer.ReadRequest[DNSZoneArgs, DNS
 func (*DNSZone) Update(ctx context.Context, req infer.UpdateRequest[DNSZoneArgs, DNSZoneState]) (infer.UpdateResponse[DNSZoneState], error) {
 	p.GetLogger(ctx).Debugf("Update:DNSZone[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	distGroups := sortedStrings(req.Inputs.DistributionGroups)
 
 	if req.DryRun {
 		return infer.UpdateResponse[DNSZoneState]{
 			Output: DNSZoneState{
+				AccountScope:       currentAccountScope(ctx),
 				Name:               req.Inputs.Name,
 				Domain:             req.Inputs.Domain,
 				Enabled:            req.Inputs.Enabled,
@@ -182,6 +197,7 @@ func (*DNSZone) Update(ctx context.Context, req infer.UpdateRequest[DNSZoneArgs,
 
 	return infer.UpdateResponse[DNSZoneState]{
 		Output: DNSZoneState{
+			AccountScope:       currentAccountScope(ctx),
 			Name:               zone.Name,
 			Domain:             zone.Domain,
 			Enabled:            zone.Enabled,
@@ -195,6 +211,9 @@ func (*DNSZone) Update(ctx context.Context, req infer.UpdateRequest[DNSZoneArgs,
 func (*DNSZone) Delete(ctx context.Context, req infer.DeleteRequest[DNSZoneState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:DNSZone[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -214,6 +233,8 @@ func (*DNSZone) Diff(ctx context.Context, req infer.DiffRequest[DNSZoneArgs, DNS
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
 	}
@@ -248,6 +269,7 @@ func (*DNSZone) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckR
 	p.GetLogger(ctx).Debugf("Check:DNSZone old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
 
 	args, failures, err := infer.DefaultCheck[DNSZoneArgs](ctx, req.NewInputs)
+	failures = append(failures, checkCapability(ctx, "domain", config.CapabilityDNSZones)...)
 
 	if isBlank(args.Name) {
 		failures = append(failures, p.CheckFailure{
@@ -277,3 +299,15 @@ func (*DNSZone) WireDependencies(field infer.FieldSelector, args *DNSZoneArgs, s
 	field.OutputField(&state.EnableSearchDomain).DependsOn(field.InputField(&args.EnableSearchDomain))
 	field.OutputField(&state.DistributionGroups).DependsOn(field.InputField(&args.DistributionGroups))
 }
+
+// DNSZoneStateFromAPI builds a DNSZoneState from an API Zone response.
+func DNSZoneStateFromAPI(ctx context.Context, zone *nbapi.Zone) DNSZoneState {
+	return DNSZoneState{
+		AccountScope:       currentAccountScope(ctx),
+		Name:               zone.Name,
+		Domain:             zone.Domain,
+		Enabled:            zone.Enabled,
+		EnableSearchDomain: zone.EnableSearchDomain,
+		DistributionGroups: zone.DistributionGroups,
+	}
+}
@@ -0,0 +1,128 @@
+package resource
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"filippo.io/age"
+	ageArmor "filippo.io/age/armor"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgpArmor "github.com/ProtonMail/go-crypto/openpgp/armor"
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+const pgpPublicKeyHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+
+// encryptForDescription documents the encryptFor input of every resource
+// that supports it.
+const encryptForDescription = "Public key to additionally encrypt the secret to: one or more age recipients " +
+	"(age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, " +
+	"non-secret output. Changing it re-encrypts the existing secret without replacing the resource."
+
+// encryptSecret encrypts plaintext to the recipients in encryptFor and returns
+// the ASCII-armored ciphertext. Encryption happens locally; no key server is
+// consulted.
+func encryptSecret(encryptFor, plaintext string) (string, error) {
+	if strings.HasPrefix(strings.TrimSpace(encryptFor), pgpPublicKeyHeader) {
+		return encryptOpenPGP(encryptFor, plaintext)
+	}
+
+	return encryptAge(encryptFor, plaintext)
+}
+
+// encryptAge encrypts plaintext to a list of age recipients.
+func encryptAge(encryptFor, plaintext string) (string, error) {
+	recipients, err := age.ParseRecipients(strings.NewReader(encryptFor))
+	if err != nil {
+		return "", fmt.Errorf("parsing age recipients: %w", err)
+	}
+
+	var out bytes.Buffer
+
+	armored := ageArmor.NewWriter(&out)
+
+	writer, err := age.Encrypt(armored, recipients...)
+	if err != nil {
+		return "", fmt.Errorf("encrypting to age recipients: %w", err)
+	}
+
+	if err := writeAndClose(writer, plaintext, armored); err != nil {
+		return "", fmt.Errorf("encrypting to age recipients: %w", err)
+	}
+
+	return out.String(), nil
+}
+
+// encryptOpenPGP encrypts plaintext to every key in an armored OpenPGP key block.
+func encryptOpenPGP(encryptFor, plaintext string) (string, error) {
+	keys, err := openpgp.ReadArmoredKeyRing(strings.NewReader(encryptFor))
+	if err != nil {
+		return "", fmt.Errorf("parsing OpenPGP public key: %w", err)
+	}
+
+	var out bytes.Buffer
+
+	armored, err := pgpArmor.Encode(&out, "PGP MESSAGE", nil)
+	if err != nil {
+		return "", fmt.Errorf("encrypting to OpenPGP key: %w", err)
+	}
+
+	writer, err := openpgp.Encrypt(armored, keys, nil, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("encrypting to OpenPGP key: %w", err)
+	}
+
+	if err := writeAndClose(writer, plaintext, armored); err != nil {
+		return "", fmt.Errorf("encrypting to OpenPGP key: %w", err)
+	}
+
+	return out.String(), nil
+}
+
+// writeAndClose writes plaintext to an encrypting writer, then closes it and
+// the armoring writer beneath it, in that order.
+func writeAndClose(writer io.WriteCloser, plaintext string, armored io.Closer) error {
+	if _, err := io.WriteString(writer, plaintext); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return armored.Close()
+}
+
+// encryptedSecret returns the secret encrypted to encryptFor. It fails when
+// the secret is not known, such as for imported credentials.
+func encryptedSecret(encryptFor string, secret *string) (*string, error) {
+	if secret == nil {
+		return nil, errors.New("encryptFor requires the plaintext secret, which is only known for credentials created by the provider")
+	}
+
+	ciphertext, err := encryptSecret(encryptFor, *secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ciphertext, nil
+}
+
+// checkEncryptFor validates the encryptFor input by encrypting a probe to it.
+func checkEncryptFor(encryptFor *string) []p.CheckFailure {
+	if encryptFor == nil {
+		return nil
+	}
+
+	if _, err := encryptSecret(*encryptFor, ""); err != nil {
+		return []p.CheckFailure{{
+			Property: "encryptFor",
+			Reason:   fmt.Sprintf("encryptFor must be age recipients or an armored OpenPGP public key: %v", err),
+		}}
+	}
+
+	return nil
+}
@@ -46,6 +46,8 @@ func (g *GoogleIDPArgs) Annotate(annotator infer.Annotator) {
 
 // GoogleIDPState represents the output state of a Google IdP integration.
 type GoogleIDPState struct {
+	AccountScope
+
 	CustomerID        string    `pulumi:"customerId"`
 	ServiceAccountKey string    `provider:"secret"                   pulumi:"serviceAccountKey"`
 	Enabled           *bool     `pulumi:"enabled,optional"`
@@ -72,10 +74,13 @@ func (g *GoogleIDPState) Annotate(annotator infer.Annotator) {
 func (*GoogleIDP) Create(ctx context.Context, req infer.CreateRequest[GoogleIDPArgs]) (infer.CreateResponse[GoogleIDPState], error) {
 	p.GetLogger(ctx).Debugf("Create:GoogleIDP customerId=%s", req.Inputs.CustomerID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[GoogleIDPState]{
 			ID:     "preview",
-			Output: googleIDPStateFromArgs(req.Inputs, nil),
+			Output: googleIDPStateFromArgs(ctx, req.Inputs, nil),
 		}, nil
 	}
 
@@ -98,7 +103,7 @@ func (*GoogleIDP) Create(ctx context.Context, req infer.CreateRequest[GoogleIDPA
 
 	return infer.CreateResponse[GoogleIDPState]{
 		ID:     strconv.FormatInt(created.Id, 10),
-		Output: googleIDPStateFromAPI(req.Inputs.ServiceAccountKey, *created),
+		Output: googleIDPStateFromAPI(ctx, req.Inputs.ServiceAccountKey, *created),
 	}, nil
 }
 
@@ -106,6 +111,9 @@ func (*GoogleIDP) Create(ctx context.Context, req infer.CreateRequest[GoogleIDPA
 func (*GoogleIDP) Read(ctx context.Context, req infer.ReadRequest[GoogleIDPArgs, GoogleIDPState]) (infer.ReadResponse[GoogleIDPArgs, GoogleIDPState], error) {
 	p.GetLogger(ctx).Debugf("Read:GoogleIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[GoogleIDPArgs, GoogleIDPState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -124,7 +132,8 @@ func (*GoogleIDP) Read(ctx context.Context, req infer.ReadRequest[GoogleIDPArgs,
 		return infer.ReadResponse[GoogleIDPArgs, GoogleIDPState]{}, fmt.Errorf("reading Google IdP integration failed: %w", err)
 	}
 
-	state := googleIDPStateFromAPI(req.State.ServiceAccountKey, *idp)
+	state := googleIDPStateFromAPI(ctx, req.State.ServiceAccountKey, *idp)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
 
 	return infer.ReadResponse[GoogleIDPArgs, GoogleIDPState]{
 		ID: req.ID,
@@ -145,9 +154,12 @@ func (*GoogleIDP) Read(ctx context.Context, req infer.ReadRequest[GoogleIDPArgs,
 func (*GoogleIDP) Update(ctx context.Context, req infer.UpdateRequest[GoogleIDPArgs, GoogleIDPState]) (infer.UpdateResponse[GoogleIDPState], error) {
 	p.GetLogger(ctx).Debugf("Update:GoogleIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[GoogleIDPState]{
-			Output: googleIDPStateFromArgs(req.Inputs, req.State.LastSyncedAt),
+			Output: googleIDPStateFromArgs(ctx, req.Inputs, req.State.LastSyncedAt),
 		}, nil
 	}
 
@@ -173,7 +185,7 @@ func (*GoogleIDP) Update(ctx context.Context, req infer.UpdateRequest[GoogleIDPA
 	}
 
 	return infer.UpdateResponse[GoogleIDPState]{
-		Output: googleIDPStateFromAPI(req.Inputs.ServiceAccountKey, *updated),
+		Output: googleIDPStateFromAPI(ctx, req.Inputs.ServiceAccountKey, *updated),
 	}, nil
 }
 
@@ -181,6 +193,9 @@ func (*GoogleIDP) Update(ctx context.Context, req infer.UpdateRequest[GoogleIDPA
 func (*GoogleIDP) Delete(ctx context.Context, req infer.DeleteRequest[GoogleIDPState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:GoogleIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -200,6 +215,8 @@ func (*GoogleIDP) Diff(ctx context.Context, req infer.DiffRequest[GoogleIDPArgs,
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.CustomerID != req.State.CustomerID {
 		diff["customerId"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
 	}
@@ -275,8 +292,9 @@ func (*GoogleIDP) WireDependencies(field infer.FieldSelector, args *GoogleIDPArg
 	field.OutputField(&state.SyncInterval).DependsOn(field.InputField(&args.SyncInterval))
 }
 
-func googleIDPStateFromArgs(args GoogleIDPArgs, lastSyncedAt *string) GoogleIDPState {
+func googleIDPStateFromArgs(ctx context.Context, args GoogleIDPArgs, lastSyncedAt *string) GoogleIDPState {
 	return GoogleIDPState{
+		AccountScope:      currentAccountScope(ctx),
 		CustomerID:        args.CustomerID,
 		ServiceAccountKey: args.ServiceAccountKey,
 		Enabled:           args.Enabled,
@@ -288,10 +306,11 @@ func googleIDPStateFromArgs(args GoogleIDPArgs, lastSyncedAt *string) GoogleIDPS
 	}
 }
 
-func googleIDPStateFromAPI(serviceAccountKey string, idp nbapi.GoogleIntegration) GoogleIDPState {
+func googleIDPStateFromAPI(ctx context.Context, serviceAccountKey string, idp nbapi.GoogleIntegration) GoogleIDPState {
 	lastSynced := idp.LastSyncedAt.Format(idpTimeFormat)
 
 	return GoogleIDPState{
+		AccountScope:      currentAccountScope(ctx),
 		CustomerID:        idp.CustomerId,
 		ServiceAccountKey: serviceAccountKey,
 		Enabled:           &idp.Enabled,
@@ -35,6 +35,8 @@ func (g *GroupArgs) Annotate(a infer.Annotator) {
 
 // GroupState represents the output state of a group resource.
 type GroupState struct {
+	AccountScope
+
 	Name      string      `pulumi:"name"`
 	Peers     *[]string   `pulumi:"peers,optional"`
 	Resources *[]Resource `pulumi:"resources,optional"`
@@ -51,13 +53,17 @@ func (g *GroupState) Annotate(a infer.Annotator) {
 func (*Group) Create(ctx context.Context, req infer.CreateRequest[GroupArgs]) (infer.CreateResponse[GroupState], error) {
 	p.GetLogger(ctx).Debugf("Create:Group name=%s, peers=%v", req.Inputs.Name, req.Inputs.Peers)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[GroupState]{
 			ID: "preview",
 			Output: GroupState{
-				Name:      req.Inputs.Name,
-				Peers:     req.Inputs.Peers,
-				Resources: req.Inputs.Resources,
+				AccountScope: currentAccountScope(ctx),
+				Name:         req.Inputs.Name,
+				Peers:        req.Inputs.Peers,
+				Resources:    req.Inputs.Resources,
 			},
 		}, nil
 	}
@@ -88,9 +94,10 @@ func (*Group) Create(ctx context.Context, req infer.CreateRequest[GroupArgs]) (i
 	return infer.CreateResponse[GroupState]{
 		ID: group.Id,
 		Output: GroupState{
-			Name:      group.Name,
-			Peers:     &peerIDs,
-			Resources: groupStateResources(req.Inputs.Resources, group.Resources),
+			AccountScope: currentAccountScope(ctx),
+			Name:         group.Name,
+			Peers:        &peerIDs,
+			Resources:    groupStateResources(req.Inputs.Resources, group.Resources),
 		},
 	}, nil
 }
@@ -98,6 +105,10 @@ func (*Group) Create(ctx context.Context, req infer.CreateRequest[GroupArgs]) (i
 // Read fetches the current state of a group resource from NetBird.
 func (*Group) Read(ctx context.Context, req infer.ReadRequest[GroupArgs, GroupState]) (infer.ReadResponse[GroupArgs, GroupState], error) {
 	p.GetLogger(ctx).Debugf("Read:GroupArgs[%s] name=%s", req.ID, req.Inputs.Name)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	p.GetLogger(ctx).Debugf("Read:GroupState[%s] name=%s, id=%s", req.ID, req.State.Name, req.ID)
 
 	client, err := config.GetNetBirdClient(ctx)
@@ -135,9 +146,10 @@ func (*Group) Read(ctx context.Context, req infer.ReadRequest[GroupArgs, GroupSt
 			Resources: req.Inputs.Resources,
 		},
 		State: GroupState{
-			Name:      group.Name,
-			Peers:     &peerIDs,
-			Resources: stateResources,
+			AccountScope: readAccountScope(ctx, req.State.AccountScope),
+			Name:         group.Name,
+			Peers:        &peerIDs,
+			Resources:    stateResources,
 		},
 	}, nil
 }
@@ -146,12 +158,16 @@ func (*Group) Read(ctx context.Context, req infer.ReadRequest[GroupArgs, GroupSt
 func (*Group) Update(ctx context.Context, req infer.UpdateRequest[GroupArgs, GroupState]) (infer.UpdateResponse[GroupState], error) {
 	p.GetLogger(ctx).Debugf("Update:Group[%s] name=%s", req.ID, req.Inputs.Name)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[GroupState]{
 			Output: GroupState{
-				Name:      req.Inputs.Name,
-				Peers:     req.Inputs.Peers,
-				Resources: req.Inputs.Resources,
+				AccountScope: currentAccountScope(ctx),
+				Name:         req.Inputs.Name,
+				Peers:        req.Inputs.Peers,
+				Resources:    req.Inputs.Resources,
 			},
 		}, nil
 	}
@@ -179,9 +195,10 @@ func (*Group) Update(ctx context.Context, req infer.UpdateRequest[GroupArgs, Gro
 
 	return infer.UpdateResponse[GroupState]{
 		Output: GroupState{
-			Name:      updated.Name,
-			Peers:     &peerIDs,
-			Resources: groupStateResources(req.Inputs.Resources, updated.Resources),
+			AccountScope: currentAccountScope(ctx),
+			Name:         updated.Name,
+			Peers:        &peerIDs,
+			Resources:    groupStateResources(req.Inputs.Resources, updated.Resources),
 		},
 	}, nil
 }
@@ -190,6 +207,9 @@ func (*Group) Update(ctx context.Context, req infer.UpdateRequest[GroupArgs, Gro
 func (*Group) Delete(ctx context.Context, req infer.DeleteRequest[GroupState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:Group[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -209,6 +229,8 @@ func (*Group) Diff(ctx context.Context, req infer.DiffRequest[GroupArgs, GroupSt
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	// Name is reflected in state — normal comparison
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{
@@ -327,3 +349,21 @@ func groupStateResources(inputResources *[]Resource, apiResources []nbapi.Resour
 
 	return &sorted
 }
+
+// GroupStateFromAPI builds a GroupState from an API Group response, including
+// every resource the group contains.
+func GroupStateFromAPI(ctx context.Context, group *nbapi.Group) GroupState {
+	peerIDs := make([]string, len(group.Peers))
+	for i, peer := range group.Peers {
+		peerIDs[i] = peer.Id
+	}
+
+	slices.Sort(peerIDs)
+
+	return GroupState{
+		AccountScope: currentAccountScope(ctx),
+		Name:         group.Name,
+		Peers:        &peerIDs,
+		Resources:    fromAPIResourceList(&group.Resources),
+	}
+}
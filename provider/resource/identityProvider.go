@@ -75,6 +75,8 @@ func (i *IdentityProviderArgs) Annotate(a infer.Annotator) {
 
 // IdentityProviderState represents the output state of an identity provider resource.
 type IdentityProviderState struct {
+	AccountScope
+
 	Name         string               `pulumi:"name"`
 	Type         IdentityProviderType `pulumi:"type"`
 	Issuer       string               `pulumi:"issuer"`
@@ -95,10 +97,13 @@ func (i *IdentityProviderState) Annotate(a infer.Annotator) {
 func (*IdentityProvider) Create(ctx context.Context, req infer.CreateRequest[IdentityProviderArgs]) (infer.CreateResponse[IdentityProviderState], error) {
 	p.GetLogger(ctx).Debugf("Create:IdentityProvider name=%s type=%s", req.Inputs.Name, req.Inputs.Type)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[IdentityProviderState]{
 			ID:     "preview",
-			Output: identityProviderStateFromArgs(req.Inputs),
+			Output: identityProviderStateFromArgs(ctx, req.Inputs),
 		}, nil
 	}
 
@@ -124,7 +129,7 @@ func (*IdentityProvider) Create(ctx context.Context, req infer.CreateRequest[Ide
 
 	return infer.CreateResponse[IdentityProviderState]{
 		ID:     *idp.Id,
-		Output: identityProviderStateFromArgs(req.Inputs),
+		Output: identityProviderStateFromArgs(ctx, req.Inputs),
 	}, nil
 }
 
@@ -132,6 +137,9 @@ func (*IdentityProvider) Create(ctx context.Context, req infer.CreateRequest[Ide
 func (*IdentityProvider) Read(ctx context.Context, req infer.ReadRequest[IdentityProviderArgs, IdentityProviderState]) (infer.ReadResponse[IdentityProviderArgs, IdentityProviderState], error) {
 	p.GetLogger(ctx).Debugf("Read:IdentityProvider[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[IdentityProviderArgs, IdentityProviderState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -161,6 +169,7 @@ func (*IdentityProvider) Read(ctx context.Context, req infer.ReadRequest[Identit
 			ClientSecret: req.Inputs.ClientSecret,
 		},
 		State: IdentityProviderState{
+			AccountScope: readAccountScope(ctx, req.State.AccountScope),
 			Name:         idp.Name,
 			Type:         IdentityProviderType(idp.Type),
 			Issuer:       idp.Issuer,
@@ -174,9 +183,12 @@ func (*IdentityProvider) Read(ctx context.Context, req infer.ReadRequest[Identit
 func (*IdentityProvider) Update(ctx context.Context, req infer.UpdateRequest[IdentityProviderArgs, IdentityProviderState]) (infer.UpdateResponse[IdentityProviderState], error) {
 	p.GetLogger(ctx).Debugf("Update:IdentityProvider[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[IdentityProviderState]{
-			Output: identityProviderStateFromArgs(req.Inputs),
+			Output: identityProviderStateFromArgs(ctx, req.Inputs),
 		}, nil
 	}
 
@@ -197,7 +209,7 @@ func (*IdentityProvider) Update(ctx context.Context, req infer.UpdateRequest[Ide
 	}
 
 	return infer.UpdateResponse[IdentityProviderState]{
-		Output: identityProviderStateFromArgs(req.Inputs),
+		Output: identityProviderStateFromArgs(ctx, req.Inputs),
 	}, nil
 }
 
@@ -205,6 +217,9 @@ func (*IdentityProvider) Update(ctx context.Context, req infer.UpdateRequest[Ide
 func (*IdentityProvider) Delete(ctx context.Context, req infer.DeleteRequest[IdentityProviderState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:IdentityProvider[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -224,6 +239,8 @@ func (*IdentityProvider) Diff(ctx context.Context, req infer.DiffRequest[Identit
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
 	}
@@ -256,6 +273,7 @@ func (*IdentityProvider) Check(ctx context.Context, req infer.CheckRequest) (inf
 	p.GetLogger(ctx).Debugf("Check:IdentityProvider old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
 
 	args, failures, err := infer.DefaultCheck[IdentityProviderArgs](ctx, req.NewInputs)
+	failures = append(failures, checkCapability(ctx, "name", config.CapabilityIdentityProviders)...)
 
 	if isBlank(args.Name) {
 		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name must not be empty"})
@@ -289,6 +307,27 @@ func (*IdentityProvider) WireDependencies(field infer.FieldSelector, args *Ident
 }
 
 // identityProviderStateFromArgs mirrors inputs into state (all fields are user-supplied).
-func identityProviderStateFromArgs(args IdentityProviderArgs) IdentityProviderState {
-	return IdentityProviderState(args)
+func identityProviderStateFromArgs(ctx context.Context, args IdentityProviderArgs) IdentityProviderState {
+	return IdentityProviderState{
+		AccountScope: currentAccountScope(ctx),
+		Name:         args.Name,
+		Type:         args.Type,
+		Issuer:       args.Issuer,
+		ClientID:     args.ClientID,
+		ClientSecret: args.ClientSecret,
+	}
+}
+
+// IdentityProviderStateFromAPI builds an IdentityProviderState from an API
+// IdentityProvider response. The API never returns the client secret, so it is
+// left empty.
+func IdentityProviderStateFromAPI(ctx context.Context, idp *nbapi.IdentityProvider) IdentityProviderState {
+	return IdentityProviderState{
+		AccountScope: currentAccountScope(ctx),
+		Name:         idp.Name,
+		Type:         IdentityProviderType(idp.Type),
+		Issuer:       idp.Issuer,
+		ClientID:     idp.ClientId,
+		ClientSecret: "",
+	}
 }
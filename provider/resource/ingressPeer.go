@@ -46,6 +46,8 @@ func (i *IngressAvailablePorts) Annotate(a infer.Annotator) {
 
 // IngressPeerState represents the output state of an ingress peer resource.
 type IngressPeerState struct {
+	AccountScope
+
 	PeerID         string                 `pulumi:"peerId"`
 	Enabled        bool                   `pulumi:"enabled"`
 	Fallback       bool                   `pulumi:"fallback"`
@@ -70,10 +72,13 @@ func (i *IngressPeerState) Annotate(annotator infer.Annotator) {
 func (*IngressPeer) Create(ctx context.Context, req infer.CreateRequest[IngressPeerArgs]) (infer.CreateResponse[IngressPeerState], error) {
 	p.GetLogger(ctx).Debugf("Create:IngressPeer peerId=%s", req.Inputs.PeerID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[IngressPeerState]{
 			ID:     "preview",
-			Output: ingressPeerDryRun(req.Inputs),
+			Output: ingressPeerDryRun(ctx, req.Inputs),
 		}, nil
 	}
 
@@ -95,7 +100,7 @@ func (*IngressPeer) Create(ctx context.Context, req infer.CreateRequest[IngressP
 
 	return infer.CreateResponse[IngressPeerState]{
 		ID:     created.Id,
-		Output: ingressPeerStateFromAPI(*created),
+		Output: ingressPeerStateFromAPI(ctx, *created),
 	}, nil
 }
 
@@ -103,6 +108,9 @@ func (*IngressPeer) Create(ctx context.Context, req infer.CreateRequest[IngressP
 func (*IngressPeer) Read(ctx context.Context, req infer.ReadRequest[IngressPeerArgs, IngressPeerState]) (infer.ReadResponse[IngressPeerArgs, IngressPeerState], error) {
 	p.GetLogger(ctx).Debugf("Read:IngressPeer[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[IngressPeerArgs, IngressPeerState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -121,6 +129,9 @@ func (*IngressPeer) Read(ctx context.Context, req infer.ReadRequest[IngressPeerA
 		return infer.ReadResponse[IngressPeerArgs, IngressPeerState]{}, fmt.Errorf("reading ingress peer failed: %w", err)
 	}
 
+	state := ingressPeerStateFromAPI(ctx, *peer)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
+
 	return infer.ReadResponse[IngressPeerArgs, IngressPeerState]{
 		ID: req.ID,
 		Inputs: IngressPeerArgs{
@@ -128,7 +139,7 @@ func (*IngressPeer) Read(ctx context.Context, req infer.ReadRequest[IngressPeerA
 			Enabled:  peer.Enabled,
 			Fallback: peer.Fallback,
 		},
-		State: ingressPeerStateFromAPI(*peer),
+		State: state,
 	}, nil
 }
 
@@ -136,9 +147,12 @@ func (*IngressPeer) Read(ctx context.Context, req infer.ReadRequest[IngressPeerA
 func (*IngressPeer) Update(ctx context.Context, req infer.UpdateRequest[IngressPeerArgs, IngressPeerState]) (infer.UpdateResponse[IngressPeerState], error) {
 	p.GetLogger(ctx).Debugf("Update:IngressPeer[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[IngressPeerState]{
-			Output: ingressPeerDryRun(req.Inputs),
+			Output: ingressPeerDryRun(ctx, req.Inputs),
 		}, nil
 	}
 
@@ -156,7 +170,7 @@ func (*IngressPeer) Update(ctx context.Context, req infer.UpdateRequest[IngressP
 	}
 
 	return infer.UpdateResponse[IngressPeerState]{
-		Output: ingressPeerStateFromAPI(*updated),
+		Output: ingressPeerStateFromAPI(ctx, *updated),
 	}, nil
 }
 
@@ -164,6 +178,9 @@ func (*IngressPeer) Update(ctx context.Context, req infer.UpdateRequest[IngressP
 func (*IngressPeer) Delete(ctx context.Context, req infer.DeleteRequest[IngressPeerState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:IngressPeer[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -183,6 +200,8 @@ func (*IngressPeer) Diff(ctx context.Context, req infer.DiffRequest[IngressPeerA
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.PeerID != req.State.PeerID {
 		diff["peerId"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
 	}
@@ -207,6 +226,7 @@ func (*IngressPeer) Check(ctx context.Context, req infer.CheckRequest) (infer.Ch
 	p.GetLogger(ctx).Debugf("Check:IngressPeer old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
 
 	args, failures, err := infer.DefaultCheck[IngressPeerArgs](ctx, req.NewInputs)
+	failures = append(failures, checkCapability(ctx, "peerId", config.CapabilityIngressPeers)...)
 
 	if isBlank(args.PeerID) {
 		failures = append(failures, p.CheckFailure{
@@ -229,8 +249,9 @@ func (*IngressPeer) WireDependencies(field infer.FieldSelector, args *IngressPee
 }
 
 // ingressPeerDryRun builds a preview state from inputs alone.
-func ingressPeerDryRun(inputs IngressPeerArgs) IngressPeerState {
+func ingressPeerDryRun(ctx context.Context, inputs IngressPeerArgs) IngressPeerState {
 	return IngressPeerState{
+		AccountScope:   currentAccountScope(ctx),
 		PeerID:         inputs.PeerID,
 		Enabled:        inputs.Enabled,
 		Fallback:       inputs.Fallback,
@@ -242,18 +263,19 @@ func ingressPeerDryRun(inputs IngressPeerArgs) IngressPeerState {
 }
 
 // ingressPeerStateFromAPI maps an API ingress peer into resource state.
-func ingressPeerStateFromAPI(peer nbapi.IngressPeer) IngressPeerState {
+func ingressPeerStateFromAPI(ctx context.Context, peer nbapi.IngressPeer) IngressPeerState {
 	ingressIP := peer.IngressIp
 	region := peer.Region
 	connected := peer.Connected
 
 	return IngressPeerState{
-		PeerID:    peer.PeerId,
-		Enabled:   peer.Enabled,
-		Fallback:  peer.Fallback,
-		IngressIP: &ingressIP,
-		Region:    &region,
-		Connected: &connected,
+		AccountScope: currentAccountScope(ctx),
+		PeerID:       peer.PeerId,
+		Enabled:      peer.Enabled,
+		Fallback:     peer.Fallback,
+		IngressIP:    &ingressIP,
+		Region:       &region,
+		Connected:    &connected,
 		AvailablePorts: &IngressAvailablePorts{
 			TCP: peer.AvailablePorts.Tcp,
 			UDP: peer.AvailablePorts.Udp,
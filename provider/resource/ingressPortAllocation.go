@@ -0,0 +1,562 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+const maxPort = 65535
+
+// IngressPortAllocation represents a port forwarding allocation for a peer.
+type IngressPortAllocation struct{}
+
+// Annotate adds a description to the IngressPortAllocation resource type.
+func (i *IngressPortAllocation) Annotate(a infer.Annotator) {
+	a.Describe(&i, "Forwards a port or port range of a NetBird peer through an ingress peer. "+
+		"NetBird picks the ingress peer and the public ports; they are reported as outputs. "+
+		"Import with the ID <peerID>/<allocationID>.")
+}
+
+// IngressProtocol defines the protocols an ingress port allocation forwards.
+type IngressProtocol string
+
+const (
+	// IngressProtocolTCP forwards TCP only.
+	IngressProtocolTCP IngressProtocol = IngressProtocol(nbapi.IngressPortAllocationRequestPortRangeProtocolTcp)
+	// IngressProtocolUDP forwards UDP only.
+	IngressProtocolUDP IngressProtocol = IngressProtocol(nbapi.IngressPortAllocationRequestPortRangeProtocolUdp)
+	// IngressProtocolTCPUDP forwards both TCP and UDP.
+	IngressProtocolTCPUDP IngressProtocol = IngressProtocol(nbapi.IngressPortAllocationRequestPortRangeProtocolTcpudp)
+)
+
+// Values returns the valid enum values for IngressProtocol.
+func (IngressProtocol) Values() []infer.EnumValue[IngressProtocol] {
+	return []infer.EnumValue[IngressProtocol]{
+		{Name: "tcp", Value: IngressProtocolTCP, Description: "TCP only."},
+		{Name: "udp", Value: IngressProtocolUDP, Description: "UDP only."},
+		{Name: "tcpUdp", Value: IngressProtocolTCPUDP, Description: "Both TCP and UDP."},
+	}
+}
+
+// IngressPortRange is an inclusive range of ports.
+type IngressPortRange struct {
+	Start int `pulumi:"start"`
+	End   int `pulumi:"end"`
+}
+
+// Annotate provides documentation for IngressPortRange fields.
+func (r *IngressPortRange) Annotate(a infer.Annotator) {
+	a.Describe(&r.Start, "First port of the range.")
+	a.Describe(&r.End, "Last port of the range, inclusive.")
+}
+
+// IngressPortAllocationArgs defines input fields for an ingress port allocation.
+type IngressPortAllocationArgs struct {
+	PeerID    string            `pulumi:"peerId"`
+	Name      string            `pulumi:"name"`
+	Protocol  IngressProtocol   `pulumi:"protocol"`
+	Port      *int              `pulumi:"port,optional"`
+	PortRange *IngressPortRange `pulumi:"portRange,optional"`
+	Region    *string           `pulumi:"region,optional"`
+	Enabled   bool              `pulumi:"enabled"`
+}
+
+// Annotate provides documentation for IngressPortAllocationArgs fields.
+func (i *IngressPortAllocationArgs) Annotate(a infer.Annotator) {
+	a.Describe(&i.PeerID, "ID of the peer whose ports are forwarded. Changing this forces a replacement.")
+	a.Describe(&i.Name, "Name of the allocation.")
+	a.Describe(&i.Protocol, "Protocol to forward: tcp, udp, or tcp/udp.")
+	a.Describe(&i.Port, "Single peer port to forward. Exactly one of port or portRange must be set.")
+	a.Describe(&i.PortRange, "Range of peer ports to forward. Exactly one of port or portRange must be set.")
+	a.Describe(&i.Region, "Ingress region the allocation must be served from. NetBird assigns the ingress peer itself, "+
+		"so creation fails if it picks one in another region. Changing this forces a replacement.")
+	a.Describe(&i.Enabled, "Whether the allocation is enabled.")
+}
+
+// IngressPortMapping maps public ingress ports to ports on the peer.
+type IngressPortMapping struct {
+	Protocol        string `pulumi:"protocol"`
+	IngressStart    int    `pulumi:"ingressStart"`
+	IngressEnd      int    `pulumi:"ingressEnd"`
+	TranslatedStart int    `pulumi:"translatedStart"`
+	TranslatedEnd   int    `pulumi:"translatedEnd"`
+}
+
+// Annotate provides documentation for IngressPortMapping fields.
+func (m *IngressPortMapping) Annotate(a infer.Annotator) {
+	a.Describe(&m.Protocol, "Protocol accepted by the ports.")
+	a.Describe(&m.IngressStart, "First public port on the ingress peer.")
+	a.Describe(&m.IngressEnd, "Last public port on the ingress peer.")
+	a.Describe(&m.TranslatedStart, "First port on the peer the traffic is forwarded to.")
+	a.Describe(&m.TranslatedEnd, "Last port on the peer the traffic is forwarded to.")
+}
+
+// IngressPortAllocationState represents the output state of an ingress port allocation.
+type IngressPortAllocationState struct {
+	AccountScope
+
+	IngressPortAllocationArgs
+
+	IngressPeerID    *string              `pulumi:"ingressPeerId,optional"`
+	IngressIP        *string              `pulumi:"ingressIp,optional"`
+	AssignedRegion   *string              `pulumi:"assignedRegion,optional"`
+	IngressPortStart *int                 `pulumi:"ingressPortStart,optional"`
+	IngressPortEnd   *int                 `pulumi:"ingressPortEnd,optional"`
+	PortMappings     []IngressPortMapping `pulumi:"portMappings,optional"`
+}
+
+// Annotate provides documentation for IngressPortAllocationState fields.
+func (i *IngressPortAllocationState) Annotate(a infer.Annotator) {
+	a.Describe(&i.IngressPeerID, "ID of the ingress peer that forwards the ports.")
+	a.Describe(&i.IngressIP, "Public IP address where the forwarded traffic arrives.")
+	a.Describe(&i.AssignedRegion, "Region of the assigned ingress peer.")
+	a.Describe(&i.IngressPortStart, "First public port assigned on the ingress peer.")
+	a.Describe(&i.IngressPortEnd, "Last public port assigned on the ingress peer.")
+	a.Describe(&i.PortMappings, "All port mappings of the allocation, one per protocol and range.")
+}
+
+// Create allocates the ingress ports.
+func (*IngressPortAllocation) Create(
+	ctx context.Context,
+	req infer.CreateRequest[IngressPortAllocationArgs],
+) (infer.CreateResponse[IngressPortAllocationState], error) {
+	p.GetLogger(ctx).Debugf("Create:IngressPortAllocation peerId=%s name=%s", req.Inputs.PeerID, req.Inputs.Name)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
+	if req.DryRun {
+		return infer.CreateResponse[IngressPortAllocationState]{
+			ID:     "preview",
+			Output: ingressPortAllocationDryRun(ctx, req.Inputs),
+		}, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.CreateResponse[IngressPortAllocationState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	if req.Inputs.Region != nil {
+		err = checkIngressRegion(ctx, client, *req.Inputs.Region)
+		if err != nil {
+			return infer.CreateResponse[IngressPortAllocationState]{}, err
+		}
+	}
+
+	ports := client.Peers.IngressPorts(req.Inputs.PeerID)
+
+	created, err := ports.Create(ctx, ingressPortAllocationRequest(req.Inputs))
+	if err != nil {
+		return infer.CreateResponse[IngressPortAllocationState]{}, fmt.Errorf("creating ingress port allocation failed: %w", err)
+	}
+
+	p.GetLogger(ctx).Debugf("Create:IngressPortAllocationAPI id=%s region=%s", created.Id, created.Region)
+
+	if req.Inputs.Region != nil && created.Region != *req.Inputs.Region {
+		// NetBird assigned an ingress peer elsewhere; do not leave it behind.
+		err = ports.Delete(ctx, created.Id)
+		if err != nil {
+			return infer.CreateResponse[IngressPortAllocationState]{}, fmt.Errorf(
+				"ingress port allocation %s was assigned to region %q instead of %q and could not be removed: %w",
+				created.Id, created.Region, *req.Inputs.Region, err)
+		}
+
+		return infer.CreateResponse[IngressPortAllocationState]{}, fmt.Errorf(
+			"NetBird assigned an ingress peer in region %q instead of %q; the allocation was removed",
+			created.Region, *req.Inputs.Region)
+	}
+
+	return infer.CreateResponse[IngressPortAllocationState]{
+		ID:     created.Id,
+		Output: ingressPortAllocationStateFromAPI(ctx, req.Inputs, *created),
+	}, nil
+}
+
+// Read fetches the current state of an ingress port allocation from NetBird.
+// Supports the compound import ID "peerID/allocationID".
+func (*IngressPortAllocation) Read(
+	ctx context.Context,
+	req infer.ReadRequest[IngressPortAllocationArgs, IngressPortAllocationState],
+) (infer.ReadResponse[IngressPortAllocationArgs, IngressPortAllocationState], error) {
+	p.GetLogger(ctx).Debugf("Read:IngressPortAllocation[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
+	peerID := req.State.PeerID
+	allocationID := req.ID
+
+	if peerID == "" {
+		var parseErr error
+
+		peerID, allocationID, parseErr = parseNestedID("IngressPortAllocation", req.ID)
+		if parseErr != nil {
+			return infer.ReadResponse[IngressPortAllocationArgs, IngressPortAllocationState]{}, parseErr
+		}
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.ReadResponse[IngressPortAllocationArgs, IngressPortAllocationState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	allocation, err := client.Peers.IngressPorts(peerID).Get(ctx, allocationID)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return infer.ReadResponse[IngressPortAllocationArgs, IngressPortAllocationState]{
+				ID:     "",
+				Inputs: IngressPortAllocationArgs{},  //nolint:exhaustruct
+				State:  IngressPortAllocationState{}, //nolint:exhaustruct
+			}, nil
+		}
+
+		return infer.ReadResponse[IngressPortAllocationArgs, IngressPortAllocationState]{}, fmt.Errorf("reading ingress port allocation failed: %w", err)
+	}
+
+	inputs := ingressPortAllocationArgsFromAPI(peerID, *allocation)
+	// The API does not report the requested region; keep it from prior state.
+	inputs.Region = req.State.Region
+
+	// Keep a one-port range written as portRange in that form.
+	if req.State.PortRange != nil && inputs.Port != nil {
+		inputs.PortRange = &IngressPortRange{Start: *inputs.Port, End: *inputs.Port}
+		inputs.Port = nil
+	}
+
+	state := ingressPortAllocationStateFromAPI(ctx, inputs, *allocation)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
+
+	return infer.ReadResponse[IngressPortAllocationArgs, IngressPortAllocationState]{
+		ID:     allocationID,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
+
+// Update changes the name, ports, protocol, or enabled flag of an allocation.
+func (*IngressPortAllocation) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[IngressPortAllocationArgs, IngressPortAllocationState],
+) (infer.UpdateResponse[IngressPortAllocationState], error) {
+	p.GetLogger(ctx).Debugf("Update:IngressPortAllocation[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
+	if req.DryRun {
+		return infer.UpdateResponse[IngressPortAllocationState]{
+			Output: ingressPortAllocationDryRun(ctx, req.Inputs),
+		}, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.UpdateResponse[IngressPortAllocationState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	updated, err := client.Peers.IngressPorts(req.State.PeerID).Update(ctx, req.ID, ingressPortAllocationRequest(req.Inputs))
+	if err != nil {
+		return infer.UpdateResponse[IngressPortAllocationState]{}, fmt.Errorf("updating ingress port allocation failed: %w", err)
+	}
+
+	return infer.UpdateResponse[IngressPortAllocationState]{
+		Output: ingressPortAllocationStateFromAPI(ctx, req.Inputs, *updated),
+	}, nil
+}
+
+// Delete releases the ingress ports.
+func (*IngressPortAllocation) Delete(ctx context.Context, req infer.DeleteRequest[IngressPortAllocationState]) (infer.DeleteResponse, error) {
+	p.GetLogger(ctx).Debugf("Delete:IngressPortAllocation[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	err = client.Peers.IngressPorts(req.State.PeerID).Delete(ctx, req.ID)
+	if err != nil && !isNotFoundErr(err) {
+		return infer.DeleteResponse{}, fmt.Errorf("deleting ingress port allocation failed: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
+
+// Diff detects changes between inputs and prior state.
+func (*IngressPortAllocation) Diff(
+	ctx context.Context,
+	req infer.DiffRequest[IngressPortAllocationArgs, IngressPortAllocationState],
+) (infer.DiffResponse, error) {
+	p.GetLogger(ctx).Debugf("Diff:IngressPortAllocation[%s]", req.ID)
+
+	diff := map[string]p.PropertyDiff{}
+
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
+	if req.Inputs.PeerID != req.State.PeerID {
+		diff["peerId"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+	}
+
+	if !equalPtr(req.Inputs.Region, req.State.Region) {
+		diff["region"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+	}
+
+	if req.Inputs.Name != req.State.Name {
+		diff["name"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	if req.Inputs.Protocol != req.State.Protocol {
+		diff["protocol"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	if ingressPortRangeOf(req.Inputs) != ingressPortRangeOf(req.State.IngressPortAllocationArgs) {
+		diff["portRange"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	if req.Inputs.Enabled != req.State.Enabled {
+		diff["enabled"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	return infer.DiffResponse{
+		DeleteBeforeReplace: false,
+		HasChanges:          len(diff) > 0,
+		DetailedDiff:        diff,
+	}, nil
+}
+
+// Check validates the forwarded ports and protocol.
+func (*IngressPortAllocation) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[IngressPortAllocationArgs], error) {
+	p.GetLogger(ctx).Debugf("Check:IngressPortAllocation old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
+
+	args, failures, err := infer.DefaultCheck[IngressPortAllocationArgs](ctx, req.NewInputs)
+	failures = append(failures, checkCapability(ctx, "peerId", config.CapabilityIngressPeers)...)
+
+	if isBlank(args.PeerID) {
+		failures = append(failures, p.CheckFailure{Property: "peerId", Reason: "peerId must not be empty"})
+	}
+
+	if isBlank(args.Name) {
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name must not be empty"})
+	}
+
+	if !slices.Contains([]IngressProtocol{IngressProtocolTCP, IngressProtocolUDP, IngressProtocolTCPUDP}, args.Protocol) {
+		failures = append(failures, p.CheckFailure{Property: "protocol", Reason: "protocol must be 'tcp', 'udp', or 'tcp/udp'"})
+	}
+
+	if args.Region != nil && isBlank(*args.Region) {
+		failures = append(failures, p.CheckFailure{Property: "region", Reason: "region must not be empty when set"})
+	}
+
+	failures = append(failures, checkIngressPorts(args)...)
+
+	return infer.CheckResponse[IngressPortAllocationArgs]{
+		Inputs:   args,
+		Failures: failures,
+	}, err
+}
+
+// WireDependencies explicitly defines input/output relationships.
+func (*IngressPortAllocation) WireDependencies(
+	field infer.FieldSelector,
+	args *IngressPortAllocationArgs,
+	state *IngressPortAllocationState,
+) {
+	field.OutputField(&state.PeerID).DependsOn(field.InputField(&args.PeerID))
+	field.OutputField(&state.Name).DependsOn(field.InputField(&args.Name))
+	field.OutputField(&state.Protocol).DependsOn(field.InputField(&args.Protocol))
+	field.OutputField(&state.Port).DependsOn(field.InputField(&args.Port))
+	field.OutputField(&state.PortRange).DependsOn(field.InputField(&args.PortRange))
+	field.OutputField(&state.Region).DependsOn(field.InputField(&args.Region))
+	field.OutputField(&state.Enabled).DependsOn(field.InputField(&args.Enabled))
+}
+
+// checkIngressPorts validates that exactly one of port and portRange is set
+// and that it holds valid port numbers.
+func checkIngressPorts(args IngressPortAllocationArgs) []p.CheckFailure {
+	switch {
+	case args.Port != nil && args.PortRange != nil:
+		return []p.CheckFailure{{Property: "portRange", Reason: "port and portRange are mutually exclusive"}}
+	case args.Port != nil:
+		if *args.Port < 1 || *args.Port > maxPort {
+			return []p.CheckFailure{{Property: "port", Reason: fmt.Sprintf("port must be between 1 and %d", maxPort)}}
+		}
+	case args.PortRange != nil:
+		var failures []p.CheckFailure
+
+		for property, port := range map[string]int{"portRange.start": args.PortRange.Start, "portRange.end": args.PortRange.End} {
+			if port < 1 || port > maxPort {
+				failures = append(failures, p.CheckFailure{Property: property, Reason: fmt.Sprintf("port must be between 1 and %d", maxPort)})
+			}
+		}
+
+		if args.PortRange.Start > args.PortRange.End {
+			failures = append(failures, p.CheckFailure{Property: "portRange", Reason: "portRange.start must not be greater than portRange.end"})
+		}
+
+		slices.SortFunc(failures, func(a, b p.CheckFailure) int { return strings.Compare(a.Property, b.Property) })
+
+		return failures
+	default:
+		return []p.CheckFailure{{Property: "port", Reason: "exactly one of port or portRange must be set"}}
+	}
+
+	return nil
+}
+
+// checkIngressRegion verifies that an enabled ingress peer serves the region,
+// so that an allocation there can succeed.
+func checkIngressRegion(ctx context.Context, client *rest.Client, region string) error {
+	peers, err := client.Ingress.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing ingress peers failed: %w", err)
+	}
+
+	var regions []string
+
+	for _, peer := range peers {
+		if !peer.Enabled {
+			continue
+		}
+
+		if peer.Region == region {
+			return nil
+		}
+
+		if !slices.Contains(regions, peer.Region) {
+			regions = append(regions, peer.Region)
+		}
+	}
+
+	if len(regions) == 0 {
+		return errors.New("no enabled ingress peer is available")
+	}
+
+	slices.Sort(regions)
+
+	return fmt.Errorf("no enabled ingress peer in region %q; available regions: %s", region, strings.Join(regions, ", "))
+}
+
+// ingressPortRangeOf returns the forwarded ports of args as a range.
+func ingressPortRangeOf(args IngressPortAllocationArgs) IngressPortRange {
+	if args.Port != nil {
+		return IngressPortRange{Start: *args.Port, End: *args.Port}
+	}
+
+	if args.PortRange != nil {
+		return *args.PortRange
+	}
+
+	return IngressPortRange{Start: 0, End: 0}
+}
+
+// ingressPortAllocationRequest builds the API request for args.
+func ingressPortAllocationRequest(args IngressPortAllocationArgs) nbapi.IngressPortAllocationRequest {
+	ports := ingressPortRangeOf(args)
+
+	return nbapi.IngressPortAllocationRequest{
+		Name:       args.Name,
+		Enabled:    args.Enabled,
+		DirectPort: nil,
+		PortRanges: &[]nbapi.IngressPortAllocationRequestPortRange{{
+			Start:    ports.Start,
+			End:      ports.End,
+			Protocol: nbapi.IngressPortAllocationRequestPortRangeProtocol(args.Protocol),
+		}},
+	}
+}
+
+// ingressPortAllocationArgsFromAPI recovers the inputs of an allocation from
+// its first port mapping. A single port is reported as port, a range as portRange.
+func ingressPortAllocationArgsFromAPI(peerID string, allocation nbapi.IngressPortAllocation) IngressPortAllocationArgs {
+	args := IngressPortAllocationArgs{
+		PeerID:    peerID,
+		Name:      allocation.Name,
+		Protocol:  "",
+		Port:      nil,
+		PortRange: nil,
+		Region:    nil,
+		Enabled:   allocation.Enabled,
+	}
+
+	if len(allocation.PortRangeMappings) == 0 {
+		return args
+	}
+
+	mapping := allocation.PortRangeMappings[0]
+	args.Protocol = IngressProtocol(mapping.Protocol)
+
+	if mapping.TranslatedStart == mapping.TranslatedEnd {
+		port := mapping.TranslatedStart
+		args.Port = &port
+	} else {
+		args.PortRange = &IngressPortRange{Start: mapping.TranslatedStart, End: mapping.TranslatedEnd}
+	}
+
+	return args
+}
+
+// ingressPortAllocationDryRun builds a preview state from inputs alone.
+func ingressPortAllocationDryRun(ctx context.Context, inputs IngressPortAllocationArgs) IngressPortAllocationState {
+	return IngressPortAllocationState{
+		AccountScope:              currentAccountScope(ctx),
+		IngressPortAllocationArgs: inputs,
+		IngressPeerID:             nil,
+		IngressIP:                 nil,
+		AssignedRegion:            nil,
+		IngressPortStart:          nil,
+		IngressPortEnd:            nil,
+		PortMappings:              nil,
+	}
+}
+
+// ingressPortAllocationStateFromAPI maps an API allocation into resource state.
+func ingressPortAllocationStateFromAPI(
+	ctx context.Context,
+	inputs IngressPortAllocationArgs,
+	allocation nbapi.IngressPortAllocation,
+) IngressPortAllocationState {
+	ingressPeerID := allocation.IngressPeerId
+	ingressIP := allocation.IngressIp
+	region := allocation.Region
+
+	mappings := make([]IngressPortMapping, len(allocation.PortRangeMappings))
+	for i, mapping := range allocation.PortRangeMappings {
+		mappings[i] = IngressPortMapping{
+			Protocol:        string(mapping.Protocol),
+			IngressStart:    mapping.IngressStart,
+			IngressEnd:      mapping.IngressEnd,
+			TranslatedStart: mapping.TranslatedStart,
+			TranslatedEnd:   mapping.TranslatedEnd,
+		}
+	}
+
+	state := IngressPortAllocationState{
+		AccountScope:              currentAccountScope(ctx),
+		IngressPortAllocationArgs: inputs,
+		IngressPeerID:             &ingressPeerID,
+		IngressIP:                 &ingressIP,
+		AssignedRegion:            &region,
+		IngressPortStart:          nil,
+		IngressPortEnd:            nil,
+		PortMappings:              mappings,
+	}
+
+	if len(mappings) > 0 {
+		state.IngressPortStart = &mappings[0].IngressStart
+		state.IngressPortEnd = &mappings[0].IngressEnd
+	}
+
+	return state
+}
@@ -32,6 +32,8 @@ func (n *NetworkArgs) Annotate(a infer.Annotator) {
 
 // NetworkState represents the output state of a network resource.
 type NetworkState struct {
+	AccountScope
+
 	Name        string  `pulumi:"name"`
 	Description *string `pulumi:"description,optional"`
 }
@@ -46,12 +48,16 @@ func (n *NetworkState) Annotate(a infer.Annotator) {
 func (*Network) Create(ctx context.Context, req infer.CreateRequest[NetworkArgs]) (infer.CreateResponse[NetworkState], error) {
 	p.GetLogger(ctx).Debugf("Create:Network name=%s, description=%s", req.Inputs.Name, strPtr(req.Inputs.Description))
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[NetworkState]{
 			ID: "preview",
 			Output: NetworkState{
-				Name:        req.Inputs.Name,
-				Description: req.Inputs.Description,
+				AccountScope: currentAccountScope(ctx),
+				Name:         req.Inputs.Name,
+				Description:  req.Inputs.Description,
 			},
 		}, nil
 	}
@@ -74,8 +80,9 @@ func (*Network) Create(ctx context.Context, req infer.CreateRequest[NetworkArgs]
 	return infer.CreateResponse[NetworkState]{
 		ID: net.Id,
 		Output: NetworkState{
-			Name:        net.Name,
-			Description: net.Description,
+			AccountScope: currentAccountScope(ctx),
+			Name:         net.Name,
+			Description:  net.Description,
 		},
 	}, nil
 }
@@ -83,6 +90,10 @@ func (*Network) Create(ctx context.Context, req infer.CreateRequest[NetworkArgs]
 // Read fetches the current state of a network from NetBird.
 func (*Network) Read(ctx context.Context, req infer.ReadRequest[NetworkArgs, NetworkState]) (infer.ReadResponse[NetworkArgs, NetworkState], error) {
 	p.GetLogger(ctx).Debugf("Read:NetworkArgs[%s] name=%s", req.ID, req.Inputs.Name)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	p.GetLogger(ctx).Debugf("Read:NetworkState[%s] name=%s, id=%s", req.ID, req.State.Name, req.ID)
 
 	client, err := config.GetNetBirdClient(ctx)
@@ -117,8 +128,9 @@ func (*Network) Read(ctx context.Context, req infer.ReadRequest[NetworkArgs, Net
 			Description: req.Inputs.Description,
 		},
 		State: NetworkState{
-			Name:        net.Name,
-			Description: stateDescription,
+			AccountScope: readAccountScope(ctx, req.State.AccountScope),
+			Name:         net.Name,
+			Description:  stateDescription,
 		},
 	}, nil
 }
@@ -127,11 +139,15 @@ func (*Network) Read(ctx context.Context, req infer.ReadRequest[NetworkArgs, Net
 func (*Network) Update(ctx context.Context, req infer.UpdateRequest[NetworkArgs, NetworkState]) (infer.UpdateResponse[NetworkState], error) {
 	p.GetLogger(ctx).Debugf("Update:Network[%s] name=%s", req.ID, req.Inputs.Name)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[NetworkState]{
 			Output: NetworkState{
-				Name:        req.Inputs.Name,
-				Description: req.Inputs.Description,
+				AccountScope: currentAccountScope(ctx),
+				Name:         req.Inputs.Name,
+				Description:  req.Inputs.Description,
 			},
 		}, nil
 	}
@@ -151,8 +167,9 @@ func (*Network) Update(ctx context.Context, req infer.UpdateRequest[NetworkArgs,
 
 	return infer.UpdateResponse[NetworkState]{
 		Output: NetworkState{
-			Name:        req.Inputs.Name,
-			Description: req.Inputs.Description,
+			AccountScope: currentAccountScope(ctx),
+			Name:         req.Inputs.Name,
+			Description:  req.Inputs.Description,
 		},
 	}, nil
 }
@@ -161,6 +178,9 @@ func (*Network) Update(ctx context.Context, req infer.UpdateRequest[NetworkArgs,
 func (*Network) Delete(ctx context.Context, req infer.DeleteRequest[NetworkState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:Network[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -180,6 +200,8 @@ func (*Network) Diff(ctx context.Context, req infer.DiffRequest[NetworkArgs, Net
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{
 			InputDiff: false,
@@ -226,3 +248,12 @@ func (*Network) WireDependencies(f infer.FieldSelector, args *NetworkArgs, state
 	f.OutputField(&state.Name).DependsOn(f.InputField(&args.Name))
 	f.OutputField(&state.Description).DependsOn(f.InputField(&args.Description))
 }
+
+// NetworkStateFromAPI builds a NetworkState from an API Network response.
+func NetworkStateFromAPI(ctx context.Context, net *nbapi.Network) NetworkState {
+	return NetworkState{
+		AccountScope: currentAccountScope(ctx),
+		Name:         net.Name,
+		Description:  net.Description,
+	}
+}
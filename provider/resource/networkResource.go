@@ -41,6 +41,8 @@ func (a *NetworkResourceArgs) Annotate(annotator infer.Annotator) {
 
 // NetworkResourceState represents the state of a network resource.
 type NetworkResourceState struct {
+	AccountScope
+
 	Name        string   `pulumi:"name"`
 	Description *string  `pulumi:"description,optional"`
 	NetworkID   string   `pulumi:"networkID"`
@@ -63,18 +65,22 @@ func (s *NetworkResourceState) Annotate(annotator infer.Annotator) {
 func (*NetworkResource) Create(ctx context.Context, req infer.CreateRequest[NetworkResourceArgs]) (infer.CreateResponse[NetworkResourceState], error) {
 	p.GetLogger(ctx).Debugf("Create:NetworkResource name=%s, description=%s net_id=%s", req.Inputs.Name, strPtr(req.Inputs.Description), req.Inputs.NetworkID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	groupIDs := sortedStrings(req.Inputs.GroupIDs)
 
 	if req.DryRun {
 		return infer.CreateResponse[NetworkResourceState]{
 			ID: "preview",
 			Output: NetworkResourceState{
-				Name:        req.Inputs.Name,
-				Description: req.Inputs.Description,
-				NetworkID:   req.Inputs.NetworkID,
-				Address:     req.Inputs.Address,
-				Enabled:     req.Inputs.Enabled,
-				GroupIDs:    groupIDs,
+				AccountScope: currentAccountScope(ctx),
+				Name:         req.Inputs.Name,
+				Description:  req.Inputs.Description,
+				NetworkID:    req.Inputs.NetworkID,
+				Address:      req.Inputs.Address,
+				Enabled:      req.Inputs.Enabled,
+				GroupIDs:     groupIDs,
 			},
 		}, nil
 	}
@@ -100,12 +106,13 @@ func (*NetworkResource) Create(ctx context.Context, req infer.CreateRequest[Netw
 	return infer.CreateResponse[NetworkResourceState]{
 		ID: net.Id,
 		Output: NetworkResourceState{
-			Name:        net.Name,
-			Description: net.Description,
-			NetworkID:   req.Inputs.NetworkID,
-			Address:     net.Address,
-			Enabled:     net.Enabled,
-			GroupIDs:    getNetworkResourceGroupIDs(net),
+			AccountScope: currentAccountScope(ctx),
+			Name:         net.Name,
+			Description:  net.Description,
+			NetworkID:    req.Inputs.NetworkID,
+			Address:      net.Address,
+			Enabled:      net.Enabled,
+			GroupIDs:     getNetworkResourceGroupIDs(net),
 		},
 	}, nil
 }
@@ -113,6 +120,10 @@ func (*NetworkResource) Create(ctx context.Context, req infer.CreateRequest[Netw
 // Read fetches the current state of a network resource from NetBird.
 func (*NetworkResource) Read(ctx context.Context, req infer.ReadRequest[NetworkResourceArgs, NetworkResourceState]) (infer.ReadResponse[NetworkResourceArgs, NetworkResourceState], error) {
 	p.GetLogger(ctx).Debugf("Read:NetworkReourceArgs[%s] name=%s", req.ID, req.Inputs.Name)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	p.GetLogger(ctx).Debugf("Read:NetworkResourceState[%s] name=%s, netd_id=%s", req.ID, req.State.Name, req.State.NetworkID)
 
 	// Support compound import ID "networkID/resourceID" when state has no networkID yet.
@@ -165,12 +176,13 @@ func (*NetworkResource) Read(ctx context.Context, req infer.ReadRequest[NetworkR
 			GroupIDs:    getNetworkResourceGroupIDs(net),
 		},
 		State: NetworkResourceState{
-			Name:        net.Name,
-			Description: stateDescription,
-			NetworkID:   networkID,
-			Address:     net.Address,
-			Enabled:     net.Enabled,
-			GroupIDs:    getNetworkResourceGroupIDs(net),
+			AccountScope: readAccountScope(ctx, req.State.AccountScope),
+			Name:         net.Name,
+			Description:  stateDescription,
+			NetworkID:    networkID,
+			Address:      net.Address,
+			Enabled:      net.Enabled,
+			GroupIDs:     getNetworkResourceGroupIDs(net),
 		},
 	}, nil
 }
@@ -179,17 +191,21 @@ func (*NetworkResource) Read(ctx context.Context, req infer.ReadRequest[NetworkR
 func (*NetworkResource) Update(ctx context.Context, req infer.UpdateRequest[NetworkResourceArgs, NetworkResourceState]) (infer.UpdateResponse[NetworkResourceState], error) {
 	p.GetLogger(ctx).Debugf("Update:NetworkResource[%s] name=%s", req.ID, req.Inputs.Name)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	groupIDs := sortedStrings(req.Inputs.GroupIDs)
 
 	if req.DryRun {
 		return infer.UpdateResponse[NetworkResourceState]{
 			Output: NetworkResourceState{
-				Name:        req.Inputs.Name,
-				Description: req.Inputs.Description,
-				NetworkID:   req.Inputs.NetworkID,
-				Address:     req.Inputs.Address,
-				Enabled:     req.Inputs.Enabled,
-				GroupIDs:    groupIDs,
+				AccountScope: currentAccountScope(ctx),
+				Name:         req.Inputs.Name,
+				Description:  req.Inputs.Description,
+				NetworkID:    req.Inputs.NetworkID,
+				Address:      req.Inputs.Address,
+				Enabled:      req.Inputs.Enabled,
+				GroupIDs:     groupIDs,
 			},
 		}, nil
 	}
@@ -212,12 +228,13 @@ func (*NetworkResource) Update(ctx context.Context, req infer.UpdateRequest[Netw
 
 	return infer.UpdateResponse[NetworkResourceState]{
 		Output: NetworkResourceState{
-			Name:        net.Name,
-			Description: net.Description,
-			NetworkID:   req.Inputs.NetworkID,
-			Address:     net.Address,
-			Enabled:     net.Enabled,
-			GroupIDs:    getNetworkResourceGroupIDs(net),
+			AccountScope: currentAccountScope(ctx),
+			Name:         net.Name,
+			Description:  net.Description,
+			NetworkID:    req.Inputs.NetworkID,
+			Address:      net.Address,
+			Enabled:      net.Enabled,
+			GroupIDs:     getNetworkResourceGroupIDs(net),
 		},
 	}, nil
 }
@@ -226,6 +243,9 @@ func (*NetworkResource) Update(ctx context.Context, req infer.UpdateRequest[Netw
 func (*NetworkResource) Delete(ctx context.Context, req infer.DeleteRequest[NetworkResourceState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:NetworkResource[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -245,6 +265,8 @@ func (*NetworkResource) Diff(ctx context.Context, req infer.DiffRequest[NetworkR
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.NetworkID != req.State.NetworkID {
 		diff["networkID"] = p.PropertyDiff{
 			InputDiff: false,
@@ -360,3 +382,17 @@ func getNetworkResourceGroupIDs(net *nbapi.NetworkResource) []string {
 
 	return groupIDs
 }
+
+// NetworkResourceStateFromAPI builds a NetworkResourceState from an API
+// NetworkResource response. The API omits the parent network, so it is passed in.
+func NetworkResourceStateFromAPI(ctx context.Context, networkID string, net *nbapi.NetworkResource) NetworkResourceState {
+	return NetworkResourceState{
+		AccountScope: currentAccountScope(ctx),
+		Name:         net.Name,
+		Description:  net.Description,
+		NetworkID:    networkID,
+		Address:      net.Address,
+		Enabled:      net.Enabled,
+		GroupIDs:     getNetworkResourceGroupIDs(net),
+	}
+}
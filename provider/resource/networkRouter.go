@@ -40,6 +40,8 @@ func (a *NetworkRouterArgs) Annotate(annotator infer.Annotator) {
 
 // NetworkRouterState represents the state of a network router.
 type NetworkRouterState struct {
+	AccountScope
+
 	NetworkID  string    `pulumi:"networkID"`
 	Enabled    bool      `pulumi:"enabled"`
 	Masquerade bool      `pulumi:"masquerade"`
@@ -62,16 +64,20 @@ func (s *NetworkRouterState) Annotate(annotator infer.Annotator) {
 func (*NetworkRouter) Create(ctx context.Context, req infer.CreateRequest[NetworkRouterArgs]) (infer.CreateResponse[NetworkRouterState], error) {
 	p.GetLogger(ctx).Debugf("Create:NetworkRouter networkID=%s", req.Inputs.NetworkID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[NetworkRouterState]{
 			ID: "preview",
 			Output: NetworkRouterState{
-				NetworkID:  req.Inputs.NetworkID,
-				Enabled:    req.Inputs.Enabled,
-				Masquerade: req.Inputs.Masquerade,
-				Metric:     req.Inputs.Metric,
-				Peer:       req.Inputs.Peer,
-				PeerGroups: req.Inputs.PeerGroups,
+				AccountScope: currentAccountScope(ctx),
+				NetworkID:    req.Inputs.NetworkID,
+				Enabled:      req.Inputs.Enabled,
+				Masquerade:   req.Inputs.Masquerade,
+				Metric:       req.Inputs.Metric,
+				Peer:         req.Inputs.Peer,
+				PeerGroups:   req.Inputs.PeerGroups,
 			},
 		}, nil
 	}
@@ -97,12 +103,13 @@ func (*NetworkRouter) Create(ctx context.Context, req infer.CreateRequest[Networ
 	return infer.CreateResponse[NetworkRouterState]{
 		ID: router.Id,
 		Output: NetworkRouterState{
-			NetworkID:  req.Inputs.NetworkID,
-			Enabled:    router.Enabled,
-			Masquerade: router.Masquerade,
-			Metric:     router.Metric,
-			Peer:       router.Peer,
-			PeerGroups: router.PeerGroups,
+			AccountScope: currentAccountScope(ctx),
+			NetworkID:    req.Inputs.NetworkID,
+			Enabled:      router.Enabled,
+			Masquerade:   router.Masquerade,
+			Metric:       router.Metric,
+			Peer:         router.Peer,
+			PeerGroups:   router.PeerGroups,
 		},
 	}, nil
 }
@@ -111,6 +118,9 @@ func (*NetworkRouter) Create(ctx context.Context, req infer.CreateRequest[Networ
 func (*NetworkRouter) Read(ctx context.Context, req infer.ReadRequest[NetworkRouterArgs, NetworkRouterState]) (infer.ReadResponse[NetworkRouterArgs, NetworkRouterState], error) {
 	p.GetLogger(ctx).Debugf("Read:NetworkRouter[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	// Support compound import ID "networkID/routerID" when state has no networkID yet.
 	networkID := req.State.NetworkID
 
@@ -156,12 +166,13 @@ func (*NetworkRouter) Read(ctx context.Context, req infer.ReadRequest[NetworkRou
 			PeerGroups: router.PeerGroups,
 		},
 		State: NetworkRouterState{
-			NetworkID:  networkID,
-			Enabled:    router.Enabled,
-			Masquerade: router.Masquerade,
-			Metric:     router.Metric,
-			Peer:       router.Peer,
-			PeerGroups: router.PeerGroups,
+			AccountScope: readAccountScope(ctx, req.State.AccountScope),
+			NetworkID:    networkID,
+			Enabled:      router.Enabled,
+			Masquerade:   router.Masquerade,
+			Metric:       router.Metric,
+			Peer:         router.Peer,
+			PeerGroups:   router.PeerGroups,
 		},
 	}, nil
 }
@@ -170,15 +181,19 @@ func (*NetworkRouter) Read(ctx context.Context, req infer.ReadRequest[NetworkRou
 func (*NetworkRouter) Update(ctx context.Context, req infer.UpdateRequest[NetworkRouterArgs, NetworkRouterState]) (infer.UpdateResponse[NetworkRouterState], error) {
 	p.GetLogger(ctx).Debugf("Update:NetworkRouter[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[NetworkRouterState]{
 			Output: NetworkRouterState{
-				NetworkID:  req.Inputs.NetworkID,
-				Enabled:    req.Inputs.Enabled,
-				Masquerade: req.Inputs.Masquerade,
-				Metric:     req.Inputs.Metric,
-				Peer:       req.Inputs.Peer,
-				PeerGroups: req.Inputs.PeerGroups,
+				AccountScope: currentAccountScope(ctx),
+				NetworkID:    req.Inputs.NetworkID,
+				Enabled:      req.Inputs.Enabled,
+				Masquerade:   req.Inputs.Masquerade,
+				Metric:       req.Inputs.Metric,
+				Peer:         req.Inputs.Peer,
+				PeerGroups:   req.Inputs.PeerGroups,
 			},
 		}, nil
 	}
@@ -201,12 +216,13 @@ func (*NetworkRouter) Update(ctx context.Context, req infer.UpdateRequest[Networ
 
 	return infer.UpdateResponse[NetworkRouterState]{
 		Output: NetworkRouterState{
-			NetworkID:  req.Inputs.NetworkID,
-			Enabled:    router.Enabled,
-			Masquerade: router.Masquerade,
-			Metric:     router.Metric,
-			Peer:       router.Peer,
-			PeerGroups: router.PeerGroups,
+			AccountScope: currentAccountScope(ctx),
+			NetworkID:    req.Inputs.NetworkID,
+			Enabled:      router.Enabled,
+			Masquerade:   router.Masquerade,
+			Metric:       router.Metric,
+			Peer:         router.Peer,
+			PeerGroups:   router.PeerGroups,
 		},
 	}, nil
 }
@@ -215,6 +231,9 @@ func (*NetworkRouter) Update(ctx context.Context, req infer.UpdateRequest[Networ
 func (*NetworkRouter) Delete(ctx context.Context, req infer.DeleteRequest[NetworkRouterState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:NetworkRouter[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -234,6 +253,8 @@ func (*NetworkRouter) Diff(ctx context.Context, req infer.DiffRequest[NetworkRou
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.NetworkID != req.State.NetworkID {
 		diff["networkID"] = p.PropertyDiff{
 			InputDiff: false,
@@ -347,3 +368,17 @@ func (*NetworkRouter) WireDependencies(field infer.FieldSelector, args *NetworkR
 	field.OutputField(&state.Peer).DependsOn(field.InputField(&args.Peer))
 	field.OutputField(&state.PeerGroups).DependsOn(field.InputField(&args.PeerGroups))
 }
+
+// NetworkRouterStateFromAPI builds a NetworkRouterState from an API NetworkRouter
+// response. The API omits the parent network, so it is passed in.
+func NetworkRouterStateFromAPI(ctx context.Context, networkID string, router *nbapi.NetworkRouter) NetworkRouterState {
+	return NetworkRouterState{
+		AccountScope: currentAccountScope(ctx),
+		NetworkID:    networkID,
+		Enabled:      router.Enabled,
+		Masquerade:   router.Masquerade,
+		Metric:       router.Metric,
+		Peer:         router.Peer,
+		PeerGroups:   router.PeerGroups,
+	}
+}
@@ -39,6 +39,8 @@ func (o *OktaScimIDPArgs) Annotate(a infer.Annotator) {
 
 // OktaScimIDPState represents the output state of an Okta SCIM integration.
 type OktaScimIDPState struct {
+	AccountScope
+
 	ConnectionName    string    `pulumi:"connectionName"`
 	Enabled           *bool     `pulumi:"enabled,optional"`
 	ConnectorID       *string   `pulumi:"connectorId,optional"`
@@ -63,10 +65,13 @@ func (o *OktaScimIDPState) Annotate(annotator infer.Annotator) {
 func (*OktaScimIDP) Create(ctx context.Context, req infer.CreateRequest[OktaScimIDPArgs]) (infer.CreateResponse[OktaScimIDPState], error) {
 	p.GetLogger(ctx).Debugf("Create:OktaScimIDP connectionName=%s", req.Inputs.ConnectionName)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[OktaScimIDPState]{
 			ID:     "preview",
-			Output: oktaScimIDPStateFromArgs(req.Inputs, nil, nil),
+			Output: oktaScimIDPStateFromArgs(ctx, req.Inputs, nil, nil),
 		}, nil
 	}
 
@@ -89,7 +94,7 @@ func (*OktaScimIDP) Create(ctx context.Context, req infer.CreateRequest[OktaScim
 
 	return infer.CreateResponse[OktaScimIDPState]{
 		ID:     strconv.FormatInt(created.Id, 10),
-		Output: oktaScimIDPStateFromAPI(req.Inputs.ConnectionName, &authToken, *created),
+		Output: oktaScimIDPStateFromAPI(ctx, req.Inputs.ConnectionName, &authToken, *created),
 	}, nil
 }
 
@@ -97,6 +102,9 @@ func (*OktaScimIDP) Create(ctx context.Context, req infer.CreateRequest[OktaScim
 func (*OktaScimIDP) Read(ctx context.Context, req infer.ReadRequest[OktaScimIDPArgs, OktaScimIDPState]) (infer.ReadResponse[OktaScimIDPArgs, OktaScimIDPState], error) {
 	p.GetLogger(ctx).Debugf("Read:OktaScimIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[OktaScimIDPArgs, OktaScimIDPState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -116,6 +124,9 @@ func (*OktaScimIDP) Read(ctx context.Context, req infer.ReadRequest[OktaScimIDPA
 	}
 
 	// connectionName is not returned by the API; auth token is masked on read.
+	state := oktaScimIDPStateFromAPI(ctx, req.State.ConnectionName, req.State.AuthToken, *idp)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
+
 	return infer.ReadResponse[OktaScimIDPArgs, OktaScimIDPState]{
 		ID: req.ID,
 		Inputs: OktaScimIDPArgs{
@@ -125,7 +136,7 @@ func (*OktaScimIDP) Read(ctx context.Context, req infer.ReadRequest[OktaScimIDPA
 			GroupPrefixes:     &idp.GroupPrefixes,
 			UserGroupPrefixes: &idp.UserGroupPrefixes,
 		},
-		State: oktaScimIDPStateFromAPI(req.State.ConnectionName, req.State.AuthToken, *idp),
+		State: state,
 	}, nil
 }
 
@@ -133,9 +144,12 @@ func (*OktaScimIDP) Read(ctx context.Context, req infer.ReadRequest[OktaScimIDPA
 func (*OktaScimIDP) Update(ctx context.Context, req infer.UpdateRequest[OktaScimIDPArgs, OktaScimIDPState]) (infer.UpdateResponse[OktaScimIDPState], error) {
 	p.GetLogger(ctx).Debugf("Update:OktaScimIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[OktaScimIDPState]{
-			Output: oktaScimIDPStateFromArgs(req.Inputs, req.State.AuthToken, req.State.LastSyncedAt),
+			Output: oktaScimIDPStateFromArgs(ctx, req.Inputs, req.State.AuthToken, req.State.LastSyncedAt),
 		}, nil
 	}
 
@@ -155,7 +169,7 @@ func (*OktaScimIDP) Update(ctx context.Context, req infer.UpdateRequest[OktaScim
 	}
 
 	return infer.UpdateResponse[OktaScimIDPState]{
-		Output: oktaScimIDPStateFromAPI(req.Inputs.ConnectionName, req.State.AuthToken, *updated),
+		Output: oktaScimIDPStateFromAPI(ctx, req.Inputs.ConnectionName, req.State.AuthToken, *updated),
 	}, nil
 }
 
@@ -163,6 +177,9 @@ func (*OktaScimIDP) Update(ctx context.Context, req infer.UpdateRequest[OktaScim
 func (*OktaScimIDP) Delete(ctx context.Context, req infer.DeleteRequest[OktaScimIDPState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:OktaScimIDP[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -182,6 +199,8 @@ func (*OktaScimIDP) Diff(ctx context.Context, req infer.DiffRequest[OktaScimIDPA
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.ConnectionName != req.State.ConnectionName {
 		diff["connectionName"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
 	}
@@ -239,8 +258,9 @@ func (*OktaScimIDP) WireDependencies(field infer.FieldSelector, args *OktaScimID
 	field.OutputField(&state.UserGroupPrefixes).DependsOn(field.InputField(&args.UserGroupPrefixes))
 }
 
-func oktaScimIDPStateFromArgs(args OktaScimIDPArgs, authToken *string, lastSyncedAt *string) OktaScimIDPState {
+func oktaScimIDPStateFromArgs(ctx context.Context, args OktaScimIDPArgs, authToken *string, lastSyncedAt *string) OktaScimIDPState {
 	return OktaScimIDPState{
+		AccountScope:      currentAccountScope(ctx),
 		ConnectionName:    args.ConnectionName,
 		Enabled:           args.Enabled,
 		ConnectorID:       args.ConnectorID,
@@ -251,10 +271,11 @@ func oktaScimIDPStateFromArgs(args OktaScimIDPArgs, authToken *string, lastSynce
 	}
 }
 
-func oktaScimIDPStateFromAPI(connectionName string, authToken *string, idp nbapi.OktaScimIntegration) OktaScimIDPState {
+func oktaScimIDPStateFromAPI(ctx context.Context, connectionName string, authToken *string, idp nbapi.OktaScimIntegration) OktaScimIDPState {
 	lastSynced := idp.LastSyncedAt.Format(idpTimeFormat)
 
 	return OktaScimIDPState{
+		AccountScope:      currentAccountScope(ctx),
 		ConnectionName:    connectionName,
 		Enabled:           &idp.Enabled,
 		ConnectorID:       idp.ConnectorId,
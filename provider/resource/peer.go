@@ -40,6 +40,8 @@ func (p *PeerArgs) Annotate(a infer.Annotator) {
 
 // PeerState represents the state of the peer resource.
 type PeerState struct {
+	AccountScope
+
 	Name                        string `pulumi:"name"`
 	InactivityExpirationEnabled bool   `pulumi:"inactivityExpirationEnabled,optional"`
 	LoginExpirationEnabled      bool   `pulumi:"loginExpirationEnabled,optional"`
@@ -58,8 +60,9 @@ func (p *PeerState) Annotate(a infer.Annotator) {
 }
 
 // Create is a no-op; peers must be imported.
-func (*Peer) Create(_ context.Context, req infer.CreateRequest[PeerArgs]) (infer.CreateResponse[PeerState], error) {
+func (*Peer) Create(ctx context.Context, req infer.CreateRequest[PeerArgs]) (infer.CreateResponse[PeerState], error) {
 	state := PeerState{
+		AccountScope:                currentAccountScope(ctx),
 		Name:                        req.Inputs.Name,
 		InactivityExpirationEnabled: req.Inputs.InactivityExpirationEnabled,
 		LoginExpirationEnabled:      req.Inputs.LoginExpirationEnabled,
@@ -81,6 +84,9 @@ func (*Peer) Create(_ context.Context, req infer.CreateRequest[PeerArgs]) (infer
 func (*Peer) Read(ctx context.Context, req infer.ReadRequest[PeerArgs, PeerState]) (infer.ReadResponse[PeerArgs, PeerState], error) {
 	p.GetLogger(ctx).Debugf("Read:Peer[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[PeerArgs, PeerState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -103,6 +109,7 @@ func (*Peer) Read(ctx context.Context, req infer.ReadRequest[PeerArgs, PeerState
 			ApprovalRequired:            nil,
 		},
 		State: PeerState{
+			AccountScope:                readAccountScope(ctx, req.State.AccountScope),
 			Name:                        peer.Name,
 			InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
 			LoginExpirationEnabled:      peer.LoginExpirationEnabled,
@@ -116,9 +123,13 @@ func (*Peer) Read(ctx context.Context, req infer.ReadRequest[PeerArgs, PeerState
 func (*Peer) Update(ctx context.Context, req infer.UpdateRequest[PeerArgs, PeerState]) (infer.UpdateResponse[PeerState], error) {
 	p.GetLogger(ctx).Debugf("Update:Peer[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[PeerState]{
 			Output: PeerState{
+				AccountScope:                currentAccountScope(ctx),
 				Name:                        req.Inputs.Name,
 				InactivityExpirationEnabled: req.Inputs.InactivityExpirationEnabled,
 				LoginExpirationEnabled:      req.Inputs.LoginExpirationEnabled,
@@ -148,6 +159,7 @@ func (*Peer) Update(ctx context.Context, req infer.UpdateRequest[PeerArgs, PeerS
 
 	return infer.UpdateResponse[PeerState]{
 		Output: PeerState{
+			AccountScope:                currentAccountScope(ctx),
 			Name:                        req.Inputs.Name,
 			InactivityExpirationEnabled: req.Inputs.InactivityExpirationEnabled,
 			LoginExpirationEnabled:      req.Inputs.LoginExpirationEnabled,
@@ -161,6 +173,9 @@ func (*Peer) Update(ctx context.Context, req infer.UpdateRequest[PeerArgs, PeerS
 func (*Peer) Delete(ctx context.Context, req infer.DeleteRequest[PeerState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:Peer[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -180,6 +195,8 @@ func (*Peer) Diff(ctx context.Context, req infer.DiffRequest[PeerArgs, PeerState
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{
 			InputDiff: false,
@@ -234,6 +251,10 @@ func (*Peer) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResp
 		})
 	}
 
+	if args.ApprovalRequired != nil {
+		failures = append(failures, checkCapability(ctx, "approvalRequired", config.CapabilityPeerApproval)...)
+	}
+
 	return infer.CheckResponse[PeerArgs]{
 		Inputs:   args,
 		Failures: failures,
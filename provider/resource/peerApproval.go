@@ -0,0 +1,475 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// PeerApproval approves peers that are pending approval.
+type PeerApproval struct{}
+
+// Annotate adds a description to the PeerApproval resource type.
+func (pa *PeerApproval) Annotate(a infer.Annotator) {
+	a.Describe(&pa, "Approves NetBird peers that are pending approval (NetBird Cloud peer approval). "+
+		"Select a single peer by peerId, or every pending peer matching groupId and/or nameRegex, the same "+
+		"selector getPeers uses. Peers that start matching later are approved on the next `pulumi up --refresh`.")
+}
+
+// PeerApprovalArgs defines input fields for a peer approval.
+type PeerApprovalArgs struct {
+	PeerID         *string `pulumi:"peerId,optional"`
+	GroupID        *string `pulumi:"groupId,optional"`
+	NameRegex      *string `pulumi:"nameRegex,optional"`
+	RevokeOnDelete *bool   `pulumi:"revokeOnDelete,optional"`
+}
+
+// Annotate provides documentation for PeerApprovalArgs fields.
+func (pa *PeerApprovalArgs) Annotate(a infer.Annotator) {
+	a.Describe(&pa.PeerID, "ID of the peer to approve. Mutually exclusive with groupId and nameRegex. Changing this forces a replacement.")
+	a.Describe(&pa.GroupID, "Approve every pending peer that belongs to this group, as in getPeers. "+
+		"Combines with nameRegex; mutually exclusive with peerId. Changing this forces a replacement.")
+	a.Describe(&pa.NameRegex, "Approve every pending peer whose name matches this regular expression (RE2 syntax), as in getPeers. "+
+		"Unanchored, so use ^ and $ for an exact match. Combines with groupId; mutually exclusive with peerId. "+
+		"Changing this forces a replacement.")
+	a.Describe(&pa.RevokeOnDelete, "Whether deleting the resource puts the peers it approved back into the pending state. Defaults to false.")
+}
+
+// ApprovedPeer records a peer approved by a PeerApproval.
+type ApprovedPeer struct {
+	PeerID     string `pulumi:"peerId"`
+	Hostname   string `pulumi:"hostname"`
+	ApprovedAt string `pulumi:"approvedAt"`
+}
+
+// Annotate provides documentation for ApprovedPeer fields.
+func (ap *ApprovedPeer) Annotate(a infer.Annotator) {
+	a.Describe(&ap.PeerID, "ID of the approved peer.")
+	a.Describe(&ap.Hostname, "Hostname of the approved peer at approval time.")
+	a.Describe(&ap.ApprovedAt, "When the peer was approved (RFC 3339).")
+}
+
+// PeerApprovalState represents the output state of a peer approval.
+type PeerApprovalState struct {
+	AccountScope
+
+	PeerApprovalArgs
+
+	Approved []ApprovedPeer `pulumi:"approved"`
+	Pending  []string       `pulumi:"pending"`
+}
+
+// Annotate provides documentation for PeerApprovalState fields.
+func (pa *PeerApprovalState) Annotate(a infer.Annotator) {
+	a.Describe(&pa.Approved, "Peers approved by this resource. Peers that were already approved are not recorded and never revoked.")
+	a.Describe(&pa.Pending, "IDs of matching peers found pending on the last refresh; they are approved on the next update.")
+}
+
+// Create approves the matching pending peers.
+func (*PeerApproval) Create(ctx context.Context, req infer.CreateRequest[PeerApprovalArgs]) (infer.CreateResponse[PeerApprovalState], error) {
+	p.GetLogger(ctx).Debugf("Create:PeerApproval peerId=%s, groupId=%s, nameRegex=%s",
+		strPtr(req.Inputs.PeerID), strPtr(req.Inputs.GroupID), strPtr(req.Inputs.NameRegex))
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
+	state := PeerApprovalState{
+		AccountScope:     currentAccountScope(ctx),
+		PeerApprovalArgs: req.Inputs,
+		Approved:         []ApprovedPeer{},
+		Pending:          []string{},
+	}
+
+	if req.DryRun {
+		return infer.CreateResponse[PeerApprovalState]{
+			ID:     "preview",
+			Output: state,
+		}, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.CreateResponse[PeerApprovalState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	approved, err := approvePendingPeers(ctx, client, req.Inputs)
+	if err != nil {
+		return infer.CreateResponse[PeerApprovalState]{}, err
+	}
+
+	state.Approved = approved
+
+	return infer.CreateResponse[PeerApprovalState]{
+		ID:     peerApprovalID(req.Inputs),
+		Output: state,
+	}, nil
+}
+
+// Read drops approved peers that no longer exist and records matching peers still pending.
+func (*PeerApproval) Read(
+	ctx context.Context,
+	req infer.ReadRequest[PeerApprovalArgs, PeerApprovalState],
+) (infer.ReadResponse[PeerApprovalArgs, PeerApprovalState], error) {
+	p.GetLogger(ctx).Debugf("Read:PeerApproval[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.ReadResponse[PeerApprovalArgs, PeerApprovalState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	peers, err := client.Peers.List(ctx)
+	if err != nil {
+		return infer.ReadResponse[PeerApprovalArgs, PeerApprovalState]{}, fmt.Errorf("listing peers failed: %w", err)
+	}
+
+	if req.Inputs.PeerID != nil && !slices.ContainsFunc(peers, func(peer nbapi.Peer) bool { return peer.Id == *req.Inputs.PeerID }) {
+		return infer.ReadResponse[PeerApprovalArgs, PeerApprovalState]{
+			ID:     "",
+			Inputs: PeerApprovalArgs{},  //nolint:exhaustruct
+			State:  PeerApprovalState{}, //nolint:exhaustruct
+		}, nil
+	}
+
+	approved := make([]ApprovedPeer, 0, len(req.State.Approved))
+
+	for _, record := range req.State.Approved {
+		if slices.ContainsFunc(peers, func(peer nbapi.Peer) bool { return peer.Id == record.PeerID }) {
+			approved = append(approved, record)
+		}
+	}
+
+	matched, err := matchPendingPeers(peers, req.Inputs)
+	if err != nil {
+		return infer.ReadResponse[PeerApprovalArgs, PeerApprovalState]{}, err
+	}
+
+	pending := []string{}
+
+	for _, peer := range matched {
+		pending = append(pending, peer.Id)
+	}
+
+	return infer.ReadResponse[PeerApprovalArgs, PeerApprovalState]{
+		ID:     req.ID,
+		Inputs: req.Inputs,
+		State: PeerApprovalState{
+			AccountScope:     readAccountScope(ctx, req.State.AccountScope),
+			PeerApprovalArgs: req.Inputs,
+			Approved:         approved,
+			Pending:          pending,
+		},
+	}, nil
+}
+
+// Update approves matching peers that became pending since the last run.
+func (*PeerApproval) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[PeerApprovalArgs, PeerApprovalState],
+) (infer.UpdateResponse[PeerApprovalState], error) {
+	p.GetLogger(ctx).Debugf("Update:PeerApproval[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
+	state := PeerApprovalState{
+		AccountScope:     currentAccountScope(ctx),
+		PeerApprovalArgs: req.Inputs,
+		Approved:         slices.Clone(req.State.Approved),
+		Pending:          []string{},
+	}
+
+	if req.DryRun {
+		state.Pending = req.State.Pending
+
+		return infer.UpdateResponse[PeerApprovalState]{Output: state}, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.UpdateResponse[PeerApprovalState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	approved, err := approvePendingPeers(ctx, client, req.Inputs)
+	if err != nil {
+		return infer.UpdateResponse[PeerApprovalState]{}, err
+	}
+
+	state.Approved = append(state.Approved, approved...)
+
+	return infer.UpdateResponse[PeerApprovalState]{Output: state}, nil
+}
+
+// Delete optionally returns the approved peers to the pending state.
+func (*PeerApproval) Delete(ctx context.Context, req infer.DeleteRequest[PeerApprovalState]) (infer.DeleteResponse, error) {
+	p.GetLogger(ctx).Debugf("Delete:PeerApproval[%s] revoke=%t", req.ID, boolVal(req.State.RevokeOnDelete))
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
+	if !boolVal(req.State.RevokeOnDelete) {
+		return infer.DeleteResponse{}, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	for _, record := range req.State.Approved {
+		peer, err := client.Peers.Get(ctx, record.PeerID)
+		if err != nil {
+			if isNotFoundErr(err) {
+				continue
+			}
+
+			return infer.DeleteResponse{}, fmt.Errorf("reading peer %s failed: %w", record.PeerID, err)
+		}
+
+		err = setPeerApprovalRequired(ctx, client, *peer, true)
+		if err != nil {
+			return infer.DeleteResponse{}, fmt.Errorf("revoking approval of peer %s failed: %w", record.PeerID, err)
+		}
+	}
+
+	return infer.DeleteResponse{}, nil
+}
+
+// Diff detects selector changes and matching peers found pending on refresh.
+func (*PeerApproval) Diff(ctx context.Context, req infer.DiffRequest[PeerApprovalArgs, PeerApprovalState]) (infer.DiffResponse, error) {
+	p.GetLogger(ctx).Debugf("Diff:PeerApproval[%s]", req.ID)
+
+	diff := map[string]p.PropertyDiff{}
+
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
+	if !equalPtr(req.Inputs.PeerID, req.State.PeerID) {
+		diff["peerId"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+	}
+
+	if !equalPtr(req.Inputs.GroupID, req.State.GroupID) {
+		diff["groupId"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+	}
+
+	if !equalPtr(req.Inputs.NameRegex, req.State.NameRegex) {
+		diff["nameRegex"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+	}
+
+	if boolVal(req.Inputs.RevokeOnDelete) != boolVal(req.State.RevokeOnDelete) {
+		diff["revokeOnDelete"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	if len(req.State.Pending) > 0 {
+		diff["pending"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	return infer.DiffResponse{
+		DeleteBeforeReplace: false,
+		HasChanges:          len(diff) > 0,
+		DetailedDiff:        diff,
+	}, nil
+}
+
+// Check validates that peerId or a groupId/nameRegex selector is set, but not both.
+func (*PeerApproval) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[PeerApprovalArgs], error) {
+	p.GetLogger(ctx).Debugf("Check:PeerApproval old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
+
+	args, failures, err := infer.DefaultCheck[PeerApprovalArgs](ctx, req.NewInputs)
+
+	switch {
+	case args.PeerID == nil && args.GroupID == nil && args.NameRegex == nil:
+		failures = append(failures, p.CheckFailure{
+			Property: "peerId",
+			Reason:   "one of peerId, groupId or nameRegex must be set",
+		})
+	case args.PeerID != nil && args.GroupID != nil:
+		failures = append(failures, p.CheckFailure{
+			Property: "groupId",
+			Reason:   "peerId and groupId are mutually exclusive",
+		})
+	case args.PeerID != nil && args.NameRegex != nil:
+		failures = append(failures, p.CheckFailure{
+			Property: "nameRegex",
+			Reason:   "peerId and nameRegex are mutually exclusive",
+		})
+	case args.PeerID != nil:
+		if isBlank(*args.PeerID) {
+			failures = append(failures, p.CheckFailure{Property: "peerId", Reason: "peerId must not be empty"})
+		}
+
+		failures = append(failures, checkCapability(ctx, "peerId", config.CapabilityPeerApproval)...)
+	default:
+		failures = append(failures, checkPeerApprovalSelector(ctx, args)...)
+	}
+
+	return infer.CheckResponse[PeerApprovalArgs]{
+		Inputs:   args,
+		Failures: failures,
+	}, err
+}
+
+// WireDependencies explicitly defines input/output relationships.
+func (*PeerApproval) WireDependencies(f infer.FieldSelector, args *PeerApprovalArgs, state *PeerApprovalState) {
+	f.OutputField(&state.PeerID).DependsOn(f.InputField(&args.PeerID))
+	f.OutputField(&state.GroupID).DependsOn(f.InputField(&args.GroupID))
+	f.OutputField(&state.NameRegex).DependsOn(f.InputField(&args.NameRegex))
+	f.OutputField(&state.RevokeOnDelete).DependsOn(f.InputField(&args.RevokeOnDelete))
+	f.OutputField(&state.Approved).DependsOn(f.InputField(&args.PeerID), f.InputField(&args.GroupID), f.InputField(&args.NameRegex))
+}
+
+// checkPeerApprovalSelector validates a groupId/nameRegex selector.
+func checkPeerApprovalSelector(ctx context.Context, args PeerApprovalArgs) []p.CheckFailure {
+	var failures []p.CheckFailure
+
+	field := "nameRegex"
+
+	if args.GroupID != nil {
+		field = "groupId"
+
+		if isBlank(*args.GroupID) {
+			failures = append(failures, p.CheckFailure{Property: "groupId", Reason: "groupId must not be empty"})
+		}
+	}
+
+	if args.NameRegex != nil {
+		_, err := regexp.Compile(*args.NameRegex)
+		if err != nil {
+			failures = append(failures, p.CheckFailure{
+				Property: "nameRegex",
+				Reason:   fmt.Sprintf("invalid nameRegex %q: %v", *args.NameRegex, err),
+			})
+		}
+	}
+
+	return append(failures, checkCapability(ctx, field, config.CapabilityPeerApproval)...)
+}
+
+// peerApprovalID derives a stable ID from the selector. A group-only selector
+// keeps the group/<id> form IDs had before nameRegex existed.
+func peerApprovalID(args PeerApprovalArgs) string {
+	switch {
+	case args.PeerID != nil:
+		return *args.PeerID
+	case args.NameRegex == nil:
+		return "group/" + strPtr(args.GroupID)
+	default:
+		return "match/" + strPtr(args.GroupID) + "/" + *args.NameRegex
+	}
+}
+
+// matchPendingPeers returns the peers awaiting approval selected by args. The
+// groupId and nameRegex selectors combine like the getPeers filters.
+func matchPendingPeers(peers []nbapi.Peer, args PeerApprovalArgs) ([]nbapi.Peer, error) {
+	var name *regexp.Regexp
+
+	if args.PeerID == nil && args.NameRegex != nil {
+		var err error
+
+		name, err = regexp.Compile(*args.NameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nameRegex %q: %w", *args.NameRegex, err)
+		}
+	}
+
+	var matched []nbapi.Peer
+
+	for _, peer := range peers {
+		if !peer.ApprovalRequired {
+			continue
+		}
+
+		if args.PeerID != nil {
+			if peer.Id != *args.PeerID {
+				continue
+			}
+		} else {
+			if args.GroupID != nil &&
+				!slices.ContainsFunc(peer.Groups, func(g nbapi.GroupMinimum) bool { return g.Id == *args.GroupID }) {
+				continue
+			}
+
+			if name != nil && !name.MatchString(peer.Name) {
+				continue
+			}
+		}
+
+		matched = append(matched, peer)
+	}
+
+	return matched, nil
+}
+
+// approvePendingPeers approves every pending peer selected by args and returns
+// the approval records. A peerId that does not exist is an error.
+func approvePendingPeers(ctx context.Context, client *rest.Client, args PeerApprovalArgs) ([]ApprovedPeer, error) {
+	var peers []nbapi.Peer
+
+	if args.PeerID != nil {
+		peer, err := client.Peers.Get(ctx, *args.PeerID)
+		if err != nil {
+			return nil, fmt.Errorf("reading peer %s failed: %w", *args.PeerID, err)
+		}
+
+		peers = []nbapi.Peer{*peer}
+	} else {
+		var err error
+
+		peers, err = client.Peers.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing peers failed: %w", err)
+		}
+	}
+
+	matched, err := matchPendingPeers(peers, args)
+	if err != nil {
+		return nil, err
+	}
+
+	approved := []ApprovedPeer{}
+
+	for _, peer := range matched {
+		err := setPeerApprovalRequired(ctx, client, peer, false)
+		if err != nil {
+			return nil, fmt.Errorf("approving peer %s failed: %w", peer.Id, err)
+		}
+
+		p.GetLogger(ctx).Debugf("PeerApproval: approved peer %s (%s)", peer.Id, peer.Hostname)
+
+		approved = append(approved, ApprovedPeer{
+			PeerID:     peer.Id,
+			Hostname:   peer.Hostname,
+			ApprovedAt: config.Now(ctx).UTC().Format(time.RFC3339),
+		})
+	}
+
+	return approved, nil
+}
+
+// setPeerApprovalRequired updates the approval flag, resending the peer's
+// other settings unchanged since the API replaces the whole peer.
+func setPeerApprovalRequired(ctx context.Context, client *rest.Client, peer nbapi.Peer, required bool) error {
+	_, err := client.Peers.Update(ctx, peer.Id, nbapi.PeerRequest{
+		Name:                        peer.Name,
+		InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
+		LoginExpirationEnabled:      peer.LoginExpirationEnabled,
+		SshEnabled:                  peer.SshEnabled,
+		ApprovalRequired:            &required,
+		Ip:                          nil,
+		Ipv6:                        nil,
+	})
+	if err != nil {
+		return fmt.Errorf("updating peer failed: %w", err)
+	}
+
+	return nil
+}
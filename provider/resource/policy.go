@@ -40,6 +40,8 @@ func (policy *PolicyArgs) Annotate(annotator infer.Annotator) {
 
 // PolicyState represents the state of a Policy resource stored in Pulumi state.
 type PolicyState struct {
+	AccountScope
+
 	Name                string            `pulumi:"name"`
 	Description         *string           `pulumi:"description,optional"`
 	Enabled             bool              `pulumi:"enabled"`
@@ -196,6 +198,9 @@ func (Protocol) Values() []infer.EnumValue[Protocol] {
 func (*Policy) Create(ctx context.Context, req infer.CreateRequest[PolicyArgs]) (infer.CreateResponse[PolicyState], error) {
 	p.GetLogger(ctx).Debugf("Create:Policy")
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	// Handle dry-run (preview) mode by constructing a preview PolicyState.
 	if req.DryRun {
 		// Convert PolicyRuleArgs to PolicyRuleState for preview
@@ -244,6 +249,7 @@ func (*Policy) Create(ctx context.Context, req infer.CreateRequest[PolicyArgs])
 		return infer.CreateResponse[PolicyState]{
 			ID: "preview",
 			Output: PolicyState{
+				AccountScope:        currentAccountScope(ctx),
 				Name:                req.Inputs.Name,
 				Description:         req.Inputs.Description,
 				Enabled:             req.Inputs.Enabled,
@@ -294,30 +300,12 @@ func (*Policy) Create(ctx context.Context, req infer.CreateRequest[PolicyArgs])
 		return infer.CreateResponse[PolicyState]{}, errors.New("policy create response did not include an id")
 	}
 
-	// Convert created rules to PolicyRuleState
-	rules := make([]PolicyRuleState, len(created.Rules))
-	for ruleIndex, rule := range created.Rules {
-		rules[ruleIndex] = PolicyRuleState{
-			ID:                  rule.Id,
-			Name:                rule.Name,
-			Description:         rule.Description,
-			Bidirectional:       rule.Bidirectional,
-			Action:              RuleAction(rule.Action),
-			Enabled:             rule.Enabled,
-			Protocol:            Protocol(rule.Protocol),
-			Ports:               rule.Ports,
-			PortRanges:          fromAPIPortRanges(rule.PortRanges),
-			Sources:             fromAPIGroupMinimums(rule.Sources),
-			Destinations:        fromAPIGroupMinimums(rule.Destinations),
-			SourceResource:      fromAPIResource(rule.SourceResource),
-			DestinationResource: fromAPIResource(rule.DestinationResource),
-			AuthorizedGroups:    rule.AuthorizedGroups,
-		}
-	}
+	rules := policyRuleStatesFromAPI(created.Rules)
 
 	return infer.CreateResponse[PolicyState]{
 		ID: *created.Id,
 		Output: PolicyState{
+			AccountScope:        currentAccountScope(ctx),
 			Name:                created.Name,
 			Description:         created.Description,
 			Enabled:             created.Enabled,
@@ -331,6 +319,9 @@ func (*Policy) Create(ctx context.Context, req infer.CreateRequest[PolicyArgs])
 func (*Policy) Read(ctx context.Context, req infer.ReadRequest[PolicyArgs, PolicyState]) (infer.ReadResponse[PolicyArgs, PolicyState], error) {
 	p.GetLogger(ctx).Debugf("Read:Policy[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[PolicyArgs, PolicyState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -349,25 +340,7 @@ func (*Policy) Read(ctx context.Context, req infer.ReadRequest[PolicyArgs, Polic
 		return infer.ReadResponse[PolicyArgs, PolicyState]{}, fmt.Errorf("reading policy failed: %w", err)
 	}
 
-	rules := make([]PolicyRuleState, len(policy.Rules))
-	for ruleIndex, rule := range policy.Rules {
-		rules[ruleIndex] = PolicyRuleState{
-			ID:                  rule.Id,
-			Name:                rule.Name,
-			Description:         rule.Description,
-			Bidirectional:       rule.Bidirectional,
-			Action:              RuleAction(rule.Action),
-			Enabled:             rule.Enabled,
-			Protocol:            Protocol(rule.Protocol),
-			Ports:               rule.Ports,
-			PortRanges:          fromAPIPortRanges(rule.PortRanges),
-			Sources:             fromAPIGroupMinimums(rule.Sources),
-			Destinations:        fromAPIGroupMinimums(rule.Destinations),
-			SourceResource:      fromAPIResource(rule.SourceResource),
-			DestinationResource: fromAPIResource(rule.DestinationResource),
-			AuthorizedGroups:    rule.AuthorizedGroups,
-		}
-	}
+	rules := policyRuleStatesFromAPI(policy.Rules)
 
 	inputRules := req.Inputs.Rules
 	if len(inputRules) == 0 && len(policy.Rules) > 0 {
@@ -392,6 +365,7 @@ func (*Policy) Read(ctx context.Context, req infer.ReadRequest[PolicyArgs, Polic
 			SourcePostureChecks: &postureChecks,
 		},
 		State: PolicyState{
+			AccountScope:        readAccountScope(ctx, req.State.AccountScope),
 			Name:                policy.Name,
 			Description:         stateDescription,
 			Enabled:             policy.Enabled,
@@ -405,6 +379,9 @@ func (*Policy) Read(ctx context.Context, req infer.ReadRequest[PolicyArgs, Polic
 func (*Policy) Update(ctx context.Context, req infer.UpdateRequest[PolicyArgs, PolicyState]) (infer.UpdateResponse[PolicyState], error) {
 	p.GetLogger(ctx).Debugf("Update:Policy[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		// Construct PolicyRuleState for preview output
 		rules := make([]PolicyRuleState, len(req.Inputs.Rules))
@@ -450,6 +427,7 @@ func (*Policy) Update(ctx context.Context, req infer.UpdateRequest[PolicyArgs, P
 
 		return infer.UpdateResponse[PolicyState]{
 			Output: PolicyState{
+				AccountScope:        currentAccountScope(ctx),
 				Name:                req.Inputs.Name,
 				Description:         req.Inputs.Description,
 				Enabled:             req.Inputs.Enabled,
@@ -496,29 +474,11 @@ func (*Policy) Update(ctx context.Context, req infer.UpdateRequest[PolicyArgs, P
 		return infer.UpdateResponse[PolicyState]{}, fmt.Errorf("updating policy %s failed: %w", req.Inputs.Name, err)
 	}
 
-	// Convert updated rules to PolicyRuleState
-	rules := make([]PolicyRuleState, len(updated.Rules))
-	for ruleIndex, rule := range updated.Rules {
-		rules[ruleIndex] = PolicyRuleState{
-			ID:                  rule.Id,
-			Name:                rule.Name,
-			Description:         rule.Description,
-			Bidirectional:       rule.Bidirectional,
-			Action:              RuleAction(rule.Action),
-			Enabled:             rule.Enabled,
-			Protocol:            Protocol(rule.Protocol),
-			Ports:               rule.Ports,
-			PortRanges:          fromAPIPortRanges(rule.PortRanges),
-			Sources:             fromAPIGroupMinimums(rule.Sources),
-			Destinations:        fromAPIGroupMinimums(rule.Destinations),
-			SourceResource:      fromAPIResource(rule.SourceResource),
-			DestinationResource: fromAPIResource(rule.DestinationResource),
-			AuthorizedGroups:    rule.AuthorizedGroups,
-		}
-	}
+	rules := policyRuleStatesFromAPI(updated.Rules)
 
 	return infer.UpdateResponse[PolicyState]{
 		Output: PolicyState{
+			AccountScope:        currentAccountScope(ctx),
 			Name:                updated.Name,
 			Description:         updated.Description,
 			Enabled:             updated.Enabled,
@@ -532,6 +492,9 @@ func (*Policy) Update(ctx context.Context, req infer.UpdateRequest[PolicyArgs, P
 func (*Policy) Delete(ctx context.Context, req infer.DeleteRequest[PolicyState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:Policy[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -551,6 +514,8 @@ func (*Policy) Diff(ctx context.Context, req infer.DiffRequest[PolicyArgs, Polic
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{
 			InputDiff: false,
@@ -939,3 +904,43 @@ func equalMapStringSlice(aSlice, bSlice *map[string][]string) bool {
 
 	return true
 }
+
+// policyRuleStatesFromAPI converts API policy rules into rule state.
+func policyRuleStatesFromAPI(apiRules []nbapi.PolicyRule) []PolicyRuleState {
+	rules := make([]PolicyRuleState, len(apiRules))
+	for ruleIndex, rule := range apiRules {
+		rules[ruleIndex] = PolicyRuleState{
+			ID:                  rule.Id,
+			Name:                rule.Name,
+			Description:         rule.Description,
+			Bidirectional:       rule.Bidirectional,
+			Action:              RuleAction(rule.Action),
+			Enabled:             rule.Enabled,
+			Protocol:            Protocol(rule.Protocol),
+			Ports:               rule.Ports,
+			PortRanges:          fromAPIPortRanges(rule.PortRanges),
+			Sources:             fromAPIGroupMinimums(rule.Sources),
+			Destinations:        fromAPIGroupMinimums(rule.Destinations),
+			SourceResource:      fromAPIResource(rule.SourceResource),
+			DestinationResource: fromAPIResource(rule.DestinationResource),
+			AuthorizedGroups:    rule.AuthorizedGroups,
+		}
+	}
+
+	return rules
+}
+
+// PolicyStateFromAPI builds a PolicyState from an API Policy response.
+func PolicyStateFromAPI(ctx context.Context, policy *nbapi.Policy) PolicyState {
+	postureChecks := slices.Clone(policy.SourcePostureChecks)
+	slices.Sort(postureChecks)
+
+	return PolicyState{
+		AccountScope:        currentAccountScope(ctx),
+		Name:                policy.Name,
+		Description:         policy.Description,
+		Enabled:             policy.Enabled,
+		Rules:               policyRuleStatesFromAPI(policy.Rules),
+		SourcePostureChecks: &postureChecks,
+	}
+}
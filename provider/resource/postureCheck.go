@@ -35,6 +35,8 @@ func (pc *PostureCheckArgs) Annotate(annotator infer.Annotator) {
 
 // PostureCheckState represents the output state of a posture check resource.
 type PostureCheckState struct {
+	AccountScope
+
 	Name        string              `pulumi:"name"`
 	Description *string             `pulumi:"description,optional"`
 	Checks      PostureChecksConfig `pulumi:"checks"`
@@ -383,12 +385,13 @@ func fromAPIChecks(checks nbapi.Checks) PostureChecksConfig {
 	return result
 }
 
-// postureCheckStateFromAPI builds a PostureCheckState from an API PostureCheck response.
-func postureCheckStateFromAPI(apiCheck *nbapi.PostureCheck) PostureCheckState {
+// PostureCheckStateFromAPI builds a PostureCheckState from an API PostureCheck response.
+func PostureCheckStateFromAPI(ctx context.Context, apiCheck *nbapi.PostureCheck) PostureCheckState {
 	return PostureCheckState{
-		Name:        apiCheck.Name,
-		Description: apiCheck.Description,
-		Checks:      fromAPIChecks(apiCheck.Checks),
+		AccountScope: currentAccountScope(ctx),
+		Name:         apiCheck.Name,
+		Description:  apiCheck.Description,
+		Checks:       fromAPIChecks(apiCheck.Checks),
 	}
 }
 
@@ -412,13 +415,17 @@ func buildPostureCheckRequest(args PostureCheckArgs) nbapi.PostureCheckUpdate {
 func (*PostureCheck) Create(ctx context.Context, req infer.CreateRequest[PostureCheckArgs]) (infer.CreateResponse[PostureCheckState], error) {
 	p.GetLogger(ctx).Debugf("Create:PostureCheck name=%s", req.Inputs.Name)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[PostureCheckState]{
 			ID: "preview",
 			Output: PostureCheckState{
-				Name:        req.Inputs.Name,
-				Description: req.Inputs.Description,
-				Checks:      req.Inputs.Checks,
+				AccountScope: currentAccountScope(ctx),
+				Name:         req.Inputs.Name,
+				Description:  req.Inputs.Description,
+				Checks:       req.Inputs.Checks,
 			},
 		}, nil
 	}
@@ -435,7 +442,7 @@ func (*PostureCheck) Create(ctx context.Context, req infer.CreateRequest[Posture
 
 	return infer.CreateResponse[PostureCheckState]{
 		ID:     created.Id,
-		Output: postureCheckStateFromAPI(created),
+		Output: PostureCheckStateFromAPI(ctx, created),
 	}, nil
 }
 
@@ -443,6 +450,9 @@ func (*PostureCheck) Create(ctx context.Context, req infer.CreateRequest[Posture
 func (*PostureCheck) Read(ctx context.Context, req infer.ReadRequest[PostureCheckArgs, PostureCheckState]) (infer.ReadResponse[PostureCheckArgs, PostureCheckState], error) {
 	p.GetLogger(ctx).Debugf("Read:PostureCheck[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[PostureCheckArgs, PostureCheckState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -453,16 +463,21 @@ func (*PostureCheck) Read(ctx context.Context, req infer.ReadRequest[PostureChec
 		return infer.ReadResponse[PostureCheckArgs, PostureCheckState]{}, fmt.Errorf("reading posture check failed: %w", err)
 	}
 
-	state := postureCheckStateFromAPI(apiCheck)
+	state := PostureCheckStateFromAPI(ctx, apiCheck)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
 
 	if req.Inputs.Description == nil {
 		state.Description = nil
 	}
 
 	return infer.ReadResponse[PostureCheckArgs, PostureCheckState]{
-		ID:     req.ID,
-		Inputs: PostureCheckArgs(state),
-		State:  state,
+		ID: req.ID,
+		Inputs: PostureCheckArgs{
+			Name:        state.Name,
+			Description: state.Description,
+			Checks:      state.Checks,
+		},
+		State: state,
 	}, nil
 }
 
@@ -470,12 +485,16 @@ func (*PostureCheck) Read(ctx context.Context, req infer.ReadRequest[PostureChec
 func (*PostureCheck) Update(ctx context.Context, req infer.UpdateRequest[PostureCheckArgs, PostureCheckState]) (infer.UpdateResponse[PostureCheckState], error) {
 	p.GetLogger(ctx).Debugf("Update:PostureCheck[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[PostureCheckState]{
 			Output: PostureCheckState{
-				Name:        req.Inputs.Name,
-				Description: req.Inputs.Description,
-				Checks:      req.Inputs.Checks,
+				AccountScope: currentAccountScope(ctx),
+				Name:         req.Inputs.Name,
+				Description:  req.Inputs.Description,
+				Checks:       req.Inputs.Checks,
 			},
 		}, nil
 	}
@@ -491,7 +510,7 @@ func (*PostureCheck) Update(ctx context.Context, req infer.UpdateRequest[Posture
 	}
 
 	return infer.UpdateResponse[PostureCheckState]{
-		Output: postureCheckStateFromAPI(updated),
+		Output: PostureCheckStateFromAPI(ctx, updated),
 	}, nil
 }
 
@@ -499,6 +518,9 @@ func (*PostureCheck) Update(ctx context.Context, req infer.UpdateRequest[Posture
 func (*PostureCheck) Delete(ctx context.Context, req infer.DeleteRequest[PostureCheckState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:PostureCheck[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -518,6 +540,8 @@ func (*PostureCheck) Diff(ctx context.Context, req infer.DiffRequest[PostureChec
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
 	}
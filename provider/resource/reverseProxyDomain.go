@@ -32,6 +32,8 @@ func (r *ReverseProxyDomainArgs) Annotate(annotator infer.Annotator) {
 
 // ReverseProxyDomainState represents the output state of a reverse proxy domain resource.
 type ReverseProxyDomainState struct {
+	AccountScope
+
 	Domain              string                 `pulumi:"domain"`
 	TargetCluster       string                 `pulumi:"targetCluster"`
 	Type                ReverseProxyDomainType `pulumi:"type"`
@@ -72,10 +74,14 @@ func (ReverseProxyDomainType) Values() []infer.EnumValue[ReverseProxyDomainType]
 func (*ReverseProxyDomain) Create(ctx context.Context, req infer.CreateRequest[ReverseProxyDomainArgs]) (infer.CreateResponse[ReverseProxyDomainState], error) {
 	p.GetLogger(ctx).Debugf("Create:ReverseProxyDomain domain=%s, cluster=%s", req.Inputs.Domain, req.Inputs.TargetCluster)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[ReverseProxyDomainState]{
 			ID: "preview",
 			Output: ReverseProxyDomainState{
+				AccountScope:        currentAccountScope(ctx),
 				Domain:              req.Inputs.Domain,
 				TargetCluster:       req.Inputs.TargetCluster,
 				Type:                "",
@@ -107,6 +113,7 @@ func (*ReverseProxyDomain) Create(ctx context.Context, req infer.CreateRequest[R
 	return infer.CreateResponse[ReverseProxyDomainState]{
 		ID: domain.Id,
 		Output: ReverseProxyDomainState{
+			AccountScope:        currentAccountScope(ctx),
 			Domain:              domain.Domain,
 			TargetCluster:       targetCluster,
 			Type:                ReverseProxyDomainType(domain.Type),
@@ -122,6 +129,9 @@ func (*ReverseProxyDomain) Create(ctx context.Context, req infer.CreateRequest[R
 func (*ReverseProxyDomain) Read(ctx context.Context, req infer.ReadRequest[ReverseProxyDomainArgs, ReverseProxyDomainState]) (infer.ReadResponse[ReverseProxyDomainArgs, ReverseProxyDomainState], error) {
 	p.GetLogger(ctx).Debugf("Read:ReverseProxyDomain[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[ReverseProxyDomainArgs, ReverseProxyDomainState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -158,6 +168,7 @@ func (*ReverseProxyDomain) Read(ctx context.Context, req infer.ReadRequest[Rever
 			TargetCluster: targetCluster,
 		},
 		State: ReverseProxyDomainState{
+			AccountScope:        readAccountScope(ctx, req.State.AccountScope),
 			Domain:              found.Domain,
 			TargetCluster:       targetCluster,
 			Type:                ReverseProxyDomainType(found.Type),
@@ -172,6 +183,9 @@ func (*ReverseProxyDomain) Read(ctx context.Context, req infer.ReadRequest[Rever
 func (*ReverseProxyDomain) Delete(ctx context.Context, req infer.DeleteRequest[ReverseProxyDomainState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:ReverseProxyDomain[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -192,6 +206,8 @@ func (*ReverseProxyDomain) Diff(ctx context.Context, req infer.DiffRequest[Rever
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Domain != req.State.Domain {
 		diff["domain"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
 	}
@@ -214,6 +230,7 @@ func (*ReverseProxyDomain) Check(ctx context.Context, req infer.CheckRequest) (i
 	p.GetLogger(ctx).Debugf("Check:ReverseProxyDomain old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
 
 	args, failures, err := infer.DefaultCheck[ReverseProxyDomainArgs](ctx, req.NewInputs)
+	failures = append(failures, checkCapability(ctx, "domain", config.CapabilityReverseProxyDomain)...)
 
 	if isBlank(args.Domain) {
 		failures = append(failures, p.CheckFailure{
@@ -240,3 +257,22 @@ func (*ReverseProxyDomain) WireDependencies(field infer.FieldSelector, args *Rev
 	field.OutputField(&state.Domain).DependsOn(field.InputField(&args.Domain))
 	field.OutputField(&state.TargetCluster).DependsOn(field.InputField(&args.TargetCluster))
 }
+
+// ReverseProxyDomainStateFromAPI builds a ReverseProxyDomainState from an API
+// ReverseProxyDomain response.
+func ReverseProxyDomainStateFromAPI(ctx context.Context, domain *nbapi.ReverseProxyDomain) ReverseProxyDomainState {
+	targetCluster := ""
+	if domain.TargetCluster != nil {
+		targetCluster = *domain.TargetCluster
+	}
+
+	return ReverseProxyDomainState{
+		AccountScope:        currentAccountScope(ctx),
+		Domain:              domain.Domain,
+		TargetCluster:       targetCluster,
+		Type:                ReverseProxyDomainType(domain.Type),
+		Validated:           domain.Validated,
+		RequireSubdomain:    domain.RequireSubdomain,
+		SupportsCustomPorts: domain.SupportsCustomPorts,
+	}
+}
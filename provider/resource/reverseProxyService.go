@@ -53,6 +53,8 @@ func (r *ReverseProxyServiceArgs) Annotate(annotator infer.Annotator) {
 
 // ReverseProxyServiceState represents the output state of a reverse proxy service resource.
 type ReverseProxyServiceState struct {
+	AccountScope
+
 	Name               string                          `pulumi:"name"`
 	Domain             string                          `pulumi:"domain"`
 	Enabled            bool                            `pulumi:"enabled"`
@@ -619,8 +621,8 @@ func fromAPIAccessRestrictions(restrictions *nbapi.AccessRestrictions) *ReverseP
 	}
 }
 
-// serviceStateFromAPI builds a ReverseProxyServiceState from an API Service response.
-func serviceStateFromAPI(svc *nbapi.Service) ReverseProxyServiceState {
+// ReverseProxyServiceStateFromAPI builds a ReverseProxyServiceState from an API Service response.
+func ReverseProxyServiceStateFromAPI(ctx context.Context, svc *nbapi.Service) ReverseProxyServiceState {
 	var mode *ReverseProxyServiceMode
 
 	if svc.Mode != nil {
@@ -631,6 +633,7 @@ func serviceStateFromAPI(svc *nbapi.Service) ReverseProxyServiceState {
 	statusVal := ReverseProxyServiceStatus(svc.Meta.Status)
 
 	return ReverseProxyServiceState{
+		AccountScope:       currentAccountScope(ctx),
 		Name:               svc.Name,
 		Domain:             svc.Domain,
 		Enabled:            svc.Enabled,
@@ -681,10 +684,13 @@ func buildServiceRequest(args ReverseProxyServiceArgs) nbapi.ServiceRequest {
 func (*ReverseProxyService) Create(ctx context.Context, req infer.CreateRequest[ReverseProxyServiceArgs]) (infer.CreateResponse[ReverseProxyServiceState], error) {
 	p.GetLogger(ctx).Debugf("Create:ReverseProxyService name=%s, domain=%s", req.Inputs.Name, req.Inputs.Domain)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[ReverseProxyServiceState]{
 			ID:     "preview",
-			Output: dryRunState(req.Inputs, nil, nil),
+			Output: dryRunState(ctx, req.Inputs, nil, nil),
 		}, nil
 	}
 
@@ -700,7 +706,7 @@ func (*ReverseProxyService) Create(ctx context.Context, req infer.CreateRequest[
 
 	return infer.CreateResponse[ReverseProxyServiceState]{
 		ID:     svc.Id,
-		Output: serviceStateFromAPI(svc),
+		Output: ReverseProxyServiceStateFromAPI(ctx, svc),
 	}, nil
 }
 
@@ -708,6 +714,9 @@ func (*ReverseProxyService) Create(ctx context.Context, req infer.CreateRequest[
 func (*ReverseProxyService) Read(ctx context.Context, req infer.ReadRequest[ReverseProxyServiceArgs, ReverseProxyServiceState]) (infer.ReadResponse[ReverseProxyServiceArgs, ReverseProxyServiceState], error) {
 	p.GetLogger(ctx).Debugf("Read:ReverseProxyService[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[ReverseProxyServiceArgs, ReverseProxyServiceState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -726,7 +735,8 @@ func (*ReverseProxyService) Read(ctx context.Context, req infer.ReadRequest[Reve
 		return infer.ReadResponse[ReverseProxyServiceArgs, ReverseProxyServiceState]{}, fmt.Errorf("reading reverse proxy service failed: %w", err)
 	}
 
-	state := serviceStateFromAPI(svc)
+	state := ReverseProxyServiceStateFromAPI(ctx, svc)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
 
 	return infer.ReadResponse[ReverseProxyServiceArgs, ReverseProxyServiceState]{
 		ID: req.ID,
@@ -752,9 +762,12 @@ func (*ReverseProxyService) Read(ctx context.Context, req infer.ReadRequest[Reve
 func (*ReverseProxyService) Update(ctx context.Context, req infer.UpdateRequest[ReverseProxyServiceArgs, ReverseProxyServiceState]) (infer.UpdateResponse[ReverseProxyServiceState], error) {
 	p.GetLogger(ctx).Debugf("Update:ReverseProxyService[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[ReverseProxyServiceState]{
-			Output: dryRunState(req.Inputs, req.State.ProxyCluster, req.State.Status),
+			Output: dryRunState(ctx, req.Inputs, req.State.ProxyCluster, req.State.Status),
 		}, nil
 	}
 
@@ -769,14 +782,15 @@ func (*ReverseProxyService) Update(ctx context.Context, req infer.UpdateRequest[
 	}
 
 	return infer.UpdateResponse[ReverseProxyServiceState]{
-		Output: serviceStateFromAPI(svc),
+		Output: ReverseProxyServiceStateFromAPI(ctx, svc),
 	}, nil
 }
 
 // dryRunState builds a preview state from inputs, carrying over server-derived
 // outputs when they are known (Update) and leaving them nil otherwise (Create).
-func dryRunState(inputs ReverseProxyServiceArgs, proxyCluster *string, status *ReverseProxyServiceStatus) ReverseProxyServiceState {
+func dryRunState(ctx context.Context, inputs ReverseProxyServiceArgs, proxyCluster *string, status *ReverseProxyServiceStatus) ReverseProxyServiceState {
 	return ReverseProxyServiceState{
+		AccountScope:       currentAccountScope(ctx),
 		Name:               inputs.Name,
 		Domain:             inputs.Domain,
 		Enabled:            inputs.Enabled,
@@ -800,6 +814,9 @@ func dryRunState(inputs ReverseProxyServiceArgs, proxyCluster *string, status *R
 func (*ReverseProxyService) Delete(ctx context.Context, req infer.DeleteRequest[ReverseProxyServiceState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:ReverseProxyService[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -819,6 +836,8 @@ func (*ReverseProxyService) Diff(ctx context.Context, req infer.DiffRequest[Reve
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
 	}
@@ -881,6 +900,7 @@ func (*ReverseProxyService) Check(ctx context.Context, req infer.CheckRequest) (
 	p.GetLogger(ctx).Debugf("Check:ReverseProxyService old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
 
 	args, failures, err := infer.DefaultCheck[ReverseProxyServiceArgs](ctx, req.NewInputs)
+	failures = append(failures, checkCapability(ctx, "domain", config.CapabilityReverseProxy)...)
 
 	failures = append(failures, reverseProxyCheckArgs(args)...)
 
@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"fmt"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// rotationPolicy describes when a credential with a fixed lifetime must be
+// replaced. Durations are counted in unit, the unit of the resource's
+// expiresIn input (seconds for setup keys, days for tokens).
+type rotationPolicy struct {
+	rotateBefore     *int
+	rotationInterval *int
+	unit             time.Duration
+}
+
+// due reports whether a credential created at createdAt and expiring at
+// expiresAt must be replaced at now. Zero times are unknown and never
+// trigger a rotation on their own.
+func (r rotationPolicy) due(now, createdAt, expiresAt time.Time) bool {
+	if r.rotateBefore != nil && !expiresAt.IsZero() &&
+		!now.Before(expiresAt.Add(-time.Duration(*r.rotateBefore)*r.unit)) {
+		return true
+	}
+
+	return r.rotationInterval != nil && !createdAt.IsZero() &&
+		!now.Before(createdAt.Add(time.Duration(*r.rotationInterval)*r.unit))
+}
+
+// check validates the rotation settings against the credential lifetime
+// expiresIn (in the same unit). A lifetime of 0 means the credential does
+// not expire, so only rotationInterval applies.
+func (r rotationPolicy) check(expiresIn int) []p.CheckFailure {
+	var failures []p.CheckFailure
+
+	if r.rotateBefore != nil {
+		switch {
+		case *r.rotateBefore <= 0:
+			failures = append(failures, p.CheckFailure{
+				Property: "rotateBefore",
+				Reason:   "rotateBefore must be greater than 0",
+			})
+		case expiresIn <= 0:
+			failures = append(failures, p.CheckFailure{
+				Property: "rotateBefore",
+				Reason:   "rotateBefore requires an expiring credential (expiresIn > 0)",
+			})
+		case *r.rotateBefore >= expiresIn:
+			failures = append(failures, p.CheckFailure{
+				Property: "rotateBefore",
+				Reason:   fmt.Sprintf("rotateBefore must be less than expiresIn (%d)", expiresIn),
+			})
+		}
+	}
+
+	if r.rotationInterval != nil {
+		switch {
+		case *r.rotationInterval <= 0:
+			failures = append(failures, p.CheckFailure{
+				Property: "rotationInterval",
+				Reason:   "rotationInterval must be greater than 0",
+			})
+		case expiresIn > 0 && *r.rotationInterval > expiresIn:
+			failures = append(failures, p.CheckFailure{
+				Property: "rotationInterval",
+				Reason:   fmt.Sprintf("rotationInterval must not exceed expiresIn (%d)", expiresIn),
+			})
+		}
+	}
+
+	return failures
+}
+
+// remainingValidity returns the whole seconds left until expiresAt at now,
+// clamped at 0, or nil when the expiry is unknown.
+func remainingValidity(now, expiresAt time.Time) *int {
+	if expiresAt.IsZero() {
+		return nil
+	}
+
+	remaining := max(int(expiresAt.Sub(now)/time.Second), 0)
+
+	return &remaining
+}
+
+// parseRotationTime parses an RFC 3339 timestamp from state, returning the
+// zero time for unset or unparsable values.
+func parseRotationTime(value *string) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed
+}
@@ -55,6 +55,8 @@ func (a *RouteArgs) Annotate(annotator infer.Annotator) {
 
 // RouteState represents the output state of a route resource.
 type RouteState struct {
+	AccountScope
+
 	NetworkID           string    `pulumi:"networkId"`
 	Description         string    `pulumi:"description"`
 	Enabled             bool      `pulumi:"enabled"`
@@ -89,7 +91,8 @@ func (s *RouteState) Annotate(annotator infer.Annotator) {
 	annotator.Describe(&s.NetworkType, "Network type (IPv4, IPv6, or domain) — computed by the API.")
 }
 
-func routeStateFromAPI(route *nbapi.Route) RouteState {
+// RouteStateFromAPI builds a RouteState from an API Route response.
+func RouteStateFromAPI(ctx context.Context, route *nbapi.Route) RouteState {
 	groups := slices.Clone(route.Groups)
 	slices.Sort(groups)
 
@@ -122,6 +125,7 @@ func routeStateFromAPI(route *nbapi.Route) RouteState {
 	}
 
 	return RouteState{
+		AccountScope:        currentAccountScope(ctx),
 		NetworkID:           route.NetworkId,
 		Description:         route.Description,
 		Enabled:             route.Enabled,
@@ -179,10 +183,13 @@ func routeRequest(args RouteArgs) nbapi.RouteRequest {
 func (*Route) Create(ctx context.Context, req infer.CreateRequest[RouteArgs]) (infer.CreateResponse[RouteState], error) {
 	p.GetLogger(ctx).Debugf("Create:Route networkId=%s", req.Inputs.NetworkID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[RouteState]{
 			ID: "preview",
-			Output: routeStateFromAPI(&nbapi.Route{
+			Output: RouteStateFromAPI(ctx, &nbapi.Route{
 				NetworkId:           req.Inputs.NetworkID,
 				Id:                  "preview",
 				Description:         req.Inputs.Description,
@@ -216,7 +223,7 @@ func (*Route) Create(ctx context.Context, req infer.CreateRequest[RouteArgs]) (i
 
 	return infer.CreateResponse[RouteState]{
 		ID:     route.Id,
-		Output: routeStateFromAPI(route),
+		Output: RouteStateFromAPI(ctx, route),
 	}, nil
 }
 
@@ -224,6 +231,9 @@ func (*Route) Create(ctx context.Context, req infer.CreateRequest[RouteArgs]) (i
 func (*Route) Read(ctx context.Context, req infer.ReadRequest[RouteArgs, RouteState]) (infer.ReadResponse[RouteArgs, RouteState], error) {
 	p.GetLogger(ctx).Debugf("Read:Route[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[RouteArgs, RouteState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -242,7 +252,8 @@ func (*Route) Read(ctx context.Context, req infer.ReadRequest[RouteArgs, RouteSt
 		return infer.ReadResponse[RouteArgs, RouteState]{}, fmt.Errorf("reading route failed: %w", err)
 	}
 
-	state := routeStateFromAPI(route)
+	state := RouteStateFromAPI(ctx, route)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
 
 	return infer.ReadResponse[RouteArgs, RouteState]{
 		ID:     route.Id,
@@ -255,9 +266,12 @@ func (*Route) Read(ctx context.Context, req infer.ReadRequest[RouteArgs, RouteSt
 func (*Route) Update(ctx context.Context, req infer.UpdateRequest[RouteArgs, RouteState]) (infer.UpdateResponse[RouteState], error) {
 	p.GetLogger(ctx).Debugf("Update:Route[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[RouteState]{
-			Output: routeStateFromAPI(&nbapi.Route{
+			Output: RouteStateFromAPI(ctx, &nbapi.Route{
 				NetworkId:           req.Inputs.NetworkID,
 				Id:                  req.ID,
 				Description:         req.Inputs.Description,
@@ -288,7 +302,7 @@ func (*Route) Update(ctx context.Context, req infer.UpdateRequest[RouteArgs, Rou
 	}
 
 	return infer.UpdateResponse[RouteState]{
-		Output: routeStateFromAPI(route),
+		Output: RouteStateFromAPI(ctx, route),
 	}, nil
 }
 
@@ -296,6 +310,9 @@ func (*Route) Update(ctx context.Context, req infer.UpdateRequest[RouteArgs, Rou
 func (*Route) Delete(ctx context.Context, req infer.DeleteRequest[RouteState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:Route[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -315,6 +332,8 @@ func (*Route) Diff(ctx context.Context, req infer.DiffRequest[RouteArgs, RouteSt
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.NetworkID != req.State.NetworkID {
 		diff["networkId"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
 	}
@@ -41,6 +41,8 @@ func (s *ScimIntegrationArgs) Annotate(annotator infer.Annotator) {
 
 // ScimIntegrationState represents the output state of a SCIM integration.
 type ScimIntegrationState struct {
+	AccountScope
+
 	Prefix            string    `pulumi:"prefix"`
 	Provider          string    `pulumi:"provider"`
 	Enabled           *bool     `pulumi:"enabled,optional"`
@@ -67,10 +69,13 @@ func (s *ScimIntegrationState) Annotate(annotator infer.Annotator) {
 func (*ScimIntegration) Create(ctx context.Context, req infer.CreateRequest[ScimIntegrationArgs]) (infer.CreateResponse[ScimIntegrationState], error) {
 	p.GetLogger(ctx).Debugf("Create:ScimIntegration provider=%s prefix=%s", req.Inputs.Provider, req.Inputs.Prefix)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[ScimIntegrationState]{
 			ID:     "preview",
-			Output: scimIntegrationStateFromArgs(req.Inputs, nil, nil),
+			Output: scimIntegrationStateFromArgs(ctx, req.Inputs, nil, nil),
 		}, nil
 	}
 
@@ -94,7 +99,7 @@ func (*ScimIntegration) Create(ctx context.Context, req infer.CreateRequest[Scim
 
 	return infer.CreateResponse[ScimIntegrationState]{
 		ID:     strconv.FormatInt(created.Id, 10),
-		Output: scimIntegrationStateFromAPI(&authToken, *created),
+		Output: scimIntegrationStateFromAPI(ctx, &authToken, *created),
 	}, nil
 }
 
@@ -102,6 +107,9 @@ func (*ScimIntegration) Create(ctx context.Context, req infer.CreateRequest[Scim
 func (*ScimIntegration) Read(ctx context.Context, req infer.ReadRequest[ScimIntegrationArgs, ScimIntegrationState]) (infer.ReadResponse[ScimIntegrationArgs, ScimIntegrationState], error) {
 	p.GetLogger(ctx).Debugf("Read:ScimIntegration[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[ScimIntegrationArgs, ScimIntegrationState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -121,6 +129,9 @@ func (*ScimIntegration) Read(ctx context.Context, req infer.ReadRequest[ScimInte
 	}
 
 	// The auth token is masked on read; preserve the created value.
+	state := scimIntegrationStateFromAPI(ctx, req.State.AuthToken, *idp)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
+
 	return infer.ReadResponse[ScimIntegrationArgs, ScimIntegrationState]{
 		ID: req.ID,
 		Inputs: ScimIntegrationArgs{
@@ -131,7 +142,7 @@ func (*ScimIntegration) Read(ctx context.Context, req infer.ReadRequest[ScimInte
 			GroupPrefixes:     &idp.GroupPrefixes,
 			UserGroupPrefixes: &idp.UserGroupPrefixes,
 		},
-		State: scimIntegrationStateFromAPI(req.State.AuthToken, *idp),
+		State: state,
 	}, nil
 }
 
@@ -139,9 +150,12 @@ func (*ScimIntegration) Read(ctx context.Context, req infer.ReadRequest[ScimInte
 func (*ScimIntegration) Update(ctx context.Context, req infer.UpdateRequest[ScimIntegrationArgs, ScimIntegrationState]) (infer.UpdateResponse[ScimIntegrationState], error) {
 	p.GetLogger(ctx).Debugf("Update:ScimIntegration[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.UpdateResponse[ScimIntegrationState]{
-			Output: scimIntegrationStateFromArgs(req.Inputs, req.State.AuthToken, req.State.LastSyncedAt),
+			Output: scimIntegrationStateFromArgs(ctx, req.Inputs, req.State.AuthToken, req.State.LastSyncedAt),
 		}, nil
 	}
 
@@ -164,7 +178,7 @@ func (*ScimIntegration) Update(ctx context.Context, req infer.UpdateRequest[Scim
 	}
 
 	return infer.UpdateResponse[ScimIntegrationState]{
-		Output: scimIntegrationStateFromAPI(req.State.AuthToken, *updated),
+		Output: scimIntegrationStateFromAPI(ctx, req.State.AuthToken, *updated),
 	}, nil
 }
 
@@ -172,6 +186,9 @@ func (*ScimIntegration) Update(ctx context.Context, req infer.UpdateRequest[Scim
 func (*ScimIntegration) Delete(ctx context.Context, req infer.DeleteRequest[ScimIntegrationState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:ScimIntegration[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -191,6 +208,8 @@ func (*ScimIntegration) Diff(ctx context.Context, req infer.DiffRequest[ScimInte
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Prefix != req.State.Prefix {
 		diff["prefix"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
 	}
@@ -257,8 +276,9 @@ func (*ScimIntegration) WireDependencies(field infer.FieldSelector, args *ScimIn
 	field.OutputField(&state.UserGroupPrefixes).DependsOn(field.InputField(&args.UserGroupPrefixes))
 }
 
-func scimIntegrationStateFromArgs(args ScimIntegrationArgs, authToken *string, lastSyncedAt *string) ScimIntegrationState {
+func scimIntegrationStateFromArgs(ctx context.Context, args ScimIntegrationArgs, authToken *string, lastSyncedAt *string) ScimIntegrationState {
 	return ScimIntegrationState{
+		AccountScope:      currentAccountScope(ctx),
 		Prefix:            args.Prefix,
 		Provider:          args.Provider,
 		Enabled:           args.Enabled,
@@ -270,10 +290,11 @@ func scimIntegrationStateFromArgs(args ScimIntegrationArgs, authToken *string, l
 	}
 }
 
-func scimIntegrationStateFromAPI(authToken *string, idp nbapi.ScimIntegration) ScimIntegrationState {
+func scimIntegrationStateFromAPI(ctx context.Context, authToken *string, idp nbapi.ScimIntegration) ScimIntegrationState {
 	lastSynced := idp.LastSyncedAt.Format(idpTimeFormat)
 
 	return ScimIntegrationState{
+		AccountScope:      currentAccountScope(ctx),
 		Prefix:            idp.Prefix,
 		Provider:          idp.Provider,
 		Enabled:           &idp.Enabled,
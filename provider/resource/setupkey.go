@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
 	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -40,6 +44,10 @@ type SetupKeyArgs struct {
 	UsageLimit          int          `pulumi:"usageLimit"`
 	Ephemeral           *bool        `pulumi:"ephemeral,optional"`
 	AllowExtraDNSLabels *bool        `pulumi:"allowExtraDnsLabels,optional"`
+	Revoked             *bool        `pulumi:"revoked,optional"`
+	EncryptFor          *string      `pulumi:"encryptFor,optional"`
+	RotateBefore        *int         `pulumi:"rotateBefore,optional"`     // seconds
+	RotationInterval    *int         `pulumi:"rotationInterval,optional"` // seconds
 }
 
 // Annotate provides documentation for SetupKeyArgs fields.
@@ -51,20 +59,45 @@ func (a *SetupKeyArgs) Annotate(annotator infer.Annotator) {
 	annotator.Describe(&a.UsageLimit, "Maximum uses for reusable keys; 0 = unlimited.")
 	annotator.Describe(&a.Ephemeral, "Whether peers registered with this key are ephemeral (auto-expire).")
 	annotator.Describe(&a.AllowExtraDNSLabels, "Allow peers to add extra DNS labels beyond the base peer name.")
+	annotator.Describe(&a.Revoked, "Whether the key is revoked. A revoked key can no longer enroll peers but is kept, "+
+		"with its usage history, until the resource is deleted. When unset, the revocation state is left as is.")
+	annotator.Describe(&a.EncryptFor, encryptForDescription)
+	annotator.Describe(&a.RotateBefore, "Replace the key once it expires within this many seconds. "+
+		"The replacement key is created before the old one is deleted. Requires expiresIn > 0.")
+	annotator.Describe(&a.RotationInterval, "Replace the key once it is this many seconds old. "+
+		"The replacement key is created before the old one is deleted.")
 }
 
 // SetupKeyState represents the state/output of a setup key resource.
 type SetupKeyState struct {
+	AccountScope
+
 	SetupKeyArgs
 
-	Key       *string `pulumi:"key,optional"`
-	Valid     *bool   `pulumi:"valid,optional"`
-	Revoked   *bool   `pulumi:"revoked,optional"`
-	UsedTimes *int    `pulumi:"usedTimes,optional"`
-	LastUsed  *string `pulumi:"lastUsed,optional"`
-	Expires   *string `pulumi:"expires,optional"`
-	State     *string `pulumi:"state,optional"`
-	UpdatedAt *string `pulumi:"updatedAt,optional"`
+	Key               *string  `pulumi:"key,optional"`
+	Valid             *bool    `pulumi:"valid,optional"`
+	UsedTimes         *int     `pulumi:"usedTimes,optional"`
+	LastUsed          *string  `pulumi:"lastUsed,optional"`
+	Expires           *string  `pulumi:"expires,optional"`
+	State             *string  `pulumi:"state,optional"`
+	UpdatedAt         *string  `pulumi:"updatedAt,optional"`
+	CreatedAt         *string  `pulumi:"createdAt,optional"`
+	RemainingValidity *int     `pulumi:"remainingValidity,optional"`
+	EnrolledPeers     []string `pulumi:"enrolledPeers,optional"`
+	EncryptedKey      *string  `pulumi:"encryptedKey,optional"`
+}
+
+// Annotate provides documentation for SetupKeyState fields.
+func (s *SetupKeyState) Annotate(annotator infer.Annotator) {
+	annotator.Describe(&s.CreatedAt, "When the provider created the key. Unset for imported keys, which rotationInterval then never rotates.")
+	annotator.Describe(&s.RemainingValidity, "Seconds until the key expires, as of the last create or refresh. Unset for keys that do not expire.")
+	annotator.Describe(&s.Valid, "Whether the key can currently enroll peers.")
+	annotator.Describe(&s.UsedTimes, "How many times the key was used to enroll a peer.")
+	annotator.Describe(&s.LastUsed, "When the key was last used to enroll a peer.")
+	annotator.Describe(&s.State, "Key state: valid, overused, expired, or revoked.")
+	annotator.Describe(&s.EnrolledPeers, "IDs of existing peers that enrolled with the key, oldest first, as of the last refresh. "+
+		"Taken from the account's audit events, so the provider token must be allowed to read them.")
+	annotator.Describe(&s.EncryptedKey, "The full key encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.")
 }
 
 // Values describes the setup key type enum for schema generation.
@@ -79,19 +112,26 @@ func (SetupKeyType) Values() []infer.EnumValue[SetupKeyType] {
 func (*SetupKey) Create(ctx context.Context, req infer.CreateRequest[SetupKeyArgs]) (infer.CreateResponse[SetupKeyState], error) {
 	p.GetLogger(ctx).Debugf("Create:SetupKey name=%s, type=%s", req.Inputs.Name, req.Inputs.Type)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[SetupKeyState]{
 			ID: "preview",
 			Output: SetupKeyState{
-				SetupKeyArgs: req.Inputs,
-				Key:          nil,
-				Valid:        nil,
-				Revoked:      nil,
-				UsedTimes:    nil,
-				LastUsed:     nil,
-				Expires:      nil,
-				State:        nil,
-				UpdatedAt:    nil,
+				AccountScope:      currentAccountScope(ctx),
+				SetupKeyArgs:      req.Inputs,
+				Key:               nil,
+				Valid:             nil,
+				UsedTimes:         nil,
+				LastUsed:          nil,
+				Expires:           nil,
+				State:             nil,
+				UpdatedAt:         nil,
+				CreatedAt:         nil,
+				RemainingValidity: nil,
+				EnrolledPeers:     nil,
+				EncryptedKey:      nil,
 			},
 		}, nil
 	}
@@ -119,8 +159,26 @@ func (*SetupKey) Create(ctx context.Context, req infer.CreateRequest[SetupKeyArg
 
 	p.GetLogger(ctx).Debugf("Create:SetupKeyAPI name=%s, id=%s", setupKey.Name, setupKey.Id)
 
-	// Convert time.Time to string
+	// The full key is only returned on creation.
 	key := setupKey.Key
+
+	if boolVal(req.Inputs.Revoked) {
+		// Keys cannot be created revoked; revoke right after creation.
+		revokedKey, err := client.SetupKeys.Update(ctx, setupKey.Id, nbapi.SetupKeyRequest{
+			AutoGroups: req.Inputs.AutoGroups,
+			Revoked:    true,
+		})
+		if err != nil {
+			return infer.CreateResponse[SetupKeyState]{}, fmt.Errorf("revoking setup key failed: %w", err)
+		}
+
+		setupKey.Revoked = revokedKey.Revoked
+		setupKey.State = revokedKey.State
+		setupKey.Valid = revokedKey.Valid
+		setupKey.UpdatedAt = revokedKey.UpdatedAt
+	}
+
+	// Convert time.Time to string
 	expires := setupKey.Expires.Format("2006-01-02T15:04:05Z07:00")
 	lastUsed := setupKey.LastUsed.Format("2006-01-02T15:04:05Z07:00")
 	updatedAt := setupKey.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
@@ -131,16 +189,31 @@ func (*SetupKey) Create(ctx context.Context, req infer.CreateRequest[SetupKeyArg
 	// Note: SetupKey doesn't have a Valid field in the API, using State instead
 	valid := state == setupKeyStateValid
 
+	now := config.Now(ctx)
+	createdAt := now.UTC().Format(time.RFC3339)
+
 	stateObj := SetupKeyState{
-		SetupKeyArgs: req.Inputs,
-		Key:          &key,
-		Valid:        &valid,
-		Revoked:      &revoked,
-		UsedTimes:    &usedTimes,
-		LastUsed:     &lastUsed,
-		Expires:      &expires,
-		State:        &state,
-		UpdatedAt:    &updatedAt,
+		AccountScope:      currentAccountScope(ctx),
+		SetupKeyArgs:      req.Inputs,
+		Key:               &key,
+		Valid:             &valid,
+		UsedTimes:         &usedTimes,
+		LastUsed:          &lastUsed,
+		Expires:           &expires,
+		State:             &state,
+		UpdatedAt:         &updatedAt,
+		CreatedAt:         &createdAt,
+		RemainingValidity: remainingValidity(now, setupKey.Expires),
+		EnrolledPeers:     nil,
+		EncryptedKey:      nil,
+	}
+	stateObj.Revoked = &revoked
+
+	if req.Inputs.EncryptFor != nil {
+		stateObj.EncryptedKey, err = encryptedSecret(*req.Inputs.EncryptFor, &key)
+		if err != nil {
+			return infer.CreateResponse[SetupKeyState]{}, fmt.Errorf("encrypting setup key failed: %w", err)
+		}
 	}
 
 	return infer.CreateResponse[SetupKeyState]{
@@ -150,50 +223,78 @@ func (*SetupKey) Create(ctx context.Context, req infer.CreateRequest[SetupKeyArg
 }
 
 // Read fetches the current state of a setup key resource from NetBird.
-func (*SetupKey) Read(ctx context.Context, setupKeyID string, state SetupKeyState) (SetupKeyState, error) {
-	p.GetLogger(ctx).Debugf("Read:SetupKey id=%s", setupKeyID)
+func (*SetupKey) Read(
+	ctx context.Context,
+	req infer.ReadRequest[SetupKeyArgs, SetupKeyState],
+) (infer.ReadResponse[SetupKeyArgs, SetupKeyState], error) {
+	p.GetLogger(ctx).Debugf("Read:SetupKey id=%s", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
 
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
-		return state, fmt.Errorf("error getting NetBird client: %w", err)
+		return infer.ReadResponse[SetupKeyArgs, SetupKeyState]{}, fmt.Errorf("error getting NetBird client: %w", err)
 	}
 
-	setupKey, err := client.SetupKeys.Get(ctx, setupKeyID)
+	setupKey, err := client.SetupKeys.Get(ctx, req.ID)
 	if err != nil {
-		return state, fmt.Errorf("reading setup key failed: %w", err)
+		if isNotFoundErr(err) {
+			return infer.ReadResponse[SetupKeyArgs, SetupKeyState]{
+				ID:     "",
+				Inputs: SetupKeyArgs{},  //nolint:exhaustruct
+				State:  SetupKeyState{}, //nolint:exhaustruct
+			}, nil
+		}
+
+		return infer.ReadResponse[SetupKeyArgs, SetupKeyState]{}, fmt.Errorf("reading setup key failed: %w", err)
 	}
 
 	p.GetLogger(ctx).Debugf("Read:SetupKeyAPI name=%s, id=%s", setupKey.Name, setupKey.Id)
 
-	state.Name = setupKey.Name
-	state.Type = SetupKeyType(setupKey.Type)
-	state.AutoGroups = setupKey.AutoGroups
-	state.UsageLimit = setupKey.UsageLimit
-	state.Ephemeral = &setupKey.Ephemeral
-	state.AllowExtraDNSLabels = &setupKey.AllowExtraDnsLabels
-
-	// Output fields
-	state.Key = &setupKey.Key
-	state.Revoked = &setupKey.Revoked
-	state.UsedTimes = &setupKey.UsedTimes
-	expires := setupKey.Expires.Format("2006-01-02T15:04:05Z07:00")
-	state.Expires = &expires
-	lastUsed := setupKey.LastUsed.Format("2006-01-02T15:04:05Z07:00")
-	state.LastUsed = &lastUsed
-	stateStr := setupKey.State
-	state.State = &stateStr
-	updatedAt := setupKey.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
-	state.UpdatedAt = &updatedAt
-	valid := stateStr == setupKeyStateValid
-	state.Valid = &valid
+	state := SetupKeyStateFromAPI(ctx, setupKey)
+	// The API does not report the requested lifetime or the rotation settings,
+	// and only returns the full key on creation; keep them from prior state.
+	state.ExpiresIn = req.State.ExpiresIn
+	state.RotateBefore = req.State.RotateBefore
+	state.RotationInterval = req.State.RotationInterval
+	state.EncryptFor = req.State.EncryptFor
+	state.EncryptedKey = req.State.EncryptedKey
+	state.CreatedAt = req.State.CreatedAt
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
+
+	if req.State.Key != nil {
+		state.Key = req.State.Key
+	}
+
+	state.EnrolledPeers, err = enrolledPeerIDs(ctx, client, req.ID)
+	if err != nil {
+		// Audit events need more privileges than setup keys; keep the last known peers.
+		p.GetLogger(ctx).Warningf("Read:SetupKey[%s] cannot list enrolled peers: %v", req.ID, err)
+		state.EnrolledPeers = req.State.EnrolledPeers
+	}
 
-	return state, nil
+	inputs := state.SetupKeyArgs
+	inputs.ExpiresIn = req.Inputs.ExpiresIn
+	inputs.Revoked = req.Inputs.Revoked
+	inputs.RotateBefore = req.Inputs.RotateBefore
+	inputs.RotationInterval = req.Inputs.RotationInterval
+	inputs.EncryptFor = req.Inputs.EncryptFor
+
+	return infer.ReadResponse[SetupKeyArgs, SetupKeyState]{
+		ID:     req.ID,
+		Inputs: inputs,
+		State:  state,
+	}, nil
 }
 
 // Update updates the state of the setup key if needed.
 func (*SetupKey) Update(ctx context.Context, req infer.UpdateRequest[SetupKeyArgs, SetupKeyState]) (infer.UpdateResponse[SetupKeyState], error) {
 	p.GetLogger(ctx).Debugf("Update:SetupKey[%s] name=%s", req.ID, req.Inputs.Name)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	// Check for non-updatable field changes (would require replace)
 	if req.Inputs.Name != req.State.Name ||
 		req.Inputs.Type != req.State.Type ||
@@ -209,15 +310,19 @@ func (*SetupKey) Update(ctx context.Context, req infer.UpdateRequest[SetupKeyArg
 	if req.DryRun {
 		return infer.UpdateResponse[SetupKeyState]{
 			Output: SetupKeyState{
-				SetupKeyArgs: req.Inputs,
-				Key:          nil,
-				Valid:        nil,
-				Revoked:      nil,
-				UsedTimes:    nil,
-				LastUsed:     nil,
-				Expires:      nil,
-				State:        nil,
-				UpdatedAt:    nil,
+				AccountScope:      currentAccountScope(ctx),
+				SetupKeyArgs:      req.Inputs,
+				Key:               nil,
+				Valid:             nil,
+				UsedTimes:         nil,
+				LastUsed:          nil,
+				Expires:           nil,
+				State:             nil,
+				UpdatedAt:         nil,
+				CreatedAt:         req.State.CreatedAt,
+				RemainingValidity: nil,
+				EnrolledPeers:     req.State.EnrolledPeers,
+				EncryptedKey:      nil,
 			},
 		}, nil
 	}
@@ -227,10 +332,16 @@ func (*SetupKey) Update(ctx context.Context, req infer.UpdateRequest[SetupKeyArg
 		return infer.UpdateResponse[SetupKeyState]{}, fmt.Errorf("error getting NetBird client: %w", err)
 	}
 
-	// Only AutoGroups and Revoked can be updated
+	// Only AutoGroups and Revoked can be updated. An unset revoked input
+	// leaves the current revocation state alone.
+	revokedInput := req.State.Revoked
+	if req.Inputs.Revoked != nil {
+		revokedInput = req.Inputs.Revoked
+	}
+
 	updateReq := nbapi.SetupKeyRequest{
 		AutoGroups: req.Inputs.AutoGroups,
-		Revoked:    req.State.Revoked != nil && *req.State.Revoked,
+		Revoked:    boolVal(revokedInput),
 	}
 
 	updated, err := client.SetupKeys.Update(ctx, req.ID, updateReq)
@@ -240,6 +351,9 @@ func (*SetupKey) Update(ctx context.Context, req infer.UpdateRequest[SetupKeyArg
 
 	out := req.State
 	out.AutoGroups = req.Inputs.AutoGroups
+	out.RotateBefore = req.Inputs.RotateBefore
+	out.RotationInterval = req.Inputs.RotationInterval
+	out.EncryptFor = req.Inputs.EncryptFor
 	revoked := updated.Revoked
 	out.Revoked = &revoked
 	stateStr := updated.State
@@ -249,6 +363,17 @@ func (*SetupKey) Update(ctx context.Context, req infer.UpdateRequest[SetupKeyArg
 	updatedAt := updated.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
 	out.UpdatedAt = &updatedAt
 
+	if !equalPtr(req.Inputs.EncryptFor, req.State.EncryptFor) {
+		out.EncryptedKey = nil
+
+		if req.Inputs.EncryptFor != nil {
+			out.EncryptedKey, err = encryptedSecret(*req.Inputs.EncryptFor, setupKeyPlaintext(req.State.Key))
+			if err != nil {
+				return infer.UpdateResponse[SetupKeyState]{}, fmt.Errorf("encrypting setup key failed: %w", err)
+			}
+		}
+	}
+
 	return infer.UpdateResponse[SetupKeyState]{Output: out}, nil
 }
 
@@ -256,6 +381,9 @@ func (*SetupKey) Update(ctx context.Context, req infer.UpdateRequest[SetupKeyArg
 func (*SetupKey) Delete(ctx context.Context, req infer.DeleteRequest[SetupKeyState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:SetupKey[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -275,6 +403,8 @@ func (*SetupKey) Diff(ctx context.Context, req infer.DiffRequest[SetupKeyArgs, S
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.Name != req.State.Name {
 		diff["name"] = p.PropertyDiff{
 			InputDiff: false,
@@ -324,6 +454,41 @@ func (*SetupKey) Diff(ctx context.Context, req infer.DiffRequest[SetupKeyArgs, S
 		}
 	}
 
+	if req.Inputs.Revoked != nil && *req.Inputs.Revoked != boolVal(req.State.Revoked) {
+		diff["revoked"] = p.PropertyDiff{
+			InputDiff: false,
+			Kind:      p.Update,
+		}
+	}
+
+	if !equalPtr(req.Inputs.EncryptFor, req.State.EncryptFor) {
+		diff["encryptFor"] = p.PropertyDiff{
+			InputDiff: false,
+			Kind:      p.Update,
+		}
+	}
+
+	if !equalPtr(req.Inputs.RotateBefore, req.State.RotateBefore) {
+		diff["rotateBefore"] = p.PropertyDiff{
+			InputDiff: false,
+			Kind:      p.Update,
+		}
+	}
+
+	if !equalPtr(req.Inputs.RotationInterval, req.State.RotationInterval) {
+		diff["rotationInterval"] = p.PropertyDiff{
+			InputDiff: false,
+			Kind:      p.Update,
+		}
+	}
+
+	if setupKeyRotation(req.Inputs).due(config.Now(ctx), parseRotationTime(req.State.CreatedAt), parseRotationTime(req.State.Expires)) {
+		diff["expires"] = p.PropertyDiff{
+			InputDiff: false,
+			Kind:      p.UpdateReplace,
+		}
+	}
+
 	p.GetLogger(ctx).Debugf("Diff:SetupKey[%s] diff=%d", req.ID, len(diff))
 
 	return infer.DiffResponse{
@@ -366,6 +531,9 @@ func (*SetupKey) Check(ctx context.Context, req infer.CheckRequest) (infer.Check
 		})
 	}
 
+	failures = append(failures, setupKeyRotation(args).check(args.ExpiresIn)...)
+	failures = append(failures, checkEncryptFor(args.EncryptFor)...)
+
 	for i, groupID := range args.AutoGroups {
 		if isBlank(groupID) {
 			failures = append(failures, p.CheckFailure{
@@ -380,3 +548,126 @@ func (*SetupKey) Check(ctx context.Context, req infer.CheckRequest) (infer.Check
 		Failures: failures,
 	}, err
 }
+
+// SetupKeyStateFromAPI builds a SetupKeyState from an API SetupKey response.
+// The API does not report the requested lifetime, so expiresIn is left at zero.
+func SetupKeyStateFromAPI(ctx context.Context, setupKey *nbapi.SetupKey) SetupKeyState {
+	key := setupKey.Key
+	expires := setupKey.Expires.Format("2006-01-02T15:04:05Z07:00")
+	lastUsed := setupKey.LastUsed.Format("2006-01-02T15:04:05Z07:00")
+	updatedAt := setupKey.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	state := setupKey.State
+	revoked := setupKey.Revoked
+	usedTimes := setupKey.UsedTimes
+	valid := state == setupKeyStateValid
+	ephemeral := setupKey.Ephemeral
+	allowExtraDNSLabels := setupKey.AllowExtraDnsLabels
+
+	return SetupKeyState{
+		AccountScope: currentAccountScope(ctx),
+		SetupKeyArgs: SetupKeyArgs{
+			Name:                setupKey.Name,
+			Type:                SetupKeyType(setupKey.Type),
+			ExpiresIn:           0,
+			AutoGroups:          setupKey.AutoGroups,
+			UsageLimit:          setupKey.UsageLimit,
+			Ephemeral:           &ephemeral,
+			AllowExtraDNSLabels: &allowExtraDNSLabels,
+			Revoked:             &revoked,
+			EncryptFor:          nil,
+			RotateBefore:        nil,
+			RotationInterval:    nil,
+		},
+		Key:               &key,
+		Valid:             &valid,
+		UsedTimes:         &usedTimes,
+		LastUsed:          &lastUsed,
+		Expires:           &expires,
+		State:             &state,
+		UpdatedAt:         &updatedAt,
+		CreatedAt:         nil,
+		RemainingValidity: remainingValidity(config.Now(ctx), setupKey.Expires),
+		EnrolledPeers:     nil,
+		EncryptedKey:      nil,
+	}
+}
+
+// setupKeyPlaintext returns the full key from state, or nil for keys the
+// provider did not create, whose state only holds the masked key.
+func setupKeyPlaintext(key *string) *string {
+	if key == nil || strings.Contains(*key, "*") {
+		return nil
+	}
+
+	return key
+}
+
+// setupKeyRotation returns the rotation policy of a setup key, in seconds.
+func setupKeyRotation(args SetupKeyArgs) rotationPolicy {
+	return rotationPolicy{
+		rotateBefore:     args.RotateBefore,
+		rotationInterval: args.RotationInterval,
+		unit:             time.Second,
+	}
+}
+
+// SetupKeyEnrollment is a peer that joined the account with a setup key.
+type SetupKeyEnrollment struct {
+	PeerID     string
+	PeerName   string
+	EnrolledAt time.Time
+}
+
+// ListSetupKeyEnrollments returns the peers that enrolled with the setup key,
+// oldest first. NetBird does not link peers to setup keys, so they are taken
+// from the "peer added with setup key" audit events, which name the key as
+// initiator and the peer as target.
+func ListSetupKeyEnrollments(ctx context.Context, client *rest.Client, setupKeyID string) ([]SetupKeyEnrollment, error) {
+	events, err := client.Events.ListAuditEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing audit events failed: %w", err)
+	}
+
+	var enrollments []SetupKeyEnrollment
+
+	for _, event := range events {
+		if event.ActivityCode != nbapi.EventActivityCodePeerSetupkeyAdd || event.InitiatorId != setupKeyID {
+			continue
+		}
+
+		enrollments = append(enrollments, SetupKeyEnrollment{
+			PeerID:     event.TargetId,
+			PeerName:   event.Meta["name"],
+			EnrolledAt: event.Timestamp,
+		})
+	}
+
+	slices.SortStableFunc(enrollments, func(a, b SetupKeyEnrollment) int { return a.EnrolledAt.Compare(b.EnrolledAt) })
+
+	return enrollments, nil
+}
+
+// enrolledPeerIDs returns the IDs of the existing peers that enrolled with
+// the setup key, oldest first.
+func enrolledPeerIDs(ctx context.Context, client *rest.Client, setupKeyID string) ([]string, error) {
+	enrollments, err := ListSetupKeyEnrollments(ctx, client, setupKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	peers, err := client.Peers.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing peers failed: %w", err)
+	}
+
+	ids := make([]string, 0, len(enrollments))
+
+	for _, enrollment := range enrollments {
+		exists := slices.ContainsFunc(peers, func(peer nbapi.Peer) bool { return peer.Id == enrollment.PeerID })
+		if exists && !slices.Contains(ids, enrollment.PeerID) {
+			ids = append(ids, enrollment.PeerID)
+		}
+	}
+
+	return ids, nil
+}
@@ -3,6 +3,7 @@ package resource
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/mbrav/pulumi-netbird/provider/config"
 	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
@@ -19,14 +20,17 @@ type Token struct{}
 func (t *Token) Annotate(a infer.Annotator) {
 	a.Describe(&t, "A NetBird personal access token (PAT) for a user. The plaintext token is "+
 		"returned only once, on creation, and is exposed as a secret output. The token cannot be "+
-		"modified after creation; any input change forces a replacement.")
+		"modified after creation; any input change other than the rotation settings or encryptFor forces a replacement.")
 }
 
 // TokenArgs defines input fields for creating a personal access token.
 type TokenArgs struct {
-	UserID    string `pulumi:"userId"`
-	Name      string `pulumi:"name"`
-	ExpiresIn int    `pulumi:"expiresIn"`
+	UserID           string  `pulumi:"userId"`
+	Name             string  `pulumi:"name"`
+	ExpiresIn        int     `pulumi:"expiresIn"`
+	RotateBefore     *int    `pulumi:"rotateBefore,optional"`
+	RotationInterval *int    `pulumi:"rotationInterval,optional"`
+	EncryptFor       *string `pulumi:"encryptFor,optional"`
 }
 
 // Annotate provides documentation for TokenArgs fields.
@@ -34,18 +38,30 @@ func (t *TokenArgs) Annotate(a infer.Annotator) {
 	a.Describe(&t.UserID, "ID of the user the token is issued for.")
 	a.Describe(&t.Name, "Display name of the token.")
 	a.Describe(&t.ExpiresIn, "Token lifetime in days.")
+	a.Describe(&t.RotateBefore, "Replace the token once it expires within this many days. "+
+		"The replacement token is created before the old one is deleted.")
+	a.Describe(&t.RotationInterval, "Replace the token once it is this many days old. "+
+		"The replacement token is created before the old one is deleted.")
+	a.Describe(&t.EncryptFor, encryptForDescription)
 }
 
 // TokenState represents the output state of a personal access token resource.
 type TokenState struct {
-	UserID         string  `pulumi:"userId"`
-	Name           string  `pulumi:"name"`
-	ExpiresIn      int     `pulumi:"expiresIn"`
-	Token          *string `provider:"secret"                pulumi:"token,optional"`
-	CreatedAt      *string `pulumi:"createdAt,optional"`
-	CreatedBy      *string `pulumi:"createdBy,optional"`
-	ExpirationDate *string `pulumi:"expirationDate,optional"`
-	LastUsed       *string `pulumi:"lastUsed,optional"`
+	AccountScope
+
+	UserID            string  `pulumi:"userId"`
+	Name              string  `pulumi:"name"`
+	ExpiresIn         int     `pulumi:"expiresIn"`
+	RotateBefore      *int    `pulumi:"rotateBefore,optional"`
+	RotationInterval  *int    `pulumi:"rotationInterval,optional"`
+	EncryptFor        *string `pulumi:"encryptFor,optional"`
+	Token             *string `provider:"secret"                pulumi:"token,optional"`
+	CreatedAt         *string `pulumi:"createdAt,optional"`
+	CreatedBy         *string `pulumi:"createdBy,optional"`
+	ExpirationDate    *string `pulumi:"expirationDate,optional"`
+	LastUsed          *string `pulumi:"lastUsed,optional"`
+	RemainingValidity *int    `pulumi:"remainingValidity,optional"`
+	EncryptedToken    *string `pulumi:"encryptedToken,optional"`
 }
 
 // Annotate provides documentation for TokenState fields.
@@ -53,29 +69,43 @@ func (t *TokenState) Annotate(annotator infer.Annotator) {
 	annotator.Describe(&t.UserID, "ID of the user the token is issued for.")
 	annotator.Describe(&t.Name, "Display name of the token.")
 	annotator.Describe(&t.ExpiresIn, "Token lifetime in days.")
+	annotator.Describe(&t.RotateBefore, "Days before expiry at which the token is replaced.")
+	annotator.Describe(&t.RotationInterval, "Age in days at which the token is replaced.")
 	annotator.Describe(&t.Token, "Plaintext token value. Only populated on creation; never returned by the API afterwards.")
 	annotator.Describe(&t.CreatedAt, "Timestamp the token was created.")
 	annotator.Describe(&t.CreatedBy, "User ID of the principal that created the token.")
 	annotator.Describe(&t.ExpirationDate, "Timestamp the token expires.")
 	annotator.Describe(&t.LastUsed, "Timestamp the token was last used, if ever.")
+	annotator.Describe(&t.RemainingValidity, "Seconds until the token expires, as of the last create or refresh.")
+	annotator.Describe(&t.EncryptFor, "Public key the token is encrypted to in encryptedToken.")
+	annotator.Describe(&t.EncryptedToken, "The plaintext token encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.")
 }
 
 // Create issues a new personal access token for a user.
 func (*Token) Create(ctx context.Context, req infer.CreateRequest[TokenArgs]) (infer.CreateResponse[TokenState], error) {
 	p.GetLogger(ctx).Debugf("Create:Token userId=%s name=%s", req.Inputs.UserID, req.Inputs.Name)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[TokenState]{
 			ID: "preview",
 			Output: TokenState{
-				UserID:         req.Inputs.UserID,
-				Name:           req.Inputs.Name,
-				ExpiresIn:      req.Inputs.ExpiresIn,
-				Token:          nil,
-				CreatedAt:      nil,
-				CreatedBy:      nil,
-				ExpirationDate: nil,
-				LastUsed:       nil,
+				AccountScope:      currentAccountScope(ctx),
+				UserID:            req.Inputs.UserID,
+				Name:              req.Inputs.Name,
+				ExpiresIn:         req.Inputs.ExpiresIn,
+				RotateBefore:      req.Inputs.RotateBefore,
+				RotationInterval:  req.Inputs.RotationInterval,
+				EncryptFor:        req.Inputs.EncryptFor,
+				Token:             nil,
+				CreatedAt:         nil,
+				CreatedBy:         nil,
+				ExpirationDate:    nil,
+				LastUsed:          nil,
+				RemainingValidity: nil,
+				EncryptedToken:    nil,
 			},
 		}, nil
 	}
@@ -98,9 +128,19 @@ func (*Token) Create(ctx context.Context, req infer.CreateRequest[TokenArgs]) (i
 
 	p.GetLogger(ctx).Debugf("Create:TokenAPI id=%s name=%s", pat.Id, pat.Name)
 
-	state := tokenStateFromAPI(req.Inputs.UserID, req.Inputs.ExpiresIn, pat)
+	state := TokenStateFromAPI(ctx, req.Inputs.UserID, req.Inputs.ExpiresIn, pat)
+	state.RotateBefore = req.Inputs.RotateBefore
+	state.RotationInterval = req.Inputs.RotationInterval
+	state.EncryptFor = req.Inputs.EncryptFor
 	state.Token = &plain
 
+	if req.Inputs.EncryptFor != nil {
+		state.EncryptedToken, err = encryptedSecret(*req.Inputs.EncryptFor, &plain)
+		if err != nil {
+			return infer.CreateResponse[TokenState]{}, fmt.Errorf("encrypting token failed: %w", err)
+		}
+	}
+
 	return infer.CreateResponse[TokenState]{
 		ID:     pat.Id,
 		Output: state,
@@ -112,6 +152,9 @@ func (*Token) Create(ctx context.Context, req infer.CreateRequest[TokenArgs]) (i
 func (*Token) Read(ctx context.Context, req infer.ReadRequest[TokenArgs, TokenState]) (infer.ReadResponse[TokenArgs, TokenState], error) {
 	p.GetLogger(ctx).Debugf("Read:Token[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	userID := req.State.UserID
 	tokenID := req.ID
 
@@ -142,16 +185,24 @@ func (*Token) Read(ctx context.Context, req infer.ReadRequest[TokenArgs, TokenSt
 		return infer.ReadResponse[TokenArgs, TokenState]{}, fmt.Errorf("reading token failed: %w", err)
 	}
 
-	state := tokenStateFromAPI(userID, req.State.ExpiresIn, *pat)
+	state := TokenStateFromAPI(ctx, userID, req.State.ExpiresIn, *pat)
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
+	state.RotateBefore = req.State.RotateBefore
+	state.RotationInterval = req.State.RotationInterval
+	state.EncryptFor = req.State.EncryptFor
 	// The plaintext token is only ever returned on creation; preserve any prior value.
 	state.Token = req.State.Token
+	state.EncryptedToken = req.State.EncryptedToken
 
 	return infer.ReadResponse[TokenArgs, TokenState]{
 		ID: tokenID,
 		Inputs: TokenArgs{
-			UserID:    userID,
-			Name:      pat.Name,
-			ExpiresIn: req.Inputs.ExpiresIn,
+			UserID:           userID,
+			Name:             pat.Name,
+			ExpiresIn:        req.Inputs.ExpiresIn,
+			RotateBefore:     req.Inputs.RotateBefore,
+			RotationInterval: req.Inputs.RotationInterval,
+			EncryptFor:       req.Inputs.EncryptFor,
 		},
 		State: state,
 	}, nil
@@ -161,6 +212,9 @@ func (*Token) Read(ctx context.Context, req infer.ReadRequest[TokenArgs, TokenSt
 func (*Token) Delete(ctx context.Context, req infer.DeleteRequest[TokenState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:Token[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -174,13 +228,42 @@ func (*Token) Delete(ctx context.Context, req infer.DeleteRequest[TokenState]) (
 	return infer.DeleteResponse{}, nil
 }
 
+// Update applies changes to the rotation settings and the encryption
+// recipient, which only live in state.
+func (*Token) Update(ctx context.Context, req infer.UpdateRequest[TokenArgs, TokenState]) (infer.UpdateResponse[TokenState], error) {
+	p.GetLogger(ctx).Debugf("Update:Token[%s]", req.ID)
+
+	out := req.State
+	out.RotateBefore = req.Inputs.RotateBefore
+	out.RotationInterval = req.Inputs.RotationInterval
+	out.EncryptFor = req.Inputs.EncryptFor
+
+	if !equalPtr(req.Inputs.EncryptFor, req.State.EncryptFor) {
+		out.EncryptedToken = nil
+
+		if req.Inputs.EncryptFor != nil && !req.DryRun {
+			var err error
+
+			out.EncryptedToken, err = encryptedSecret(*req.Inputs.EncryptFor, req.State.Token)
+			if err != nil {
+				return infer.UpdateResponse[TokenState]{}, fmt.Errorf("encrypting token failed: %w", err)
+			}
+		}
+	}
+
+	return infer.UpdateResponse[TokenState]{Output: out}, nil
+}
+
 // Diff detects changes between inputs and prior state. The API has no update
-// endpoint for tokens, so any input change forces a replacement.
+// endpoint for tokens, so any change other than to the rotation settings or
+// the encryption recipient forces a replacement, as does a due rotation.
 func (*Token) Diff(ctx context.Context, req infer.DiffRequest[TokenArgs, TokenState]) (infer.DiffResponse, error) {
 	p.GetLogger(ctx).Debugf("Diff:Token[%s]", req.ID)
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if req.Inputs.UserID != req.State.UserID {
 		diff["userId"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
 	}
@@ -193,6 +276,22 @@ func (*Token) Diff(ctx context.Context, req infer.DiffRequest[TokenArgs, TokenSt
 		diff["expiresIn"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
 	}
 
+	if !equalPtr(req.Inputs.EncryptFor, req.State.EncryptFor) {
+		diff["encryptFor"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	if !equalPtr(req.Inputs.RotateBefore, req.State.RotateBefore) {
+		diff["rotateBefore"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	if !equalPtr(req.Inputs.RotationInterval, req.State.RotationInterval) {
+		diff["rotationInterval"] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+	}
+
+	if tokenRotation(req.Inputs).due(config.Now(ctx), parseRotationTime(req.State.CreatedAt), parseRotationTime(req.State.ExpirationDate)) {
+		diff["expirationDate"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+	}
+
 	return infer.DiffResponse{
 		DeleteBeforeReplace: false,
 		HasChanges:          len(diff) > 0,
@@ -227,6 +326,9 @@ func (*Token) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckRes
 		})
 	}
 
+	failures = append(failures, tokenRotation(args).check(args.ExpiresIn)...)
+	failures = append(failures, checkEncryptFor(args.EncryptFor)...)
+
 	return infer.CheckResponse[TokenArgs]{
 		Inputs:   args,
 		Failures: failures,
@@ -238,10 +340,13 @@ func (*Token) WireDependencies(field infer.FieldSelector, args *TokenArgs, state
 	field.OutputField(&state.UserID).DependsOn(field.InputField(&args.UserID))
 	field.OutputField(&state.Name).DependsOn(field.InputField(&args.Name))
 	field.OutputField(&state.ExpiresIn).DependsOn(field.InputField(&args.ExpiresIn))
+	field.OutputField(&state.RotateBefore).DependsOn(field.InputField(&args.RotateBefore))
+	field.OutputField(&state.RotationInterval).DependsOn(field.InputField(&args.RotationInterval))
+	field.OutputField(&state.EncryptFor).DependsOn(field.InputField(&args.EncryptFor))
 }
 
-// tokenStateFromAPI maps an API token into resource state (excluding the plaintext token).
-func tokenStateFromAPI(userID string, expiresIn int, pat nbapi.PersonalAccessToken) TokenState {
+// TokenStateFromAPI maps an API token into resource state (excluding the plaintext token).
+func TokenStateFromAPI(ctx context.Context, userID string, expiresIn int, pat nbapi.PersonalAccessToken) TokenState {
 	createdAt := pat.CreatedAt.Format(tokenTimeFormat)
 	createdBy := pat.CreatedBy
 	expirationDate := pat.ExpirationDate.Format(tokenTimeFormat)
@@ -254,13 +359,28 @@ func tokenStateFromAPI(userID string, expiresIn int, pat nbapi.PersonalAccessTok
 	}
 
 	return TokenState{
-		UserID:         userID,
-		Name:           pat.Name,
-		ExpiresIn:      expiresIn,
-		Token:          nil,
-		CreatedAt:      &createdAt,
-		CreatedBy:      &createdBy,
-		ExpirationDate: &expirationDate,
-		LastUsed:       lastUsed,
+		AccountScope:      currentAccountScope(ctx),
+		UserID:            userID,
+		Name:              pat.Name,
+		ExpiresIn:         expiresIn,
+		RotateBefore:      nil,
+		RotationInterval:  nil,
+		EncryptFor:        nil,
+		Token:             nil,
+		CreatedAt:         &createdAt,
+		CreatedBy:         &createdBy,
+		ExpirationDate:    &expirationDate,
+		LastUsed:          lastUsed,
+		RemainingValidity: remainingValidity(config.Now(ctx), pat.ExpirationDate),
+		EncryptedToken:    nil,
+	}
+}
+
+// tokenRotation returns the rotation policy of a token, in days.
+func tokenRotation(args TokenArgs) rotationPolicy {
+	return rotationPolicy{
+		rotateBefore:     args.RotateBefore,
+		rotationInterval: args.RotationInterval,
+		unit:             24 * time.Hour,
 	}
 }
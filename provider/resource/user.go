@@ -69,6 +69,8 @@ func (UserStatus) Values() []infer.EnumValue[UserStatus] {
 
 // UserState represents the stored state of a NetBird user in Pulumi.
 type UserState struct {
+	AccountScope
+
 	Email         *string     `pulumi:"email,optional"`
 	Name          *string     `pulumi:"name,optional"`
 	Role          string      `pulumi:"role"`
@@ -93,10 +95,14 @@ func (user *UserState) Annotate(annotator infer.Annotator) {
 func (*User) Create(ctx context.Context, req infer.CreateRequest[UserArgs]) (infer.CreateResponse[UserState], error) {
 	p.GetLogger(ctx).Debugf("Create:User name=%s, email=%s", strPtr(req.Inputs.Name), strPtr(req.Inputs.Email))
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
 	if req.DryRun {
 		return infer.CreateResponse[UserState]{
 			ID: "preview",
 			Output: UserState{
+				AccountScope:  currentAccountScope(ctx),
 				Name:          req.Inputs.Name,
 				Email:         req.Inputs.Email,
 				Role:          req.Inputs.Role,
@@ -131,6 +137,7 @@ func (*User) Create(ctx context.Context, req infer.CreateRequest[UserArgs]) (inf
 	return infer.CreateResponse[UserState]{
 		ID: user.Id,
 		Output: UserState{
+			AccountScope:  currentAccountScope(ctx),
 			Name:          req.Inputs.Name,
 			Email:         req.Inputs.Email,
 			Role:          req.Inputs.Role,
@@ -146,6 +153,9 @@ func (*User) Create(ctx context.Context, req infer.CreateRequest[UserArgs]) (inf
 func (*User) Read(ctx context.Context, req infer.ReadRequest[UserArgs, UserState]) (infer.ReadResponse[UserArgs, UserState], error) {
 	p.GetLogger(ctx).Debugf("Read:User[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.ReadResponse[UserArgs, UserState]{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -189,6 +199,7 @@ func (*User) Read(ctx context.Context, req infer.ReadRequest[UserArgs, UserState
 			IsBlocked:     &foundUser.IsBlocked,
 		},
 		State: UserState{
+			AccountScope:  readAccountScope(ctx, req.State.AccountScope),
 			Name:          &foundUser.Name,
 			Email:         &foundUser.Email,
 			Role:          foundUser.Role,
@@ -204,6 +215,9 @@ func (*User) Read(ctx context.Context, req infer.ReadRequest[UserArgs, UserState
 func (*User) Update(ctx context.Context, req infer.UpdateRequest[UserArgs, UserState]) (infer.UpdateResponse[UserState], error) {
 	p.GetLogger(ctx).Debugf("Update:User[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
 	// Check if isBlocked is nil, if so, we set it to false
 	var isBlocked *bool
 	if req.Inputs.IsBlocked != nil {
@@ -216,6 +230,7 @@ func (*User) Update(ctx context.Context, req infer.UpdateRequest[UserArgs, UserS
 	if req.DryRun {
 		return infer.UpdateResponse[UserState]{
 			Output: UserState{
+				AccountScope:  currentAccountScope(ctx),
 				Name:          req.Inputs.Name,
 				Email:         req.Inputs.Email,
 				Role:          req.Inputs.Role,
@@ -245,6 +260,7 @@ func (*User) Update(ctx context.Context, req infer.UpdateRequest[UserArgs, UserS
 
 	return infer.UpdateResponse[UserState]{
 		Output: UserState{
+			AccountScope:  currentAccountScope(ctx),
 			Name:          req.Inputs.Name,
 			Email:         req.Inputs.Email,
 			Role:          req.Inputs.Role,
@@ -260,6 +276,9 @@ func (*User) Update(ctx context.Context, req infer.UpdateRequest[UserArgs, UserS
 func (*User) Delete(ctx context.Context, req infer.DeleteRequest[UserState]) (infer.DeleteResponse, error) {
 	p.GetLogger(ctx).Debugf("Delete:User[%s]", req.ID)
 
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
 	client, err := config.GetNetBirdClient(ctx)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
@@ -279,6 +298,8 @@ func (*User) Diff(ctx context.Context, req infer.DiffRequest[UserArgs, UserState
 
 	diff := map[string]p.PropertyDiff{}
 
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
 	if !equalPtr(req.Inputs.Name, req.State.Name) {
 		diff["name"] = p.PropertyDiff{
 			InputDiff: false,
@@ -369,3 +390,25 @@ func (*User) WireDependencies(field infer.FieldSelector, args *UserArgs, state *
 	field.OutputField(&state.AutoGroups).DependsOn(field.InputField(&args.AutoGroups))
 	field.OutputField(&state.IsBlocked).DependsOn(field.InputField(&args.IsBlocked))
 }
+
+// UserStateFromAPI builds a UserState from an API User response.
+func UserStateFromAPI(ctx context.Context, user *nbapi.User) UserState {
+	autoGroups := slices.Clone(user.AutoGroups)
+	slices.Sort(autoGroups)
+
+	email := user.Email
+	name := user.Name
+	isBlocked := user.IsBlocked
+	status := UserStatus(user.Status)
+
+	return UserState{
+		AccountScope:  currentAccountScope(ctx),
+		Email:         &email,
+		Name:          &name,
+		Role:          user.Role,
+		IsServiceUser: user.IsServiceUser != nil && *user.IsServiceUser,
+		AutoGroups:    autoGroups,
+		IsBlocked:     &isBlocked,
+		Status:        &status,
+	}
+}
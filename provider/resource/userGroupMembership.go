@@ -0,0 +1,256 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// UserGroupMembership adds a group to the auto-groups of an existing user.
+type UserGroupMembership struct{}
+
+// Annotate adds a description to the UserGroupMembership resource type.
+func (m *UserGroupMembership) Annotate(a infer.Annotator) {
+	a.Describe(&m, "Adds a group to the auto-groups of an existing NetBird user, keeping the groups the user already has. "+
+		"Deleting the resource removes only this group. Use it for users that are not managed by a User resource.")
+}
+
+// UserGroupMembershipArgs defines input fields for a user group membership.
+type UserGroupMembershipArgs struct {
+	UserID  string `pulumi:"userId"`
+	GroupID string `pulumi:"groupId"`
+}
+
+// Annotate provides documentation for UserGroupMembershipArgs fields.
+func (m *UserGroupMembershipArgs) Annotate(a infer.Annotator) {
+	a.Describe(&m.UserID, "ID of the existing user. Changing this forces a replacement.")
+	a.Describe(&m.GroupID, "ID of the group to add to the user's auto-groups. Changing this forces a replacement.")
+}
+
+// UserGroupMembershipState represents the output state of a user group membership.
+type UserGroupMembershipState struct {
+	AccountScope
+
+	UserGroupMembershipArgs
+}
+
+// userAutoGroupsMu serializes the read-modify-write of auto-groups, so
+// memberships of the same user created in parallel do not drop each other.
+var userAutoGroupsMu sync.Mutex
+
+// Create adds the group to the user's auto-groups.
+func (*UserGroupMembership) Create(
+	ctx context.Context,
+	req infer.CreateRequest[UserGroupMembershipArgs],
+) (infer.CreateResponse[UserGroupMembershipState], error) {
+	p.GetLogger(ctx).Debugf("Create:UserGroupMembership userId=%s, groupId=%s", req.Inputs.UserID, req.Inputs.GroupID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
+	resp := infer.CreateResponse[UserGroupMembershipState]{
+		ID: userGroupMembershipID(req.Inputs),
+		Output: UserGroupMembershipState{
+			AccountScope:            currentAccountScope(ctx),
+			UserGroupMembershipArgs: req.Inputs,
+		},
+	}
+
+	if req.DryRun {
+		return resp, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.CreateResponse[UserGroupMembershipState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	found, err := updateUserAutoGroups(ctx, client, req.Inputs.UserID, func(groups []string) []string {
+		if slices.Contains(groups, req.Inputs.GroupID) {
+			return groups
+		}
+
+		return append(groups, req.Inputs.GroupID)
+	})
+	if err != nil {
+		return infer.CreateResponse[UserGroupMembershipState]{}, err
+	}
+
+	if !found {
+		return infer.CreateResponse[UserGroupMembershipState]{}, fmt.Errorf("user with ID %s not found", req.Inputs.UserID)
+	}
+
+	return resp, nil
+}
+
+// Read reports the membership as gone when the user or the group assignment no longer exists.
+func (*UserGroupMembership) Read(
+	ctx context.Context,
+	req infer.ReadRequest[UserGroupMembershipArgs, UserGroupMembershipState],
+) (infer.ReadResponse[UserGroupMembershipArgs, UserGroupMembershipState], error) {
+	p.GetLogger(ctx).Debugf("Read:UserGroupMembership[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.ReadResponse[UserGroupMembershipArgs, UserGroupMembershipState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	user, err := findUser(ctx, client, req.Inputs.UserID)
+	if err != nil {
+		return infer.ReadResponse[UserGroupMembershipArgs, UserGroupMembershipState]{}, err
+	}
+
+	if user == nil || !slices.Contains(user.AutoGroups, req.Inputs.GroupID) {
+		return infer.ReadResponse[UserGroupMembershipArgs, UserGroupMembershipState]{
+			ID:     "",
+			Inputs: UserGroupMembershipArgs{},  //nolint:exhaustruct
+			State:  UserGroupMembershipState{}, //nolint:exhaustruct
+		}, nil
+	}
+
+	return infer.ReadResponse[UserGroupMembershipArgs, UserGroupMembershipState]{
+		ID:     req.ID,
+		Inputs: req.Inputs,
+		State: UserGroupMembershipState{
+			AccountScope:            readAccountScope(ctx, req.State.AccountScope),
+			UserGroupMembershipArgs: req.Inputs,
+		},
+	}, nil
+}
+
+// Delete removes the group from the user's auto-groups and keeps the others.
+func (*UserGroupMembership) Delete(ctx context.Context, req infer.DeleteRequest[UserGroupMembershipState]) (infer.DeleteResponse, error) {
+	p.GetLogger(ctx).Debugf("Delete:UserGroupMembership[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	// A user deleted in the meantime has no membership left to remove.
+	_, err = updateUserAutoGroups(ctx, client, req.State.UserID, func(groups []string) []string {
+		return slices.DeleteFunc(groups, func(group string) bool { return group == req.State.GroupID })
+	})
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	return infer.DeleteResponse{}, nil
+}
+
+// Diff replaces the membership when the user, the group or the account changes.
+func (*UserGroupMembership) Diff(
+	ctx context.Context,
+	req infer.DiffRequest[UserGroupMembershipArgs, UserGroupMembershipState],
+) (infer.DiffResponse, error) {
+	p.GetLogger(ctx).Debugf("Diff:UserGroupMembership[%s]", req.ID)
+
+	diff := map[string]p.PropertyDiff{}
+
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
+	if req.Inputs.UserID != req.State.UserID {
+		diff["userId"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+	}
+
+	if req.Inputs.GroupID != req.State.GroupID {
+		diff["groupId"] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+	}
+
+	// The old membership goes first: when only the account changes, creating
+	// the new one would be a no-op that the delete then undoes.
+	return infer.DiffResponse{
+		DeleteBeforeReplace: true,
+		HasChanges:          len(diff) > 0,
+		DetailedDiff:        diff,
+	}, nil
+}
+
+// Check validates that userId and groupId are set.
+func (*UserGroupMembership) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[UserGroupMembershipArgs], error) {
+	p.GetLogger(ctx).Debugf("Check:UserGroupMembership old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
+
+	args, failures, err := infer.DefaultCheck[UserGroupMembershipArgs](ctx, req.NewInputs)
+
+	if isBlank(args.UserID) {
+		failures = append(failures, p.CheckFailure{Property: "userId", Reason: "userId must not be empty"})
+	}
+
+	if isBlank(args.GroupID) {
+		failures = append(failures, p.CheckFailure{Property: "groupId", Reason: "groupId must not be empty"})
+	}
+
+	return infer.CheckResponse[UserGroupMembershipArgs]{
+		Inputs:   args,
+		Failures: failures,
+	}, err
+}
+
+// WireDependencies explicitly defines input/output relationships.
+func (*UserGroupMembership) WireDependencies(f infer.FieldSelector, args *UserGroupMembershipArgs, state *UserGroupMembershipState) {
+	f.OutputField(&state.UserID).DependsOn(f.InputField(&args.UserID))
+	f.OutputField(&state.GroupID).DependsOn(f.InputField(&args.GroupID))
+}
+
+// userGroupMembershipID derives the ID of a membership as <userID>/<groupID>.
+func userGroupMembershipID(args UserGroupMembershipArgs) string {
+	return args.UserID + "/" + args.GroupID
+}
+
+// findUser returns the user with the given ID, or nil when it does not exist.
+// The API has no endpoint to get a single user.
+func findUser(ctx context.Context, client *rest.Client, userID string) (*nbapi.User, error) {
+	users, err := client.Users.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing users failed: %w", err)
+	}
+
+	for _, user := range users {
+		if user.Id == userID {
+			return &user, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// updateUserAutoGroups applies change to the auto-groups of a user and keeps
+// its role and blocked state. It reports whether the user exists.
+func updateUserAutoGroups(ctx context.Context, client *rest.Client, userID string, change func([]string) []string) (bool, error) {
+	userAutoGroupsMu.Lock()
+	defer userAutoGroupsMu.Unlock()
+
+	user, err := findUser(ctx, client, userID)
+	if err != nil || user == nil {
+		return false, err
+	}
+
+	groups := change(slices.Clone(user.AutoGroups))
+	if slices.Equal(groups, user.AutoGroups) {
+		return true, nil
+	}
+
+	_, err = client.Users.Update(ctx, userID, nbapi.UserRequest{
+		Role:       user.Role,
+		AutoGroups: groups,
+		IsBlocked:  user.IsBlocked,
+	})
+	if err != nil {
+		return true, fmt.Errorf("updating auto-groups of user %s failed: %w", userID, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,454 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	nbapi "github.com/netbirdio/netbird/shared/management/http/api"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// defaultInviteExpiresIn matches the management server's default invite validity (72 hours).
+const defaultInviteExpiresIn = 72 * 60 * 60
+
+// UserInvite manages the invite lifecycle of a NetBird user.
+type UserInvite struct{}
+
+// Annotate describes the resource.
+func (ui *UserInvite) Annotate(a infer.Annotator) {
+	a.Describe(ui, "Tracks and renews a NetBird user invite. With userId, re-sends the IdP invite of an existing user "+
+		"(e.g. one created by User) until it is accepted. With email, creates an invite link on self-hosted servers "+
+		"using the embedded IdP. In both modes the invite is renewed once its expiry falls within renewBefore.")
+}
+
+// UserInviteArgs defines input fields for a user invite.
+type UserInviteArgs struct {
+	UserID            *string   `pulumi:"userId,optional"`
+	Email             *string   `pulumi:"email,optional"`
+	Name              *string   `pulumi:"name,optional"`
+	Role              *string   `pulumi:"role,optional"`
+	AutoGroups        *[]string `pulumi:"autoGroups,optional"`
+	ExpiresIn         *int      `pulumi:"expiresIn,optional"`
+	RenewBefore       *int      `pulumi:"renewBefore,optional"`
+	ExposeInviteToken *bool     `pulumi:"exposeInviteToken,optional"`
+}
+
+// Annotate provides documentation for UserInviteArgs fields.
+func (ui *UserInviteArgs) Annotate(a infer.Annotator) {
+	a.Describe(&ui.UserID, "ID of an existing user whose IdP invite is managed. Mutually exclusive with email. "+
+		"Changing this forces a replacement.")
+	a.Describe(&ui.Email, "Email address to create an embedded IdP invite for (self-hosted only). Mutually exclusive with userId. "+
+		"Changing this forces a replacement.")
+	a.Describe(&ui.Name, "Full name of the invited user. Required with email.")
+	a.Describe(&ui.Role, "NetBird account role of the invited user. Defaults to 'user'. Only used with email.")
+	a.Describe(&ui.AutoGroups, "Group IDs to auto-assign to peers registered by the invited user. Only used with email.")
+	a.Describe(&ui.ExpiresIn, "Invite validity in seconds. Defaults to 259200 (72 hours). With userId this is the assumed "+
+		"lifetime of the IdP invite, measured from when it was last sent.")
+	a.Describe(&ui.RenewBefore, "Renew the invite once fewer than this many seconds of validity remain. Defaults to 0, "+
+		"renewing only after the invite expired.")
+	a.Describe(&ui.ExposeInviteToken, "Whether to output the invite token (secret) so the invite can be delivered out of band. "+
+		"Only used with email.")
+}
+
+// UserInviteState represents the output state of a user invite.
+type UserInviteState struct {
+	AccountScope
+
+	UserInviteArgs
+
+	Status      UserStatus `pulumi:"status"`
+	Expired     bool       `pulumi:"expired"`
+	SentAt      string     `pulumi:"sentAt"`
+	ExpiresAt   string     `pulumi:"expiresAt"`
+	InviteToken *string    `provider:"secret" pulumi:"inviteToken,optional"`
+}
+
+// Annotate provides documentation for UserInviteState fields.
+func (ui *UserInviteState) Annotate(a infer.Annotator) {
+	a.Describe(&ui.Status, "Status of the invited user: 'invited' until the invite is accepted, then 'active' or 'blocked'.")
+	a.Describe(&ui.Expired, "Whether the invite had expired on the last refresh.")
+	a.Describe(&ui.SentAt, "When the invite was last sent or regenerated (RFC 3339).")
+	a.Describe(&ui.ExpiresAt, "When the invite expires (RFC 3339).")
+	a.Describe(&ui.InviteToken, "Token of the invite, not a full link. The invite link is the dashboard's invite page "+
+		"with this token, as shown by the dashboard when the invite is created. Only set with email and exposeInviteToken; "+
+		"it changes every time the invite is renewed.")
+}
+
+// Create sends or creates the invite.
+func (*UserInvite) Create(ctx context.Context, req infer.CreateRequest[UserInviteArgs]) (infer.CreateResponse[UserInviteState], error) {
+	p.GetLogger(ctx).Debugf("Create:UserInvite userId=%s, email=%s", strPtr(req.Inputs.UserID), strPtr(req.Inputs.Email))
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationCreate)
+	defer cancel()
+
+	now := config.Now(ctx).UTC()
+	state := UserInviteState{
+		AccountScope:   currentAccountScope(ctx),
+		UserInviteArgs: req.Inputs,
+		Status:         UserStatusInvited,
+		Expired:        false,
+		SentAt:         now.Format(time.RFC3339),
+		ExpiresAt:      now.Add(inviteExpiresIn(req.Inputs)).Format(time.RFC3339),
+		InviteToken:    nil,
+	}
+
+	if req.DryRun {
+		return infer.CreateResponse[UserInviteState]{
+			ID:     "preview",
+			Output: state,
+		}, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.CreateResponse[UserInviteState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	if req.Inputs.UserID != nil {
+		err = client.Users.ResendInvitation(ctx, *req.Inputs.UserID)
+		if err != nil {
+			return infer.CreateResponse[UserInviteState]{}, fmt.Errorf("sending user invite failed: %w", err)
+		}
+
+		return infer.CreateResponse[UserInviteState]{
+			ID:     *req.Inputs.UserID,
+			Output: state,
+		}, nil
+	}
+
+	expiresIn := int(inviteExpiresIn(req.Inputs).Seconds())
+
+	invite, err := client.Users.CreateInvite(ctx, nbapi.UserInviteCreateRequest{
+		Email:      strPtr(req.Inputs.Email),
+		Name:       strPtr(req.Inputs.Name),
+		Role:       inviteRole(req.Inputs),
+		AutoGroups: inviteAutoGroups(req.Inputs),
+		ExpiresIn:  &expiresIn,
+	})
+	if err != nil {
+		return infer.CreateResponse[UserInviteState]{}, fmt.Errorf("creating user invite failed: %w", err)
+	}
+
+	state.SentAt = invite.CreatedAt.UTC().Format(time.RFC3339)
+	state.ExpiresAt = invite.ExpiresAt.UTC().Format(time.RFC3339)
+	state.InviteToken = exposedInviteToken(req.Inputs, invite.InviteToken)
+
+	return infer.CreateResponse[UserInviteState]{
+		ID:     invite.Id,
+		Output: state,
+	}, nil
+}
+
+// Read refreshes the invite status.
+func (*UserInvite) Read(
+	ctx context.Context,
+	req infer.ReadRequest[UserInviteArgs, UserInviteState],
+) (infer.ReadResponse[UserInviteArgs, UserInviteState], error) {
+	p.GetLogger(ctx).Debugf("Read:UserInvite[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationRead)
+	defer cancel()
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.ReadResponse[UserInviteArgs, UserInviteState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	state := req.State
+	state.AccountScope = readAccountScope(ctx, req.State.AccountScope)
+	state.UserInviteArgs = req.Inputs
+
+	found, err := refreshInviteStatus(ctx, client, req.ID, &state)
+	if err != nil {
+		return infer.ReadResponse[UserInviteArgs, UserInviteState]{}, err
+	}
+
+	if !found {
+		return infer.ReadResponse[UserInviteArgs, UserInviteState]{
+			ID:     "",
+			Inputs: UserInviteArgs{},  //nolint:exhaustruct
+			State:  UserInviteState{}, //nolint:exhaustruct
+		}, nil
+	}
+
+	return infer.ReadResponse[UserInviteArgs, UserInviteState]{
+		ID:     req.ID,
+		Inputs: req.Inputs,
+		State:  state,
+	}, nil
+}
+
+// Update renews the invite when it is due and records setting changes.
+func (*UserInvite) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[UserInviteArgs, UserInviteState],
+) (infer.UpdateResponse[UserInviteState], error) {
+	p.GetLogger(ctx).Debugf("Update:UserInvite[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationUpdate)
+	defer cancel()
+
+	state := req.State
+	state.AccountScope = currentAccountScope(ctx)
+	state.UserInviteArgs = req.Inputs
+
+	if !boolVal(req.Inputs.ExposeInviteToken) {
+		state.InviteToken = nil
+	}
+
+	// The token is only returned when an invite is created or regenerated, so
+	// exposing it later requires a fresh one.
+	tokenMissing := req.Inputs.UserID == nil && boolVal(req.Inputs.ExposeInviteToken) &&
+		req.State.InviteToken == nil && req.State.Status == UserStatusInvited
+
+	if req.DryRun || (!tokenMissing && !inviteRenewalDue(req.Inputs, req.State, config.Now(ctx))) {
+		return infer.UpdateResponse[UserInviteState]{Output: state}, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.UpdateResponse[UserInviteState]{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	now := config.Now(ctx).UTC()
+
+	if req.Inputs.UserID != nil {
+		err = client.Users.ResendInvitation(ctx, req.ID)
+		if err != nil {
+			return infer.UpdateResponse[UserInviteState]{}, fmt.Errorf("resending user invite failed: %w", err)
+		}
+
+		state.ExpiresAt = now.Add(inviteExpiresIn(req.Inputs)).Format(time.RFC3339)
+	} else {
+		expiresIn := int(inviteExpiresIn(req.Inputs).Seconds())
+
+		regenerated, err := client.Users.RegenerateInvite(ctx, req.ID, nbapi.UserInviteRegenerateRequest{ExpiresIn: &expiresIn})
+		if err != nil {
+			return infer.UpdateResponse[UserInviteState]{}, fmt.Errorf("regenerating user invite failed: %w", err)
+		}
+
+		state.ExpiresAt = regenerated.InviteExpiresAt.UTC().Format(time.RFC3339)
+		state.InviteToken = exposedInviteToken(req.Inputs, &regenerated.InviteToken)
+	}
+
+	p.GetLogger(ctx).Debugf("Update:UserInvite[%s] renewed, expires %s", req.ID, state.ExpiresAt)
+
+	state.SentAt = now.Format(time.RFC3339)
+	state.Expired = false
+
+	return infer.UpdateResponse[UserInviteState]{Output: state}, nil
+}
+
+// Delete revokes a pending embedded IdP invite. Users referenced by userId are left untouched.
+func (*UserInvite) Delete(ctx context.Context, req infer.DeleteRequest[UserInviteState]) (infer.DeleteResponse, error) {
+	p.GetLogger(ctx).Debugf("Delete:UserInvite[%s]", req.ID)
+
+	ctx, cancel := config.WithOperationTimeout(ctx, config.OperationDelete)
+	defer cancel()
+
+	if req.State.UserID != nil || req.State.Status != UserStatusInvited {
+		return infer.DeleteResponse{}, nil
+	}
+
+	client, err := config.GetNetBirdClient(ctx)
+	if err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("error getting NetBird client: %w", err)
+	}
+
+	err = client.Users.DeleteInvite(ctx, req.ID)
+	if err != nil && !isNotFoundErr(err) {
+		return infer.DeleteResponse{}, fmt.Errorf("deleting user invite failed: %w", err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
+
+// Diff detects input changes and invites whose renewal window has been reached.
+func (*UserInvite) Diff(ctx context.Context, req infer.DiffRequest[UserInviteArgs, UserInviteState]) (infer.DiffResponse, error) {
+	p.GetLogger(ctx).Debugf("Diff:UserInvite[%s]", req.ID)
+
+	diff := map[string]p.PropertyDiff{}
+
+	diffAccountScope(ctx, req.State.AccountScope, diff)
+
+	replaceFields := map[string]bool{
+		"userId":     !equalPtr(req.Inputs.UserID, req.State.UserID),
+		"email":      !equalPtr(req.Inputs.Email, req.State.Email),
+		"name":       !equalPtr(req.Inputs.Name, req.State.Name),
+		"role":       !equalPtr(req.Inputs.Role, req.State.Role),
+		"autoGroups": !equalSlicePtr(req.Inputs.AutoGroups, req.State.AutoGroups),
+	}
+	for field, changed := range replaceFields {
+		if changed {
+			diff[field] = p.PropertyDiff{InputDiff: false, Kind: p.UpdateReplace}
+		}
+	}
+
+	updateFields := map[string]bool{
+		"expiresIn":         !equalPtr(req.Inputs.ExpiresIn, req.State.ExpiresIn),
+		"renewBefore":       !equalPtr(req.Inputs.RenewBefore, req.State.RenewBefore),
+		"exposeInviteToken": boolVal(req.Inputs.ExposeInviteToken) != boolVal(req.State.ExposeInviteToken),
+		"expiresAt":         inviteRenewalDue(req.Inputs, req.State, config.Now(ctx)),
+	}
+	for field, changed := range updateFields {
+		if changed {
+			diff[field] = p.PropertyDiff{InputDiff: false, Kind: p.Update}
+		}
+	}
+
+	return infer.DiffResponse{
+		DeleteBeforeReplace: true,
+		HasChanges:          len(diff) > 0,
+		DetailedDiff:        diff,
+	}, nil
+}
+
+// Check validates the invite mode and its inputs.
+func (*UserInvite) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[UserInviteArgs], error) {
+	p.GetLogger(ctx).Debugf("Check:UserInvite old=%s, new=%s", req.OldInputs.GoString(), req.NewInputs.GoString())
+
+	args, failures, err := infer.DefaultCheck[UserInviteArgs](ctx, req.NewInputs)
+
+	switch {
+	case args.UserID == nil && args.Email == nil:
+		failures = append(failures, p.CheckFailure{Property: "email", Reason: "one of userId or email must be set"})
+	case args.UserID != nil && args.Email != nil:
+		failures = append(failures, p.CheckFailure{Property: "email", Reason: "userId and email are mutually exclusive"})
+	case args.Email != nil && isBlank(strPtr(args.Name)):
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name is required when email is set"})
+	}
+
+	if args.ExpiresIn != nil && *args.ExpiresIn <= 0 {
+		failures = append(failures, p.CheckFailure{Property: "expiresIn", Reason: "expiresIn must be positive"})
+	}
+
+	if args.RenewBefore != nil && (*args.RenewBefore < 0 || *args.RenewBefore >= int(inviteExpiresIn(args).Seconds())) {
+		failures = append(failures, p.CheckFailure{
+			Property: "renewBefore",
+			Reason:   "renewBefore must be zero or positive and shorter than expiresIn",
+		})
+	}
+
+	return infer.CheckResponse[UserInviteArgs]{
+		Inputs:   args,
+		Failures: failures,
+	}, err
+}
+
+// WireDependencies explicitly defines input/output relationships.
+func (*UserInvite) WireDependencies(f infer.FieldSelector, args *UserInviteArgs, state *UserInviteState) {
+	f.OutputField(&state.UserID).DependsOn(f.InputField(&args.UserID))
+	f.OutputField(&state.Email).DependsOn(f.InputField(&args.Email))
+	f.OutputField(&state.Name).DependsOn(f.InputField(&args.Name))
+	f.OutputField(&state.Role).DependsOn(f.InputField(&args.Role))
+	f.OutputField(&state.AutoGroups).DependsOn(f.InputField(&args.AutoGroups))
+	f.OutputField(&state.ExpiresIn).DependsOn(f.InputField(&args.ExpiresIn))
+	f.OutputField(&state.RenewBefore).DependsOn(f.InputField(&args.RenewBefore))
+	f.OutputField(&state.ExposeInviteToken).DependsOn(f.InputField(&args.ExposeInviteToken))
+	f.OutputField(&state.InviteToken).DependsOn(f.InputField(&args.ExposeInviteToken))
+}
+
+// refreshInviteStatus updates state from the server and reports whether the
+// invite or its user still exists. Accepted embedded invites disappear from
+// the invite list; the user that accepted is then looked up by email.
+func refreshInviteStatus(ctx context.Context, client *rest.Client, id string, state *UserInviteState) (bool, error) {
+	if state.UserID == nil {
+		invites, err := client.Users.ListInvites(ctx)
+		if err != nil {
+			return false, fmt.Errorf("listing user invites failed: %w", err)
+		}
+
+		idx := slices.IndexFunc(invites, func(invite nbapi.UserInvite) bool { return invite.Id == id })
+		if idx >= 0 {
+			state.Status = UserStatusInvited
+			state.Expired = invites[idx].Expired
+			state.ExpiresAt = invites[idx].ExpiresAt.UTC().Format(time.RFC3339)
+
+			return true, nil
+		}
+	}
+
+	users, err := client.Users.List(ctx)
+	if err != nil {
+		return false, fmt.Errorf("listing users failed: %w", err)
+	}
+
+	idx := slices.IndexFunc(users, func(user nbapi.User) bool {
+		if state.UserID != nil {
+			return user.Id == *state.UserID
+		}
+
+		return user.Email == strPtr(state.Email)
+	})
+	if idx < 0 {
+		return false, nil
+	}
+
+	state.Status = UserStatus(users[idx].Status)
+	state.Expired = state.Status == UserStatusInvited && !config.Now(ctx).Before(parseInviteTime(state.ExpiresAt))
+
+	return true, nil
+}
+
+// inviteRenewalDue reports whether a still-pending invite has reached its renewal window.
+func inviteRenewalDue(args UserInviteArgs, state UserInviteState, now time.Time) bool {
+	if state.Status != UserStatusInvited || state.ExpiresAt == "" {
+		return false
+	}
+
+	renewBefore := time.Duration(0)
+	if args.RenewBefore != nil {
+		renewBefore = time.Duration(*args.RenewBefore) * time.Second
+	}
+
+	return !now.Before(parseInviteTime(state.ExpiresAt).Add(-renewBefore))
+}
+
+// parseInviteTime parses an RFC 3339 timestamp from state; unparsable values
+// count as already passed so that the invite is renewed rather than stuck.
+func parseInviteTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed
+}
+
+func inviteExpiresIn(args UserInviteArgs) time.Duration {
+	if args.ExpiresIn != nil {
+		return time.Duration(*args.ExpiresIn) * time.Second
+	}
+
+	return defaultInviteExpiresIn * time.Second
+}
+
+func inviteRole(args UserInviteArgs) string {
+	if args.Role != nil {
+		return *args.Role
+	}
+
+	return "user"
+}
+
+func inviteAutoGroups(args UserInviteArgs) []string {
+	if args.AutoGroups != nil {
+		return *args.AutoGroups
+	}
+
+	return []string{}
+}
+
+// exposedInviteToken returns the token when it should be exposed.
+func exposedInviteToken(args UserInviteArgs, token *string) *string {
+	if !boolVal(args.ExposeInviteToken) || token == nil {
+		return nil
+	}
+
+	return token
+}
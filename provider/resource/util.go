@@ -1,9 +1,13 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"strings"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	p "github.com/pulumi/pulumi-go-provider"
 )
 
 // strPtr helper function to stringify a pointer safely.
@@ -91,6 +95,22 @@ func isNotFoundErr(err error) bool {
 	return err != nil && strings.Contains(strings.ToLower(err.Error()), "not found")
 }
 
+// checkCapability returns a failure on property when the management server
+// detected during Configure lacks the given capability.
+func checkCapability(ctx context.Context, property string, capability config.Capability) []p.CheckFailure {
+	info, err := config.GetServerInfo(ctx)
+	if err != nil {
+		return nil
+	}
+
+	err = info.Supports(capability)
+	if err != nil {
+		return []p.CheckFailure{{Property: property, Reason: err.Error()}}
+	}
+
+	return nil
+}
+
 // parseNestedID splits a compound "<parentID>/<childID>" import ID.
 // Both parts must be non-empty; otherwise an error is returned to the caller.
 func parseNestedID(kind, id string) (string, string, error) {
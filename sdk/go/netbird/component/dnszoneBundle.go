@@ -15,7 +15,7 @@ import (
 type DNSZoneBundle struct {
 	pulumi.ResourceState
 
-	// IDs of the created DNSRecord resources, in declaration order.
+	// IDs of the created DNSRecord resources, records before zone file entries, in declaration order.
 	RecordIds pulumi.StringArrayOutput `pulumi:"recordIds"`
 	// ID of the created DNSZone resource.
 	ZoneId pulumi.StringOutput `pulumi:"zoneId"`
@@ -31,9 +31,6 @@ func NewDNSZoneBundle(ctx *pulumi.Context,
 	if args.DistributionGroups == nil {
 		return nil, errors.New("invalid value for required argument 'DistributionGroups'")
 	}
-	if args.Records == nil {
-		return nil, errors.New("invalid value for required argument 'Records'")
-	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource DNSZoneBundle
 	err := ctx.RegisterRemoteComponentResource("netbird:component:DNSZoneBundle", name, args, &resource, opts...)
@@ -56,6 +53,8 @@ type dnszoneBundleArgs struct {
 	Name string `pulumi:"name"`
 	// DNS records to create within the zone.
 	Records []DNSRecordSpec `pulumi:"records"`
+	// Optional zone file text whose A, AAAA and CNAME records are added to records. Supports $ORIGIN, $TTL and names relative to the origin, which defaults to the domain. Other record types are rejected.
+	ZoneFile *string `pulumi:"zoneFile"`
 }
 
 // The set of arguments for constructing a DNSZoneBundle resource.
@@ -72,6 +71,8 @@ type DNSZoneBundleArgs struct {
 	Name string
 	// DNS records to create within the zone.
 	Records DNSRecordSpecArrayInput
+	// Optional zone file text whose A, AAAA and CNAME records are added to records. Supports $ORIGIN, $TTL and names relative to the origin, which defaults to the domain. Other record types are rejected.
+	ZoneFile *string
 }
 
 func (DNSZoneBundleArgs) ElementType() reflect.Type {
@@ -161,7 +162,7 @@ func (o DNSZoneBundleOutput) ToDNSZoneBundleOutputWithContext(ctx context.Contex
 	return o
 }
 
-// IDs of the created DNSRecord resources, in declaration order.
+// IDs of the created DNSRecord resources, records before zone file entries, in declaration order.
 func (o DNSZoneBundleOutput) RecordIds() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *DNSZoneBundle) pulumi.StringArrayOutput { return v.RecordIds }).(pulumi.StringArrayOutput)
 }
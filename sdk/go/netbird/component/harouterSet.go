@@ -0,0 +1,218 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package component
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type HARouterSet struct {
+	pulumi.ResourceState
+
+	// Metric assigned to each router, in list order.
+	Metrics pulumi.IntArrayOutput `pulumi:"metrics"`
+	// IDs of the created NetworkRouter resources, in list order.
+	RouterIds pulumi.StringArrayOutput `pulumi:"routerIds"`
+}
+
+// NewHARouterSet registers a new resource with the given unique name, arguments, and options.
+func NewHARouterSet(ctx *pulumi.Context,
+	name string, args *HARouterSetArgs, opts ...pulumi.ResourceOption) (*HARouterSet, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Routers == nil {
+		return nil, errors.New("invalid value for required argument 'Routers'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource HARouterSet
+	err := ctx.RegisterRemoteComponentResource("netbird:component:HARouterSet", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type harouterSetArgs struct {
+	// Metric of the first router. Defaults to 100.
+	BaseMetric *int `pulumi:"baseMetric"`
+	// Whether the routers are enabled. Defaults to true.
+	Enabled *bool `pulumi:"enabled"`
+	// Whether all routers masquerade traffic.
+	Masquerade bool `pulumi:"masquerade"`
+	// Metric added for each following router. Defaults to 100.
+	MetricStep *int `pulumi:"metricStep"`
+	// ID of the network the routers serve.
+	NetworkId string `pulumi:"networkId"`
+	// Routing peers or peer groups, most preferred first. Each entry gets its own router. Routers are identified by their peer or peer group, so reordering the list only updates their metrics.
+	Routers []HARouterSpec `pulumi:"routers"`
+}
+
+// The set of arguments for constructing a HARouterSet resource.
+type HARouterSetArgs struct {
+	// Metric of the first router. Defaults to 100.
+	BaseMetric *int
+	// Whether the routers are enabled. Defaults to true.
+	Enabled *bool
+	// Whether all routers masquerade traffic.
+	Masquerade bool
+	// Metric added for each following router. Defaults to 100.
+	MetricStep *int
+	// ID of the network the routers serve.
+	NetworkId string
+	// Routing peers or peer groups, most preferred first. Each entry gets its own router. Routers are identified by their peer or peer group, so reordering the list only updates their metrics.
+	Routers HARouterSpecArrayInput
+}
+
+func (HARouterSetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*harouterSetArgs)(nil)).Elem()
+}
+
+type HARouterSetInput interface {
+	pulumi.Input
+
+	ToHARouterSetOutput() HARouterSetOutput
+	ToHARouterSetOutputWithContext(ctx context.Context) HARouterSetOutput
+}
+
+func (*HARouterSet) ElementType() reflect.Type {
+	return reflect.TypeOf((**HARouterSet)(nil)).Elem()
+}
+
+func (i *HARouterSet) ToHARouterSetOutput() HARouterSetOutput {
+	return i.ToHARouterSetOutputWithContext(context.Background())
+}
+
+func (i *HARouterSet) ToHARouterSetOutputWithContext(ctx context.Context) HARouterSetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HARouterSetOutput)
+}
+
+// HARouterSetArrayInput is an input type that accepts HARouterSetArray and HARouterSetArrayOutput values.
+// You can construct a concrete instance of `HARouterSetArrayInput` via:
+//
+//	HARouterSetArray{ HARouterSetArgs{...} }
+type HARouterSetArrayInput interface {
+	pulumi.Input
+
+	ToHARouterSetArrayOutput() HARouterSetArrayOutput
+	ToHARouterSetArrayOutputWithContext(context.Context) HARouterSetArrayOutput
+}
+
+type HARouterSetArray []HARouterSetInput
+
+func (HARouterSetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*HARouterSet)(nil)).Elem()
+}
+
+func (i HARouterSetArray) ToHARouterSetArrayOutput() HARouterSetArrayOutput {
+	return i.ToHARouterSetArrayOutputWithContext(context.Background())
+}
+
+func (i HARouterSetArray) ToHARouterSetArrayOutputWithContext(ctx context.Context) HARouterSetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HARouterSetArrayOutput)
+}
+
+// HARouterSetMapInput is an input type that accepts HARouterSetMap and HARouterSetMapOutput values.
+// You can construct a concrete instance of `HARouterSetMapInput` via:
+//
+//	HARouterSetMap{ "key": HARouterSetArgs{...} }
+type HARouterSetMapInput interface {
+	pulumi.Input
+
+	ToHARouterSetMapOutput() HARouterSetMapOutput
+	ToHARouterSetMapOutputWithContext(context.Context) HARouterSetMapOutput
+}
+
+type HARouterSetMap map[string]HARouterSetInput
+
+func (HARouterSetMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*HARouterSet)(nil)).Elem()
+}
+
+func (i HARouterSetMap) ToHARouterSetMapOutput() HARouterSetMapOutput {
+	return i.ToHARouterSetMapOutputWithContext(context.Background())
+}
+
+func (i HARouterSetMap) ToHARouterSetMapOutputWithContext(ctx context.Context) HARouterSetMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HARouterSetMapOutput)
+}
+
+type HARouterSetOutput struct{ *pulumi.OutputState }
+
+func (HARouterSetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**HARouterSet)(nil)).Elem()
+}
+
+func (o HARouterSetOutput) ToHARouterSetOutput() HARouterSetOutput {
+	return o
+}
+
+func (o HARouterSetOutput) ToHARouterSetOutputWithContext(ctx context.Context) HARouterSetOutput {
+	return o
+}
+
+// Metric assigned to each router, in list order.
+func (o HARouterSetOutput) Metrics() pulumi.IntArrayOutput {
+	return o.ApplyT(func(v *HARouterSet) pulumi.IntArrayOutput { return v.Metrics }).(pulumi.IntArrayOutput)
+}
+
+// IDs of the created NetworkRouter resources, in list order.
+func (o HARouterSetOutput) RouterIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *HARouterSet) pulumi.StringArrayOutput { return v.RouterIds }).(pulumi.StringArrayOutput)
+}
+
+type HARouterSetArrayOutput struct{ *pulumi.OutputState }
+
+func (HARouterSetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*HARouterSet)(nil)).Elem()
+}
+
+func (o HARouterSetArrayOutput) ToHARouterSetArrayOutput() HARouterSetArrayOutput {
+	return o
+}
+
+func (o HARouterSetArrayOutput) ToHARouterSetArrayOutputWithContext(ctx context.Context) HARouterSetArrayOutput {
+	return o
+}
+
+func (o HARouterSetArrayOutput) Index(i pulumi.IntInput) HARouterSetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *HARouterSet {
+		return vs[0].([]*HARouterSet)[vs[1].(int)]
+	}).(HARouterSetOutput)
+}
+
+type HARouterSetMapOutput struct{ *pulumi.OutputState }
+
+func (HARouterSetMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*HARouterSet)(nil)).Elem()
+}
+
+func (o HARouterSetMapOutput) ToHARouterSetMapOutput() HARouterSetMapOutput {
+	return o
+}
+
+func (o HARouterSetMapOutput) ToHARouterSetMapOutputWithContext(ctx context.Context) HARouterSetMapOutput {
+	return o
+}
+
+func (o HARouterSetMapOutput) MapIndex(k pulumi.StringInput) HARouterSetOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *HARouterSet {
+		return vs[0].(map[string]*HARouterSet)[vs[1].(string)]
+	}).(HARouterSetOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*HARouterSetInput)(nil)).Elem(), &HARouterSet{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HARouterSetArrayInput)(nil)).Elem(), HARouterSetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HARouterSetMapInput)(nil)).Elem(), HARouterSetMap{})
+	pulumi.RegisterOutputType(HARouterSetOutput{})
+	pulumi.RegisterOutputType(HARouterSetArrayOutput{})
+	pulumi.RegisterOutputType(HARouterSetMapOutput{})
+}
@@ -23,8 +23,26 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 	switch typ {
 	case "netbird:component:DNSZoneBundle":
 		r = &DNSZoneBundle{}
+	case "netbird:component:ExitNode":
+		r = &ExitNode{}
+	case "netbird:component:HARouterSet":
+		r = &HARouterSet{}
+	case "netbird:component:Manifest":
+		r = &Manifest{}
 	case "netbird:component:NetworkBundle":
 		r = &NetworkBundle{}
+	case "netbird:component:PeerDNSRecords":
+		r = &PeerDNSRecords{}
+	case "netbird:component:ReverseProxyApp":
+		r = &ReverseProxyApp{}
+	case "netbird:component:ServiceAccount":
+		r = &ServiceAccount{}
+	case "netbird:component:SiteToSite":
+		r = &SiteToSite{}
+	case "netbird:component:TeamOnboarding":
+		r = &TeamOnboarding{}
+	case "netbird:component:ZeroTrustSegment":
+		r = &ZeroTrustSegment{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
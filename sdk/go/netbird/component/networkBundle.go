@@ -17,8 +17,14 @@ type NetworkBundle struct {
 
 	// ID of the created Network resource.
 	NetworkId pulumi.StringOutput `pulumi:"networkId"`
-	// ID of the created NetworkRouter resource.
+	// IDs of the generated Policy resources, keyed by resource name or, with access.per group, by group ID.
+	PolicyIds pulumi.StringMapOutput `pulumi:"policyIds"`
+	// IDs of all created NetworkResource resources, keyed by resource name.
+	ResourceIds pulumi.StringMapOutput `pulumi:"resourceIds"`
+	// ID of the first created NetworkRouter resource.
 	RouterId pulumi.StringOutput `pulumi:"routerId"`
+	// IDs of the created NetworkRouter resources, router first, then routers in declaration order.
+	RouterIds pulumi.StringArrayOutput `pulumi:"routerIds"`
 	// IDs of the created NetworkResource (subnet) resources, in declaration order.
 	SubnetIds pulumi.StringArrayOutput `pulumi:"subnetIds"`
 }
@@ -30,12 +36,6 @@ func NewNetworkBundle(ctx *pulumi.Context,
 		return nil, errors.New("missing one or more required arguments")
 	}
 
-	if args.Router == nil {
-		return nil, errors.New("invalid value for required argument 'Router'")
-	}
-	if args.Subnets == nil {
-		return nil, errors.New("invalid value for required argument 'Subnets'")
-	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource NetworkBundle
 	err := ctx.RegisterRemoteComponentResource("netbird:component:NetworkBundle", name, args, &resource, opts...)
@@ -46,24 +46,40 @@ func NewNetworkBundle(ctx *pulumi.Context,
 }
 
 type networkBundleArgs struct {
+	// Optional access policies to generate for the resources.
+	Access *NetworkAccessSpec `pulumi:"access"`
 	// Optional description for the network.
 	Description *string `pulumi:"description"`
+	// Domain resources to attach to the network.
+	Domains []NetworkResourceSpec `pulumi:"domains"`
+	// Host resources to attach to the network.
+	Hosts []NetworkResourceSpec `pulumi:"hosts"`
 	// Name of the overlay network.
 	Name string `pulumi:"name"`
 	// Router configuration attached to the network.
-	Router NetworkRouterSpec `pulumi:"router"`
+	Router *NetworkRouterSpec `pulumi:"router"`
+	// Additional routers attached to the network. At least one of router and routers is required.
+	Routers []NetworkRouterSpec `pulumi:"routers"`
 	// Subnet resources to attach to the network.
 	Subnets []NetworkSubnetSpec `pulumi:"subnets"`
 }
 
 // The set of arguments for constructing a NetworkBundle resource.
 type NetworkBundleArgs struct {
+	// Optional access policies to generate for the resources.
+	Access NetworkAccessSpecPtrInput
 	// Optional description for the network.
 	Description *string
+	// Domain resources to attach to the network.
+	Domains NetworkResourceSpecArrayInput
+	// Host resources to attach to the network.
+	Hosts NetworkResourceSpecArrayInput
 	// Name of the overlay network.
 	Name string
 	// Router configuration attached to the network.
-	Router NetworkRouterSpecInput
+	Router NetworkRouterSpecPtrInput
+	// Additional routers attached to the network. At least one of router and routers is required.
+	Routers NetworkRouterSpecArrayInput
 	// Subnet resources to attach to the network.
 	Subnets NetworkSubnetSpecArrayInput
 }
@@ -160,11 +176,26 @@ func (o NetworkBundleOutput) NetworkId() pulumi.StringOutput {
 	return o.ApplyT(func(v *NetworkBundle) pulumi.StringOutput { return v.NetworkId }).(pulumi.StringOutput)
 }
 
-// ID of the created NetworkRouter resource.
+// IDs of the generated Policy resources, keyed by resource name or, with access.per group, by group ID.
+func (o NetworkBundleOutput) PolicyIds() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *NetworkBundle) pulumi.StringMapOutput { return v.PolicyIds }).(pulumi.StringMapOutput)
+}
+
+// IDs of all created NetworkResource resources, keyed by resource name.
+func (o NetworkBundleOutput) ResourceIds() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *NetworkBundle) pulumi.StringMapOutput { return v.ResourceIds }).(pulumi.StringMapOutput)
+}
+
+// ID of the first created NetworkRouter resource.
 func (o NetworkBundleOutput) RouterId() pulumi.StringOutput {
 	return o.ApplyT(func(v *NetworkBundle) pulumi.StringOutput { return v.RouterId }).(pulumi.StringOutput)
 }
 
+// IDs of the created NetworkRouter resources, router first, then routers in declaration order.
+func (o NetworkBundleOutput) RouterIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *NetworkBundle) pulumi.StringArrayOutput { return v.RouterIds }).(pulumi.StringArrayOutput)
+}
+
 // IDs of the created NetworkResource (subnet) resources, in declaration order.
 func (o NetworkBundleOutput) SubnetIds() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *NetworkBundle) pulumi.StringArrayOutput { return v.SubnetIds }).(pulumi.StringArrayOutput)
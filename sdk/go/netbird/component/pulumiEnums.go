@@ -0,0 +1,359 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package component
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type NetworkAccessScope string
+
+const (
+	// One policy per resource, targeting the resource itself.
+	NetworkAccessScopeResource = NetworkAccessScope("resource")
+	// One policy per group the resources are in.
+	NetworkAccessScopeGroup = NetworkAccessScope("group")
+)
+
+func (NetworkAccessScope) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkAccessScope)(nil)).Elem()
+}
+
+func (e NetworkAccessScope) ToNetworkAccessScopeOutput() NetworkAccessScopeOutput {
+	return pulumi.ToOutput(e).(NetworkAccessScopeOutput)
+}
+
+func (e NetworkAccessScope) ToNetworkAccessScopeOutputWithContext(ctx context.Context) NetworkAccessScopeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(NetworkAccessScopeOutput)
+}
+
+func (e NetworkAccessScope) ToNetworkAccessScopePtrOutput() NetworkAccessScopePtrOutput {
+	return e.ToNetworkAccessScopePtrOutputWithContext(context.Background())
+}
+
+func (e NetworkAccessScope) ToNetworkAccessScopePtrOutputWithContext(ctx context.Context) NetworkAccessScopePtrOutput {
+	return NetworkAccessScope(e).ToNetworkAccessScopeOutputWithContext(ctx).ToNetworkAccessScopePtrOutputWithContext(ctx)
+}
+
+func (e NetworkAccessScope) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e NetworkAccessScope) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e NetworkAccessScope) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e NetworkAccessScope) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type NetworkAccessScopeOutput struct{ *pulumi.OutputState }
+
+func (NetworkAccessScopeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkAccessScope)(nil)).Elem()
+}
+
+func (o NetworkAccessScopeOutput) ToNetworkAccessScopeOutput() NetworkAccessScopeOutput {
+	return o
+}
+
+func (o NetworkAccessScopeOutput) ToNetworkAccessScopeOutputWithContext(ctx context.Context) NetworkAccessScopeOutput {
+	return o
+}
+
+func (o NetworkAccessScopeOutput) ToNetworkAccessScopePtrOutput() NetworkAccessScopePtrOutput {
+	return o.ToNetworkAccessScopePtrOutputWithContext(context.Background())
+}
+
+func (o NetworkAccessScopeOutput) ToNetworkAccessScopePtrOutputWithContext(ctx context.Context) NetworkAccessScopePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v NetworkAccessScope) *NetworkAccessScope {
+		return &v
+	}).(NetworkAccessScopePtrOutput)
+}
+
+func (o NetworkAccessScopeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o NetworkAccessScopeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e NetworkAccessScope) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o NetworkAccessScopeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o NetworkAccessScopeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e NetworkAccessScope) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type NetworkAccessScopePtrOutput struct{ *pulumi.OutputState }
+
+func (NetworkAccessScopePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkAccessScope)(nil)).Elem()
+}
+
+func (o NetworkAccessScopePtrOutput) ToNetworkAccessScopePtrOutput() NetworkAccessScopePtrOutput {
+	return o
+}
+
+func (o NetworkAccessScopePtrOutput) ToNetworkAccessScopePtrOutputWithContext(ctx context.Context) NetworkAccessScopePtrOutput {
+	return o
+}
+
+func (o NetworkAccessScopePtrOutput) Elem() NetworkAccessScopeOutput {
+	return o.ApplyT(func(v *NetworkAccessScope) NetworkAccessScope {
+		if v != nil {
+			return *v
+		}
+		var ret NetworkAccessScope
+		return ret
+	}).(NetworkAccessScopeOutput)
+}
+
+func (o NetworkAccessScopePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o NetworkAccessScopePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *NetworkAccessScope) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// NetworkAccessScopeInput is an input type that accepts values of the NetworkAccessScope enum
+// A concrete instance of `NetworkAccessScopeInput` can be one of the following:
+//
+//	NetworkAccessScopeResource
+//	NetworkAccessScopeGroup
+type NetworkAccessScopeInput interface {
+	pulumi.Input
+
+	ToNetworkAccessScopeOutput() NetworkAccessScopeOutput
+	ToNetworkAccessScopeOutputWithContext(context.Context) NetworkAccessScopeOutput
+}
+
+var networkAccessScopePtrType = reflect.TypeOf((**NetworkAccessScope)(nil)).Elem()
+
+type NetworkAccessScopePtrInput interface {
+	pulumi.Input
+
+	ToNetworkAccessScopePtrOutput() NetworkAccessScopePtrOutput
+	ToNetworkAccessScopePtrOutputWithContext(context.Context) NetworkAccessScopePtrOutput
+}
+
+type networkAccessScopePtr string
+
+func NetworkAccessScopePtr(v string) NetworkAccessScopePtrInput {
+	return (*networkAccessScopePtr)(&v)
+}
+
+func (*networkAccessScopePtr) ElementType() reflect.Type {
+	return networkAccessScopePtrType
+}
+
+func (in *networkAccessScopePtr) ToNetworkAccessScopePtrOutput() NetworkAccessScopePtrOutput {
+	return pulumi.ToOutput(in).(NetworkAccessScopePtrOutput)
+}
+
+func (in *networkAccessScopePtr) ToNetworkAccessScopePtrOutputWithContext(ctx context.Context) NetworkAccessScopePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(NetworkAccessScopePtrOutput)
+}
+
+type ReverseProxyAppAuthPreset string
+
+const (
+	// NetBird SSO, optionally limited to groups.
+	ReverseProxyAppAuthPresetBearer = ReverseProxyAppAuthPreset("bearer")
+	// A PIN shared with the users.
+	ReverseProxyAppAuthPresetPin = ReverseProxyAppAuthPreset("pin")
+	// A password shared with the users.
+	ReverseProxyAppAuthPresetPassword = ReverseProxyAppAuthPreset("password")
+)
+
+func (ReverseProxyAppAuthPreset) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReverseProxyAppAuthPreset)(nil)).Elem()
+}
+
+func (e ReverseProxyAppAuthPreset) ToReverseProxyAppAuthPresetOutput() ReverseProxyAppAuthPresetOutput {
+	return pulumi.ToOutput(e).(ReverseProxyAppAuthPresetOutput)
+}
+
+func (e ReverseProxyAppAuthPreset) ToReverseProxyAppAuthPresetOutputWithContext(ctx context.Context) ReverseProxyAppAuthPresetOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(ReverseProxyAppAuthPresetOutput)
+}
+
+func (e ReverseProxyAppAuthPreset) ToReverseProxyAppAuthPresetPtrOutput() ReverseProxyAppAuthPresetPtrOutput {
+	return e.ToReverseProxyAppAuthPresetPtrOutputWithContext(context.Background())
+}
+
+func (e ReverseProxyAppAuthPreset) ToReverseProxyAppAuthPresetPtrOutputWithContext(ctx context.Context) ReverseProxyAppAuthPresetPtrOutput {
+	return ReverseProxyAppAuthPreset(e).ToReverseProxyAppAuthPresetOutputWithContext(ctx).ToReverseProxyAppAuthPresetPtrOutputWithContext(ctx)
+}
+
+func (e ReverseProxyAppAuthPreset) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ReverseProxyAppAuthPreset) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ReverseProxyAppAuthPreset) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ReverseProxyAppAuthPreset) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type ReverseProxyAppAuthPresetOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyAppAuthPresetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReverseProxyAppAuthPreset)(nil)).Elem()
+}
+
+func (o ReverseProxyAppAuthPresetOutput) ToReverseProxyAppAuthPresetOutput() ReverseProxyAppAuthPresetOutput {
+	return o
+}
+
+func (o ReverseProxyAppAuthPresetOutput) ToReverseProxyAppAuthPresetOutputWithContext(ctx context.Context) ReverseProxyAppAuthPresetOutput {
+	return o
+}
+
+func (o ReverseProxyAppAuthPresetOutput) ToReverseProxyAppAuthPresetPtrOutput() ReverseProxyAppAuthPresetPtrOutput {
+	return o.ToReverseProxyAppAuthPresetPtrOutputWithContext(context.Background())
+}
+
+func (o ReverseProxyAppAuthPresetOutput) ToReverseProxyAppAuthPresetPtrOutputWithContext(ctx context.Context) ReverseProxyAppAuthPresetPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ReverseProxyAppAuthPreset) *ReverseProxyAppAuthPreset {
+		return &v
+	}).(ReverseProxyAppAuthPresetPtrOutput)
+}
+
+func (o ReverseProxyAppAuthPresetOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o ReverseProxyAppAuthPresetOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ReverseProxyAppAuthPreset) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o ReverseProxyAppAuthPresetOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ReverseProxyAppAuthPresetOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ReverseProxyAppAuthPreset) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type ReverseProxyAppAuthPresetPtrOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyAppAuthPresetPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ReverseProxyAppAuthPreset)(nil)).Elem()
+}
+
+func (o ReverseProxyAppAuthPresetPtrOutput) ToReverseProxyAppAuthPresetPtrOutput() ReverseProxyAppAuthPresetPtrOutput {
+	return o
+}
+
+func (o ReverseProxyAppAuthPresetPtrOutput) ToReverseProxyAppAuthPresetPtrOutputWithContext(ctx context.Context) ReverseProxyAppAuthPresetPtrOutput {
+	return o
+}
+
+func (o ReverseProxyAppAuthPresetPtrOutput) Elem() ReverseProxyAppAuthPresetOutput {
+	return o.ApplyT(func(v *ReverseProxyAppAuthPreset) ReverseProxyAppAuthPreset {
+		if v != nil {
+			return *v
+		}
+		var ret ReverseProxyAppAuthPreset
+		return ret
+	}).(ReverseProxyAppAuthPresetOutput)
+}
+
+func (o ReverseProxyAppAuthPresetPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ReverseProxyAppAuthPresetPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *ReverseProxyAppAuthPreset) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// ReverseProxyAppAuthPresetInput is an input type that accepts values of the ReverseProxyAppAuthPreset enum
+// A concrete instance of `ReverseProxyAppAuthPresetInput` can be one of the following:
+//
+//	ReverseProxyAppAuthPresetBearer
+//	ReverseProxyAppAuthPresetPin
+//	ReverseProxyAppAuthPresetPassword
+type ReverseProxyAppAuthPresetInput interface {
+	pulumi.Input
+
+	ToReverseProxyAppAuthPresetOutput() ReverseProxyAppAuthPresetOutput
+	ToReverseProxyAppAuthPresetOutputWithContext(context.Context) ReverseProxyAppAuthPresetOutput
+}
+
+var reverseProxyAppAuthPresetPtrType = reflect.TypeOf((**ReverseProxyAppAuthPreset)(nil)).Elem()
+
+type ReverseProxyAppAuthPresetPtrInput interface {
+	pulumi.Input
+
+	ToReverseProxyAppAuthPresetPtrOutput() ReverseProxyAppAuthPresetPtrOutput
+	ToReverseProxyAppAuthPresetPtrOutputWithContext(context.Context) ReverseProxyAppAuthPresetPtrOutput
+}
+
+type reverseProxyAppAuthPresetPtr string
+
+func ReverseProxyAppAuthPresetPtr(v string) ReverseProxyAppAuthPresetPtrInput {
+	return (*reverseProxyAppAuthPresetPtr)(&v)
+}
+
+func (*reverseProxyAppAuthPresetPtr) ElementType() reflect.Type {
+	return reverseProxyAppAuthPresetPtrType
+}
+
+func (in *reverseProxyAppAuthPresetPtr) ToReverseProxyAppAuthPresetPtrOutput() ReverseProxyAppAuthPresetPtrOutput {
+	return pulumi.ToOutput(in).(ReverseProxyAppAuthPresetPtrOutput)
+}
+
+func (in *reverseProxyAppAuthPresetPtr) ToReverseProxyAppAuthPresetPtrOutputWithContext(ctx context.Context) ReverseProxyAppAuthPresetPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(ReverseProxyAppAuthPresetPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkAccessScopeInput)(nil)).Elem(), NetworkAccessScope("resource"))
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkAccessScopePtrInput)(nil)).Elem(), NetworkAccessScope("resource"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyAppAuthPresetInput)(nil)).Elem(), ReverseProxyAppAuthPreset("bearer"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyAppAuthPresetPtrInput)(nil)).Elem(), ReverseProxyAppAuthPreset("bearer"))
+	pulumi.RegisterOutputType(NetworkAccessScopeOutput{})
+	pulumi.RegisterOutputType(NetworkAccessScopePtrOutput{})
+	pulumi.RegisterOutputType(ReverseProxyAppAuthPresetOutput{})
+	pulumi.RegisterOutputType(ReverseProxyAppAuthPresetPtrOutput{})
+}
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
@@ -137,236 +138,2302 @@ func (o DNSRecordSpecArrayOutput) Index(i pulumi.IntInput) DNSRecordSpecOutput {
 	}).(DNSRecordSpecOutput)
 }
 
+type ExitNodeDNSSpec struct {
+	// IP addresses of the nameservers clients resolve all domains with.
+	Nameservers []string `pulumi:"nameservers"`
+	// UDP port of the nameservers. Defaults to 53.
+	Port *int `pulumi:"port"`
+}
+
+// ExitNodeDNSSpecInput is an input type that accepts ExitNodeDNSSpecArgs and ExitNodeDNSSpecOutput values.
+// You can construct a concrete instance of `ExitNodeDNSSpecInput` via:
+//
+//	ExitNodeDNSSpecArgs{...}
+type ExitNodeDNSSpecInput interface {
+	pulumi.Input
+
+	ToExitNodeDNSSpecOutput() ExitNodeDNSSpecOutput
+	ToExitNodeDNSSpecOutputWithContext(context.Context) ExitNodeDNSSpecOutput
+}
+
+type ExitNodeDNSSpecArgs struct {
+	// IP addresses of the nameservers clients resolve all domains with.
+	Nameservers pulumi.StringArrayInput `pulumi:"nameservers"`
+	// UDP port of the nameservers. Defaults to 53.
+	Port pulumi.IntPtrInput `pulumi:"port"`
+}
+
+func (ExitNodeDNSSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExitNodeDNSSpec)(nil)).Elem()
+}
+
+func (i ExitNodeDNSSpecArgs) ToExitNodeDNSSpecOutput() ExitNodeDNSSpecOutput {
+	return i.ToExitNodeDNSSpecOutputWithContext(context.Background())
+}
+
+func (i ExitNodeDNSSpecArgs) ToExitNodeDNSSpecOutputWithContext(ctx context.Context) ExitNodeDNSSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExitNodeDNSSpecOutput)
+}
+
+func (i ExitNodeDNSSpecArgs) ToExitNodeDNSSpecPtrOutput() ExitNodeDNSSpecPtrOutput {
+	return i.ToExitNodeDNSSpecPtrOutputWithContext(context.Background())
+}
+
+func (i ExitNodeDNSSpecArgs) ToExitNodeDNSSpecPtrOutputWithContext(ctx context.Context) ExitNodeDNSSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExitNodeDNSSpecOutput).ToExitNodeDNSSpecPtrOutputWithContext(ctx)
+}
+
+// ExitNodeDNSSpecPtrInput is an input type that accepts ExitNodeDNSSpecArgs, ExitNodeDNSSpecPtr and ExitNodeDNSSpecPtrOutput values.
+// You can construct a concrete instance of `ExitNodeDNSSpecPtrInput` via:
+//
+//	        ExitNodeDNSSpecArgs{...}
+//
+//	or:
+//
+//	        nil
+type ExitNodeDNSSpecPtrInput interface {
+	pulumi.Input
+
+	ToExitNodeDNSSpecPtrOutput() ExitNodeDNSSpecPtrOutput
+	ToExitNodeDNSSpecPtrOutputWithContext(context.Context) ExitNodeDNSSpecPtrOutput
+}
+
+type exitNodeDNSSpecPtrType ExitNodeDNSSpecArgs
+
+func ExitNodeDNSSpecPtr(v *ExitNodeDNSSpecArgs) ExitNodeDNSSpecPtrInput {
+	return (*exitNodeDNSSpecPtrType)(v)
+}
+
+func (*exitNodeDNSSpecPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ExitNodeDNSSpec)(nil)).Elem()
+}
+
+func (i *exitNodeDNSSpecPtrType) ToExitNodeDNSSpecPtrOutput() ExitNodeDNSSpecPtrOutput {
+	return i.ToExitNodeDNSSpecPtrOutputWithContext(context.Background())
+}
+
+func (i *exitNodeDNSSpecPtrType) ToExitNodeDNSSpecPtrOutputWithContext(ctx context.Context) ExitNodeDNSSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExitNodeDNSSpecPtrOutput)
+}
+
+type ExitNodeDNSSpecOutput struct{ *pulumi.OutputState }
+
+func (ExitNodeDNSSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExitNodeDNSSpec)(nil)).Elem()
+}
+
+func (o ExitNodeDNSSpecOutput) ToExitNodeDNSSpecOutput() ExitNodeDNSSpecOutput {
+	return o
+}
+
+func (o ExitNodeDNSSpecOutput) ToExitNodeDNSSpecOutputWithContext(ctx context.Context) ExitNodeDNSSpecOutput {
+	return o
+}
+
+func (o ExitNodeDNSSpecOutput) ToExitNodeDNSSpecPtrOutput() ExitNodeDNSSpecPtrOutput {
+	return o.ToExitNodeDNSSpecPtrOutputWithContext(context.Background())
+}
+
+func (o ExitNodeDNSSpecOutput) ToExitNodeDNSSpecPtrOutputWithContext(ctx context.Context) ExitNodeDNSSpecPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ExitNodeDNSSpec) *ExitNodeDNSSpec {
+		return &v
+	}).(ExitNodeDNSSpecPtrOutput)
+}
+
+// IP addresses of the nameservers clients resolve all domains with.
+func (o ExitNodeDNSSpecOutput) Nameservers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ExitNodeDNSSpec) []string { return v.Nameservers }).(pulumi.StringArrayOutput)
+}
+
+// UDP port of the nameservers. Defaults to 53.
+func (o ExitNodeDNSSpecOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ExitNodeDNSSpec) *int { return v.Port }).(pulumi.IntPtrOutput)
+}
+
+type ExitNodeDNSSpecPtrOutput struct{ *pulumi.OutputState }
+
+func (ExitNodeDNSSpecPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ExitNodeDNSSpec)(nil)).Elem()
+}
+
+func (o ExitNodeDNSSpecPtrOutput) ToExitNodeDNSSpecPtrOutput() ExitNodeDNSSpecPtrOutput {
+	return o
+}
+
+func (o ExitNodeDNSSpecPtrOutput) ToExitNodeDNSSpecPtrOutputWithContext(ctx context.Context) ExitNodeDNSSpecPtrOutput {
+	return o
+}
+
+func (o ExitNodeDNSSpecPtrOutput) Elem() ExitNodeDNSSpecOutput {
+	return o.ApplyT(func(v *ExitNodeDNSSpec) ExitNodeDNSSpec {
+		if v != nil {
+			return *v
+		}
+		var ret ExitNodeDNSSpec
+		return ret
+	}).(ExitNodeDNSSpecOutput)
+}
+
+// IP addresses of the nameservers clients resolve all domains with.
+func (o ExitNodeDNSSpecPtrOutput) Nameservers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ExitNodeDNSSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Nameservers
+	}).(pulumi.StringArrayOutput)
+}
+
+// UDP port of the nameservers. Defaults to 53.
+func (o ExitNodeDNSSpecPtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ExitNodeDNSSpec) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+type ExitNodePeerSpec struct {
+	// Route metric; lower values have higher priority. Defaults to 9999. Give each entry a distinct metric to make the others standbys.
+	Metric *int `pulumi:"metric"`
+	// ID of a peer to route through. Conflicts with peerGroup.
+	Peer *string `pulumi:"peer"`
+	// ID of a group of peers to route through. Conflicts with peer.
+	PeerGroup *string `pulumi:"peerGroup"`
+}
+
+// ExitNodePeerSpecInput is an input type that accepts ExitNodePeerSpecArgs and ExitNodePeerSpecOutput values.
+// You can construct a concrete instance of `ExitNodePeerSpecInput` via:
+//
+//	ExitNodePeerSpecArgs{...}
+type ExitNodePeerSpecInput interface {
+	pulumi.Input
+
+	ToExitNodePeerSpecOutput() ExitNodePeerSpecOutput
+	ToExitNodePeerSpecOutputWithContext(context.Context) ExitNodePeerSpecOutput
+}
+
+type ExitNodePeerSpecArgs struct {
+	// Route metric; lower values have higher priority. Defaults to 9999. Give each entry a distinct metric to make the others standbys.
+	Metric pulumi.IntPtrInput `pulumi:"metric"`
+	// ID of a peer to route through. Conflicts with peerGroup.
+	Peer pulumi.StringPtrInput `pulumi:"peer"`
+	// ID of a group of peers to route through. Conflicts with peer.
+	PeerGroup pulumi.StringPtrInput `pulumi:"peerGroup"`
+}
+
+func (ExitNodePeerSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExitNodePeerSpec)(nil)).Elem()
+}
+
+func (i ExitNodePeerSpecArgs) ToExitNodePeerSpecOutput() ExitNodePeerSpecOutput {
+	return i.ToExitNodePeerSpecOutputWithContext(context.Background())
+}
+
+func (i ExitNodePeerSpecArgs) ToExitNodePeerSpecOutputWithContext(ctx context.Context) ExitNodePeerSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExitNodePeerSpecOutput)
+}
+
+// ExitNodePeerSpecArrayInput is an input type that accepts ExitNodePeerSpecArray and ExitNodePeerSpecArrayOutput values.
+// You can construct a concrete instance of `ExitNodePeerSpecArrayInput` via:
+//
+//	ExitNodePeerSpecArray{ ExitNodePeerSpecArgs{...} }
+type ExitNodePeerSpecArrayInput interface {
+	pulumi.Input
+
+	ToExitNodePeerSpecArrayOutput() ExitNodePeerSpecArrayOutput
+	ToExitNodePeerSpecArrayOutputWithContext(context.Context) ExitNodePeerSpecArrayOutput
+}
+
+type ExitNodePeerSpecArray []ExitNodePeerSpecInput
+
+func (ExitNodePeerSpecArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ExitNodePeerSpec)(nil)).Elem()
+}
+
+func (i ExitNodePeerSpecArray) ToExitNodePeerSpecArrayOutput() ExitNodePeerSpecArrayOutput {
+	return i.ToExitNodePeerSpecArrayOutputWithContext(context.Background())
+}
+
+func (i ExitNodePeerSpecArray) ToExitNodePeerSpecArrayOutputWithContext(ctx context.Context) ExitNodePeerSpecArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExitNodePeerSpecArrayOutput)
+}
+
+type ExitNodePeerSpecOutput struct{ *pulumi.OutputState }
+
+func (ExitNodePeerSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExitNodePeerSpec)(nil)).Elem()
+}
+
+func (o ExitNodePeerSpecOutput) ToExitNodePeerSpecOutput() ExitNodePeerSpecOutput {
+	return o
+}
+
+func (o ExitNodePeerSpecOutput) ToExitNodePeerSpecOutputWithContext(ctx context.Context) ExitNodePeerSpecOutput {
+	return o
+}
+
+// Route metric; lower values have higher priority. Defaults to 9999. Give each entry a distinct metric to make the others standbys.
+func (o ExitNodePeerSpecOutput) Metric() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ExitNodePeerSpec) *int { return v.Metric }).(pulumi.IntPtrOutput)
+}
+
+// ID of a peer to route through. Conflicts with peerGroup.
+func (o ExitNodePeerSpecOutput) Peer() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ExitNodePeerSpec) *string { return v.Peer }).(pulumi.StringPtrOutput)
+}
+
+// ID of a group of peers to route through. Conflicts with peer.
+func (o ExitNodePeerSpecOutput) PeerGroup() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ExitNodePeerSpec) *string { return v.PeerGroup }).(pulumi.StringPtrOutput)
+}
+
+type ExitNodePeerSpecArrayOutput struct{ *pulumi.OutputState }
+
+func (ExitNodePeerSpecArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ExitNodePeerSpec)(nil)).Elem()
+}
+
+func (o ExitNodePeerSpecArrayOutput) ToExitNodePeerSpecArrayOutput() ExitNodePeerSpecArrayOutput {
+	return o
+}
+
+func (o ExitNodePeerSpecArrayOutput) ToExitNodePeerSpecArrayOutputWithContext(ctx context.Context) ExitNodePeerSpecArrayOutput {
+	return o
+}
+
+func (o ExitNodePeerSpecArrayOutput) Index(i pulumi.IntInput) ExitNodePeerSpecOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ExitNodePeerSpec {
+		return vs[0].([]ExitNodePeerSpec)[vs[1].(int)]
+	}).(ExitNodePeerSpecOutput)
+}
+
+type HARouterSpec struct {
+	// ID of a routing peer. Conflicts with peerGroup.
+	Peer *string `pulumi:"peer"`
+	// ID of a group of routing peers. Conflicts with peer.
+	PeerGroup *string `pulumi:"peerGroup"`
+}
+
+// HARouterSpecInput is an input type that accepts HARouterSpecArgs and HARouterSpecOutput values.
+// You can construct a concrete instance of `HARouterSpecInput` via:
+//
+//	HARouterSpecArgs{...}
+type HARouterSpecInput interface {
+	pulumi.Input
+
+	ToHARouterSpecOutput() HARouterSpecOutput
+	ToHARouterSpecOutputWithContext(context.Context) HARouterSpecOutput
+}
+
+type HARouterSpecArgs struct {
+	// ID of a routing peer. Conflicts with peerGroup.
+	Peer pulumi.StringPtrInput `pulumi:"peer"`
+	// ID of a group of routing peers. Conflicts with peer.
+	PeerGroup pulumi.StringPtrInput `pulumi:"peerGroup"`
+}
+
+func (HARouterSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*HARouterSpec)(nil)).Elem()
+}
+
+func (i HARouterSpecArgs) ToHARouterSpecOutput() HARouterSpecOutput {
+	return i.ToHARouterSpecOutputWithContext(context.Background())
+}
+
+func (i HARouterSpecArgs) ToHARouterSpecOutputWithContext(ctx context.Context) HARouterSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HARouterSpecOutput)
+}
+
+// HARouterSpecArrayInput is an input type that accepts HARouterSpecArray and HARouterSpecArrayOutput values.
+// You can construct a concrete instance of `HARouterSpecArrayInput` via:
+//
+//	HARouterSpecArray{ HARouterSpecArgs{...} }
+type HARouterSpecArrayInput interface {
+	pulumi.Input
+
+	ToHARouterSpecArrayOutput() HARouterSpecArrayOutput
+	ToHARouterSpecArrayOutputWithContext(context.Context) HARouterSpecArrayOutput
+}
+
+type HARouterSpecArray []HARouterSpecInput
+
+func (HARouterSpecArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]HARouterSpec)(nil)).Elem()
+}
+
+func (i HARouterSpecArray) ToHARouterSpecArrayOutput() HARouterSpecArrayOutput {
+	return i.ToHARouterSpecArrayOutputWithContext(context.Background())
+}
+
+func (i HARouterSpecArray) ToHARouterSpecArrayOutputWithContext(ctx context.Context) HARouterSpecArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HARouterSpecArrayOutput)
+}
+
+type HARouterSpecOutput struct{ *pulumi.OutputState }
+
+func (HARouterSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*HARouterSpec)(nil)).Elem()
+}
+
+func (o HARouterSpecOutput) ToHARouterSpecOutput() HARouterSpecOutput {
+	return o
+}
+
+func (o HARouterSpecOutput) ToHARouterSpecOutputWithContext(ctx context.Context) HARouterSpecOutput {
+	return o
+}
+
+// ID of a routing peer. Conflicts with peerGroup.
+func (o HARouterSpecOutput) Peer() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v HARouterSpec) *string { return v.Peer }).(pulumi.StringPtrOutput)
+}
+
+// ID of a group of routing peers. Conflicts with peer.
+func (o HARouterSpecOutput) PeerGroup() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v HARouterSpec) *string { return v.PeerGroup }).(pulumi.StringPtrOutput)
+}
+
+type HARouterSpecArrayOutput struct{ *pulumi.OutputState }
+
+func (HARouterSpecArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]HARouterSpec)(nil)).Elem()
+}
+
+func (o HARouterSpecArrayOutput) ToHARouterSpecArrayOutput() HARouterSpecArrayOutput {
+	return o
+}
+
+func (o HARouterSpecArrayOutput) ToHARouterSpecArrayOutputWithContext(ctx context.Context) HARouterSpecArrayOutput {
+	return o
+}
+
+func (o HARouterSpecArrayOutput) Index(i pulumi.IntInput) HARouterSpecOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) HARouterSpec {
+		return vs[0].([]HARouterSpec)[vs[1].(int)]
+	}).(HARouterSpecOutput)
+}
+
+type NetworkAccessSpec struct {
+	// Whether to generate one policy per resource or per resource group. Defaults to resource.
+	Per *NetworkAccessScope `pulumi:"per"`
+	// Port ranges to accept. Only valid with protocol tcp or udp.
+	PortRanges []resource.RulePortRange `pulumi:"portRanges"`
+	// Ports to accept. Only valid with protocol tcp or udp.
+	Ports []string `pulumi:"ports"`
+	// Protocol to accept. Defaults to all.
+	Protocol *resource.Protocol `pulumi:"protocol"`
+	// IDs of the groups whose peers may reach the resources.
+	Sources []string `pulumi:"sources"`
+}
+
+// NetworkAccessSpecInput is an input type that accepts NetworkAccessSpecArgs and NetworkAccessSpecOutput values.
+// You can construct a concrete instance of `NetworkAccessSpecInput` via:
+//
+//	NetworkAccessSpecArgs{...}
+type NetworkAccessSpecInput interface {
+	pulumi.Input
+
+	ToNetworkAccessSpecOutput() NetworkAccessSpecOutput
+	ToNetworkAccessSpecOutputWithContext(context.Context) NetworkAccessSpecOutput
+}
+
+type NetworkAccessSpecArgs struct {
+	// Whether to generate one policy per resource or per resource group. Defaults to resource.
+	Per NetworkAccessScopePtrInput `pulumi:"per"`
+	// Port ranges to accept. Only valid with protocol tcp or udp.
+	PortRanges resource.RulePortRangeArrayInput `pulumi:"portRanges"`
+	// Ports to accept. Only valid with protocol tcp or udp.
+	Ports pulumi.StringArrayInput `pulumi:"ports"`
+	// Protocol to accept. Defaults to all.
+	Protocol resource.ProtocolPtrInput `pulumi:"protocol"`
+	// IDs of the groups whose peers may reach the resources.
+	Sources pulumi.StringArrayInput `pulumi:"sources"`
+}
+
+func (NetworkAccessSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkAccessSpec)(nil)).Elem()
+}
+
+func (i NetworkAccessSpecArgs) ToNetworkAccessSpecOutput() NetworkAccessSpecOutput {
+	return i.ToNetworkAccessSpecOutputWithContext(context.Background())
+}
+
+func (i NetworkAccessSpecArgs) ToNetworkAccessSpecOutputWithContext(ctx context.Context) NetworkAccessSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkAccessSpecOutput)
+}
+
+func (i NetworkAccessSpecArgs) ToNetworkAccessSpecPtrOutput() NetworkAccessSpecPtrOutput {
+	return i.ToNetworkAccessSpecPtrOutputWithContext(context.Background())
+}
+
+func (i NetworkAccessSpecArgs) ToNetworkAccessSpecPtrOutputWithContext(ctx context.Context) NetworkAccessSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkAccessSpecOutput).ToNetworkAccessSpecPtrOutputWithContext(ctx)
+}
+
+// NetworkAccessSpecPtrInput is an input type that accepts NetworkAccessSpecArgs, NetworkAccessSpecPtr and NetworkAccessSpecPtrOutput values.
+// You can construct a concrete instance of `NetworkAccessSpecPtrInput` via:
+//
+//	        NetworkAccessSpecArgs{...}
+//
+//	or:
+//
+//	        nil
+type NetworkAccessSpecPtrInput interface {
+	pulumi.Input
+
+	ToNetworkAccessSpecPtrOutput() NetworkAccessSpecPtrOutput
+	ToNetworkAccessSpecPtrOutputWithContext(context.Context) NetworkAccessSpecPtrOutput
+}
+
+type networkAccessSpecPtrType NetworkAccessSpecArgs
+
+func NetworkAccessSpecPtr(v *NetworkAccessSpecArgs) NetworkAccessSpecPtrInput {
+	return (*networkAccessSpecPtrType)(v)
+}
+
+func (*networkAccessSpecPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkAccessSpec)(nil)).Elem()
+}
+
+func (i *networkAccessSpecPtrType) ToNetworkAccessSpecPtrOutput() NetworkAccessSpecPtrOutput {
+	return i.ToNetworkAccessSpecPtrOutputWithContext(context.Background())
+}
+
+func (i *networkAccessSpecPtrType) ToNetworkAccessSpecPtrOutputWithContext(ctx context.Context) NetworkAccessSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkAccessSpecPtrOutput)
+}
+
+type NetworkAccessSpecOutput struct{ *pulumi.OutputState }
+
+func (NetworkAccessSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkAccessSpec)(nil)).Elem()
+}
+
+func (o NetworkAccessSpecOutput) ToNetworkAccessSpecOutput() NetworkAccessSpecOutput {
+	return o
+}
+
+func (o NetworkAccessSpecOutput) ToNetworkAccessSpecOutputWithContext(ctx context.Context) NetworkAccessSpecOutput {
+	return o
+}
+
+func (o NetworkAccessSpecOutput) ToNetworkAccessSpecPtrOutput() NetworkAccessSpecPtrOutput {
+	return o.ToNetworkAccessSpecPtrOutputWithContext(context.Background())
+}
+
+func (o NetworkAccessSpecOutput) ToNetworkAccessSpecPtrOutputWithContext(ctx context.Context) NetworkAccessSpecPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v NetworkAccessSpec) *NetworkAccessSpec {
+		return &v
+	}).(NetworkAccessSpecPtrOutput)
+}
+
+// Whether to generate one policy per resource or per resource group. Defaults to resource.
+func (o NetworkAccessSpecOutput) Per() NetworkAccessScopePtrOutput {
+	return o.ApplyT(func(v NetworkAccessSpec) *NetworkAccessScope { return v.Per }).(NetworkAccessScopePtrOutput)
+}
+
+// Port ranges to accept. Only valid with protocol tcp or udp.
+func (o NetworkAccessSpecOutput) PortRanges() resource.RulePortRangeArrayOutput {
+	return o.ApplyT(func(v NetworkAccessSpec) []resource.RulePortRange { return v.PortRanges }).(resource.RulePortRangeArrayOutput)
+}
+
+// Ports to accept. Only valid with protocol tcp or udp.
+func (o NetworkAccessSpecOutput) Ports() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NetworkAccessSpec) []string { return v.Ports }).(pulumi.StringArrayOutput)
+}
+
+// Protocol to accept. Defaults to all.
+func (o NetworkAccessSpecOutput) Protocol() resource.ProtocolPtrOutput {
+	return o.ApplyT(func(v NetworkAccessSpec) *resource.Protocol { return v.Protocol }).(resource.ProtocolPtrOutput)
+}
+
+// IDs of the groups whose peers may reach the resources.
+func (o NetworkAccessSpecOutput) Sources() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NetworkAccessSpec) []string { return v.Sources }).(pulumi.StringArrayOutput)
+}
+
+type NetworkAccessSpecPtrOutput struct{ *pulumi.OutputState }
+
+func (NetworkAccessSpecPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkAccessSpec)(nil)).Elem()
+}
+
+func (o NetworkAccessSpecPtrOutput) ToNetworkAccessSpecPtrOutput() NetworkAccessSpecPtrOutput {
+	return o
+}
+
+func (o NetworkAccessSpecPtrOutput) ToNetworkAccessSpecPtrOutputWithContext(ctx context.Context) NetworkAccessSpecPtrOutput {
+	return o
+}
+
+func (o NetworkAccessSpecPtrOutput) Elem() NetworkAccessSpecOutput {
+	return o.ApplyT(func(v *NetworkAccessSpec) NetworkAccessSpec {
+		if v != nil {
+			return *v
+		}
+		var ret NetworkAccessSpec
+		return ret
+	}).(NetworkAccessSpecOutput)
+}
+
+// Whether to generate one policy per resource or per resource group. Defaults to resource.
+func (o NetworkAccessSpecPtrOutput) Per() NetworkAccessScopePtrOutput {
+	return o.ApplyT(func(v *NetworkAccessSpec) *NetworkAccessScope {
+		if v == nil {
+			return nil
+		}
+		return v.Per
+	}).(NetworkAccessScopePtrOutput)
+}
+
+// Port ranges to accept. Only valid with protocol tcp or udp.
+func (o NetworkAccessSpecPtrOutput) PortRanges() resource.RulePortRangeArrayOutput {
+	return o.ApplyT(func(v *NetworkAccessSpec) []resource.RulePortRange {
+		if v == nil {
+			return nil
+		}
+		return v.PortRanges
+	}).(resource.RulePortRangeArrayOutput)
+}
+
+// Ports to accept. Only valid with protocol tcp or udp.
+func (o NetworkAccessSpecPtrOutput) Ports() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *NetworkAccessSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Ports
+	}).(pulumi.StringArrayOutput)
+}
+
+// Protocol to accept. Defaults to all.
+func (o NetworkAccessSpecPtrOutput) Protocol() resource.ProtocolPtrOutput {
+	return o.ApplyT(func(v *NetworkAccessSpec) *resource.Protocol {
+		if v == nil {
+			return nil
+		}
+		return v.Protocol
+	}).(resource.ProtocolPtrOutput)
+}
+
+// IDs of the groups whose peers may reach the resources.
+func (o NetworkAccessSpecPtrOutput) Sources() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *NetworkAccessSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Sources
+	}).(pulumi.StringArrayOutput)
+}
+
+type NetworkResourceSpec struct {
+	// Domain name (e.g. api.example.com or *.example.com) for domains, or an IP address for hosts.
+	Address string `pulumi:"address"`
+	// Optional description for the resource.
+	Description *string `pulumi:"description"`
+	// Whether the resource is enabled.
+	Enabled bool `pulumi:"enabled"`
+	// IDs of the groups the resource belongs to. With access per group, each of these groups is the destination of a generated policy.
+	GroupIDs []string `pulumi:"groupIDs"`
+	// Display name for the resource. Unique across the subnets, domains, and hosts of the bundle.
+	Name string `pulumi:"name"`
+}
+
+// NetworkResourceSpecInput is an input type that accepts NetworkResourceSpecArgs and NetworkResourceSpecOutput values.
+// You can construct a concrete instance of `NetworkResourceSpecInput` via:
+//
+//	NetworkResourceSpecArgs{...}
+type NetworkResourceSpecInput interface {
+	pulumi.Input
+
+	ToNetworkResourceSpecOutput() NetworkResourceSpecOutput
+	ToNetworkResourceSpecOutputWithContext(context.Context) NetworkResourceSpecOutput
+}
+
+type NetworkResourceSpecArgs struct {
+	// Domain name (e.g. api.example.com or *.example.com) for domains, or an IP address for hosts.
+	Address pulumi.StringInput `pulumi:"address"`
+	// Optional description for the resource.
+	Description pulumi.StringPtrInput `pulumi:"description"`
+	// Whether the resource is enabled.
+	Enabled pulumi.BoolInput `pulumi:"enabled"`
+	// IDs of the groups the resource belongs to. With access per group, each of these groups is the destination of a generated policy.
+	GroupIDs pulumi.StringArrayInput `pulumi:"groupIDs"`
+	// Display name for the resource. Unique across the subnets, domains, and hosts of the bundle.
+	Name pulumi.StringInput `pulumi:"name"`
+}
+
+func (NetworkResourceSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkResourceSpec)(nil)).Elem()
+}
+
+func (i NetworkResourceSpecArgs) ToNetworkResourceSpecOutput() NetworkResourceSpecOutput {
+	return i.ToNetworkResourceSpecOutputWithContext(context.Background())
+}
+
+func (i NetworkResourceSpecArgs) ToNetworkResourceSpecOutputWithContext(ctx context.Context) NetworkResourceSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkResourceSpecOutput)
+}
+
+// NetworkResourceSpecArrayInput is an input type that accepts NetworkResourceSpecArray and NetworkResourceSpecArrayOutput values.
+// You can construct a concrete instance of `NetworkResourceSpecArrayInput` via:
+//
+//	NetworkResourceSpecArray{ NetworkResourceSpecArgs{...} }
+type NetworkResourceSpecArrayInput interface {
+	pulumi.Input
+
+	ToNetworkResourceSpecArrayOutput() NetworkResourceSpecArrayOutput
+	ToNetworkResourceSpecArrayOutputWithContext(context.Context) NetworkResourceSpecArrayOutput
+}
+
+type NetworkResourceSpecArray []NetworkResourceSpecInput
+
+func (NetworkResourceSpecArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkResourceSpec)(nil)).Elem()
+}
+
+func (i NetworkResourceSpecArray) ToNetworkResourceSpecArrayOutput() NetworkResourceSpecArrayOutput {
+	return i.ToNetworkResourceSpecArrayOutputWithContext(context.Background())
+}
+
+func (i NetworkResourceSpecArray) ToNetworkResourceSpecArrayOutputWithContext(ctx context.Context) NetworkResourceSpecArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkResourceSpecArrayOutput)
+}
+
+type NetworkResourceSpecOutput struct{ *pulumi.OutputState }
+
+func (NetworkResourceSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkResourceSpec)(nil)).Elem()
+}
+
+func (o NetworkResourceSpecOutput) ToNetworkResourceSpecOutput() NetworkResourceSpecOutput {
+	return o
+}
+
+func (o NetworkResourceSpecOutput) ToNetworkResourceSpecOutputWithContext(ctx context.Context) NetworkResourceSpecOutput {
+	return o
+}
+
+// Domain name (e.g. api.example.com or *.example.com) for domains, or an IP address for hosts.
+func (o NetworkResourceSpecOutput) Address() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkResourceSpec) string { return v.Address }).(pulumi.StringOutput)
+}
+
+// Optional description for the resource.
+func (o NetworkResourceSpecOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkResourceSpec) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// Whether the resource is enabled.
+func (o NetworkResourceSpecOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v NetworkResourceSpec) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// IDs of the groups the resource belongs to. With access per group, each of these groups is the destination of a generated policy.
+func (o NetworkResourceSpecOutput) GroupIDs() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NetworkResourceSpec) []string { return v.GroupIDs }).(pulumi.StringArrayOutput)
+}
+
+// Display name for the resource. Unique across the subnets, domains, and hosts of the bundle.
+func (o NetworkResourceSpecOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkResourceSpec) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type NetworkResourceSpecArrayOutput struct{ *pulumi.OutputState }
+
+func (NetworkResourceSpecArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkResourceSpec)(nil)).Elem()
+}
+
+func (o NetworkResourceSpecArrayOutput) ToNetworkResourceSpecArrayOutput() NetworkResourceSpecArrayOutput {
+	return o
+}
+
+func (o NetworkResourceSpecArrayOutput) ToNetworkResourceSpecArrayOutputWithContext(ctx context.Context) NetworkResourceSpecArrayOutput {
+	return o
+}
+
+func (o NetworkResourceSpecArrayOutput) Index(i pulumi.IntInput) NetworkResourceSpecOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) NetworkResourceSpec {
+		return vs[0].([]NetworkResourceSpec)[vs[1].(int)]
+	}).(NetworkResourceSpecOutput)
+}
+
 type NetworkRouterSpec struct {
 	// Whether the router is enabled.
-	Enabled bool `pulumi:"enabled"`
+	Enabled bool `pulumi:"enabled"`
+	// Whether to masquerade traffic through the router.
+	Masquerade bool `pulumi:"masquerade"`
+	// Route metric; lower values have higher priority.
+	Metric int `pulumi:"metric"`
+	// Specific peer to use as router.
+	Peer *string `pulumi:"peer"`
+	// Peer groups to use as router peers.
+	PeerGroups []string `pulumi:"peerGroups"`
+}
+
+// NetworkRouterSpecInput is an input type that accepts NetworkRouterSpecArgs and NetworkRouterSpecOutput values.
+// You can construct a concrete instance of `NetworkRouterSpecInput` via:
+//
+//	NetworkRouterSpecArgs{...}
+type NetworkRouterSpecInput interface {
+	pulumi.Input
+
+	ToNetworkRouterSpecOutput() NetworkRouterSpecOutput
+	ToNetworkRouterSpecOutputWithContext(context.Context) NetworkRouterSpecOutput
+}
+
+type NetworkRouterSpecArgs struct {
+	// Whether the router is enabled.
+	Enabled pulumi.BoolInput `pulumi:"enabled"`
 	// Whether to masquerade traffic through the router.
-	Masquerade bool `pulumi:"masquerade"`
+	Masquerade pulumi.BoolInput `pulumi:"masquerade"`
 	// Route metric; lower values have higher priority.
-	Metric int `pulumi:"metric"`
+	Metric pulumi.IntInput `pulumi:"metric"`
 	// Specific peer to use as router.
+	Peer pulumi.StringPtrInput `pulumi:"peer"`
+	// Peer groups to use as router peers.
+	PeerGroups pulumi.StringArrayInput `pulumi:"peerGroups"`
+}
+
+func (NetworkRouterSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkRouterSpec)(nil)).Elem()
+}
+
+func (i NetworkRouterSpecArgs) ToNetworkRouterSpecOutput() NetworkRouterSpecOutput {
+	return i.ToNetworkRouterSpecOutputWithContext(context.Background())
+}
+
+func (i NetworkRouterSpecArgs) ToNetworkRouterSpecOutputWithContext(ctx context.Context) NetworkRouterSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkRouterSpecOutput)
+}
+
+func (i NetworkRouterSpecArgs) ToNetworkRouterSpecPtrOutput() NetworkRouterSpecPtrOutput {
+	return i.ToNetworkRouterSpecPtrOutputWithContext(context.Background())
+}
+
+func (i NetworkRouterSpecArgs) ToNetworkRouterSpecPtrOutputWithContext(ctx context.Context) NetworkRouterSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkRouterSpecOutput).ToNetworkRouterSpecPtrOutputWithContext(ctx)
+}
+
+// NetworkRouterSpecPtrInput is an input type that accepts NetworkRouterSpecArgs, NetworkRouterSpecPtr and NetworkRouterSpecPtrOutput values.
+// You can construct a concrete instance of `NetworkRouterSpecPtrInput` via:
+//
+//	        NetworkRouterSpecArgs{...}
+//
+//	or:
+//
+//	        nil
+type NetworkRouterSpecPtrInput interface {
+	pulumi.Input
+
+	ToNetworkRouterSpecPtrOutput() NetworkRouterSpecPtrOutput
+	ToNetworkRouterSpecPtrOutputWithContext(context.Context) NetworkRouterSpecPtrOutput
+}
+
+type networkRouterSpecPtrType NetworkRouterSpecArgs
+
+func NetworkRouterSpecPtr(v *NetworkRouterSpecArgs) NetworkRouterSpecPtrInput {
+	return (*networkRouterSpecPtrType)(v)
+}
+
+func (*networkRouterSpecPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkRouterSpec)(nil)).Elem()
+}
+
+func (i *networkRouterSpecPtrType) ToNetworkRouterSpecPtrOutput() NetworkRouterSpecPtrOutput {
+	return i.ToNetworkRouterSpecPtrOutputWithContext(context.Background())
+}
+
+func (i *networkRouterSpecPtrType) ToNetworkRouterSpecPtrOutputWithContext(ctx context.Context) NetworkRouterSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkRouterSpecPtrOutput)
+}
+
+// NetworkRouterSpecArrayInput is an input type that accepts NetworkRouterSpecArray and NetworkRouterSpecArrayOutput values.
+// You can construct a concrete instance of `NetworkRouterSpecArrayInput` via:
+//
+//	NetworkRouterSpecArray{ NetworkRouterSpecArgs{...} }
+type NetworkRouterSpecArrayInput interface {
+	pulumi.Input
+
+	ToNetworkRouterSpecArrayOutput() NetworkRouterSpecArrayOutput
+	ToNetworkRouterSpecArrayOutputWithContext(context.Context) NetworkRouterSpecArrayOutput
+}
+
+type NetworkRouterSpecArray []NetworkRouterSpecInput
+
+func (NetworkRouterSpecArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkRouterSpec)(nil)).Elem()
+}
+
+func (i NetworkRouterSpecArray) ToNetworkRouterSpecArrayOutput() NetworkRouterSpecArrayOutput {
+	return i.ToNetworkRouterSpecArrayOutputWithContext(context.Background())
+}
+
+func (i NetworkRouterSpecArray) ToNetworkRouterSpecArrayOutputWithContext(ctx context.Context) NetworkRouterSpecArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkRouterSpecArrayOutput)
+}
+
+type NetworkRouterSpecOutput struct{ *pulumi.OutputState }
+
+func (NetworkRouterSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkRouterSpec)(nil)).Elem()
+}
+
+func (o NetworkRouterSpecOutput) ToNetworkRouterSpecOutput() NetworkRouterSpecOutput {
+	return o
+}
+
+func (o NetworkRouterSpecOutput) ToNetworkRouterSpecOutputWithContext(ctx context.Context) NetworkRouterSpecOutput {
+	return o
+}
+
+func (o NetworkRouterSpecOutput) ToNetworkRouterSpecPtrOutput() NetworkRouterSpecPtrOutput {
+	return o.ToNetworkRouterSpecPtrOutputWithContext(context.Background())
+}
+
+func (o NetworkRouterSpecOutput) ToNetworkRouterSpecPtrOutputWithContext(ctx context.Context) NetworkRouterSpecPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v NetworkRouterSpec) *NetworkRouterSpec {
+		return &v
+	}).(NetworkRouterSpecPtrOutput)
+}
+
+// Whether the router is enabled.
+func (o NetworkRouterSpecOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v NetworkRouterSpec) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// Whether to masquerade traffic through the router.
+func (o NetworkRouterSpecOutput) Masquerade() pulumi.BoolOutput {
+	return o.ApplyT(func(v NetworkRouterSpec) bool { return v.Masquerade }).(pulumi.BoolOutput)
+}
+
+// Route metric; lower values have higher priority.
+func (o NetworkRouterSpecOutput) Metric() pulumi.IntOutput {
+	return o.ApplyT(func(v NetworkRouterSpec) int { return v.Metric }).(pulumi.IntOutput)
+}
+
+// Specific peer to use as router.
+func (o NetworkRouterSpecOutput) Peer() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkRouterSpec) *string { return v.Peer }).(pulumi.StringPtrOutput)
+}
+
+// Peer groups to use as router peers.
+func (o NetworkRouterSpecOutput) PeerGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NetworkRouterSpec) []string { return v.PeerGroups }).(pulumi.StringArrayOutput)
+}
+
+type NetworkRouterSpecPtrOutput struct{ *pulumi.OutputState }
+
+func (NetworkRouterSpecPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkRouterSpec)(nil)).Elem()
+}
+
+func (o NetworkRouterSpecPtrOutput) ToNetworkRouterSpecPtrOutput() NetworkRouterSpecPtrOutput {
+	return o
+}
+
+func (o NetworkRouterSpecPtrOutput) ToNetworkRouterSpecPtrOutputWithContext(ctx context.Context) NetworkRouterSpecPtrOutput {
+	return o
+}
+
+func (o NetworkRouterSpecPtrOutput) Elem() NetworkRouterSpecOutput {
+	return o.ApplyT(func(v *NetworkRouterSpec) NetworkRouterSpec {
+		if v != nil {
+			return *v
+		}
+		var ret NetworkRouterSpec
+		return ret
+	}).(NetworkRouterSpecOutput)
+}
+
+// Whether the router is enabled.
+func (o NetworkRouterSpecPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *NetworkRouterSpec) *bool {
+		if v == nil {
+			return nil
+		}
+		return &v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether to masquerade traffic through the router.
+func (o NetworkRouterSpecPtrOutput) Masquerade() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *NetworkRouterSpec) *bool {
+		if v == nil {
+			return nil
+		}
+		return &v.Masquerade
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Route metric; lower values have higher priority.
+func (o NetworkRouterSpecPtrOutput) Metric() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *NetworkRouterSpec) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.Metric
+	}).(pulumi.IntPtrOutput)
+}
+
+// Specific peer to use as router.
+func (o NetworkRouterSpecPtrOutput) Peer() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NetworkRouterSpec) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Peer
+	}).(pulumi.StringPtrOutput)
+}
+
+// Peer groups to use as router peers.
+func (o NetworkRouterSpecPtrOutput) PeerGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *NetworkRouterSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.PeerGroups
+	}).(pulumi.StringArrayOutput)
+}
+
+type NetworkRouterSpecArrayOutput struct{ *pulumi.OutputState }
+
+func (NetworkRouterSpecArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkRouterSpec)(nil)).Elem()
+}
+
+func (o NetworkRouterSpecArrayOutput) ToNetworkRouterSpecArrayOutput() NetworkRouterSpecArrayOutput {
+	return o
+}
+
+func (o NetworkRouterSpecArrayOutput) ToNetworkRouterSpecArrayOutputWithContext(ctx context.Context) NetworkRouterSpecArrayOutput {
+	return o
+}
+
+func (o NetworkRouterSpecArrayOutput) Index(i pulumi.IntInput) NetworkRouterSpecOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) NetworkRouterSpec {
+		return vs[0].([]NetworkRouterSpec)[vs[1].(int)]
+	}).(NetworkRouterSpecOutput)
+}
+
+type NetworkSubnetSpec struct {
+	// CIDR block for the subnet (e.g. 10.10.1.0/24).
+	Address string `pulumi:"address"`
+	// Optional description for the subnet resource.
+	Description *string `pulumi:"description"`
+	// Whether the subnet resource is enabled.
+	Enabled bool `pulumi:"enabled"`
+	// IDs of the groups the subnet resource belongs to. With access per group, each of these groups is the destination of a generated policy.
+	GroupIDs []string `pulumi:"groupIDs"`
+	// Display name for the subnet resource.
+	Name string `pulumi:"name"`
+}
+
+// NetworkSubnetSpecInput is an input type that accepts NetworkSubnetSpecArgs and NetworkSubnetSpecOutput values.
+// You can construct a concrete instance of `NetworkSubnetSpecInput` via:
+//
+//	NetworkSubnetSpecArgs{...}
+type NetworkSubnetSpecInput interface {
+	pulumi.Input
+
+	ToNetworkSubnetSpecOutput() NetworkSubnetSpecOutput
+	ToNetworkSubnetSpecOutputWithContext(context.Context) NetworkSubnetSpecOutput
+}
+
+type NetworkSubnetSpecArgs struct {
+	// CIDR block for the subnet (e.g. 10.10.1.0/24).
+	Address pulumi.StringInput `pulumi:"address"`
+	// Optional description for the subnet resource.
+	Description pulumi.StringPtrInput `pulumi:"description"`
+	// Whether the subnet resource is enabled.
+	Enabled pulumi.BoolInput `pulumi:"enabled"`
+	// IDs of the groups the subnet resource belongs to. With access per group, each of these groups is the destination of a generated policy.
+	GroupIDs pulumi.StringArrayInput `pulumi:"groupIDs"`
+	// Display name for the subnet resource.
+	Name pulumi.StringInput `pulumi:"name"`
+}
+
+func (NetworkSubnetSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkSubnetSpec)(nil)).Elem()
+}
+
+func (i NetworkSubnetSpecArgs) ToNetworkSubnetSpecOutput() NetworkSubnetSpecOutput {
+	return i.ToNetworkSubnetSpecOutputWithContext(context.Background())
+}
+
+func (i NetworkSubnetSpecArgs) ToNetworkSubnetSpecOutputWithContext(ctx context.Context) NetworkSubnetSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkSubnetSpecOutput)
+}
+
+// NetworkSubnetSpecArrayInput is an input type that accepts NetworkSubnetSpecArray and NetworkSubnetSpecArrayOutput values.
+// You can construct a concrete instance of `NetworkSubnetSpecArrayInput` via:
+//
+//	NetworkSubnetSpecArray{ NetworkSubnetSpecArgs{...} }
+type NetworkSubnetSpecArrayInput interface {
+	pulumi.Input
+
+	ToNetworkSubnetSpecArrayOutput() NetworkSubnetSpecArrayOutput
+	ToNetworkSubnetSpecArrayOutputWithContext(context.Context) NetworkSubnetSpecArrayOutput
+}
+
+type NetworkSubnetSpecArray []NetworkSubnetSpecInput
+
+func (NetworkSubnetSpecArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkSubnetSpec)(nil)).Elem()
+}
+
+func (i NetworkSubnetSpecArray) ToNetworkSubnetSpecArrayOutput() NetworkSubnetSpecArrayOutput {
+	return i.ToNetworkSubnetSpecArrayOutputWithContext(context.Background())
+}
+
+func (i NetworkSubnetSpecArray) ToNetworkSubnetSpecArrayOutputWithContext(ctx context.Context) NetworkSubnetSpecArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkSubnetSpecArrayOutput)
+}
+
+type NetworkSubnetSpecOutput struct{ *pulumi.OutputState }
+
+func (NetworkSubnetSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkSubnetSpec)(nil)).Elem()
+}
+
+func (o NetworkSubnetSpecOutput) ToNetworkSubnetSpecOutput() NetworkSubnetSpecOutput {
+	return o
+}
+
+func (o NetworkSubnetSpecOutput) ToNetworkSubnetSpecOutputWithContext(ctx context.Context) NetworkSubnetSpecOutput {
+	return o
+}
+
+// CIDR block for the subnet (e.g. 10.10.1.0/24).
+func (o NetworkSubnetSpecOutput) Address() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkSubnetSpec) string { return v.Address }).(pulumi.StringOutput)
+}
+
+// Optional description for the subnet resource.
+func (o NetworkSubnetSpecOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkSubnetSpec) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// Whether the subnet resource is enabled.
+func (o NetworkSubnetSpecOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v NetworkSubnetSpec) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// IDs of the groups the subnet resource belongs to. With access per group, each of these groups is the destination of a generated policy.
+func (o NetworkSubnetSpecOutput) GroupIDs() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NetworkSubnetSpec) []string { return v.GroupIDs }).(pulumi.StringArrayOutput)
+}
+
+// Display name for the subnet resource.
+func (o NetworkSubnetSpecOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkSubnetSpec) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type NetworkSubnetSpecArrayOutput struct{ *pulumi.OutputState }
+
+func (NetworkSubnetSpecArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkSubnetSpec)(nil)).Elem()
+}
+
+func (o NetworkSubnetSpecArrayOutput) ToNetworkSubnetSpecArrayOutput() NetworkSubnetSpecArrayOutput {
+	return o
+}
+
+func (o NetworkSubnetSpecArrayOutput) ToNetworkSubnetSpecArrayOutputWithContext(ctx context.Context) NetworkSubnetSpecArrayOutput {
+	return o
+}
+
+func (o NetworkSubnetSpecArrayOutput) Index(i pulumi.IntInput) NetworkSubnetSpecOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) NetworkSubnetSpec {
+		return vs[0].([]NetworkSubnetSpec)[vs[1].(int)]
+	}).(NetworkSubnetSpecOutput)
+}
+
+type ReverseProxyAppAuth struct {
+	// IDs of the groups whose users may sign in with the bearer preset. Empty allows every user.
+	Groups []string `pulumi:"groups"`
+	// Password required by the password preset.
+	Password *string `pulumi:"password"`
+	// PIN required by the pin preset.
+	Pin *string `pulumi:"pin"`
+	// Authentication method: bearer, pin, or password.
+	Preset ReverseProxyAppAuthPreset `pulumi:"preset"`
+}
+
+// ReverseProxyAppAuthInput is an input type that accepts ReverseProxyAppAuthArgs and ReverseProxyAppAuthOutput values.
+// You can construct a concrete instance of `ReverseProxyAppAuthInput` via:
+//
+//	ReverseProxyAppAuthArgs{...}
+type ReverseProxyAppAuthInput interface {
+	pulumi.Input
+
+	ToReverseProxyAppAuthOutput() ReverseProxyAppAuthOutput
+	ToReverseProxyAppAuthOutputWithContext(context.Context) ReverseProxyAppAuthOutput
+}
+
+type ReverseProxyAppAuthArgs struct {
+	// IDs of the groups whose users may sign in with the bearer preset. Empty allows every user.
+	Groups pulumi.StringArrayInput `pulumi:"groups"`
+	// Password required by the password preset.
+	Password pulumi.StringPtrInput `pulumi:"password"`
+	// PIN required by the pin preset.
+	Pin pulumi.StringPtrInput `pulumi:"pin"`
+	// Authentication method: bearer, pin, or password.
+	Preset ReverseProxyAppAuthPresetInput `pulumi:"preset"`
+}
+
+func (ReverseProxyAppAuthArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReverseProxyAppAuth)(nil)).Elem()
+}
+
+func (i ReverseProxyAppAuthArgs) ToReverseProxyAppAuthOutput() ReverseProxyAppAuthOutput {
+	return i.ToReverseProxyAppAuthOutputWithContext(context.Background())
+}
+
+func (i ReverseProxyAppAuthArgs) ToReverseProxyAppAuthOutputWithContext(ctx context.Context) ReverseProxyAppAuthOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReverseProxyAppAuthOutput)
+}
+
+func (i ReverseProxyAppAuthArgs) ToReverseProxyAppAuthPtrOutput() ReverseProxyAppAuthPtrOutput {
+	return i.ToReverseProxyAppAuthPtrOutputWithContext(context.Background())
+}
+
+func (i ReverseProxyAppAuthArgs) ToReverseProxyAppAuthPtrOutputWithContext(ctx context.Context) ReverseProxyAppAuthPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReverseProxyAppAuthOutput).ToReverseProxyAppAuthPtrOutputWithContext(ctx)
+}
+
+// ReverseProxyAppAuthPtrInput is an input type that accepts ReverseProxyAppAuthArgs, ReverseProxyAppAuthPtr and ReverseProxyAppAuthPtrOutput values.
+// You can construct a concrete instance of `ReverseProxyAppAuthPtrInput` via:
+//
+//	        ReverseProxyAppAuthArgs{...}
+//
+//	or:
+//
+//	        nil
+type ReverseProxyAppAuthPtrInput interface {
+	pulumi.Input
+
+	ToReverseProxyAppAuthPtrOutput() ReverseProxyAppAuthPtrOutput
+	ToReverseProxyAppAuthPtrOutputWithContext(context.Context) ReverseProxyAppAuthPtrOutput
+}
+
+type reverseProxyAppAuthPtrType ReverseProxyAppAuthArgs
+
+func ReverseProxyAppAuthPtr(v *ReverseProxyAppAuthArgs) ReverseProxyAppAuthPtrInput {
+	return (*reverseProxyAppAuthPtrType)(v)
+}
+
+func (*reverseProxyAppAuthPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ReverseProxyAppAuth)(nil)).Elem()
+}
+
+func (i *reverseProxyAppAuthPtrType) ToReverseProxyAppAuthPtrOutput() ReverseProxyAppAuthPtrOutput {
+	return i.ToReverseProxyAppAuthPtrOutputWithContext(context.Background())
+}
+
+func (i *reverseProxyAppAuthPtrType) ToReverseProxyAppAuthPtrOutputWithContext(ctx context.Context) ReverseProxyAppAuthPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReverseProxyAppAuthPtrOutput)
+}
+
+type ReverseProxyAppAuthOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyAppAuthOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReverseProxyAppAuth)(nil)).Elem()
+}
+
+func (o ReverseProxyAppAuthOutput) ToReverseProxyAppAuthOutput() ReverseProxyAppAuthOutput {
+	return o
+}
+
+func (o ReverseProxyAppAuthOutput) ToReverseProxyAppAuthOutputWithContext(ctx context.Context) ReverseProxyAppAuthOutput {
+	return o
+}
+
+func (o ReverseProxyAppAuthOutput) ToReverseProxyAppAuthPtrOutput() ReverseProxyAppAuthPtrOutput {
+	return o.ToReverseProxyAppAuthPtrOutputWithContext(context.Background())
+}
+
+func (o ReverseProxyAppAuthOutput) ToReverseProxyAppAuthPtrOutputWithContext(ctx context.Context) ReverseProxyAppAuthPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ReverseProxyAppAuth) *ReverseProxyAppAuth {
+		return &v
+	}).(ReverseProxyAppAuthPtrOutput)
+}
+
+// IDs of the groups whose users may sign in with the bearer preset. Empty allows every user.
+func (o ReverseProxyAppAuthOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ReverseProxyAppAuth) []string { return v.Groups }).(pulumi.StringArrayOutput)
+}
+
+// Password required by the password preset.
+func (o ReverseProxyAppAuthOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyAppAuth) *string { return v.Password }).(pulumi.StringPtrOutput)
+}
+
+// PIN required by the pin preset.
+func (o ReverseProxyAppAuthOutput) Pin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyAppAuth) *string { return v.Pin }).(pulumi.StringPtrOutput)
+}
+
+// Authentication method: bearer, pin, or password.
+func (o ReverseProxyAppAuthOutput) Preset() ReverseProxyAppAuthPresetOutput {
+	return o.ApplyT(func(v ReverseProxyAppAuth) ReverseProxyAppAuthPreset { return v.Preset }).(ReverseProxyAppAuthPresetOutput)
+}
+
+type ReverseProxyAppAuthPtrOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyAppAuthPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ReverseProxyAppAuth)(nil)).Elem()
+}
+
+func (o ReverseProxyAppAuthPtrOutput) ToReverseProxyAppAuthPtrOutput() ReverseProxyAppAuthPtrOutput {
+	return o
+}
+
+func (o ReverseProxyAppAuthPtrOutput) ToReverseProxyAppAuthPtrOutputWithContext(ctx context.Context) ReverseProxyAppAuthPtrOutput {
+	return o
+}
+
+func (o ReverseProxyAppAuthPtrOutput) Elem() ReverseProxyAppAuthOutput {
+	return o.ApplyT(func(v *ReverseProxyAppAuth) ReverseProxyAppAuth {
+		if v != nil {
+			return *v
+		}
+		var ret ReverseProxyAppAuth
+		return ret
+	}).(ReverseProxyAppAuthOutput)
+}
+
+// IDs of the groups whose users may sign in with the bearer preset. Empty allows every user.
+func (o ReverseProxyAppAuthPtrOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ReverseProxyAppAuth) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Groups
+	}).(pulumi.StringArrayOutput)
+}
+
+// Password required by the password preset.
+func (o ReverseProxyAppAuthPtrOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReverseProxyAppAuth) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Password
+	}).(pulumi.StringPtrOutput)
+}
+
+// PIN required by the pin preset.
+func (o ReverseProxyAppAuthPtrOutput) Pin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReverseProxyAppAuth) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Pin
+	}).(pulumi.StringPtrOutput)
+}
+
+// Authentication method: bearer, pin, or password.
+func (o ReverseProxyAppAuthPtrOutput) Preset() ReverseProxyAppAuthPresetPtrOutput {
+	return o.ApplyT(func(v *ReverseProxyAppAuth) *ReverseProxyAppAuthPreset {
+		if v == nil {
+			return nil
+		}
+		return &v.Preset
+	}).(ReverseProxyAppAuthPresetPtrOutput)
+}
+
+type ReverseProxyAppTarget struct {
+	// Backend IP or domain. Required for host and domain network resources.
+	Host *string `pulumi:"host"`
+	// ID of the network resource serving the app. Conflicts with peer.
+	NetworkResource *string `pulumi:"networkResource"`
+	// Optional URL path prefix routed to this backend.
+	Path *string `pulumi:"path"`
+	// ID of the peer serving the app. Conflicts with networkResource.
 	Peer *string `pulumi:"peer"`
-	// Peer groups to use as router peers.
-	PeerGroups []string `pulumi:"peerGroups"`
+	// Backend port.
+	Port int `pulumi:"port"`
+	// Protocol to reach the backend with: http or https. Defaults to http.
+	Protocol *resource.ReverseProxyTargetProtocol `pulumi:"protocol"`
+	// Type of the network resource: host, subnet, or domain. Defaults to host.
+	ResourceType *resource.ReverseProxyTargetType `pulumi:"resourceType"`
+}
+
+// ReverseProxyAppTargetInput is an input type that accepts ReverseProxyAppTargetArgs and ReverseProxyAppTargetOutput values.
+// You can construct a concrete instance of `ReverseProxyAppTargetInput` via:
+//
+//	ReverseProxyAppTargetArgs{...}
+type ReverseProxyAppTargetInput interface {
+	pulumi.Input
+
+	ToReverseProxyAppTargetOutput() ReverseProxyAppTargetOutput
+	ToReverseProxyAppTargetOutputWithContext(context.Context) ReverseProxyAppTargetOutput
+}
+
+type ReverseProxyAppTargetArgs struct {
+	// Backend IP or domain. Required for host and domain network resources.
+	Host pulumi.StringPtrInput `pulumi:"host"`
+	// ID of the network resource serving the app. Conflicts with peer.
+	NetworkResource pulumi.StringPtrInput `pulumi:"networkResource"`
+	// Optional URL path prefix routed to this backend.
+	Path pulumi.StringPtrInput `pulumi:"path"`
+	// ID of the peer serving the app. Conflicts with networkResource.
+	Peer pulumi.StringPtrInput `pulumi:"peer"`
+	// Backend port.
+	Port pulumi.IntInput `pulumi:"port"`
+	// Protocol to reach the backend with: http or https. Defaults to http.
+	Protocol resource.ReverseProxyTargetProtocolPtrInput `pulumi:"protocol"`
+	// Type of the network resource: host, subnet, or domain. Defaults to host.
+	ResourceType resource.ReverseProxyTargetTypePtrInput `pulumi:"resourceType"`
+}
+
+func (ReverseProxyAppTargetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReverseProxyAppTarget)(nil)).Elem()
+}
+
+func (i ReverseProxyAppTargetArgs) ToReverseProxyAppTargetOutput() ReverseProxyAppTargetOutput {
+	return i.ToReverseProxyAppTargetOutputWithContext(context.Background())
+}
+
+func (i ReverseProxyAppTargetArgs) ToReverseProxyAppTargetOutputWithContext(ctx context.Context) ReverseProxyAppTargetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReverseProxyAppTargetOutput)
+}
+
+// ReverseProxyAppTargetArrayInput is an input type that accepts ReverseProxyAppTargetArray and ReverseProxyAppTargetArrayOutput values.
+// You can construct a concrete instance of `ReverseProxyAppTargetArrayInput` via:
+//
+//	ReverseProxyAppTargetArray{ ReverseProxyAppTargetArgs{...} }
+type ReverseProxyAppTargetArrayInput interface {
+	pulumi.Input
+
+	ToReverseProxyAppTargetArrayOutput() ReverseProxyAppTargetArrayOutput
+	ToReverseProxyAppTargetArrayOutputWithContext(context.Context) ReverseProxyAppTargetArrayOutput
+}
+
+type ReverseProxyAppTargetArray []ReverseProxyAppTargetInput
+
+func (ReverseProxyAppTargetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReverseProxyAppTarget)(nil)).Elem()
+}
+
+func (i ReverseProxyAppTargetArray) ToReverseProxyAppTargetArrayOutput() ReverseProxyAppTargetArrayOutput {
+	return i.ToReverseProxyAppTargetArrayOutputWithContext(context.Background())
+}
+
+func (i ReverseProxyAppTargetArray) ToReverseProxyAppTargetArrayOutputWithContext(ctx context.Context) ReverseProxyAppTargetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ReverseProxyAppTargetArrayOutput)
+}
+
+type ReverseProxyAppTargetOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyAppTargetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReverseProxyAppTarget)(nil)).Elem()
+}
+
+func (o ReverseProxyAppTargetOutput) ToReverseProxyAppTargetOutput() ReverseProxyAppTargetOutput {
+	return o
+}
+
+func (o ReverseProxyAppTargetOutput) ToReverseProxyAppTargetOutputWithContext(ctx context.Context) ReverseProxyAppTargetOutput {
+	return o
+}
+
+// Backend IP or domain. Required for host and domain network resources.
+func (o ReverseProxyAppTargetOutput) Host() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyAppTarget) *string { return v.Host }).(pulumi.StringPtrOutput)
+}
+
+// ID of the network resource serving the app. Conflicts with peer.
+func (o ReverseProxyAppTargetOutput) NetworkResource() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyAppTarget) *string { return v.NetworkResource }).(pulumi.StringPtrOutput)
+}
+
+// Optional URL path prefix routed to this backend.
+func (o ReverseProxyAppTargetOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyAppTarget) *string { return v.Path }).(pulumi.StringPtrOutput)
+}
+
+// ID of the peer serving the app. Conflicts with networkResource.
+func (o ReverseProxyAppTargetOutput) Peer() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyAppTarget) *string { return v.Peer }).(pulumi.StringPtrOutput)
+}
+
+// Backend port.
+func (o ReverseProxyAppTargetOutput) Port() pulumi.IntOutput {
+	return o.ApplyT(func(v ReverseProxyAppTarget) int { return v.Port }).(pulumi.IntOutput)
+}
+
+// Protocol to reach the backend with: http or https. Defaults to http.
+func (o ReverseProxyAppTargetOutput) Protocol() resource.ReverseProxyTargetProtocolPtrOutput {
+	return o.ApplyT(func(v ReverseProxyAppTarget) *resource.ReverseProxyTargetProtocol { return v.Protocol }).(resource.ReverseProxyTargetProtocolPtrOutput)
+}
+
+// Type of the network resource: host, subnet, or domain. Defaults to host.
+func (o ReverseProxyAppTargetOutput) ResourceType() resource.ReverseProxyTargetTypePtrOutput {
+	return o.ApplyT(func(v ReverseProxyAppTarget) *resource.ReverseProxyTargetType { return v.ResourceType }).(resource.ReverseProxyTargetTypePtrOutput)
+}
+
+type ReverseProxyAppTargetArrayOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyAppTargetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReverseProxyAppTarget)(nil)).Elem()
+}
+
+func (o ReverseProxyAppTargetArrayOutput) ToReverseProxyAppTargetArrayOutput() ReverseProxyAppTargetArrayOutput {
+	return o
+}
+
+func (o ReverseProxyAppTargetArrayOutput) ToReverseProxyAppTargetArrayOutputWithContext(ctx context.Context) ReverseProxyAppTargetArrayOutput {
+	return o
+}
+
+func (o ReverseProxyAppTargetArrayOutput) Index(i pulumi.IntInput) ReverseProxyAppTargetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ReverseProxyAppTarget {
+		return vs[0].([]ReverseProxyAppTarget)[vs[1].(int)]
+	}).(ReverseProxyAppTargetOutput)
+}
+
+type ServiceAccountTokenSpec struct {
+	// Token lifetime in days. With rotationInterval it must be at least twice the interval, so a token outlives the period it overlaps with its successor.
+	ExpiresIn int `pulumi:"expiresIn"`
+	// Name of the token, unique within the service account. Rotated tokens get the start date of their rotation period appended.
+	Name string `pulumi:"name"`
+	// Optional rotation interval in days. When set, a new token is issued at the start of every interval and the previous one, if it exists, is kept for one more interval before it is deleted.
+	RotationInterval *int `pulumi:"rotationInterval"`
+}
+
+// ServiceAccountTokenSpecInput is an input type that accepts ServiceAccountTokenSpecArgs and ServiceAccountTokenSpecOutput values.
+// You can construct a concrete instance of `ServiceAccountTokenSpecInput` via:
+//
+//	ServiceAccountTokenSpecArgs{...}
+type ServiceAccountTokenSpecInput interface {
+	pulumi.Input
+
+	ToServiceAccountTokenSpecOutput() ServiceAccountTokenSpecOutput
+	ToServiceAccountTokenSpecOutputWithContext(context.Context) ServiceAccountTokenSpecOutput
+}
+
+type ServiceAccountTokenSpecArgs struct {
+	// Token lifetime in days. With rotationInterval it must be at least twice the interval, so a token outlives the period it overlaps with its successor.
+	ExpiresIn pulumi.IntInput `pulumi:"expiresIn"`
+	// Name of the token, unique within the service account. Rotated tokens get the start date of their rotation period appended.
+	Name pulumi.StringInput `pulumi:"name"`
+	// Optional rotation interval in days. When set, a new token is issued at the start of every interval and the previous one, if it exists, is kept for one more interval before it is deleted.
+	RotationInterval pulumi.IntPtrInput `pulumi:"rotationInterval"`
+}
+
+func (ServiceAccountTokenSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServiceAccountTokenSpec)(nil)).Elem()
+}
+
+func (i ServiceAccountTokenSpecArgs) ToServiceAccountTokenSpecOutput() ServiceAccountTokenSpecOutput {
+	return i.ToServiceAccountTokenSpecOutputWithContext(context.Background())
+}
+
+func (i ServiceAccountTokenSpecArgs) ToServiceAccountTokenSpecOutputWithContext(ctx context.Context) ServiceAccountTokenSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ServiceAccountTokenSpecOutput)
+}
+
+// ServiceAccountTokenSpecArrayInput is an input type that accepts ServiceAccountTokenSpecArray and ServiceAccountTokenSpecArrayOutput values.
+// You can construct a concrete instance of `ServiceAccountTokenSpecArrayInput` via:
+//
+//	ServiceAccountTokenSpecArray{ ServiceAccountTokenSpecArgs{...} }
+type ServiceAccountTokenSpecArrayInput interface {
+	pulumi.Input
+
+	ToServiceAccountTokenSpecArrayOutput() ServiceAccountTokenSpecArrayOutput
+	ToServiceAccountTokenSpecArrayOutputWithContext(context.Context) ServiceAccountTokenSpecArrayOutput
+}
+
+type ServiceAccountTokenSpecArray []ServiceAccountTokenSpecInput
+
+func (ServiceAccountTokenSpecArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ServiceAccountTokenSpec)(nil)).Elem()
+}
+
+func (i ServiceAccountTokenSpecArray) ToServiceAccountTokenSpecArrayOutput() ServiceAccountTokenSpecArrayOutput {
+	return i.ToServiceAccountTokenSpecArrayOutputWithContext(context.Background())
+}
+
+func (i ServiceAccountTokenSpecArray) ToServiceAccountTokenSpecArrayOutputWithContext(ctx context.Context) ServiceAccountTokenSpecArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ServiceAccountTokenSpecArrayOutput)
+}
+
+type ServiceAccountTokenSpecOutput struct{ *pulumi.OutputState }
+
+func (ServiceAccountTokenSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServiceAccountTokenSpec)(nil)).Elem()
+}
+
+func (o ServiceAccountTokenSpecOutput) ToServiceAccountTokenSpecOutput() ServiceAccountTokenSpecOutput {
+	return o
+}
+
+func (o ServiceAccountTokenSpecOutput) ToServiceAccountTokenSpecOutputWithContext(ctx context.Context) ServiceAccountTokenSpecOutput {
+	return o
+}
+
+// Token lifetime in days. With rotationInterval it must be at least twice the interval, so a token outlives the period it overlaps with its successor.
+func (o ServiceAccountTokenSpecOutput) ExpiresIn() pulumi.IntOutput {
+	return o.ApplyT(func(v ServiceAccountTokenSpec) int { return v.ExpiresIn }).(pulumi.IntOutput)
+}
+
+// Name of the token, unique within the service account. Rotated tokens get the start date of their rotation period appended.
+func (o ServiceAccountTokenSpecOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ServiceAccountTokenSpec) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Optional rotation interval in days. When set, a new token is issued at the start of every interval and the previous one, if it exists, is kept for one more interval before it is deleted.
+func (o ServiceAccountTokenSpecOutput) RotationInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ServiceAccountTokenSpec) *int { return v.RotationInterval }).(pulumi.IntPtrOutput)
+}
+
+type ServiceAccountTokenSpecArrayOutput struct{ *pulumi.OutputState }
+
+func (ServiceAccountTokenSpecArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ServiceAccountTokenSpec)(nil)).Elem()
+}
+
+func (o ServiceAccountTokenSpecArrayOutput) ToServiceAccountTokenSpecArrayOutput() ServiceAccountTokenSpecArrayOutput {
+	return o
+}
+
+func (o ServiceAccountTokenSpecArrayOutput) ToServiceAccountTokenSpecArrayOutputWithContext(ctx context.Context) ServiceAccountTokenSpecArrayOutput {
+	return o
+}
+
+func (o ServiceAccountTokenSpecArrayOutput) Index(i pulumi.IntInput) ServiceAccountTokenSpecOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ServiceAccountTokenSpec {
+		return vs[0].([]ServiceAccountTokenSpec)[vs[1].(int)]
+	}).(ServiceAccountTokenSpecOutput)
+}
+
+type SiteSpec struct {
+	// LAN subnets of the site. They must not overlap with the other site's.
+	Cidrs []string `pulumi:"cidrs"`
+	// Whether the router masquerades traffic into the site's LAN. Defaults to true. Without masquerading, the LAN needs a route back to the other site through the routing peer.
+	Masquerade *bool `pulumi:"masquerade"`
+	// Route metric of the router; lower values have higher priority. Defaults to 9999.
+	Metric *int `pulumi:"metric"`
+	// Name of the site, used in the names of its network, groups and policy.
+	Name string `pulumi:"name"`
+	// ID of the routing peer of the site. Conflicts with peerGroup.
+	Peer *string `pulumi:"peer"`
+	// ID of the group of routing peers of the site. Conflicts with peer.
+	PeerGroup *string `pulumi:"peerGroup"`
+}
+
+// SiteSpecInput is an input type that accepts SiteSpecArgs and SiteSpecOutput values.
+// You can construct a concrete instance of `SiteSpecInput` via:
+//
+//	SiteSpecArgs{...}
+type SiteSpecInput interface {
+	pulumi.Input
+
+	ToSiteSpecOutput() SiteSpecOutput
+	ToSiteSpecOutputWithContext(context.Context) SiteSpecOutput
+}
+
+type SiteSpecArgs struct {
+	// LAN subnets of the site. They must not overlap with the other site's.
+	Cidrs pulumi.StringArrayInput `pulumi:"cidrs"`
+	// Whether the router masquerades traffic into the site's LAN. Defaults to true. Without masquerading, the LAN needs a route back to the other site through the routing peer.
+	Masquerade pulumi.BoolPtrInput `pulumi:"masquerade"`
+	// Route metric of the router; lower values have higher priority. Defaults to 9999.
+	Metric pulumi.IntPtrInput `pulumi:"metric"`
+	// Name of the site, used in the names of its network, groups and policy.
+	Name pulumi.StringInput `pulumi:"name"`
+	// ID of the routing peer of the site. Conflicts with peerGroup.
+	Peer pulumi.StringPtrInput `pulumi:"peer"`
+	// ID of the group of routing peers of the site. Conflicts with peer.
+	PeerGroup pulumi.StringPtrInput `pulumi:"peerGroup"`
+}
+
+func (SiteSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SiteSpec)(nil)).Elem()
+}
+
+func (i SiteSpecArgs) ToSiteSpecOutput() SiteSpecOutput {
+	return i.ToSiteSpecOutputWithContext(context.Background())
+}
+
+func (i SiteSpecArgs) ToSiteSpecOutputWithContext(ctx context.Context) SiteSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SiteSpecOutput)
+}
+
+type SiteSpecOutput struct{ *pulumi.OutputState }
+
+func (SiteSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SiteSpec)(nil)).Elem()
+}
+
+func (o SiteSpecOutput) ToSiteSpecOutput() SiteSpecOutput {
+	return o
+}
+
+func (o SiteSpecOutput) ToSiteSpecOutputWithContext(ctx context.Context) SiteSpecOutput {
+	return o
+}
+
+// LAN subnets of the site. They must not overlap with the other site's.
+func (o SiteSpecOutput) Cidrs() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v SiteSpec) []string { return v.Cidrs }).(pulumi.StringArrayOutput)
+}
+
+// Whether the router masquerades traffic into the site's LAN. Defaults to true. Without masquerading, the LAN needs a route back to the other site through the routing peer.
+func (o SiteSpecOutput) Masquerade() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v SiteSpec) *bool { return v.Masquerade }).(pulumi.BoolPtrOutput)
+}
+
+// Route metric of the router; lower values have higher priority. Defaults to 9999.
+func (o SiteSpecOutput) Metric() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SiteSpec) *int { return v.Metric }).(pulumi.IntPtrOutput)
+}
+
+// Name of the site, used in the names of its network, groups and policy.
+func (o SiteSpecOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v SiteSpec) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// ID of the routing peer of the site. Conflicts with peerGroup.
+func (o SiteSpecOutput) Peer() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SiteSpec) *string { return v.Peer }).(pulumi.StringPtrOutput)
+}
+
+// ID of the group of routing peers of the site. Conflicts with peer.
+func (o SiteSpecOutput) PeerGroup() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SiteSpec) *string { return v.PeerGroup }).(pulumi.StringPtrOutput)
 }
 
-// NetworkRouterSpecInput is an input type that accepts NetworkRouterSpecArgs and NetworkRouterSpecOutput values.
-// You can construct a concrete instance of `NetworkRouterSpecInput` via:
+type TeamDNSSpec struct {
+	// Domains resolved with the nameservers. Empty makes them the primary nameservers of the team.
+	Domains []string `pulumi:"domains"`
+	// IP addresses of the nameservers.
+	Nameservers []string `pulumi:"nameservers"`
+	// UDP port of the nameservers. Defaults to 53.
+	Port *int `pulumi:"port"`
+}
+
+// TeamDNSSpecInput is an input type that accepts TeamDNSSpecArgs and TeamDNSSpecOutput values.
+// You can construct a concrete instance of `TeamDNSSpecInput` via:
 //
-//	NetworkRouterSpecArgs{...}
-type NetworkRouterSpecInput interface {
+//	TeamDNSSpecArgs{...}
+type TeamDNSSpecInput interface {
 	pulumi.Input
 
-	ToNetworkRouterSpecOutput() NetworkRouterSpecOutput
-	ToNetworkRouterSpecOutputWithContext(context.Context) NetworkRouterSpecOutput
+	ToTeamDNSSpecOutput() TeamDNSSpecOutput
+	ToTeamDNSSpecOutputWithContext(context.Context) TeamDNSSpecOutput
 }
 
-type NetworkRouterSpecArgs struct {
-	// Whether the router is enabled.
-	Enabled pulumi.BoolInput `pulumi:"enabled"`
-	// Whether to masquerade traffic through the router.
-	Masquerade pulumi.BoolInput `pulumi:"masquerade"`
-	// Route metric; lower values have higher priority.
-	Metric pulumi.IntInput `pulumi:"metric"`
-	// Specific peer to use as router.
-	Peer pulumi.StringPtrInput `pulumi:"peer"`
-	// Peer groups to use as router peers.
-	PeerGroups pulumi.StringArrayInput `pulumi:"peerGroups"`
+type TeamDNSSpecArgs struct {
+	// Domains resolved with the nameservers. Empty makes them the primary nameservers of the team.
+	Domains pulumi.StringArrayInput `pulumi:"domains"`
+	// IP addresses of the nameservers.
+	Nameservers pulumi.StringArrayInput `pulumi:"nameservers"`
+	// UDP port of the nameservers. Defaults to 53.
+	Port pulumi.IntPtrInput `pulumi:"port"`
 }
 
-func (NetworkRouterSpecArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*NetworkRouterSpec)(nil)).Elem()
+func (TeamDNSSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*TeamDNSSpec)(nil)).Elem()
 }
 
-func (i NetworkRouterSpecArgs) ToNetworkRouterSpecOutput() NetworkRouterSpecOutput {
-	return i.ToNetworkRouterSpecOutputWithContext(context.Background())
+func (i TeamDNSSpecArgs) ToTeamDNSSpecOutput() TeamDNSSpecOutput {
+	return i.ToTeamDNSSpecOutputWithContext(context.Background())
 }
 
-func (i NetworkRouterSpecArgs) ToNetworkRouterSpecOutputWithContext(ctx context.Context) NetworkRouterSpecOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(NetworkRouterSpecOutput)
+func (i TeamDNSSpecArgs) ToTeamDNSSpecOutputWithContext(ctx context.Context) TeamDNSSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamDNSSpecOutput)
 }
 
-type NetworkRouterSpecOutput struct{ *pulumi.OutputState }
+func (i TeamDNSSpecArgs) ToTeamDNSSpecPtrOutput() TeamDNSSpecPtrOutput {
+	return i.ToTeamDNSSpecPtrOutputWithContext(context.Background())
+}
 
-func (NetworkRouterSpecOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*NetworkRouterSpec)(nil)).Elem()
+func (i TeamDNSSpecArgs) ToTeamDNSSpecPtrOutputWithContext(ctx context.Context) TeamDNSSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamDNSSpecOutput).ToTeamDNSSpecPtrOutputWithContext(ctx)
 }
 
-func (o NetworkRouterSpecOutput) ToNetworkRouterSpecOutput() NetworkRouterSpecOutput {
+// TeamDNSSpecPtrInput is an input type that accepts TeamDNSSpecArgs, TeamDNSSpecPtr and TeamDNSSpecPtrOutput values.
+// You can construct a concrete instance of `TeamDNSSpecPtrInput` via:
+//
+//	        TeamDNSSpecArgs{...}
+//
+//	or:
+//
+//	        nil
+type TeamDNSSpecPtrInput interface {
+	pulumi.Input
+
+	ToTeamDNSSpecPtrOutput() TeamDNSSpecPtrOutput
+	ToTeamDNSSpecPtrOutputWithContext(context.Context) TeamDNSSpecPtrOutput
+}
+
+type teamDNSSpecPtrType TeamDNSSpecArgs
+
+func TeamDNSSpecPtr(v *TeamDNSSpecArgs) TeamDNSSpecPtrInput {
+	return (*teamDNSSpecPtrType)(v)
+}
+
+func (*teamDNSSpecPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**TeamDNSSpec)(nil)).Elem()
+}
+
+func (i *teamDNSSpecPtrType) ToTeamDNSSpecPtrOutput() TeamDNSSpecPtrOutput {
+	return i.ToTeamDNSSpecPtrOutputWithContext(context.Background())
+}
+
+func (i *teamDNSSpecPtrType) ToTeamDNSSpecPtrOutputWithContext(ctx context.Context) TeamDNSSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamDNSSpecPtrOutput)
+}
+
+type TeamDNSSpecOutput struct{ *pulumi.OutputState }
+
+func (TeamDNSSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TeamDNSSpec)(nil)).Elem()
+}
+
+func (o TeamDNSSpecOutput) ToTeamDNSSpecOutput() TeamDNSSpecOutput {
 	return o
 }
 
-func (o NetworkRouterSpecOutput) ToNetworkRouterSpecOutputWithContext(ctx context.Context) NetworkRouterSpecOutput {
+func (o TeamDNSSpecOutput) ToTeamDNSSpecOutputWithContext(ctx context.Context) TeamDNSSpecOutput {
 	return o
 }
 
-// Whether the router is enabled.
-func (o NetworkRouterSpecOutput) Enabled() pulumi.BoolOutput {
-	return o.ApplyT(func(v NetworkRouterSpec) bool { return v.Enabled }).(pulumi.BoolOutput)
+func (o TeamDNSSpecOutput) ToTeamDNSSpecPtrOutput() TeamDNSSpecPtrOutput {
+	return o.ToTeamDNSSpecPtrOutputWithContext(context.Background())
 }
 
-// Whether to masquerade traffic through the router.
-func (o NetworkRouterSpecOutput) Masquerade() pulumi.BoolOutput {
-	return o.ApplyT(func(v NetworkRouterSpec) bool { return v.Masquerade }).(pulumi.BoolOutput)
+func (o TeamDNSSpecOutput) ToTeamDNSSpecPtrOutputWithContext(ctx context.Context) TeamDNSSpecPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v TeamDNSSpec) *TeamDNSSpec {
+		return &v
+	}).(TeamDNSSpecPtrOutput)
 }
 
-// Route metric; lower values have higher priority.
-func (o NetworkRouterSpecOutput) Metric() pulumi.IntOutput {
-	return o.ApplyT(func(v NetworkRouterSpec) int { return v.Metric }).(pulumi.IntOutput)
+// Domains resolved with the nameservers. Empty makes them the primary nameservers of the team.
+func (o TeamDNSSpecOutput) Domains() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v TeamDNSSpec) []string { return v.Domains }).(pulumi.StringArrayOutput)
 }
 
-// Specific peer to use as router.
-func (o NetworkRouterSpecOutput) Peer() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v NetworkRouterSpec) *string { return v.Peer }).(pulumi.StringPtrOutput)
+// IP addresses of the nameservers.
+func (o TeamDNSSpecOutput) Nameservers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v TeamDNSSpec) []string { return v.Nameservers }).(pulumi.StringArrayOutput)
 }
 
-// Peer groups to use as router peers.
-func (o NetworkRouterSpecOutput) PeerGroups() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v NetworkRouterSpec) []string { return v.PeerGroups }).(pulumi.StringArrayOutput)
+// UDP port of the nameservers. Defaults to 53.
+func (o TeamDNSSpecOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v TeamDNSSpec) *int { return v.Port }).(pulumi.IntPtrOutput)
 }
 
-type NetworkSubnetSpec struct {
-	// CIDR block for the subnet (e.g. 10.10.1.0/24).
-	Address string `pulumi:"address"`
-	// Optional description for the subnet resource.
-	Description *string `pulumi:"description"`
-	// Whether the subnet resource is enabled.
-	Enabled bool `pulumi:"enabled"`
-	// Group IDs that have access to this subnet.
-	GroupIDs []string `pulumi:"groupIDs"`
-	// Display name for the subnet resource.
+type TeamDNSSpecPtrOutput struct{ *pulumi.OutputState }
+
+func (TeamDNSSpecPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**TeamDNSSpec)(nil)).Elem()
+}
+
+func (o TeamDNSSpecPtrOutput) ToTeamDNSSpecPtrOutput() TeamDNSSpecPtrOutput {
+	return o
+}
+
+func (o TeamDNSSpecPtrOutput) ToTeamDNSSpecPtrOutputWithContext(ctx context.Context) TeamDNSSpecPtrOutput {
+	return o
+}
+
+func (o TeamDNSSpecPtrOutput) Elem() TeamDNSSpecOutput {
+	return o.ApplyT(func(v *TeamDNSSpec) TeamDNSSpec {
+		if v != nil {
+			return *v
+		}
+		var ret TeamDNSSpec
+		return ret
+	}).(TeamDNSSpecOutput)
+}
+
+// Domains resolved with the nameservers. Empty makes them the primary nameservers of the team.
+func (o TeamDNSSpecPtrOutput) Domains() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *TeamDNSSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Domains
+	}).(pulumi.StringArrayOutput)
+}
+
+// IP addresses of the nameservers.
+func (o TeamDNSSpecPtrOutput) Nameservers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *TeamDNSSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Nameservers
+	}).(pulumi.StringArrayOutput)
+}
+
+// UDP port of the nameservers. Defaults to 53.
+func (o TeamDNSSpecPtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *TeamDNSSpec) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+type TeamMemberSpec struct {
+	// Email address of the user to invite.
+	Email string `pulumi:"email"`
+	// Optional display name of the user.
+	Name *string `pulumi:"name"`
+	// NetBird account role of the user. Defaults to user.
+	Role *string `pulumi:"role"`
+}
+
+// TeamMemberSpecInput is an input type that accepts TeamMemberSpecArgs and TeamMemberSpecOutput values.
+// You can construct a concrete instance of `TeamMemberSpecInput` via:
+//
+//	TeamMemberSpecArgs{...}
+type TeamMemberSpecInput interface {
+	pulumi.Input
+
+	ToTeamMemberSpecOutput() TeamMemberSpecOutput
+	ToTeamMemberSpecOutputWithContext(context.Context) TeamMemberSpecOutput
+}
+
+type TeamMemberSpecArgs struct {
+	// Email address of the user to invite.
+	Email pulumi.StringInput `pulumi:"email"`
+	// Optional display name of the user.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// NetBird account role of the user. Defaults to user.
+	Role pulumi.StringPtrInput `pulumi:"role"`
+}
+
+func (TeamMemberSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*TeamMemberSpec)(nil)).Elem()
+}
+
+func (i TeamMemberSpecArgs) ToTeamMemberSpecOutput() TeamMemberSpecOutput {
+	return i.ToTeamMemberSpecOutputWithContext(context.Background())
+}
+
+func (i TeamMemberSpecArgs) ToTeamMemberSpecOutputWithContext(ctx context.Context) TeamMemberSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamMemberSpecOutput)
+}
+
+// TeamMemberSpecArrayInput is an input type that accepts TeamMemberSpecArray and TeamMemberSpecArrayOutput values.
+// You can construct a concrete instance of `TeamMemberSpecArrayInput` via:
+//
+//	TeamMemberSpecArray{ TeamMemberSpecArgs{...} }
+type TeamMemberSpecArrayInput interface {
+	pulumi.Input
+
+	ToTeamMemberSpecArrayOutput() TeamMemberSpecArrayOutput
+	ToTeamMemberSpecArrayOutputWithContext(context.Context) TeamMemberSpecArrayOutput
+}
+
+type TeamMemberSpecArray []TeamMemberSpecInput
+
+func (TeamMemberSpecArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]TeamMemberSpec)(nil)).Elem()
+}
+
+func (i TeamMemberSpecArray) ToTeamMemberSpecArrayOutput() TeamMemberSpecArrayOutput {
+	return i.ToTeamMemberSpecArrayOutputWithContext(context.Background())
+}
+
+func (i TeamMemberSpecArray) ToTeamMemberSpecArrayOutputWithContext(ctx context.Context) TeamMemberSpecArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamMemberSpecArrayOutput)
+}
+
+type TeamMemberSpecOutput struct{ *pulumi.OutputState }
+
+func (TeamMemberSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TeamMemberSpec)(nil)).Elem()
+}
+
+func (o TeamMemberSpecOutput) ToTeamMemberSpecOutput() TeamMemberSpecOutput {
+	return o
+}
+
+func (o TeamMemberSpecOutput) ToTeamMemberSpecOutputWithContext(ctx context.Context) TeamMemberSpecOutput {
+	return o
+}
+
+// Email address of the user to invite.
+func (o TeamMemberSpecOutput) Email() pulumi.StringOutput {
+	return o.ApplyT(func(v TeamMemberSpec) string { return v.Email }).(pulumi.StringOutput)
+}
+
+// Optional display name of the user.
+func (o TeamMemberSpecOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TeamMemberSpec) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// NetBird account role of the user. Defaults to user.
+func (o TeamMemberSpecOutput) Role() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TeamMemberSpec) *string { return v.Role }).(pulumi.StringPtrOutput)
+}
+
+type TeamMemberSpecArrayOutput struct{ *pulumi.OutputState }
+
+func (TeamMemberSpecArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]TeamMemberSpec)(nil)).Elem()
+}
+
+func (o TeamMemberSpecArrayOutput) ToTeamMemberSpecArrayOutput() TeamMemberSpecArrayOutput {
+	return o
+}
+
+func (o TeamMemberSpecArrayOutput) ToTeamMemberSpecArrayOutputWithContext(ctx context.Context) TeamMemberSpecArrayOutput {
+	return o
+}
+
+func (o TeamMemberSpecArrayOutput) Index(i pulumi.IntInput) TeamMemberSpecOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) TeamMemberSpec {
+		return vs[0].([]TeamMemberSpec)[vs[1].(int)]
+	}).(TeamMemberSpecOutput)
+}
+
+type ZeroTrustGroupSpec struct {
+	// Name of the group.
 	Name string `pulumi:"name"`
+	// IDs of the peers to add to the group.
+	Peers []string `pulumi:"peers"`
 }
 
-// NetworkSubnetSpecInput is an input type that accepts NetworkSubnetSpecArgs and NetworkSubnetSpecOutput values.
-// You can construct a concrete instance of `NetworkSubnetSpecInput` via:
+// ZeroTrustGroupSpecInput is an input type that accepts ZeroTrustGroupSpecArgs and ZeroTrustGroupSpecOutput values.
+// You can construct a concrete instance of `ZeroTrustGroupSpecInput` via:
 //
-//	NetworkSubnetSpecArgs{...}
-type NetworkSubnetSpecInput interface {
+//	ZeroTrustGroupSpecArgs{...}
+type ZeroTrustGroupSpecInput interface {
 	pulumi.Input
 
-	ToNetworkSubnetSpecOutput() NetworkSubnetSpecOutput
-	ToNetworkSubnetSpecOutputWithContext(context.Context) NetworkSubnetSpecOutput
+	ToZeroTrustGroupSpecOutput() ZeroTrustGroupSpecOutput
+	ToZeroTrustGroupSpecOutputWithContext(context.Context) ZeroTrustGroupSpecOutput
 }
 
-type NetworkSubnetSpecArgs struct {
-	// CIDR block for the subnet (e.g. 10.10.1.0/24).
-	Address pulumi.StringInput `pulumi:"address"`
-	// Optional description for the subnet resource.
-	Description pulumi.StringPtrInput `pulumi:"description"`
-	// Whether the subnet resource is enabled.
-	Enabled pulumi.BoolInput `pulumi:"enabled"`
-	// Group IDs that have access to this subnet.
-	GroupIDs pulumi.StringArrayInput `pulumi:"groupIDs"`
-	// Display name for the subnet resource.
+type ZeroTrustGroupSpecArgs struct {
+	// Name of the group.
 	Name pulumi.StringInput `pulumi:"name"`
+	// IDs of the peers to add to the group.
+	Peers pulumi.StringArrayInput `pulumi:"peers"`
 }
 
-func (NetworkSubnetSpecArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*NetworkSubnetSpec)(nil)).Elem()
+func (ZeroTrustGroupSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ZeroTrustGroupSpec)(nil)).Elem()
 }
 
-func (i NetworkSubnetSpecArgs) ToNetworkSubnetSpecOutput() NetworkSubnetSpecOutput {
-	return i.ToNetworkSubnetSpecOutputWithContext(context.Background())
+func (i ZeroTrustGroupSpecArgs) ToZeroTrustGroupSpecOutput() ZeroTrustGroupSpecOutput {
+	return i.ToZeroTrustGroupSpecOutputWithContext(context.Background())
 }
 
-func (i NetworkSubnetSpecArgs) ToNetworkSubnetSpecOutputWithContext(ctx context.Context) NetworkSubnetSpecOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(NetworkSubnetSpecOutput)
+func (i ZeroTrustGroupSpecArgs) ToZeroTrustGroupSpecOutputWithContext(ctx context.Context) ZeroTrustGroupSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ZeroTrustGroupSpecOutput)
 }
 
-// NetworkSubnetSpecArrayInput is an input type that accepts NetworkSubnetSpecArray and NetworkSubnetSpecArrayOutput values.
-// You can construct a concrete instance of `NetworkSubnetSpecArrayInput` via:
+type ZeroTrustGroupSpecOutput struct{ *pulumi.OutputState }
+
+func (ZeroTrustGroupSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ZeroTrustGroupSpec)(nil)).Elem()
+}
+
+func (o ZeroTrustGroupSpecOutput) ToZeroTrustGroupSpecOutput() ZeroTrustGroupSpecOutput {
+	return o
+}
+
+func (o ZeroTrustGroupSpecOutput) ToZeroTrustGroupSpecOutputWithContext(ctx context.Context) ZeroTrustGroupSpecOutput {
+	return o
+}
+
+// Name of the group.
+func (o ZeroTrustGroupSpecOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ZeroTrustGroupSpec) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// IDs of the peers to add to the group.
+func (o ZeroTrustGroupSpecOutput) Peers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ZeroTrustGroupSpec) []string { return v.Peers }).(pulumi.StringArrayOutput)
+}
+
+type ZeroTrustPostureSpec struct {
+	// ISO 3166-1 alpha-2 codes of the countries source peers may connect from. Conflicts with blockedCountries.
+	AllowedCountries []string `pulumi:"allowedCountries"`
+	// CIDR ranges a source peer's local network must be in. Conflicts with blockedNetworkRanges.
+	AllowedNetworkRanges []string `pulumi:"allowedNetworkRanges"`
+	// ISO 3166-1 alpha-2 codes of the countries source peers may not connect from. Conflicts with allowedCountries.
+	BlockedCountries []string `pulumi:"blockedCountries"`
+	// CIDR ranges a source peer's local network must not be in. Conflicts with allowedNetworkRanges.
+	BlockedNetworkRanges []string `pulumi:"blockedNetworkRanges"`
+	// Minimum NetBird client version of source peers.
+	MinClientVersion *string `pulumi:"minClientVersion"`
+}
+
+// ZeroTrustPostureSpecInput is an input type that accepts ZeroTrustPostureSpecArgs and ZeroTrustPostureSpecOutput values.
+// You can construct a concrete instance of `ZeroTrustPostureSpecInput` via:
 //
-//	NetworkSubnetSpecArray{ NetworkSubnetSpecArgs{...} }
-type NetworkSubnetSpecArrayInput interface {
+//	ZeroTrustPostureSpecArgs{...}
+type ZeroTrustPostureSpecInput interface {
 	pulumi.Input
 
-	ToNetworkSubnetSpecArrayOutput() NetworkSubnetSpecArrayOutput
-	ToNetworkSubnetSpecArrayOutputWithContext(context.Context) NetworkSubnetSpecArrayOutput
+	ToZeroTrustPostureSpecOutput() ZeroTrustPostureSpecOutput
+	ToZeroTrustPostureSpecOutputWithContext(context.Context) ZeroTrustPostureSpecOutput
 }
 
-type NetworkSubnetSpecArray []NetworkSubnetSpecInput
+type ZeroTrustPostureSpecArgs struct {
+	// ISO 3166-1 alpha-2 codes of the countries source peers may connect from. Conflicts with blockedCountries.
+	AllowedCountries pulumi.StringArrayInput `pulumi:"allowedCountries"`
+	// CIDR ranges a source peer's local network must be in. Conflicts with blockedNetworkRanges.
+	AllowedNetworkRanges pulumi.StringArrayInput `pulumi:"allowedNetworkRanges"`
+	// ISO 3166-1 alpha-2 codes of the countries source peers may not connect from. Conflicts with allowedCountries.
+	BlockedCountries pulumi.StringArrayInput `pulumi:"blockedCountries"`
+	// CIDR ranges a source peer's local network must not be in. Conflicts with allowedNetworkRanges.
+	BlockedNetworkRanges pulumi.StringArrayInput `pulumi:"blockedNetworkRanges"`
+	// Minimum NetBird client version of source peers.
+	MinClientVersion pulumi.StringPtrInput `pulumi:"minClientVersion"`
+}
 
-func (NetworkSubnetSpecArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]NetworkSubnetSpec)(nil)).Elem()
+func (ZeroTrustPostureSpecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ZeroTrustPostureSpec)(nil)).Elem()
 }
 
-func (i NetworkSubnetSpecArray) ToNetworkSubnetSpecArrayOutput() NetworkSubnetSpecArrayOutput {
-	return i.ToNetworkSubnetSpecArrayOutputWithContext(context.Background())
+func (i ZeroTrustPostureSpecArgs) ToZeroTrustPostureSpecOutput() ZeroTrustPostureSpecOutput {
+	return i.ToZeroTrustPostureSpecOutputWithContext(context.Background())
 }
 
-func (i NetworkSubnetSpecArray) ToNetworkSubnetSpecArrayOutputWithContext(ctx context.Context) NetworkSubnetSpecArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(NetworkSubnetSpecArrayOutput)
+func (i ZeroTrustPostureSpecArgs) ToZeroTrustPostureSpecOutputWithContext(ctx context.Context) ZeroTrustPostureSpecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ZeroTrustPostureSpecOutput)
 }
 
-type NetworkSubnetSpecOutput struct{ *pulumi.OutputState }
+func (i ZeroTrustPostureSpecArgs) ToZeroTrustPostureSpecPtrOutput() ZeroTrustPostureSpecPtrOutput {
+	return i.ToZeroTrustPostureSpecPtrOutputWithContext(context.Background())
+}
 
-func (NetworkSubnetSpecOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*NetworkSubnetSpec)(nil)).Elem()
+func (i ZeroTrustPostureSpecArgs) ToZeroTrustPostureSpecPtrOutputWithContext(ctx context.Context) ZeroTrustPostureSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ZeroTrustPostureSpecOutput).ToZeroTrustPostureSpecPtrOutputWithContext(ctx)
 }
 
-func (o NetworkSubnetSpecOutput) ToNetworkSubnetSpecOutput() NetworkSubnetSpecOutput {
+// ZeroTrustPostureSpecPtrInput is an input type that accepts ZeroTrustPostureSpecArgs, ZeroTrustPostureSpecPtr and ZeroTrustPostureSpecPtrOutput values.
+// You can construct a concrete instance of `ZeroTrustPostureSpecPtrInput` via:
+//
+//	        ZeroTrustPostureSpecArgs{...}
+//
+//	or:
+//
+//	        nil
+type ZeroTrustPostureSpecPtrInput interface {
+	pulumi.Input
+
+	ToZeroTrustPostureSpecPtrOutput() ZeroTrustPostureSpecPtrOutput
+	ToZeroTrustPostureSpecPtrOutputWithContext(context.Context) ZeroTrustPostureSpecPtrOutput
+}
+
+type zeroTrustPostureSpecPtrType ZeroTrustPostureSpecArgs
+
+func ZeroTrustPostureSpecPtr(v *ZeroTrustPostureSpecArgs) ZeroTrustPostureSpecPtrInput {
+	return (*zeroTrustPostureSpecPtrType)(v)
+}
+
+func (*zeroTrustPostureSpecPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ZeroTrustPostureSpec)(nil)).Elem()
+}
+
+func (i *zeroTrustPostureSpecPtrType) ToZeroTrustPostureSpecPtrOutput() ZeroTrustPostureSpecPtrOutput {
+	return i.ToZeroTrustPostureSpecPtrOutputWithContext(context.Background())
+}
+
+func (i *zeroTrustPostureSpecPtrType) ToZeroTrustPostureSpecPtrOutputWithContext(ctx context.Context) ZeroTrustPostureSpecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ZeroTrustPostureSpecPtrOutput)
+}
+
+type ZeroTrustPostureSpecOutput struct{ *pulumi.OutputState }
+
+func (ZeroTrustPostureSpecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ZeroTrustPostureSpec)(nil)).Elem()
+}
+
+func (o ZeroTrustPostureSpecOutput) ToZeroTrustPostureSpecOutput() ZeroTrustPostureSpecOutput {
 	return o
 }
 
-func (o NetworkSubnetSpecOutput) ToNetworkSubnetSpecOutputWithContext(ctx context.Context) NetworkSubnetSpecOutput {
+func (o ZeroTrustPostureSpecOutput) ToZeroTrustPostureSpecOutputWithContext(ctx context.Context) ZeroTrustPostureSpecOutput {
 	return o
 }
 
-// CIDR block for the subnet (e.g. 10.10.1.0/24).
-func (o NetworkSubnetSpecOutput) Address() pulumi.StringOutput {
-	return o.ApplyT(func(v NetworkSubnetSpec) string { return v.Address }).(pulumi.StringOutput)
+func (o ZeroTrustPostureSpecOutput) ToZeroTrustPostureSpecPtrOutput() ZeroTrustPostureSpecPtrOutput {
+	return o.ToZeroTrustPostureSpecPtrOutputWithContext(context.Background())
 }
 
-// Optional description for the subnet resource.
-func (o NetworkSubnetSpecOutput) Description() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v NetworkSubnetSpec) *string { return v.Description }).(pulumi.StringPtrOutput)
+func (o ZeroTrustPostureSpecOutput) ToZeroTrustPostureSpecPtrOutputWithContext(ctx context.Context) ZeroTrustPostureSpecPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ZeroTrustPostureSpec) *ZeroTrustPostureSpec {
+		return &v
+	}).(ZeroTrustPostureSpecPtrOutput)
 }
 
-// Whether the subnet resource is enabled.
-func (o NetworkSubnetSpecOutput) Enabled() pulumi.BoolOutput {
-	return o.ApplyT(func(v NetworkSubnetSpec) bool { return v.Enabled }).(pulumi.BoolOutput)
+// ISO 3166-1 alpha-2 codes of the countries source peers may connect from. Conflicts with blockedCountries.
+func (o ZeroTrustPostureSpecOutput) AllowedCountries() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ZeroTrustPostureSpec) []string { return v.AllowedCountries }).(pulumi.StringArrayOutput)
 }
 
-// Group IDs that have access to this subnet.
-func (o NetworkSubnetSpecOutput) GroupIDs() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v NetworkSubnetSpec) []string { return v.GroupIDs }).(pulumi.StringArrayOutput)
+// CIDR ranges a source peer's local network must be in. Conflicts with blockedNetworkRanges.
+func (o ZeroTrustPostureSpecOutput) AllowedNetworkRanges() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ZeroTrustPostureSpec) []string { return v.AllowedNetworkRanges }).(pulumi.StringArrayOutput)
 }
 
-// Display name for the subnet resource.
-func (o NetworkSubnetSpecOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v NetworkSubnetSpec) string { return v.Name }).(pulumi.StringOutput)
+// ISO 3166-1 alpha-2 codes of the countries source peers may not connect from. Conflicts with allowedCountries.
+func (o ZeroTrustPostureSpecOutput) BlockedCountries() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ZeroTrustPostureSpec) []string { return v.BlockedCountries }).(pulumi.StringArrayOutput)
 }
 
-type NetworkSubnetSpecArrayOutput struct{ *pulumi.OutputState }
+// CIDR ranges a source peer's local network must not be in. Conflicts with allowedNetworkRanges.
+func (o ZeroTrustPostureSpecOutput) BlockedNetworkRanges() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ZeroTrustPostureSpec) []string { return v.BlockedNetworkRanges }).(pulumi.StringArrayOutput)
+}
 
-func (NetworkSubnetSpecArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]NetworkSubnetSpec)(nil)).Elem()
+// Minimum NetBird client version of source peers.
+func (o ZeroTrustPostureSpecOutput) MinClientVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ZeroTrustPostureSpec) *string { return v.MinClientVersion }).(pulumi.StringPtrOutput)
 }
 
-func (o NetworkSubnetSpecArrayOutput) ToNetworkSubnetSpecArrayOutput() NetworkSubnetSpecArrayOutput {
+type ZeroTrustPostureSpecPtrOutput struct{ *pulumi.OutputState }
+
+func (ZeroTrustPostureSpecPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ZeroTrustPostureSpec)(nil)).Elem()
+}
+
+func (o ZeroTrustPostureSpecPtrOutput) ToZeroTrustPostureSpecPtrOutput() ZeroTrustPostureSpecPtrOutput {
 	return o
 }
 
-func (o NetworkSubnetSpecArrayOutput) ToNetworkSubnetSpecArrayOutputWithContext(ctx context.Context) NetworkSubnetSpecArrayOutput {
+func (o ZeroTrustPostureSpecPtrOutput) ToZeroTrustPostureSpecPtrOutputWithContext(ctx context.Context) ZeroTrustPostureSpecPtrOutput {
 	return o
 }
 
-func (o NetworkSubnetSpecArrayOutput) Index(i pulumi.IntInput) NetworkSubnetSpecOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) NetworkSubnetSpec {
-		return vs[0].([]NetworkSubnetSpec)[vs[1].(int)]
-	}).(NetworkSubnetSpecOutput)
+func (o ZeroTrustPostureSpecPtrOutput) Elem() ZeroTrustPostureSpecOutput {
+	return o.ApplyT(func(v *ZeroTrustPostureSpec) ZeroTrustPostureSpec {
+		if v != nil {
+			return *v
+		}
+		var ret ZeroTrustPostureSpec
+		return ret
+	}).(ZeroTrustPostureSpecOutput)
+}
+
+// ISO 3166-1 alpha-2 codes of the countries source peers may connect from. Conflicts with blockedCountries.
+func (o ZeroTrustPostureSpecPtrOutput) AllowedCountries() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ZeroTrustPostureSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.AllowedCountries
+	}).(pulumi.StringArrayOutput)
+}
+
+// CIDR ranges a source peer's local network must be in. Conflicts with blockedNetworkRanges.
+func (o ZeroTrustPostureSpecPtrOutput) AllowedNetworkRanges() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ZeroTrustPostureSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.AllowedNetworkRanges
+	}).(pulumi.StringArrayOutput)
+}
+
+// ISO 3166-1 alpha-2 codes of the countries source peers may not connect from. Conflicts with allowedCountries.
+func (o ZeroTrustPostureSpecPtrOutput) BlockedCountries() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ZeroTrustPostureSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.BlockedCountries
+	}).(pulumi.StringArrayOutput)
+}
+
+// CIDR ranges a source peer's local network must not be in. Conflicts with allowedNetworkRanges.
+func (o ZeroTrustPostureSpecPtrOutput) BlockedNetworkRanges() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ZeroTrustPostureSpec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.BlockedNetworkRanges
+	}).(pulumi.StringArrayOutput)
+}
+
+// Minimum NetBird client version of source peers.
+func (o ZeroTrustPostureSpecPtrOutput) MinClientVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ZeroTrustPostureSpec) *string {
+		if v == nil {
+			return nil
+		}
+		return v.MinClientVersion
+	}).(pulumi.StringPtrOutput)
 }
 
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordSpecInput)(nil)).Elem(), DNSRecordSpecArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordSpecArrayInput)(nil)).Elem(), DNSRecordSpecArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ExitNodeDNSSpecInput)(nil)).Elem(), ExitNodeDNSSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ExitNodeDNSSpecPtrInput)(nil)).Elem(), ExitNodeDNSSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ExitNodePeerSpecInput)(nil)).Elem(), ExitNodePeerSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ExitNodePeerSpecArrayInput)(nil)).Elem(), ExitNodePeerSpecArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HARouterSpecInput)(nil)).Elem(), HARouterSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HARouterSpecArrayInput)(nil)).Elem(), HARouterSpecArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkAccessSpecInput)(nil)).Elem(), NetworkAccessSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkAccessSpecPtrInput)(nil)).Elem(), NetworkAccessSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkResourceSpecInput)(nil)).Elem(), NetworkResourceSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkResourceSpecArrayInput)(nil)).Elem(), NetworkResourceSpecArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NetworkRouterSpecInput)(nil)).Elem(), NetworkRouterSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkRouterSpecPtrInput)(nil)).Elem(), NetworkRouterSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkRouterSpecArrayInput)(nil)).Elem(), NetworkRouterSpecArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NetworkSubnetSpecInput)(nil)).Elem(), NetworkSubnetSpecArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NetworkSubnetSpecArrayInput)(nil)).Elem(), NetworkSubnetSpecArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyAppAuthInput)(nil)).Elem(), ReverseProxyAppAuthArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyAppAuthPtrInput)(nil)).Elem(), ReverseProxyAppAuthArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyAppTargetInput)(nil)).Elem(), ReverseProxyAppTargetArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyAppTargetArrayInput)(nil)).Elem(), ReverseProxyAppTargetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ServiceAccountTokenSpecInput)(nil)).Elem(), ServiceAccountTokenSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ServiceAccountTokenSpecArrayInput)(nil)).Elem(), ServiceAccountTokenSpecArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SiteSpecInput)(nil)).Elem(), SiteSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TeamDNSSpecInput)(nil)).Elem(), TeamDNSSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TeamDNSSpecPtrInput)(nil)).Elem(), TeamDNSSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TeamMemberSpecInput)(nil)).Elem(), TeamMemberSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TeamMemberSpecArrayInput)(nil)).Elem(), TeamMemberSpecArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ZeroTrustGroupSpecInput)(nil)).Elem(), ZeroTrustGroupSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ZeroTrustPostureSpecInput)(nil)).Elem(), ZeroTrustPostureSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ZeroTrustPostureSpecPtrInput)(nil)).Elem(), ZeroTrustPostureSpecArgs{})
 	pulumi.RegisterOutputType(DNSRecordSpecOutput{})
 	pulumi.RegisterOutputType(DNSRecordSpecArrayOutput{})
+	pulumi.RegisterOutputType(ExitNodeDNSSpecOutput{})
+	pulumi.RegisterOutputType(ExitNodeDNSSpecPtrOutput{})
+	pulumi.RegisterOutputType(ExitNodePeerSpecOutput{})
+	pulumi.RegisterOutputType(ExitNodePeerSpecArrayOutput{})
+	pulumi.RegisterOutputType(HARouterSpecOutput{})
+	pulumi.RegisterOutputType(HARouterSpecArrayOutput{})
+	pulumi.RegisterOutputType(NetworkAccessSpecOutput{})
+	pulumi.RegisterOutputType(NetworkAccessSpecPtrOutput{})
+	pulumi.RegisterOutputType(NetworkResourceSpecOutput{})
+	pulumi.RegisterOutputType(NetworkResourceSpecArrayOutput{})
 	pulumi.RegisterOutputType(NetworkRouterSpecOutput{})
+	pulumi.RegisterOutputType(NetworkRouterSpecPtrOutput{})
+	pulumi.RegisterOutputType(NetworkRouterSpecArrayOutput{})
 	pulumi.RegisterOutputType(NetworkSubnetSpecOutput{})
 	pulumi.RegisterOutputType(NetworkSubnetSpecArrayOutput{})
+	pulumi.RegisterOutputType(ReverseProxyAppAuthOutput{})
+	pulumi.RegisterOutputType(ReverseProxyAppAuthPtrOutput{})
+	pulumi.RegisterOutputType(ReverseProxyAppTargetOutput{})
+	pulumi.RegisterOutputType(ReverseProxyAppTargetArrayOutput{})
+	pulumi.RegisterOutputType(ServiceAccountTokenSpecOutput{})
+	pulumi.RegisterOutputType(ServiceAccountTokenSpecArrayOutput{})
+	pulumi.RegisterOutputType(SiteSpecOutput{})
+	pulumi.RegisterOutputType(TeamDNSSpecOutput{})
+	pulumi.RegisterOutputType(TeamDNSSpecPtrOutput{})
+	pulumi.RegisterOutputType(TeamMemberSpecOutput{})
+	pulumi.RegisterOutputType(TeamMemberSpecArrayOutput{})
+	pulumi.RegisterOutputType(ZeroTrustGroupSpecOutput{})
+	pulumi.RegisterOutputType(ZeroTrustPostureSpecOutput{})
+	pulumi.RegisterOutputType(ZeroTrustPostureSpecPtrOutput{})
 }
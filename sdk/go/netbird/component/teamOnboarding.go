@@ -0,0 +1,258 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package component
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type TeamOnboarding struct {
+	pulumi.ResourceState
+
+	// ID of the created team Group.
+	GroupId pulumi.StringOutput `pulumi:"groupId"`
+	// ID of the created User of each member, keyed by email.
+	MemberIds pulumi.StringMapOutput `pulumi:"memberIds"`
+	// ID of the UserGroupMembership of each existing member, keyed by user ID.
+	MembershipIds pulumi.StringMapOutput `pulumi:"membershipIds"`
+	// ID of the created DNS nameserver group. Unset without dns.
+	NameserverGroupId pulumi.StringPtrOutput `pulumi:"nameserverGroupId"`
+	// ID of the created Policy for each shared service group, keyed by group ID.
+	PolicyIds pulumi.StringMapOutput `pulumi:"policyIds"`
+	// Plaintext value of the team's setup key. Secret.
+	SetupKey pulumi.StringOutput `pulumi:"setupKey"`
+	// ID of the created SetupKey.
+	SetupKeyId pulumi.StringOutput `pulumi:"setupKeyId"`
+}
+
+// NewTeamOnboarding registers a new resource with the given unique name, arguments, and options.
+func NewTeamOnboarding(ctx *pulumi.Context,
+	name string, args *TeamOnboardingArgs, opts ...pulumi.ResourceOption) (*TeamOnboarding, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"setupKey",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource TeamOnboarding
+	err := ctx.RegisterRemoteComponentResource("netbird:component:TeamOnboarding", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type teamOnboardingArgs struct {
+	// Optional nameservers distributed to the team's peers.
+	Dns *TeamDNSSpec `pulumi:"dns"`
+	// IDs of existing users to add to the team. The team group is added to their auto-groups and the groups they already have are kept. Removing a user from the list removes only the team group.
+	ExistingMembers []string `pulumi:"existingMembers"`
+	// Users to invite as team members. Their auto-groups include the team group. The users are created by the component, so they must not exist yet; use existingMembers for those that do.
+	Members []TeamMemberSpec `pulumi:"members"`
+	// Lifetime of the team's reusable setup key in seconds.
+	SetupKeyExpiresIn int `pulumi:"setupKeyExpiresIn"`
+	// Maximum number of peers the setup key may enroll. Defaults to 0, which is unlimited.
+	SetupKeyUsageLimit *int `pulumi:"setupKeyUsageLimit"`
+	// IDs of the groups of shared services the team may reach. Each gets its own policy.
+	SharedServiceGroups []string `pulumi:"sharedServiceGroups"`
+	// Name of the team, used as the name of its group.
+	Team string `pulumi:"team"`
+}
+
+// The set of arguments for constructing a TeamOnboarding resource.
+type TeamOnboardingArgs struct {
+	// Optional nameservers distributed to the team's peers.
+	Dns TeamDNSSpecPtrInput
+	// IDs of existing users to add to the team. The team group is added to their auto-groups and the groups they already have are kept. Removing a user from the list removes only the team group.
+	ExistingMembers pulumi.StringArrayInput
+	// Users to invite as team members. Their auto-groups include the team group. The users are created by the component, so they must not exist yet; use existingMembers for those that do.
+	Members TeamMemberSpecArrayInput
+	// Lifetime of the team's reusable setup key in seconds.
+	SetupKeyExpiresIn int
+	// Maximum number of peers the setup key may enroll. Defaults to 0, which is unlimited.
+	SetupKeyUsageLimit *int
+	// IDs of the groups of shared services the team may reach. Each gets its own policy.
+	SharedServiceGroups pulumi.StringArrayInput
+	// Name of the team, used as the name of its group.
+	Team string
+}
+
+func (TeamOnboardingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*teamOnboardingArgs)(nil)).Elem()
+}
+
+type TeamOnboardingInput interface {
+	pulumi.Input
+
+	ToTeamOnboardingOutput() TeamOnboardingOutput
+	ToTeamOnboardingOutputWithContext(ctx context.Context) TeamOnboardingOutput
+}
+
+func (*TeamOnboarding) ElementType() reflect.Type {
+	return reflect.TypeOf((**TeamOnboarding)(nil)).Elem()
+}
+
+func (i *TeamOnboarding) ToTeamOnboardingOutput() TeamOnboardingOutput {
+	return i.ToTeamOnboardingOutputWithContext(context.Background())
+}
+
+func (i *TeamOnboarding) ToTeamOnboardingOutputWithContext(ctx context.Context) TeamOnboardingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamOnboardingOutput)
+}
+
+// TeamOnboardingArrayInput is an input type that accepts TeamOnboardingArray and TeamOnboardingArrayOutput values.
+// You can construct a concrete instance of `TeamOnboardingArrayInput` via:
+//
+//	TeamOnboardingArray{ TeamOnboardingArgs{...} }
+type TeamOnboardingArrayInput interface {
+	pulumi.Input
+
+	ToTeamOnboardingArrayOutput() TeamOnboardingArrayOutput
+	ToTeamOnboardingArrayOutputWithContext(context.Context) TeamOnboardingArrayOutput
+}
+
+type TeamOnboardingArray []TeamOnboardingInput
+
+func (TeamOnboardingArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*TeamOnboarding)(nil)).Elem()
+}
+
+func (i TeamOnboardingArray) ToTeamOnboardingArrayOutput() TeamOnboardingArrayOutput {
+	return i.ToTeamOnboardingArrayOutputWithContext(context.Background())
+}
+
+func (i TeamOnboardingArray) ToTeamOnboardingArrayOutputWithContext(ctx context.Context) TeamOnboardingArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamOnboardingArrayOutput)
+}
+
+// TeamOnboardingMapInput is an input type that accepts TeamOnboardingMap and TeamOnboardingMapOutput values.
+// You can construct a concrete instance of `TeamOnboardingMapInput` via:
+//
+//	TeamOnboardingMap{ "key": TeamOnboardingArgs{...} }
+type TeamOnboardingMapInput interface {
+	pulumi.Input
+
+	ToTeamOnboardingMapOutput() TeamOnboardingMapOutput
+	ToTeamOnboardingMapOutputWithContext(context.Context) TeamOnboardingMapOutput
+}
+
+type TeamOnboardingMap map[string]TeamOnboardingInput
+
+func (TeamOnboardingMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*TeamOnboarding)(nil)).Elem()
+}
+
+func (i TeamOnboardingMap) ToTeamOnboardingMapOutput() TeamOnboardingMapOutput {
+	return i.ToTeamOnboardingMapOutputWithContext(context.Background())
+}
+
+func (i TeamOnboardingMap) ToTeamOnboardingMapOutputWithContext(ctx context.Context) TeamOnboardingMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TeamOnboardingMapOutput)
+}
+
+type TeamOnboardingOutput struct{ *pulumi.OutputState }
+
+func (TeamOnboardingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**TeamOnboarding)(nil)).Elem()
+}
+
+func (o TeamOnboardingOutput) ToTeamOnboardingOutput() TeamOnboardingOutput {
+	return o
+}
+
+func (o TeamOnboardingOutput) ToTeamOnboardingOutputWithContext(ctx context.Context) TeamOnboardingOutput {
+	return o
+}
+
+// ID of the created team Group.
+func (o TeamOnboardingOutput) GroupId() pulumi.StringOutput {
+	return o.ApplyT(func(v *TeamOnboarding) pulumi.StringOutput { return v.GroupId }).(pulumi.StringOutput)
+}
+
+// ID of the created User of each member, keyed by email.
+func (o TeamOnboardingOutput) MemberIds() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *TeamOnboarding) pulumi.StringMapOutput { return v.MemberIds }).(pulumi.StringMapOutput)
+}
+
+// ID of the UserGroupMembership of each existing member, keyed by user ID.
+func (o TeamOnboardingOutput) MembershipIds() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *TeamOnboarding) pulumi.StringMapOutput { return v.MembershipIds }).(pulumi.StringMapOutput)
+}
+
+// ID of the created DNS nameserver group. Unset without dns.
+func (o TeamOnboardingOutput) NameserverGroupId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *TeamOnboarding) pulumi.StringPtrOutput { return v.NameserverGroupId }).(pulumi.StringPtrOutput)
+}
+
+// ID of the created Policy for each shared service group, keyed by group ID.
+func (o TeamOnboardingOutput) PolicyIds() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *TeamOnboarding) pulumi.StringMapOutput { return v.PolicyIds }).(pulumi.StringMapOutput)
+}
+
+// Plaintext value of the team's setup key. Secret.
+func (o TeamOnboardingOutput) SetupKey() pulumi.StringOutput {
+	return o.ApplyT(func(v *TeamOnboarding) pulumi.StringOutput { return v.SetupKey }).(pulumi.StringOutput)
+}
+
+// ID of the created SetupKey.
+func (o TeamOnboardingOutput) SetupKeyId() pulumi.StringOutput {
+	return o.ApplyT(func(v *TeamOnboarding) pulumi.StringOutput { return v.SetupKeyId }).(pulumi.StringOutput)
+}
+
+type TeamOnboardingArrayOutput struct{ *pulumi.OutputState }
+
+func (TeamOnboardingArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*TeamOnboarding)(nil)).Elem()
+}
+
+func (o TeamOnboardingArrayOutput) ToTeamOnboardingArrayOutput() TeamOnboardingArrayOutput {
+	return o
+}
+
+func (o TeamOnboardingArrayOutput) ToTeamOnboardingArrayOutputWithContext(ctx context.Context) TeamOnboardingArrayOutput {
+	return o
+}
+
+func (o TeamOnboardingArrayOutput) Index(i pulumi.IntInput) TeamOnboardingOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *TeamOnboarding {
+		return vs[0].([]*TeamOnboarding)[vs[1].(int)]
+	}).(TeamOnboardingOutput)
+}
+
+type TeamOnboardingMapOutput struct{ *pulumi.OutputState }
+
+func (TeamOnboardingMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*TeamOnboarding)(nil)).Elem()
+}
+
+func (o TeamOnboardingMapOutput) ToTeamOnboardingMapOutput() TeamOnboardingMapOutput {
+	return o
+}
+
+func (o TeamOnboardingMapOutput) ToTeamOnboardingMapOutputWithContext(ctx context.Context) TeamOnboardingMapOutput {
+	return o
+}
+
+func (o TeamOnboardingMapOutput) MapIndex(k pulumi.StringInput) TeamOnboardingOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *TeamOnboarding {
+		return vs[0].(map[string]*TeamOnboarding)[vs[1].(string)]
+	}).(TeamOnboardingOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*TeamOnboardingInput)(nil)).Elem(), &TeamOnboarding{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TeamOnboardingArrayInput)(nil)).Elem(), TeamOnboardingArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TeamOnboardingMapInput)(nil)).Elem(), TeamOnboardingMap{})
+	pulumi.RegisterOutputType(TeamOnboardingOutput{})
+	pulumi.RegisterOutputType(TeamOnboardingArrayOutput{})
+	pulumi.RegisterOutputType(TeamOnboardingMapOutput{})
+}
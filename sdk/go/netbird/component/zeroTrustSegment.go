@@ -0,0 +1,251 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package component
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type ZeroTrustSegment struct {
+	pulumi.ResourceState
+
+	// ID of the created destination Group.
+	DestinationGroupId pulumi.StringOutput `pulumi:"destinationGroupId"`
+	// ID of the created Policy.
+	PolicyId pulumi.StringOutput `pulumi:"policyId"`
+	// ID of the created PostureCheck. Unset without posture.
+	PostureCheckId pulumi.StringPtrOutput `pulumi:"postureCheckId"`
+	// ID of the created source Group.
+	SourceGroupId pulumi.StringOutput `pulumi:"sourceGroupId"`
+}
+
+// NewZeroTrustSegment registers a new resource with the given unique name, arguments, and options.
+func NewZeroTrustSegment(ctx *pulumi.Context,
+	name string, args *ZeroTrustSegmentArgs, opts ...pulumi.ResourceOption) (*ZeroTrustSegment, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Destination == nil {
+		return nil, errors.New("invalid value for required argument 'Destination'")
+	}
+	if args.Protocols == nil {
+		return nil, errors.New("invalid value for required argument 'Protocols'")
+	}
+	if args.Source == nil {
+		return nil, errors.New("invalid value for required argument 'Source'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource ZeroTrustSegment
+	err := ctx.RegisterRemoteComponentResource("netbird:component:ZeroTrustSegment", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type zeroTrustSegmentArgs struct {
+	// Whether the destination may also initiate connections to the source. Defaults to false.
+	Bidirectional *bool `pulumi:"bidirectional"`
+	// Optional description for the posture check and the policy.
+	Description *string `pulumi:"description"`
+	// Group of the peers that make up the segment.
+	Destination ZeroTrustGroupSpec `pulumi:"destination"`
+	// Name of the segment, used for the posture check and the policy.
+	Name string `pulumi:"name"`
+	// Port ranges to accept. Only valid when every protocol is tcp or udp.
+	PortRanges []resource.RulePortRange `pulumi:"portRanges"`
+	// Ports to accept. Only valid when every protocol is tcp or udp.
+	Ports []string `pulumi:"ports"`
+	// Optional posture requirements for the source peers. When set, a posture check is created and attached to the policy.
+	Posture *ZeroTrustPostureSpec `pulumi:"posture"`
+	// Protocols to accept. The policy gets one rule per protocol.
+	Protocols []resource.Protocol `pulumi:"protocols"`
+	// Group of the peers allowed to reach the segment.
+	Source ZeroTrustGroupSpec `pulumi:"source"`
+}
+
+// The set of arguments for constructing a ZeroTrustSegment resource.
+type ZeroTrustSegmentArgs struct {
+	// Whether the destination may also initiate connections to the source. Defaults to false.
+	Bidirectional *bool
+	// Optional description for the posture check and the policy.
+	Description *string
+	// Group of the peers that make up the segment.
+	Destination ZeroTrustGroupSpecInput
+	// Name of the segment, used for the posture check and the policy.
+	Name string
+	// Port ranges to accept. Only valid when every protocol is tcp or udp.
+	PortRanges resource.RulePortRangeArrayInput
+	// Ports to accept. Only valid when every protocol is tcp or udp.
+	Ports pulumi.StringArrayInput
+	// Optional posture requirements for the source peers. When set, a posture check is created and attached to the policy.
+	Posture ZeroTrustPostureSpecPtrInput
+	// Protocols to accept. The policy gets one rule per protocol.
+	Protocols resource.ProtocolArrayInput
+	// Group of the peers allowed to reach the segment.
+	Source ZeroTrustGroupSpecInput
+}
+
+func (ZeroTrustSegmentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*zeroTrustSegmentArgs)(nil)).Elem()
+}
+
+type ZeroTrustSegmentInput interface {
+	pulumi.Input
+
+	ToZeroTrustSegmentOutput() ZeroTrustSegmentOutput
+	ToZeroTrustSegmentOutputWithContext(ctx context.Context) ZeroTrustSegmentOutput
+}
+
+func (*ZeroTrustSegment) ElementType() reflect.Type {
+	return reflect.TypeOf((**ZeroTrustSegment)(nil)).Elem()
+}
+
+func (i *ZeroTrustSegment) ToZeroTrustSegmentOutput() ZeroTrustSegmentOutput {
+	return i.ToZeroTrustSegmentOutputWithContext(context.Background())
+}
+
+func (i *ZeroTrustSegment) ToZeroTrustSegmentOutputWithContext(ctx context.Context) ZeroTrustSegmentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ZeroTrustSegmentOutput)
+}
+
+// ZeroTrustSegmentArrayInput is an input type that accepts ZeroTrustSegmentArray and ZeroTrustSegmentArrayOutput values.
+// You can construct a concrete instance of `ZeroTrustSegmentArrayInput` via:
+//
+//	ZeroTrustSegmentArray{ ZeroTrustSegmentArgs{...} }
+type ZeroTrustSegmentArrayInput interface {
+	pulumi.Input
+
+	ToZeroTrustSegmentArrayOutput() ZeroTrustSegmentArrayOutput
+	ToZeroTrustSegmentArrayOutputWithContext(context.Context) ZeroTrustSegmentArrayOutput
+}
+
+type ZeroTrustSegmentArray []ZeroTrustSegmentInput
+
+func (ZeroTrustSegmentArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*ZeroTrustSegment)(nil)).Elem()
+}
+
+func (i ZeroTrustSegmentArray) ToZeroTrustSegmentArrayOutput() ZeroTrustSegmentArrayOutput {
+	return i.ToZeroTrustSegmentArrayOutputWithContext(context.Background())
+}
+
+func (i ZeroTrustSegmentArray) ToZeroTrustSegmentArrayOutputWithContext(ctx context.Context) ZeroTrustSegmentArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ZeroTrustSegmentArrayOutput)
+}
+
+// ZeroTrustSegmentMapInput is an input type that accepts ZeroTrustSegmentMap and ZeroTrustSegmentMapOutput values.
+// You can construct a concrete instance of `ZeroTrustSegmentMapInput` via:
+//
+//	ZeroTrustSegmentMap{ "key": ZeroTrustSegmentArgs{...} }
+type ZeroTrustSegmentMapInput interface {
+	pulumi.Input
+
+	ToZeroTrustSegmentMapOutput() ZeroTrustSegmentMapOutput
+	ToZeroTrustSegmentMapOutputWithContext(context.Context) ZeroTrustSegmentMapOutput
+}
+
+type ZeroTrustSegmentMap map[string]ZeroTrustSegmentInput
+
+func (ZeroTrustSegmentMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*ZeroTrustSegment)(nil)).Elem()
+}
+
+func (i ZeroTrustSegmentMap) ToZeroTrustSegmentMapOutput() ZeroTrustSegmentMapOutput {
+	return i.ToZeroTrustSegmentMapOutputWithContext(context.Background())
+}
+
+func (i ZeroTrustSegmentMap) ToZeroTrustSegmentMapOutputWithContext(ctx context.Context) ZeroTrustSegmentMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ZeroTrustSegmentMapOutput)
+}
+
+type ZeroTrustSegmentOutput struct{ *pulumi.OutputState }
+
+func (ZeroTrustSegmentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ZeroTrustSegment)(nil)).Elem()
+}
+
+func (o ZeroTrustSegmentOutput) ToZeroTrustSegmentOutput() ZeroTrustSegmentOutput {
+	return o
+}
+
+func (o ZeroTrustSegmentOutput) ToZeroTrustSegmentOutputWithContext(ctx context.Context) ZeroTrustSegmentOutput {
+	return o
+}
+
+// ID of the created destination Group.
+func (o ZeroTrustSegmentOutput) DestinationGroupId() pulumi.StringOutput {
+	return o.ApplyT(func(v *ZeroTrustSegment) pulumi.StringOutput { return v.DestinationGroupId }).(pulumi.StringOutput)
+}
+
+// ID of the created Policy.
+func (o ZeroTrustSegmentOutput) PolicyId() pulumi.StringOutput {
+	return o.ApplyT(func(v *ZeroTrustSegment) pulumi.StringOutput { return v.PolicyId }).(pulumi.StringOutput)
+}
+
+// ID of the created PostureCheck. Unset without posture.
+func (o ZeroTrustSegmentOutput) PostureCheckId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ZeroTrustSegment) pulumi.StringPtrOutput { return v.PostureCheckId }).(pulumi.StringPtrOutput)
+}
+
+// ID of the created source Group.
+func (o ZeroTrustSegmentOutput) SourceGroupId() pulumi.StringOutput {
+	return o.ApplyT(func(v *ZeroTrustSegment) pulumi.StringOutput { return v.SourceGroupId }).(pulumi.StringOutput)
+}
+
+type ZeroTrustSegmentArrayOutput struct{ *pulumi.OutputState }
+
+func (ZeroTrustSegmentArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*ZeroTrustSegment)(nil)).Elem()
+}
+
+func (o ZeroTrustSegmentArrayOutput) ToZeroTrustSegmentArrayOutput() ZeroTrustSegmentArrayOutput {
+	return o
+}
+
+func (o ZeroTrustSegmentArrayOutput) ToZeroTrustSegmentArrayOutputWithContext(ctx context.Context) ZeroTrustSegmentArrayOutput {
+	return o
+}
+
+func (o ZeroTrustSegmentArrayOutput) Index(i pulumi.IntInput) ZeroTrustSegmentOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *ZeroTrustSegment {
+		return vs[0].([]*ZeroTrustSegment)[vs[1].(int)]
+	}).(ZeroTrustSegmentOutput)
+}
+
+type ZeroTrustSegmentMapOutput struct{ *pulumi.OutputState }
+
+func (ZeroTrustSegmentMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*ZeroTrustSegment)(nil)).Elem()
+}
+
+func (o ZeroTrustSegmentMapOutput) ToZeroTrustSegmentMapOutput() ZeroTrustSegmentMapOutput {
+	return o
+}
+
+func (o ZeroTrustSegmentMapOutput) ToZeroTrustSegmentMapOutputWithContext(ctx context.Context) ZeroTrustSegmentMapOutput {
+	return o
+}
+
+func (o ZeroTrustSegmentMapOutput) MapIndex(k pulumi.StringInput) ZeroTrustSegmentOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *ZeroTrustSegment {
+		return vs[0].(map[string]*ZeroTrustSegment)[vs[1].(string)]
+	}).(ZeroTrustSegmentOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ZeroTrustSegmentInput)(nil)).Elem(), &ZeroTrustSegment{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ZeroTrustSegmentArrayInput)(nil)).Elem(), ZeroTrustSegmentArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ZeroTrustSegmentMapInput)(nil)).Elem(), ZeroTrustSegmentMap{})
+	pulumi.RegisterOutputType(ZeroTrustSegmentOutput{})
+	pulumi.RegisterOutputType(ZeroTrustSegmentArrayOutput{})
+	pulumi.RegisterOutputType(ZeroTrustSegmentMapOutput{})
+}
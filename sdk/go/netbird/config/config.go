@@ -11,6 +11,110 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+// ID of the NetBird account to manage, for tokens with access to several accounts (e.g. MSP tenants). Resources record the account they belong to; moving a resource to another account replaces it.
+func GetAccountId(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:accountId")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_ACCOUNT_ID"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// Timeout for creating a resource, as a Go duration (e.g. 10m). Defaults to 5m. A resource's customTimeouts option takes precedence.
+func GetCreateTimeout(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:createTimeout")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_CREATE_TIMEOUT"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// Timeout for deleting a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+func GetDeleteTimeout(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:deleteTimeout")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_DELETE_TIMEOUT"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// NetBird edition of the management server: cloud or self-hosted. Takes precedence over detection from url, which treats only api.netbird.io as cloud. Set it when NetBird Cloud is reached through a proxy or a custom domain.
+func GetEdition(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:edition")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_EDITION"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// Name of a credential profile in profilesFile. The profile's url, token, and accountId take precedence over url and token; an explicit accountId takes precedence over the profile's.
+func GetProfile(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:profile")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_PROFILE"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// Path to the YAML credential profiles file. Defaults to netbird/pulumi-profiles.yaml in the user config directory (e.g. ~/.config/netbird/pulumi-profiles.yaml).
+func GetProfilesFile(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:profilesFile")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_PROFILES_FILE"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// Timeout for reading (refreshing or importing) a resource, as a Go duration. Defaults to 2m.
+func GetReadTimeout(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:readTimeout")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_READ_TIMEOUT"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// Skip validating the token and URL against the management server during provider configuration, e.g. for offline previews. Server version detection is skipped as well.
+func GetSkipConfigureValidation(ctx *pulumi.Context) bool {
+	v, err := config.TryBool(ctx, "netbird:skipConfigureValidation")
+	if err == nil {
+		return v
+	}
+	var value bool
+	if d := internal.GetEnvOrDefault(false, internal.ParseEnvBool, "NETBIRD_SKIP_CONFIGURE_VALIDATION"); d != nil {
+		value = d.(bool)
+	}
+	return value
+}
+
 // Netbird API Token
 func GetToken(ctx *pulumi.Context) string {
 	v, err := config.Try(ctx, "netbird:token")
@@ -24,6 +128,58 @@ func GetToken(ctx *pulumi.Context) string {
 	return value
 }
 
+// Command whose standard output is used as the API token, e.g. a call to a local secrets manager. Run through the system shell; takes precedence over token. The result is cached for tokenLifetime and refreshed when the server rejects it with 401.
+func GetTokenCommand(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:tokenCommand")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_TOKEN_COMMAND"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// Path to a file containing the API token, re-read after tokenLifetime or on 401. Takes precedence over token; mutually exclusive with tokenCommand.
+func GetTokenFile(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:tokenFile")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_TOKEN_FILE"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// How long a token from tokenCommand or tokenFile is reused, as a Go duration (e.g. 15m, 1h). Defaults to 15m; 0s fetches a token for every request.
+func GetTokenLifetime(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:tokenLifetime")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_TOKEN_LIFETIME"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// Timeout for updating a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+func GetUpdateTimeout(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "netbird:updateTimeout")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NETBIRD_UPDATE_TIMEOUT"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
 // URL to Netbird API, example: https://api.netbird.io
 func GetUrl(ctx *pulumi.Context) string {
 	v, err := config.Try(ctx, "netbird:url")
@@ -0,0 +1,82 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package function
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// List NetBird DNS zones, optionally filtered by a name regex, a distribution group, and the enabled state.
+func GetDNSZones(ctx *pulumi.Context, args *GetDNSZonesArgs, opts ...pulumi.InvokeOption) (*GetDNSZonesResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetDNSZonesResult
+	err := ctx.Invoke("netbird:function:getDNSZones", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetDNSZonesArgs struct {
+	// Optional enabled state. When set, only objects with this enabled state are returned.
+	Enabled *bool `pulumi:"enabled"`
+	// Optional group ID. When set, only objects that reference this group are returned.
+	GroupId *string `pulumi:"groupId"`
+	// Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.
+	NameRegex *string `pulumi:"nameRegex"`
+}
+
+type GetDNSZonesResult struct {
+	// The DNS zones matching the filter criteria.
+	Zones []DNSZoneSummary `pulumi:"zones"`
+}
+
+func GetDNSZonesOutput(ctx *pulumi.Context, args GetDNSZonesOutputArgs, opts ...pulumi.InvokeOption) GetDNSZonesResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetDNSZonesResultOutput, error) {
+			args := v.(GetDNSZonesArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netbird:function:getDNSZones", args, GetDNSZonesResultOutput{}, options).(GetDNSZonesResultOutput), nil
+		}).(GetDNSZonesResultOutput)
+}
+
+type GetDNSZonesOutputArgs struct {
+	// Optional enabled state. When set, only objects with this enabled state are returned.
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// Optional group ID. When set, only objects that reference this group are returned.
+	GroupId pulumi.StringPtrInput `pulumi:"groupId"`
+	// Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.
+	NameRegex pulumi.StringPtrInput `pulumi:"nameRegex"`
+}
+
+func (GetDNSZonesOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetDNSZonesArgs)(nil)).Elem()
+}
+
+type GetDNSZonesResultOutput struct{ *pulumi.OutputState }
+
+func (GetDNSZonesResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetDNSZonesResult)(nil)).Elem()
+}
+
+func (o GetDNSZonesResultOutput) ToGetDNSZonesResultOutput() GetDNSZonesResultOutput {
+	return o
+}
+
+func (o GetDNSZonesResultOutput) ToGetDNSZonesResultOutputWithContext(ctx context.Context) GetDNSZonesResultOutput {
+	return o
+}
+
+// The DNS zones matching the filter criteria.
+func (o GetDNSZonesResultOutput) Zones() DNSZoneSummaryArrayOutput {
+	return o.ApplyT(func(v GetDNSZonesResult) []DNSZoneSummary { return v.Zones }).(DNSZoneSummaryArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetDNSZonesResultOutput{})
+}
@@ -0,0 +1,82 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package function
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// List NetBird groups with their peers and resources, optionally filtered by a name regex.
+func GetGroups(ctx *pulumi.Context, args *GetGroupsArgs, opts ...pulumi.InvokeOption) (*GetGroupsResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetGroupsResult
+	err := ctx.Invoke("netbird:function:getGroups", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetGroupsArgs struct {
+	// Optional enabled state. When set, only objects with this enabled state are returned.
+	Enabled *bool `pulumi:"enabled"`
+	// Optional group ID. When set, only objects that reference this group are returned.
+	GroupId *string `pulumi:"groupId"`
+	// Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.
+	NameRegex *string `pulumi:"nameRegex"`
+}
+
+type GetGroupsResult struct {
+	// The groups matching the filter criteria.
+	Groups []GroupSummary `pulumi:"groups"`
+}
+
+func GetGroupsOutput(ctx *pulumi.Context, args GetGroupsOutputArgs, opts ...pulumi.InvokeOption) GetGroupsResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetGroupsResultOutput, error) {
+			args := v.(GetGroupsArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netbird:function:getGroups", args, GetGroupsResultOutput{}, options).(GetGroupsResultOutput), nil
+		}).(GetGroupsResultOutput)
+}
+
+type GetGroupsOutputArgs struct {
+	// Optional enabled state. When set, only objects with this enabled state are returned.
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// Optional group ID. When set, only objects that reference this group are returned.
+	GroupId pulumi.StringPtrInput `pulumi:"groupId"`
+	// Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.
+	NameRegex pulumi.StringPtrInput `pulumi:"nameRegex"`
+}
+
+func (GetGroupsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetGroupsArgs)(nil)).Elem()
+}
+
+type GetGroupsResultOutput struct{ *pulumi.OutputState }
+
+func (GetGroupsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetGroupsResult)(nil)).Elem()
+}
+
+func (o GetGroupsResultOutput) ToGetGroupsResultOutput() GetGroupsResultOutput {
+	return o
+}
+
+func (o GetGroupsResultOutput) ToGetGroupsResultOutputWithContext(ctx context.Context) GetGroupsResultOutput {
+	return o
+}
+
+// The groups matching the filter criteria.
+func (o GetGroupsResultOutput) Groups() GroupSummaryArrayOutput {
+	return o.ApplyT(func(v GetGroupsResult) []GroupSummary { return v.Groups }).(GroupSummaryArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetGroupsResultOutput{})
+}
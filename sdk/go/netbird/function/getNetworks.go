@@ -0,0 +1,82 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package function
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// List NetBird networks, optionally filtered by a name regex.
+func GetNetworks(ctx *pulumi.Context, args *GetNetworksArgs, opts ...pulumi.InvokeOption) (*GetNetworksResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetNetworksResult
+	err := ctx.Invoke("netbird:function:getNetworks", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetNetworksArgs struct {
+	// Optional enabled state. When set, only objects with this enabled state are returned.
+	Enabled *bool `pulumi:"enabled"`
+	// Optional group ID. When set, only objects that reference this group are returned.
+	GroupId *string `pulumi:"groupId"`
+	// Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.
+	NameRegex *string `pulumi:"nameRegex"`
+}
+
+type GetNetworksResult struct {
+	// The networks matching the filter criteria.
+	Networks []NetworkSummary `pulumi:"networks"`
+}
+
+func GetNetworksOutput(ctx *pulumi.Context, args GetNetworksOutputArgs, opts ...pulumi.InvokeOption) GetNetworksResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetNetworksResultOutput, error) {
+			args := v.(GetNetworksArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netbird:function:getNetworks", args, GetNetworksResultOutput{}, options).(GetNetworksResultOutput), nil
+		}).(GetNetworksResultOutput)
+}
+
+type GetNetworksOutputArgs struct {
+	// Optional enabled state. When set, only objects with this enabled state are returned.
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// Optional group ID. When set, only objects that reference this group are returned.
+	GroupId pulumi.StringPtrInput `pulumi:"groupId"`
+	// Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.
+	NameRegex pulumi.StringPtrInput `pulumi:"nameRegex"`
+}
+
+func (GetNetworksOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetNetworksArgs)(nil)).Elem()
+}
+
+type GetNetworksResultOutput struct{ *pulumi.OutputState }
+
+func (GetNetworksResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetNetworksResult)(nil)).Elem()
+}
+
+func (o GetNetworksResultOutput) ToGetNetworksResultOutput() GetNetworksResultOutput {
+	return o
+}
+
+func (o GetNetworksResultOutput) ToGetNetworksResultOutputWithContext(ctx context.Context) GetNetworksResultOutput {
+	return o
+}
+
+// The networks matching the filter criteria.
+func (o GetNetworksResultOutput) Networks() NetworkSummaryArrayOutput {
+	return o.ApplyT(func(v GetNetworksResult) []NetworkSummary { return v.Networks }).(NetworkSummaryArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetNetworksResultOutput{})
+}
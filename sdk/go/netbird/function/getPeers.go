@@ -11,7 +11,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
-// List all NetBird peers, optionally filtered to those belonging to a specific group ID.
+// List all NetBird peers, optionally filtered by a name regex and to those belonging to a specific group ID.
 func GetPeers(ctx *pulumi.Context, args *GetPeersArgs, opts ...pulumi.InvokeOption) (*GetPeersResult, error) {
 	opts = internal.PkgInvokeDefaultOpts(opts)
 	var rv GetPeersResult
@@ -23,8 +23,12 @@ func GetPeers(ctx *pulumi.Context, args *GetPeersArgs, opts ...pulumi.InvokeOpti
 }
 
 type GetPeersArgs struct {
-	// Optional group ID to filter peers. When set, only peers that belong to this group are returned.
+	// Optional enabled state. When set, only objects with this enabled state are returned.
+	Enabled *bool `pulumi:"enabled"`
+	// Optional group ID. When set, only objects that reference this group are returned.
 	GroupId *string `pulumi:"groupId"`
+	// Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.
+	NameRegex *string `pulumi:"nameRegex"`
 }
 
 type GetPeersResult struct {
@@ -42,8 +46,12 @@ func GetPeersOutput(ctx *pulumi.Context, args GetPeersOutputArgs, opts ...pulumi
 }
 
 type GetPeersOutputArgs struct {
-	// Optional group ID to filter peers. When set, only peers that belong to this group are returned.
+	// Optional enabled state. When set, only objects with this enabled state are returned.
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// Optional group ID. When set, only objects that reference this group are returned.
 	GroupId pulumi.StringPtrInput `pulumi:"groupId"`
+	// Optional regular expression (RE2 syntax) the name must match. Unanchored, so use ^ and $ for an exact match.
+	NameRegex pulumi.StringPtrInput `pulumi:"nameRegex"`
 }
 
 func (GetPeersOutputArgs) ElementType() reflect.Type {
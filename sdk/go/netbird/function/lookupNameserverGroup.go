@@ -0,0 +1,138 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package function
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Look up an existing NetBird nameserver group by name and return it in full. Fails when no nameserver group or more than one nameserver group matches.
+func LookupNameserverGroup(ctx *pulumi.Context, args *LookupNameserverGroupArgs, opts ...pulumi.InvokeOption) (*LookupNameserverGroupResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupNameserverGroupResult
+	err := ctx.Invoke("netbird:function:lookupNameserverGroup", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupNameserverGroupArgs struct {
+	// The name of the nameserver group to look up.
+	Name string `pulumi:"name"`
+}
+
+type LookupNameserverGroupResult struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Description of the nameserver group
+	Description string `pulumi:"description"`
+	// Domains Match domain list. It should be empty only if primary is true.
+	Domains []string `pulumi:"domains"`
+	// Enabled Nameserver group status
+	Enabled bool `pulumi:"enabled"`
+	// Groups Distribution group IDs that defines group of peers that will use this nameserver group
+	Groups []string `pulumi:"groups"`
+	// Name of nameserver group name
+	Name string `pulumi:"name"`
+	// The nameserver group ID.
+	NameserverGroupId string `pulumi:"nameserverGroupId"`
+	// Nameservers Nameserver list
+	Nameservers []resource.Nameserver `pulumi:"nameservers"`
+	// Primary Defines if a nameserver group is primary that resolves all domains. It should be true only if domains list is empty.
+	Primary bool `pulumi:"primary"`
+	// SearchDomainsEnabled Search domain status for match domains. It should be true only if domains list is not empty.
+	SearchDomainsEnabled bool `pulumi:"searchDomainsEnabled"`
+}
+
+func LookupNameserverGroupOutput(ctx *pulumi.Context, args LookupNameserverGroupOutputArgs, opts ...pulumi.InvokeOption) LookupNameserverGroupResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (LookupNameserverGroupResultOutput, error) {
+			args := v.(LookupNameserverGroupArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netbird:function:lookupNameserverGroup", args, LookupNameserverGroupResultOutput{}, options).(LookupNameserverGroupResultOutput), nil
+		}).(LookupNameserverGroupResultOutput)
+}
+
+type LookupNameserverGroupOutputArgs struct {
+	// The name of the nameserver group to look up.
+	Name pulumi.StringInput `pulumi:"name"`
+}
+
+func (LookupNameserverGroupOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupNameserverGroupArgs)(nil)).Elem()
+}
+
+type LookupNameserverGroupResultOutput struct{ *pulumi.OutputState }
+
+func (LookupNameserverGroupResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupNameserverGroupResult)(nil)).Elem()
+}
+
+func (o LookupNameserverGroupResultOutput) ToLookupNameserverGroupResultOutput() LookupNameserverGroupResultOutput {
+	return o
+}
+
+func (o LookupNameserverGroupResultOutput) ToLookupNameserverGroupResultOutputWithContext(ctx context.Context) LookupNameserverGroupResultOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o LookupNameserverGroupResultOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Description of the nameserver group
+func (o LookupNameserverGroupResultOutput) Description() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) string { return v.Description }).(pulumi.StringOutput)
+}
+
+// Domains Match domain list. It should be empty only if primary is true.
+func (o LookupNameserverGroupResultOutput) Domains() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) []string { return v.Domains }).(pulumi.StringArrayOutput)
+}
+
+// Enabled Nameserver group status
+func (o LookupNameserverGroupResultOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// Groups Distribution group IDs that defines group of peers that will use this nameserver group
+func (o LookupNameserverGroupResultOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) []string { return v.Groups }).(pulumi.StringArrayOutput)
+}
+
+// Name of nameserver group name
+func (o LookupNameserverGroupResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The nameserver group ID.
+func (o LookupNameserverGroupResultOutput) NameserverGroupId() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) string { return v.NameserverGroupId }).(pulumi.StringOutput)
+}
+
+// Nameservers Nameserver list
+func (o LookupNameserverGroupResultOutput) Nameservers() resource.NameserverArrayOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) []resource.Nameserver { return v.Nameservers }).(resource.NameserverArrayOutput)
+}
+
+// Primary Defines if a nameserver group is primary that resolves all domains. It should be true only if domains list is empty.
+func (o LookupNameserverGroupResultOutput) Primary() pulumi.BoolOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) bool { return v.Primary }).(pulumi.BoolOutput)
+}
+
+// SearchDomainsEnabled Search domain status for match domains. It should be true only if domains list is not empty.
+func (o LookupNameserverGroupResultOutput) SearchDomainsEnabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v LookupNameserverGroupResult) bool { return v.SearchDomainsEnabled }).(pulumi.BoolOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupNameserverGroupResultOutput{})
+}
@@ -0,0 +1,95 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package function
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Look up an existing NetBird network by name and return it in full. Fails when no network or more than one network matches.
+func LookupNetwork(ctx *pulumi.Context, args *LookupNetworkArgs, opts ...pulumi.InvokeOption) (*LookupNetworkResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupNetworkResult
+	err := ctx.Invoke("netbird:function:lookupNetwork", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupNetworkArgs struct {
+	// The name of the network to look up.
+	Name string `pulumi:"name"`
+}
+
+type LookupNetworkResult struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// An optional description of the network.
+	Description *string `pulumi:"description"`
+	// The name of the NetBird network.
+	Name string `pulumi:"name"`
+	// The network ID.
+	NetworkId string `pulumi:"networkId"`
+}
+
+func LookupNetworkOutput(ctx *pulumi.Context, args LookupNetworkOutputArgs, opts ...pulumi.InvokeOption) LookupNetworkResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (LookupNetworkResultOutput, error) {
+			args := v.(LookupNetworkArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netbird:function:lookupNetwork", args, LookupNetworkResultOutput{}, options).(LookupNetworkResultOutput), nil
+		}).(LookupNetworkResultOutput)
+}
+
+type LookupNetworkOutputArgs struct {
+	// The name of the network to look up.
+	Name pulumi.StringInput `pulumi:"name"`
+}
+
+func (LookupNetworkOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupNetworkArgs)(nil)).Elem()
+}
+
+type LookupNetworkResultOutput struct{ *pulumi.OutputState }
+
+func (LookupNetworkResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupNetworkResult)(nil)).Elem()
+}
+
+func (o LookupNetworkResultOutput) ToLookupNetworkResultOutput() LookupNetworkResultOutput {
+	return o
+}
+
+func (o LookupNetworkResultOutput) ToLookupNetworkResultOutputWithContext(ctx context.Context) LookupNetworkResultOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o LookupNetworkResultOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupNetworkResult) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// An optional description of the network.
+func (o LookupNetworkResultOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupNetworkResult) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// The name of the NetBird network.
+func (o LookupNetworkResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupNetworkResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The network ID.
+func (o LookupNetworkResultOutput) NetworkId() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupNetworkResult) string { return v.NetworkId }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupNetworkResultOutput{})
+}
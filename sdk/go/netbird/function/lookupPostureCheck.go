@@ -0,0 +1,103 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package function
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Look up an existing NetBird posture check by name and return it in full. Fails when no posture check or more than one posture check matches.
+func LookupPostureCheck(ctx *pulumi.Context, args *LookupPostureCheckArgs, opts ...pulumi.InvokeOption) (*LookupPostureCheckResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupPostureCheckResult
+	err := ctx.Invoke("netbird:function:lookupPostureCheck", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupPostureCheckArgs struct {
+	// The name of the posture check to look up.
+	Name string `pulumi:"name"`
+}
+
+type LookupPostureCheckResult struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// List of checks to perform against peer properties.
+	Checks resource.PostureChecksConfig `pulumi:"checks"`
+	// Posture check friendly description.
+	Description *string `pulumi:"description"`
+	// Posture check unique name identifier.
+	Name string `pulumi:"name"`
+	// The posture check ID.
+	PostureCheckId string `pulumi:"postureCheckId"`
+}
+
+func LookupPostureCheckOutput(ctx *pulumi.Context, args LookupPostureCheckOutputArgs, opts ...pulumi.InvokeOption) LookupPostureCheckResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (LookupPostureCheckResultOutput, error) {
+			args := v.(LookupPostureCheckArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netbird:function:lookupPostureCheck", args, LookupPostureCheckResultOutput{}, options).(LookupPostureCheckResultOutput), nil
+		}).(LookupPostureCheckResultOutput)
+}
+
+type LookupPostureCheckOutputArgs struct {
+	// The name of the posture check to look up.
+	Name pulumi.StringInput `pulumi:"name"`
+}
+
+func (LookupPostureCheckOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupPostureCheckArgs)(nil)).Elem()
+}
+
+type LookupPostureCheckResultOutput struct{ *pulumi.OutputState }
+
+func (LookupPostureCheckResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupPostureCheckResult)(nil)).Elem()
+}
+
+func (o LookupPostureCheckResultOutput) ToLookupPostureCheckResultOutput() LookupPostureCheckResultOutput {
+	return o
+}
+
+func (o LookupPostureCheckResultOutput) ToLookupPostureCheckResultOutputWithContext(ctx context.Context) LookupPostureCheckResultOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o LookupPostureCheckResultOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupPostureCheckResult) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// List of checks to perform against peer properties.
+func (o LookupPostureCheckResultOutput) Checks() resource.PostureChecksConfigOutput {
+	return o.ApplyT(func(v LookupPostureCheckResult) resource.PostureChecksConfig { return v.Checks }).(resource.PostureChecksConfigOutput)
+}
+
+// Posture check friendly description.
+func (o LookupPostureCheckResultOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupPostureCheckResult) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// Posture check unique name identifier.
+func (o LookupPostureCheckResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupPostureCheckResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The posture check ID.
+func (o LookupPostureCheckResultOutput) PostureCheckId() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupPostureCheckResult) string { return v.PostureCheckId }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupPostureCheckResultOutput{})
+}
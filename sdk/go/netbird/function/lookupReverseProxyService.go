@@ -0,0 +1,196 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package function
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Look up an existing NetBird reverse proxy service by domain and return it in full. Fails when no reverse proxy service or more than one reverse proxy service matches.
+func LookupReverseProxyService(ctx *pulumi.Context, args *LookupReverseProxyServiceArgs, opts ...pulumi.InvokeOption) (*LookupReverseProxyServiceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupReverseProxyServiceResult
+	err := ctx.Invoke("netbird:function:lookupReverseProxyService", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupReverseProxyServiceArgs struct {
+	// The domain of the reverse proxy service to look up.
+	Domain string `pulumi:"domain"`
+}
+
+type LookupReverseProxyServiceResult struct {
+	// NetBird group IDs whose peers may reach this private service over the tunnel. Required when private=true; ignored otherwise.
+	AccessGroups []string `pulumi:"accessGroups"`
+	// Connection-level access restrictions based on IP address or geography.
+	AccessRestrictions *resource.ReverseProxyAccessRestrictions `pulumi:"accessRestrictions"`
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Authentication configuration for the service.
+	Auth *resource.ReverseProxyAuth `pulumi:"auth"`
+	// Domain for the service.
+	Domain string `pulumi:"domain"`
+	// Whether the service is enabled.
+	Enabled bool `pulumi:"enabled"`
+	// Port the proxy listens on (L4/TLS only).
+	ListenPort *int `pulumi:"listenPort"`
+	// Service mode: "http" for L7 reverse proxy, "tcp"/"udp"/"tls" for L4 passthrough.
+	Mode *resource.ReverseProxyServiceMode `pulumi:"mode"`
+	// Service name.
+	Name string `pulumi:"name"`
+	// When true, the original client Host header is passed through to the backend.
+	PassHostHeader *bool `pulumi:"passHostHeader"`
+	// Whether the listen port was auto-assigned.
+	PortAutoAssigned *bool `pulumi:"portAutoAssigned"`
+	// When true, the service is NetBird-only: peers authenticate via WireGuard tunnel identity and an ACL policy is auto-generated from accessGroups. Requires mode=http. Mutually exclusive with SSO/bearer auth.
+	Private *bool `pulumi:"private"`
+	// The proxy cluster handling this service (derived from domain).
+	ProxyCluster *string `pulumi:"proxyCluster"`
+	// When true, Location headers in backend responses are rewritten to the public-facing domain.
+	RewriteRedirects *bool `pulumi:"rewriteRedirects"`
+	// The reverse proxy service ID.
+	ServiceId string `pulumi:"serviceId"`
+	// Current status of the service.
+	Status *resource.ReverseProxyServiceStatus `pulumi:"status"`
+	// List of target backends for this service.
+	Targets []resource.ReverseProxyTarget `pulumi:"targets"`
+	// Whether the service has been terminated. Terminated services cannot be updated.
+	Terminated *bool `pulumi:"terminated"`
+}
+
+func LookupReverseProxyServiceOutput(ctx *pulumi.Context, args LookupReverseProxyServiceOutputArgs, opts ...pulumi.InvokeOption) LookupReverseProxyServiceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (LookupReverseProxyServiceResultOutput, error) {
+			args := v.(LookupReverseProxyServiceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netbird:function:lookupReverseProxyService", args, LookupReverseProxyServiceResultOutput{}, options).(LookupReverseProxyServiceResultOutput), nil
+		}).(LookupReverseProxyServiceResultOutput)
+}
+
+type LookupReverseProxyServiceOutputArgs struct {
+	// The domain of the reverse proxy service to look up.
+	Domain pulumi.StringInput `pulumi:"domain"`
+}
+
+func (LookupReverseProxyServiceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupReverseProxyServiceArgs)(nil)).Elem()
+}
+
+type LookupReverseProxyServiceResultOutput struct{ *pulumi.OutputState }
+
+func (LookupReverseProxyServiceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupReverseProxyServiceResult)(nil)).Elem()
+}
+
+func (o LookupReverseProxyServiceResultOutput) ToLookupReverseProxyServiceResultOutput() LookupReverseProxyServiceResultOutput {
+	return o
+}
+
+func (o LookupReverseProxyServiceResultOutput) ToLookupReverseProxyServiceResultOutputWithContext(ctx context.Context) LookupReverseProxyServiceResultOutput {
+	return o
+}
+
+// NetBird group IDs whose peers may reach this private service over the tunnel. Required when private=true; ignored otherwise.
+func (o LookupReverseProxyServiceResultOutput) AccessGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) []string { return v.AccessGroups }).(pulumi.StringArrayOutput)
+}
+
+// Connection-level access restrictions based on IP address or geography.
+func (o LookupReverseProxyServiceResultOutput) AccessRestrictions() resource.ReverseProxyAccessRestrictionsPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *resource.ReverseProxyAccessRestrictions {
+		return v.AccessRestrictions
+	}).(resource.ReverseProxyAccessRestrictionsPtrOutput)
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o LookupReverseProxyServiceResultOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Authentication configuration for the service.
+func (o LookupReverseProxyServiceResultOutput) Auth() resource.ReverseProxyAuthPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *resource.ReverseProxyAuth { return v.Auth }).(resource.ReverseProxyAuthPtrOutput)
+}
+
+// Domain for the service.
+func (o LookupReverseProxyServiceResultOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) string { return v.Domain }).(pulumi.StringOutput)
+}
+
+// Whether the service is enabled.
+func (o LookupReverseProxyServiceResultOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// Port the proxy listens on (L4/TLS only).
+func (o LookupReverseProxyServiceResultOutput) ListenPort() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *int { return v.ListenPort }).(pulumi.IntPtrOutput)
+}
+
+// Service mode: "http" for L7 reverse proxy, "tcp"/"udp"/"tls" for L4 passthrough.
+func (o LookupReverseProxyServiceResultOutput) Mode() resource.ReverseProxyServiceModePtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *resource.ReverseProxyServiceMode { return v.Mode }).(resource.ReverseProxyServiceModePtrOutput)
+}
+
+// Service name.
+func (o LookupReverseProxyServiceResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// When true, the original client Host header is passed through to the backend.
+func (o LookupReverseProxyServiceResultOutput) PassHostHeader() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *bool { return v.PassHostHeader }).(pulumi.BoolPtrOutput)
+}
+
+// Whether the listen port was auto-assigned.
+func (o LookupReverseProxyServiceResultOutput) PortAutoAssigned() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *bool { return v.PortAutoAssigned }).(pulumi.BoolPtrOutput)
+}
+
+// When true, the service is NetBird-only: peers authenticate via WireGuard tunnel identity and an ACL policy is auto-generated from accessGroups. Requires mode=http. Mutually exclusive with SSO/bearer auth.
+func (o LookupReverseProxyServiceResultOutput) Private() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *bool { return v.Private }).(pulumi.BoolPtrOutput)
+}
+
+// The proxy cluster handling this service (derived from domain).
+func (o LookupReverseProxyServiceResultOutput) ProxyCluster() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *string { return v.ProxyCluster }).(pulumi.StringPtrOutput)
+}
+
+// When true, Location headers in backend responses are rewritten to the public-facing domain.
+func (o LookupReverseProxyServiceResultOutput) RewriteRedirects() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *bool { return v.RewriteRedirects }).(pulumi.BoolPtrOutput)
+}
+
+// The reverse proxy service ID.
+func (o LookupReverseProxyServiceResultOutput) ServiceId() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) string { return v.ServiceId }).(pulumi.StringOutput)
+}
+
+// Current status of the service.
+func (o LookupReverseProxyServiceResultOutput) Status() resource.ReverseProxyServiceStatusPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *resource.ReverseProxyServiceStatus { return v.Status }).(resource.ReverseProxyServiceStatusPtrOutput)
+}
+
+// List of target backends for this service.
+func (o LookupReverseProxyServiceResultOutput) Targets() resource.ReverseProxyTargetArrayOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) []resource.ReverseProxyTarget { return v.Targets }).(resource.ReverseProxyTargetArrayOutput)
+}
+
+// Whether the service has been terminated. Terminated services cannot be updated.
+func (o LookupReverseProxyServiceResultOutput) Terminated() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v LookupReverseProxyServiceResult) *bool { return v.Terminated }).(pulumi.BoolPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupReverseProxyServiceResultOutput{})
+}
@@ -8,11 +8,84 @@ import (
 	"reflect"
 
 	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
 var _ = internal.GetEnvOrDefault
 
+type CapabilitySummary struct {
+	// Whether the feature is only available on NetBird Cloud.
+	CloudOnly bool `pulumi:"cloudOnly"`
+	// Minimum management server version required by the feature, if any.
+	MinVersion *string `pulumi:"minVersion"`
+	// Human-readable feature name.
+	Name string `pulumi:"name"`
+	// Why the feature is unsupported. Unset when supported.
+	Reason *string `pulumi:"reason"`
+	// Whether the configured server supports the feature. Unknown versions are assumed to support it.
+	Supported bool `pulumi:"supported"`
+}
+
+type CapabilitySummaryOutput struct{ *pulumi.OutputState }
+
+func (CapabilitySummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CapabilitySummary)(nil)).Elem()
+}
+
+func (o CapabilitySummaryOutput) ToCapabilitySummaryOutput() CapabilitySummaryOutput {
+	return o
+}
+
+func (o CapabilitySummaryOutput) ToCapabilitySummaryOutputWithContext(ctx context.Context) CapabilitySummaryOutput {
+	return o
+}
+
+// Whether the feature is only available on NetBird Cloud.
+func (o CapabilitySummaryOutput) CloudOnly() pulumi.BoolOutput {
+	return o.ApplyT(func(v CapabilitySummary) bool { return v.CloudOnly }).(pulumi.BoolOutput)
+}
+
+// Minimum management server version required by the feature, if any.
+func (o CapabilitySummaryOutput) MinVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CapabilitySummary) *string { return v.MinVersion }).(pulumi.StringPtrOutput)
+}
+
+// Human-readable feature name.
+func (o CapabilitySummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v CapabilitySummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Why the feature is unsupported. Unset when supported.
+func (o CapabilitySummaryOutput) Reason() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CapabilitySummary) *string { return v.Reason }).(pulumi.StringPtrOutput)
+}
+
+// Whether the configured server supports the feature. Unknown versions are assumed to support it.
+func (o CapabilitySummaryOutput) Supported() pulumi.BoolOutput {
+	return o.ApplyT(func(v CapabilitySummary) bool { return v.Supported }).(pulumi.BoolOutput)
+}
+
+type CapabilitySummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (CapabilitySummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CapabilitySummary)(nil)).Elem()
+}
+
+func (o CapabilitySummaryArrayOutput) ToCapabilitySummaryArrayOutput() CapabilitySummaryArrayOutput {
+	return o
+}
+
+func (o CapabilitySummaryArrayOutput) ToCapabilitySummaryArrayOutputWithContext(ctx context.Context) CapabilitySummaryArrayOutput {
+	return o
+}
+
+func (o CapabilitySummaryArrayOutput) Index(i pulumi.IntInput) CapabilitySummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CapabilitySummary {
+		return vs[0].([]CapabilitySummary)[vs[1].(int)]
+	}).(CapabilitySummaryOutput)
+}
+
 type City struct {
 	// Commonly used English name of the city.
 	CityName string `pulumi:"cityName"`
@@ -115,252 +188,2149 @@ func (o CountryArrayOutput) Index(i pulumi.IntInput) CountryOutput {
 	}).(CountryOutput)
 }
 
-type PeerSummary struct {
-	// Whether the peer is currently connected to the management server.
-	Connected bool `pulumi:"connected"`
-	// The DNS label used to form the peer's FQDN.
-	DnsLabel string `pulumi:"dnsLabel"`
-	// IDs of groups the peer belongs to.
-	Groups []string `pulumi:"groups"`
-	// The OS hostname of the machine.
-	Hostname string `pulumi:"hostname"`
-	// The peer ID.
+type DNSRecordSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// DNS record content (IP address for A/AAAA, domain for CNAME).
+	Content string `pulumi:"content"`
+	// The DNS record ID.
 	Id string `pulumi:"id"`
-	// The WireGuard IP address assigned to the peer.
-	Ip string `pulumi:"ip"`
-	// The peer name.
+	// FQDN for the DNS record. Must be a subdomain within or match the zone's domain.
 	Name string `pulumi:"name"`
+	// Time to live in seconds.
+	Ttl int `pulumi:"ttl"`
+	// DNS record type.
+	Type resource.DNSRecordType `pulumi:"type"`
+	// ID of the DNS zone this record belongs to.
+	ZoneID string `pulumi:"zoneID"`
 }
 
-type PeerSummaryOutput struct{ *pulumi.OutputState }
+type DNSRecordSummaryOutput struct{ *pulumi.OutputState }
 
-func (PeerSummaryOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*PeerSummary)(nil)).Elem()
+func (DNSRecordSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSRecordSummary)(nil)).Elem()
 }
 
-func (o PeerSummaryOutput) ToPeerSummaryOutput() PeerSummaryOutput {
+func (o DNSRecordSummaryOutput) ToDNSRecordSummaryOutput() DNSRecordSummaryOutput {
 	return o
 }
 
-func (o PeerSummaryOutput) ToPeerSummaryOutputWithContext(ctx context.Context) PeerSummaryOutput {
+func (o DNSRecordSummaryOutput) ToDNSRecordSummaryOutputWithContext(ctx context.Context) DNSRecordSummaryOutput {
 	return o
 }
 
-// Whether the peer is currently connected to the management server.
-func (o PeerSummaryOutput) Connected() pulumi.BoolOutput {
-	return o.ApplyT(func(v PeerSummary) bool { return v.Connected }).(pulumi.BoolOutput)
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o DNSRecordSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DNSRecordSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
 }
 
-// The DNS label used to form the peer's FQDN.
-func (o PeerSummaryOutput) DnsLabel() pulumi.StringOutput {
-	return o.ApplyT(func(v PeerSummary) string { return v.DnsLabel }).(pulumi.StringOutput)
+// DNS record content (IP address for A/AAAA, domain for CNAME).
+func (o DNSRecordSummaryOutput) Content() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordSummary) string { return v.Content }).(pulumi.StringOutput)
 }
 
-// IDs of groups the peer belongs to.
-func (o PeerSummaryOutput) Groups() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v PeerSummary) []string { return v.Groups }).(pulumi.StringArrayOutput)
+// The DNS record ID.
+func (o DNSRecordSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordSummary) string { return v.Id }).(pulumi.StringOutput)
 }
 
-// The OS hostname of the machine.
-func (o PeerSummaryOutput) Hostname() pulumi.StringOutput {
-	return o.ApplyT(func(v PeerSummary) string { return v.Hostname }).(pulumi.StringOutput)
+// FQDN for the DNS record. Must be a subdomain within or match the zone's domain.
+func (o DNSRecordSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordSummary) string { return v.Name }).(pulumi.StringOutput)
 }
 
-// The peer ID.
-func (o PeerSummaryOutput) Id() pulumi.StringOutput {
-	return o.ApplyT(func(v PeerSummary) string { return v.Id }).(pulumi.StringOutput)
+// Time to live in seconds.
+func (o DNSRecordSummaryOutput) Ttl() pulumi.IntOutput {
+	return o.ApplyT(func(v DNSRecordSummary) int { return v.Ttl }).(pulumi.IntOutput)
 }
 
-// The WireGuard IP address assigned to the peer.
-func (o PeerSummaryOutput) Ip() pulumi.StringOutput {
-	return o.ApplyT(func(v PeerSummary) string { return v.Ip }).(pulumi.StringOutput)
+// DNS record type.
+func (o DNSRecordSummaryOutput) Type() resource.DNSRecordTypeOutput {
+	return o.ApplyT(func(v DNSRecordSummary) resource.DNSRecordType { return v.Type }).(resource.DNSRecordTypeOutput)
 }
 
-// The peer name.
-func (o PeerSummaryOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v PeerSummary) string { return v.Name }).(pulumi.StringOutput)
+// ID of the DNS zone this record belongs to.
+func (o DNSRecordSummaryOutput) ZoneID() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordSummary) string { return v.ZoneID }).(pulumi.StringOutput)
 }
 
-type PeerSummaryArrayOutput struct{ *pulumi.OutputState }
+type DNSRecordSummaryArrayOutput struct{ *pulumi.OutputState }
 
-func (PeerSummaryArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]PeerSummary)(nil)).Elem()
+func (DNSRecordSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSRecordSummary)(nil)).Elem()
 }
 
-func (o PeerSummaryArrayOutput) ToPeerSummaryArrayOutput() PeerSummaryArrayOutput {
+func (o DNSRecordSummaryArrayOutput) ToDNSRecordSummaryArrayOutput() DNSRecordSummaryArrayOutput {
 	return o
 }
 
-func (o PeerSummaryArrayOutput) ToPeerSummaryArrayOutputWithContext(ctx context.Context) PeerSummaryArrayOutput {
+func (o DNSRecordSummaryArrayOutput) ToDNSRecordSummaryArrayOutputWithContext(ctx context.Context) DNSRecordSummaryArrayOutput {
 	return o
 }
 
-func (o PeerSummaryArrayOutput) Index(i pulumi.IntInput) PeerSummaryOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) PeerSummary {
-		return vs[0].([]PeerSummary)[vs[1].(int)]
-	}).(PeerSummaryOutput)
+func (o DNSRecordSummaryArrayOutput) Index(i pulumi.IntInput) DNSRecordSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DNSRecordSummary {
+		return vs[0].([]DNSRecordSummary)[vs[1].(int)]
+	}).(DNSRecordSummaryOutput)
 }
 
-type ProxyClusterSummary struct {
-	// Cluster address used for CNAME targets; the value to pass as a ReverseProxyDomain targetCluster.
-	Address string `pulumi:"address"`
-	// Number of proxy nodes currently connected.
-	ConnectedProxies int `pulumi:"connectedProxies"`
-	// Unique identifier of the proxy cluster.
+type DNSZoneSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Group IDs that define groups of peers that will resolve this zone.
+	DistributionGroups []string `pulumi:"distributionGroups"`
+	// Zone domain (FQDN).
+	Domain string `pulumi:"domain"`
+	// Enable this zone as a search domain.
+	EnableSearchDomain bool `pulumi:"enableSearchDomain"`
+	// Zone status.
+	Enabled bool `pulumi:"enabled"`
+	// The DNS zone ID.
 	Id string `pulumi:"id"`
-	// Whether at least one proxy in the cluster has heartbeated within the active window.
-	Online bool `pulumi:"online"`
-	// True when at least one connected proxy is embedded in a netbird client and serving over a WireGuard tunnel.
-	Private bool `pulumi:"private"`
-	// Whether services on this cluster must include a subdomain label.
-	RequireSubdomain bool `pulumi:"requireSubdomain"`
-	// Whether all active proxies in the cluster have CrowdSec configured.
-	SupportsCrowdsec bool `pulumi:"supportsCrowdsec"`
-	// Whether the cluster supports binding arbitrary TCP/UDP ports.
-	SupportsCustomPorts bool `pulumi:"supportsCustomPorts"`
-	// Source of the cluster: 'account' (BYOP) or 'shared' (operated by NetBird).
-	Type string `pulumi:"type"`
+	// Zone name identifier.
+	Name string `pulumi:"name"`
 }
 
-type ProxyClusterSummaryOutput struct{ *pulumi.OutputState }
+type DNSZoneSummaryOutput struct{ *pulumi.OutputState }
 
-func (ProxyClusterSummaryOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*ProxyClusterSummary)(nil)).Elem()
+func (DNSZoneSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSZoneSummary)(nil)).Elem()
 }
 
-func (o ProxyClusterSummaryOutput) ToProxyClusterSummaryOutput() ProxyClusterSummaryOutput {
+func (o DNSZoneSummaryOutput) ToDNSZoneSummaryOutput() DNSZoneSummaryOutput {
 	return o
 }
 
-func (o ProxyClusterSummaryOutput) ToProxyClusterSummaryOutputWithContext(ctx context.Context) ProxyClusterSummaryOutput {
+func (o DNSZoneSummaryOutput) ToDNSZoneSummaryOutputWithContext(ctx context.Context) DNSZoneSummaryOutput {
 	return o
 }
 
-// Cluster address used for CNAME targets; the value to pass as a ReverseProxyDomain targetCluster.
-func (o ProxyClusterSummaryOutput) Address() pulumi.StringOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) string { return v.Address }).(pulumi.StringOutput)
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o DNSZoneSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DNSZoneSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
 }
 
-// Number of proxy nodes currently connected.
-func (o ProxyClusterSummaryOutput) ConnectedProxies() pulumi.IntOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) int { return v.ConnectedProxies }).(pulumi.IntOutput)
+// Group IDs that define groups of peers that will resolve this zone.
+func (o DNSZoneSummaryOutput) DistributionGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v DNSZoneSummary) []string { return v.DistributionGroups }).(pulumi.StringArrayOutput)
 }
 
-// Unique identifier of the proxy cluster.
-func (o ProxyClusterSummaryOutput) Id() pulumi.StringOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) string { return v.Id }).(pulumi.StringOutput)
+// Zone domain (FQDN).
+func (o DNSZoneSummaryOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSZoneSummary) string { return v.Domain }).(pulumi.StringOutput)
 }
 
-// Whether at least one proxy in the cluster has heartbeated within the active window.
-func (o ProxyClusterSummaryOutput) Online() pulumi.BoolOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.Online }).(pulumi.BoolOutput)
+// Enable this zone as a search domain.
+func (o DNSZoneSummaryOutput) EnableSearchDomain() pulumi.BoolOutput {
+	return o.ApplyT(func(v DNSZoneSummary) bool { return v.EnableSearchDomain }).(pulumi.BoolOutput)
 }
 
-// True when at least one connected proxy is embedded in a netbird client and serving over a WireGuard tunnel.
-func (o ProxyClusterSummaryOutput) Private() pulumi.BoolOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.Private }).(pulumi.BoolOutput)
+// Zone status.
+func (o DNSZoneSummaryOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v DNSZoneSummary) bool { return v.Enabled }).(pulumi.BoolOutput)
 }
 
-// Whether services on this cluster must include a subdomain label.
-func (o ProxyClusterSummaryOutput) RequireSubdomain() pulumi.BoolOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.RequireSubdomain }).(pulumi.BoolOutput)
+// The DNS zone ID.
+func (o DNSZoneSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSZoneSummary) string { return v.Id }).(pulumi.StringOutput)
 }
 
-// Whether all active proxies in the cluster have CrowdSec configured.
-func (o ProxyClusterSummaryOutput) SupportsCrowdsec() pulumi.BoolOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.SupportsCrowdsec }).(pulumi.BoolOutput)
+// Zone name identifier.
+func (o DNSZoneSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSZoneSummary) string { return v.Name }).(pulumi.StringOutput)
 }
 
-// Whether the cluster supports binding arbitrary TCP/UDP ports.
-func (o ProxyClusterSummaryOutput) SupportsCustomPorts() pulumi.BoolOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.SupportsCustomPorts }).(pulumi.BoolOutput)
+type DNSZoneSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSZoneSummary)(nil)).Elem()
 }
 
-// Source of the cluster: 'account' (BYOP) or 'shared' (operated by NetBird).
-func (o ProxyClusterSummaryOutput) Type() pulumi.StringOutput {
-	return o.ApplyT(func(v ProxyClusterSummary) string { return v.Type }).(pulumi.StringOutput)
+func (o DNSZoneSummaryArrayOutput) ToDNSZoneSummaryArrayOutput() DNSZoneSummaryArrayOutput {
+	return o
 }
 
-type ProxyClusterSummaryArrayOutput struct{ *pulumi.OutputState }
+func (o DNSZoneSummaryArrayOutput) ToDNSZoneSummaryArrayOutputWithContext(ctx context.Context) DNSZoneSummaryArrayOutput {
+	return o
+}
 
-func (ProxyClusterSummaryArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ProxyClusterSummary)(nil)).Elem()
+func (o DNSZoneSummaryArrayOutput) Index(i pulumi.IntInput) DNSZoneSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DNSZoneSummary {
+		return vs[0].([]DNSZoneSummary)[vs[1].(int)]
+	}).(DNSZoneSummaryOutput)
 }
 
-func (o ProxyClusterSummaryArrayOutput) ToProxyClusterSummaryArrayOutput() ProxyClusterSummaryArrayOutput {
+type GroupSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// The group ID.
+	Id string `pulumi:"id"`
+	// The name of the NetBird group.
+	Name string `pulumi:"name"`
+	// An optional list of peer IDs associated with this group.
+	Peers []string `pulumi:"peers"`
+	// An optional list of resources to associate with this group.
+	Resources []resource.Resource `pulumi:"resources"`
+}
+
+type GroupSummaryOutput struct{ *pulumi.OutputState }
+
+func (GroupSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupSummary)(nil)).Elem()
+}
+
+func (o GroupSummaryOutput) ToGroupSummaryOutput() GroupSummaryOutput {
 	return o
 }
 
-func (o ProxyClusterSummaryArrayOutput) ToProxyClusterSummaryArrayOutputWithContext(ctx context.Context) ProxyClusterSummaryArrayOutput {
+func (o GroupSummaryOutput) ToGroupSummaryOutputWithContext(ctx context.Context) GroupSummaryOutput {
 	return o
 }
 
-func (o ProxyClusterSummaryArrayOutput) Index(i pulumi.IntInput) ProxyClusterSummaryOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ProxyClusterSummary {
-		return vs[0].([]ProxyClusterSummary)[vs[1].(int)]
-	}).(ProxyClusterSummaryOutput)
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o GroupSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GroupSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
 }
 
-type ResourceRef struct {
-	// The unique identifier of the resource.
+// The group ID.
+func (o GroupSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v GroupSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// The name of the NetBird group.
+func (o GroupSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v GroupSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// An optional list of peer IDs associated with this group.
+func (o GroupSummaryOutput) Peers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v GroupSummary) []string { return v.Peers }).(pulumi.StringArrayOutput)
+}
+
+// An optional list of resources to associate with this group.
+func (o GroupSummaryOutput) Resources() resource.ResourceArrayOutput {
+	return o.ApplyT(func(v GroupSummary) []resource.Resource { return v.Resources }).(resource.ResourceArrayOutput)
+}
+
+type GroupSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (GroupSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]GroupSummary)(nil)).Elem()
+}
+
+func (o GroupSummaryArrayOutput) ToGroupSummaryArrayOutput() GroupSummaryArrayOutput {
+	return o
+}
+
+func (o GroupSummaryArrayOutput) ToGroupSummaryArrayOutputWithContext(ctx context.Context) GroupSummaryArrayOutput {
+	return o
+}
+
+func (o GroupSummaryArrayOutput) Index(i pulumi.IntInput) GroupSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) GroupSummary {
+		return vs[0].([]GroupSummary)[vs[1].(int)]
+	}).(GroupSummaryOutput)
+}
+
+type IdentityProviderSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// OAuth2 client ID.
+	ClientId string `pulumi:"clientId"`
+	// OAuth2 client secret. Not returned by the API; preserved from configuration.
+	ClientSecret string `pulumi:"clientSecret"`
+	// The identity provider ID.
 	Id string `pulumi:"id"`
-	// The type of resource: 'domain', 'host', or 'subnet'.
-	Type string `pulumi:"type"`
+	// OIDC issuer URL.
+	Issuer string `pulumi:"issuer"`
+	// Human-readable name for the identity provider.
+	Name string `pulumi:"name"`
+	// Type of identity provider.
+	Type resource.IdentityProviderType `pulumi:"type"`
 }
 
-type ResourceRefOutput struct{ *pulumi.OutputState }
+type IdentityProviderSummaryOutput struct{ *pulumi.OutputState }
 
-func (ResourceRefOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*ResourceRef)(nil)).Elem()
+func (IdentityProviderSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*IdentityProviderSummary)(nil)).Elem()
 }
 
-func (o ResourceRefOutput) ToResourceRefOutput() ResourceRefOutput {
+func (o IdentityProviderSummaryOutput) ToIdentityProviderSummaryOutput() IdentityProviderSummaryOutput {
 	return o
 }
 
-func (o ResourceRefOutput) ToResourceRefOutputWithContext(ctx context.Context) ResourceRefOutput {
+func (o IdentityProviderSummaryOutput) ToIdentityProviderSummaryOutputWithContext(ctx context.Context) IdentityProviderSummaryOutput {
 	return o
 }
 
-// The unique identifier of the resource.
-func (o ResourceRefOutput) Id() pulumi.StringOutput {
-	return o.ApplyT(func(v ResourceRef) string { return v.Id }).(pulumi.StringOutput)
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o IdentityProviderSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v IdentityProviderSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
 }
 
-// The type of resource: 'domain', 'host', or 'subnet'.
-func (o ResourceRefOutput) Type() pulumi.StringOutput {
-	return o.ApplyT(func(v ResourceRef) string { return v.Type }).(pulumi.StringOutput)
+// OAuth2 client ID.
+func (o IdentityProviderSummaryOutput) ClientId() pulumi.StringOutput {
+	return o.ApplyT(func(v IdentityProviderSummary) string { return v.ClientId }).(pulumi.StringOutput)
 }
 
-type ResourceRefArrayOutput struct{ *pulumi.OutputState }
+// OAuth2 client secret. Not returned by the API; preserved from configuration.
+func (o IdentityProviderSummaryOutput) ClientSecret() pulumi.StringOutput {
+	return o.ApplyT(func(v IdentityProviderSummary) string { return v.ClientSecret }).(pulumi.StringOutput)
+}
 
-func (ResourceRefArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ResourceRef)(nil)).Elem()
+// The identity provider ID.
+func (o IdentityProviderSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v IdentityProviderSummary) string { return v.Id }).(pulumi.StringOutput)
 }
 
-func (o ResourceRefArrayOutput) ToResourceRefArrayOutput() ResourceRefArrayOutput {
+// OIDC issuer URL.
+func (o IdentityProviderSummaryOutput) Issuer() pulumi.StringOutput {
+	return o.ApplyT(func(v IdentityProviderSummary) string { return v.Issuer }).(pulumi.StringOutput)
+}
+
+// Human-readable name for the identity provider.
+func (o IdentityProviderSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v IdentityProviderSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Type of identity provider.
+func (o IdentityProviderSummaryOutput) Type() resource.IdentityProviderTypeOutput {
+	return o.ApplyT(func(v IdentityProviderSummary) resource.IdentityProviderType { return v.Type }).(resource.IdentityProviderTypeOutput)
+}
+
+type IdentityProviderSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (IdentityProviderSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]IdentityProviderSummary)(nil)).Elem()
+}
+
+func (o IdentityProviderSummaryArrayOutput) ToIdentityProviderSummaryArrayOutput() IdentityProviderSummaryArrayOutput {
 	return o
 }
 
-func (o ResourceRefArrayOutput) ToResourceRefArrayOutputWithContext(ctx context.Context) ResourceRefArrayOutput {
+func (o IdentityProviderSummaryArrayOutput) ToIdentityProviderSummaryArrayOutputWithContext(ctx context.Context) IdentityProviderSummaryArrayOutput {
 	return o
 }
 
-func (o ResourceRefArrayOutput) Index(i pulumi.IntInput) ResourceRefOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ResourceRef {
-		return vs[0].([]ResourceRef)[vs[1].(int)]
-	}).(ResourceRefOutput)
+func (o IdentityProviderSummaryArrayOutput) Index(i pulumi.IntInput) IdentityProviderSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) IdentityProviderSummary {
+		return vs[0].([]IdentityProviderSummary)[vs[1].(int)]
+	}).(IdentityProviderSummaryOutput)
+}
+
+type NameserverGroupSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Description of the nameserver group
+	Description string `pulumi:"description"`
+	// Domains Match domain list. It should be empty only if primary is true.
+	Domains []string `pulumi:"domains"`
+	// Enabled Nameserver group status
+	Enabled bool `pulumi:"enabled"`
+	// Groups Distribution group IDs that defines group of peers that will use this nameserver group
+	Groups []string `pulumi:"groups"`
+	// The nameserver group ID.
+	Id string `pulumi:"id"`
+	// Name of nameserver group name
+	Name string `pulumi:"name"`
+	// Nameservers Nameserver list
+	Nameservers []resource.Nameserver `pulumi:"nameservers"`
+	// Primary Defines if a nameserver group is primary that resolves all domains. It should be true only if domains list is empty.
+	Primary bool `pulumi:"primary"`
+	// SearchDomainsEnabled Search domain status for match domains. It should be true only if domains list is not empty.
+	SearchDomainsEnabled bool `pulumi:"searchDomainsEnabled"`
+}
+
+type NameserverGroupSummaryOutput struct{ *pulumi.OutputState }
+
+func (NameserverGroupSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NameserverGroupSummary)(nil)).Elem()
+}
+
+func (o NameserverGroupSummaryOutput) ToNameserverGroupSummaryOutput() NameserverGroupSummaryOutput {
+	return o
+}
+
+func (o NameserverGroupSummaryOutput) ToNameserverGroupSummaryOutputWithContext(ctx context.Context) NameserverGroupSummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o NameserverGroupSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Description of the nameserver group
+func (o NameserverGroupSummaryOutput) Description() pulumi.StringOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) string { return v.Description }).(pulumi.StringOutput)
+}
+
+// Domains Match domain list. It should be empty only if primary is true.
+func (o NameserverGroupSummaryOutput) Domains() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) []string { return v.Domains }).(pulumi.StringArrayOutput)
+}
+
+// Enabled Nameserver group status
+func (o NameserverGroupSummaryOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// Groups Distribution group IDs that defines group of peers that will use this nameserver group
+func (o NameserverGroupSummaryOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) []string { return v.Groups }).(pulumi.StringArrayOutput)
+}
+
+// The nameserver group ID.
+func (o NameserverGroupSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Name of nameserver group name
+func (o NameserverGroupSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Nameservers Nameserver list
+func (o NameserverGroupSummaryOutput) Nameservers() resource.NameserverArrayOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) []resource.Nameserver { return v.Nameservers }).(resource.NameserverArrayOutput)
+}
+
+// Primary Defines if a nameserver group is primary that resolves all domains. It should be true only if domains list is empty.
+func (o NameserverGroupSummaryOutput) Primary() pulumi.BoolOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) bool { return v.Primary }).(pulumi.BoolOutput)
+}
+
+// SearchDomainsEnabled Search domain status for match domains. It should be true only if domains list is not empty.
+func (o NameserverGroupSummaryOutput) SearchDomainsEnabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v NameserverGroupSummary) bool { return v.SearchDomainsEnabled }).(pulumi.BoolOutput)
+}
+
+type NameserverGroupSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (NameserverGroupSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NameserverGroupSummary)(nil)).Elem()
+}
+
+func (o NameserverGroupSummaryArrayOutput) ToNameserverGroupSummaryArrayOutput() NameserverGroupSummaryArrayOutput {
+	return o
+}
+
+func (o NameserverGroupSummaryArrayOutput) ToNameserverGroupSummaryArrayOutputWithContext(ctx context.Context) NameserverGroupSummaryArrayOutput {
+	return o
+}
+
+func (o NameserverGroupSummaryArrayOutput) Index(i pulumi.IntInput) NameserverGroupSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) NameserverGroupSummary {
+		return vs[0].([]NameserverGroupSummary)[vs[1].(int)]
+	}).(NameserverGroupSummaryOutput)
+}
+
+type NetworkResourceSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// CIDR or IP address block assigned to the resource.
+	Address string `pulumi:"address"`
+	// Optional description of the resource.
+	Description *string `pulumi:"description"`
+	// Whether the resource is enabled.
+	Enabled bool `pulumi:"enabled"`
+	// List of group IDs associated with this resource.
+	GroupIDs []string `pulumi:"groupIDs"`
+	// The network resource ID.
+	Id string `pulumi:"id"`
+	// Name of the network resource.
+	Name string `pulumi:"name"`
+	// ID of the network this resource belongs to.
+	NetworkID string `pulumi:"networkID"`
+}
+
+type NetworkResourceSummaryOutput struct{ *pulumi.OutputState }
+
+func (NetworkResourceSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkResourceSummary)(nil)).Elem()
+}
+
+func (o NetworkResourceSummaryOutput) ToNetworkResourceSummaryOutput() NetworkResourceSummaryOutput {
+	return o
+}
+
+func (o NetworkResourceSummaryOutput) ToNetworkResourceSummaryOutputWithContext(ctx context.Context) NetworkResourceSummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o NetworkResourceSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkResourceSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// CIDR or IP address block assigned to the resource.
+func (o NetworkResourceSummaryOutput) Address() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkResourceSummary) string { return v.Address }).(pulumi.StringOutput)
+}
+
+// Optional description of the resource.
+func (o NetworkResourceSummaryOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkResourceSummary) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// Whether the resource is enabled.
+func (o NetworkResourceSummaryOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v NetworkResourceSummary) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// List of group IDs associated with this resource.
+func (o NetworkResourceSummaryOutput) GroupIDs() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NetworkResourceSummary) []string { return v.GroupIDs }).(pulumi.StringArrayOutput)
+}
+
+// The network resource ID.
+func (o NetworkResourceSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkResourceSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Name of the network resource.
+func (o NetworkResourceSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkResourceSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// ID of the network this resource belongs to.
+func (o NetworkResourceSummaryOutput) NetworkID() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkResourceSummary) string { return v.NetworkID }).(pulumi.StringOutput)
+}
+
+type NetworkResourceSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (NetworkResourceSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkResourceSummary)(nil)).Elem()
+}
+
+func (o NetworkResourceSummaryArrayOutput) ToNetworkResourceSummaryArrayOutput() NetworkResourceSummaryArrayOutput {
+	return o
+}
+
+func (o NetworkResourceSummaryArrayOutput) ToNetworkResourceSummaryArrayOutputWithContext(ctx context.Context) NetworkResourceSummaryArrayOutput {
+	return o
+}
+
+func (o NetworkResourceSummaryArrayOutput) Index(i pulumi.IntInput) NetworkResourceSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) NetworkResourceSummary {
+		return vs[0].([]NetworkResourceSummary)[vs[1].(int)]
+	}).(NetworkResourceSummaryOutput)
+}
+
+type NetworkRouterSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Whether the router is enabled.
+	Enabled bool `pulumi:"enabled"`
+	// The network router ID.
+	Id string `pulumi:"id"`
+	// Whether masquerading is enabled.
+	Masquerade bool `pulumi:"masquerade"`
+	// Routing metric value.
+	Metric int `pulumi:"metric"`
+	// ID of the network this router belongs to.
+	NetworkID string `pulumi:"networkID"`
+	// Optional peer ID associated with this router.
+	Peer *string `pulumi:"peer"`
+	// Optional list of peer group IDs associated with this router.
+	PeerGroups []string `pulumi:"peerGroups"`
+}
+
+type NetworkRouterSummaryOutput struct{ *pulumi.OutputState }
+
+func (NetworkRouterSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkRouterSummary)(nil)).Elem()
+}
+
+func (o NetworkRouterSummaryOutput) ToNetworkRouterSummaryOutput() NetworkRouterSummaryOutput {
+	return o
+}
+
+func (o NetworkRouterSummaryOutput) ToNetworkRouterSummaryOutputWithContext(ctx context.Context) NetworkRouterSummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o NetworkRouterSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkRouterSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Whether the router is enabled.
+func (o NetworkRouterSummaryOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v NetworkRouterSummary) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// The network router ID.
+func (o NetworkRouterSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkRouterSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Whether masquerading is enabled.
+func (o NetworkRouterSummaryOutput) Masquerade() pulumi.BoolOutput {
+	return o.ApplyT(func(v NetworkRouterSummary) bool { return v.Masquerade }).(pulumi.BoolOutput)
+}
+
+// Routing metric value.
+func (o NetworkRouterSummaryOutput) Metric() pulumi.IntOutput {
+	return o.ApplyT(func(v NetworkRouterSummary) int { return v.Metric }).(pulumi.IntOutput)
+}
+
+// ID of the network this router belongs to.
+func (o NetworkRouterSummaryOutput) NetworkID() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkRouterSummary) string { return v.NetworkID }).(pulumi.StringOutput)
+}
+
+// Optional peer ID associated with this router.
+func (o NetworkRouterSummaryOutput) Peer() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkRouterSummary) *string { return v.Peer }).(pulumi.StringPtrOutput)
+}
+
+// Optional list of peer group IDs associated with this router.
+func (o NetworkRouterSummaryOutput) PeerGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v NetworkRouterSummary) []string { return v.PeerGroups }).(pulumi.StringArrayOutput)
+}
+
+type NetworkRouterSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (NetworkRouterSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkRouterSummary)(nil)).Elem()
+}
+
+func (o NetworkRouterSummaryArrayOutput) ToNetworkRouterSummaryArrayOutput() NetworkRouterSummaryArrayOutput {
+	return o
+}
+
+func (o NetworkRouterSummaryArrayOutput) ToNetworkRouterSummaryArrayOutputWithContext(ctx context.Context) NetworkRouterSummaryArrayOutput {
+	return o
+}
+
+func (o NetworkRouterSummaryArrayOutput) Index(i pulumi.IntInput) NetworkRouterSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) NetworkRouterSummary {
+		return vs[0].([]NetworkRouterSummary)[vs[1].(int)]
+	}).(NetworkRouterSummaryOutput)
+}
+
+type NetworkSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// An optional description of the network.
+	Description *string `pulumi:"description"`
+	// The network ID.
+	Id string `pulumi:"id"`
+	// The name of the NetBird network.
+	Name string `pulumi:"name"`
+}
+
+type NetworkSummaryOutput struct{ *pulumi.OutputState }
+
+func (NetworkSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*NetworkSummary)(nil)).Elem()
+}
+
+func (o NetworkSummaryOutput) ToNetworkSummaryOutput() NetworkSummaryOutput {
+	return o
+}
+
+func (o NetworkSummaryOutput) ToNetworkSummaryOutputWithContext(ctx context.Context) NetworkSummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o NetworkSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// An optional description of the network.
+func (o NetworkSummaryOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v NetworkSummary) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// The network ID.
+func (o NetworkSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// The name of the NetBird network.
+func (o NetworkSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v NetworkSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type NetworkSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (NetworkSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]NetworkSummary)(nil)).Elem()
+}
+
+func (o NetworkSummaryArrayOutput) ToNetworkSummaryArrayOutput() NetworkSummaryArrayOutput {
+	return o
+}
+
+func (o NetworkSummaryArrayOutput) ToNetworkSummaryArrayOutputWithContext(ctx context.Context) NetworkSummaryArrayOutput {
+	return o
+}
+
+func (o NetworkSummaryArrayOutput) Index(i pulumi.IntInput) NetworkSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) NetworkSummary {
+		return vs[0].([]NetworkSummary)[vs[1].(int)]
+	}).(NetworkSummaryOutput)
+}
+
+type PeerSummary struct {
+	// Whether the peer is currently connected to the management server.
+	Connected bool `pulumi:"connected"`
+	// The DNS label used to form the peer's FQDN.
+	DnsLabel string `pulumi:"dnsLabel"`
+	// IDs of groups the peer belongs to.
+	Groups []string `pulumi:"groups"`
+	// The OS hostname of the machine.
+	Hostname string `pulumi:"hostname"`
+	// The peer ID.
+	Id string `pulumi:"id"`
+	// The WireGuard IP address assigned to the peer.
+	Ip string `pulumi:"ip"`
+	// The IPv6 overlay address assigned to the peer, if any.
+	Ipv6 *string `pulumi:"ipv6"`
+	// The peer name.
+	Name string `pulumi:"name"`
+}
+
+type PeerSummaryOutput struct{ *pulumi.OutputState }
+
+func (PeerSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PeerSummary)(nil)).Elem()
+}
+
+func (o PeerSummaryOutput) ToPeerSummaryOutput() PeerSummaryOutput {
+	return o
+}
+
+func (o PeerSummaryOutput) ToPeerSummaryOutputWithContext(ctx context.Context) PeerSummaryOutput {
+	return o
+}
+
+// Whether the peer is currently connected to the management server.
+func (o PeerSummaryOutput) Connected() pulumi.BoolOutput {
+	return o.ApplyT(func(v PeerSummary) bool { return v.Connected }).(pulumi.BoolOutput)
+}
+
+// The DNS label used to form the peer's FQDN.
+func (o PeerSummaryOutput) DnsLabel() pulumi.StringOutput {
+	return o.ApplyT(func(v PeerSummary) string { return v.DnsLabel }).(pulumi.StringOutput)
+}
+
+// IDs of groups the peer belongs to.
+func (o PeerSummaryOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v PeerSummary) []string { return v.Groups }).(pulumi.StringArrayOutput)
+}
+
+// The OS hostname of the machine.
+func (o PeerSummaryOutput) Hostname() pulumi.StringOutput {
+	return o.ApplyT(func(v PeerSummary) string { return v.Hostname }).(pulumi.StringOutput)
+}
+
+// The peer ID.
+func (o PeerSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v PeerSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// The WireGuard IP address assigned to the peer.
+func (o PeerSummaryOutput) Ip() pulumi.StringOutput {
+	return o.ApplyT(func(v PeerSummary) string { return v.Ip }).(pulumi.StringOutput)
+}
+
+// The IPv6 overlay address assigned to the peer, if any.
+func (o PeerSummaryOutput) Ipv6() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PeerSummary) *string { return v.Ipv6 }).(pulumi.StringPtrOutput)
+}
+
+// The peer name.
+func (o PeerSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v PeerSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type PeerSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (PeerSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]PeerSummary)(nil)).Elem()
+}
+
+func (o PeerSummaryArrayOutput) ToPeerSummaryArrayOutput() PeerSummaryArrayOutput {
+	return o
+}
+
+func (o PeerSummaryArrayOutput) ToPeerSummaryArrayOutputWithContext(ctx context.Context) PeerSummaryArrayOutput {
+	return o
+}
+
+func (o PeerSummaryArrayOutput) Index(i pulumi.IntInput) PeerSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) PeerSummary {
+		return vs[0].([]PeerSummary)[vs[1].(int)]
+	}).(PeerSummaryOutput)
+}
+
+type PendingPeer struct {
+	// The public IP address the peer connected from.
+	ConnectionIp string `pulumi:"connectionIp"`
+	// IDs of groups the peer belongs to.
+	Groups []string `pulumi:"groups"`
+	// The OS hostname of the machine.
+	Hostname string `pulumi:"hostname"`
+	// The peer ID.
+	Id string `pulumi:"id"`
+	// When the peer was last seen by the management server (RFC 3339).
+	LastSeen string `pulumi:"lastSeen"`
+	// The peer name.
+	Name string `pulumi:"name"`
+	// The peer's operating system and version.
+	Os string `pulumi:"os"`
+}
+
+type PendingPeerOutput struct{ *pulumi.OutputState }
+
+func (PendingPeerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PendingPeer)(nil)).Elem()
+}
+
+func (o PendingPeerOutput) ToPendingPeerOutput() PendingPeerOutput {
+	return o
+}
+
+func (o PendingPeerOutput) ToPendingPeerOutputWithContext(ctx context.Context) PendingPeerOutput {
+	return o
+}
+
+// The public IP address the peer connected from.
+func (o PendingPeerOutput) ConnectionIp() pulumi.StringOutput {
+	return o.ApplyT(func(v PendingPeer) string { return v.ConnectionIp }).(pulumi.StringOutput)
+}
+
+// IDs of groups the peer belongs to.
+func (o PendingPeerOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v PendingPeer) []string { return v.Groups }).(pulumi.StringArrayOutput)
+}
+
+// The OS hostname of the machine.
+func (o PendingPeerOutput) Hostname() pulumi.StringOutput {
+	return o.ApplyT(func(v PendingPeer) string { return v.Hostname }).(pulumi.StringOutput)
+}
+
+// The peer ID.
+func (o PendingPeerOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v PendingPeer) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// When the peer was last seen by the management server (RFC 3339).
+func (o PendingPeerOutput) LastSeen() pulumi.StringOutput {
+	return o.ApplyT(func(v PendingPeer) string { return v.LastSeen }).(pulumi.StringOutput)
+}
+
+// The peer name.
+func (o PendingPeerOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v PendingPeer) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The peer's operating system and version.
+func (o PendingPeerOutput) Os() pulumi.StringOutput {
+	return o.ApplyT(func(v PendingPeer) string { return v.Os }).(pulumi.StringOutput)
+}
+
+type PendingPeerArrayOutput struct{ *pulumi.OutputState }
+
+func (PendingPeerArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]PendingPeer)(nil)).Elem()
+}
+
+func (o PendingPeerArrayOutput) ToPendingPeerArrayOutput() PendingPeerArrayOutput {
+	return o
+}
+
+func (o PendingPeerArrayOutput) ToPendingPeerArrayOutputWithContext(ctx context.Context) PendingPeerArrayOutput {
+	return o
+}
+
+func (o PendingPeerArrayOutput) Index(i pulumi.IntInput) PendingPeerOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) PendingPeer {
+		return vs[0].([]PendingPeer)[vs[1].(int)]
+	}).(PendingPeerOutput)
+}
+
+type PolicySummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Description Policy friendly description, optional
+	Description *string `pulumi:"description"`
+	// Enabled Policy status
+	Enabled bool `pulumi:"enabled"`
+	// The policy ID.
+	Id string `pulumi:"id"`
+	// Name Policy name identifier
+	Name string `pulumi:"name"`
+	// SourcePostureChecks Posture checks ID's applied to policy source groups, optional
+	PostureChecks []string `pulumi:"postureChecks"`
+	// Rules Policy rule object for policy UI editor
+	Rules []resource.PolicyRuleState `pulumi:"rules"`
+}
+
+type PolicySummaryOutput struct{ *pulumi.OutputState }
+
+func (PolicySummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PolicySummary)(nil)).Elem()
+}
+
+func (o PolicySummaryOutput) ToPolicySummaryOutput() PolicySummaryOutput {
+	return o
+}
+
+func (o PolicySummaryOutput) ToPolicySummaryOutputWithContext(ctx context.Context) PolicySummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o PolicySummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PolicySummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Description Policy friendly description, optional
+func (o PolicySummaryOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PolicySummary) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// Enabled Policy status
+func (o PolicySummaryOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v PolicySummary) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// The policy ID.
+func (o PolicySummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v PolicySummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Name Policy name identifier
+func (o PolicySummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v PolicySummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// SourcePostureChecks Posture checks ID's applied to policy source groups, optional
+func (o PolicySummaryOutput) PostureChecks() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v PolicySummary) []string { return v.PostureChecks }).(pulumi.StringArrayOutput)
+}
+
+// Rules Policy rule object for policy UI editor
+func (o PolicySummaryOutput) Rules() resource.PolicyRuleStateArrayOutput {
+	return o.ApplyT(func(v PolicySummary) []resource.PolicyRuleState { return v.Rules }).(resource.PolicyRuleStateArrayOutput)
+}
+
+type PolicySummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (PolicySummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]PolicySummary)(nil)).Elem()
+}
+
+func (o PolicySummaryArrayOutput) ToPolicySummaryArrayOutput() PolicySummaryArrayOutput {
+	return o
+}
+
+func (o PolicySummaryArrayOutput) ToPolicySummaryArrayOutputWithContext(ctx context.Context) PolicySummaryArrayOutput {
+	return o
+}
+
+func (o PolicySummaryArrayOutput) Index(i pulumi.IntInput) PolicySummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) PolicySummary {
+		return vs[0].([]PolicySummary)[vs[1].(int)]
+	}).(PolicySummaryOutput)
+}
+
+type PostureCheckSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// List of checks to perform against peer properties.
+	Checks resource.PostureChecksConfig `pulumi:"checks"`
+	// Posture check friendly description.
+	Description *string `pulumi:"description"`
+	// The posture check ID.
+	Id string `pulumi:"id"`
+	// Posture check unique name identifier.
+	Name string `pulumi:"name"`
+}
+
+type PostureCheckSummaryOutput struct{ *pulumi.OutputState }
+
+func (PostureCheckSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PostureCheckSummary)(nil)).Elem()
+}
+
+func (o PostureCheckSummaryOutput) ToPostureCheckSummaryOutput() PostureCheckSummaryOutput {
+	return o
+}
+
+func (o PostureCheckSummaryOutput) ToPostureCheckSummaryOutputWithContext(ctx context.Context) PostureCheckSummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o PostureCheckSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PostureCheckSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// List of checks to perform against peer properties.
+func (o PostureCheckSummaryOutput) Checks() resource.PostureChecksConfigOutput {
+	return o.ApplyT(func(v PostureCheckSummary) resource.PostureChecksConfig { return v.Checks }).(resource.PostureChecksConfigOutput)
+}
+
+// Posture check friendly description.
+func (o PostureCheckSummaryOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PostureCheckSummary) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// The posture check ID.
+func (o PostureCheckSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v PostureCheckSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Posture check unique name identifier.
+func (o PostureCheckSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v PostureCheckSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type PostureCheckSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (PostureCheckSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]PostureCheckSummary)(nil)).Elem()
+}
+
+func (o PostureCheckSummaryArrayOutput) ToPostureCheckSummaryArrayOutput() PostureCheckSummaryArrayOutput {
+	return o
+}
+
+func (o PostureCheckSummaryArrayOutput) ToPostureCheckSummaryArrayOutputWithContext(ctx context.Context) PostureCheckSummaryArrayOutput {
+	return o
+}
+
+func (o PostureCheckSummaryArrayOutput) Index(i pulumi.IntInput) PostureCheckSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) PostureCheckSummary {
+		return vs[0].([]PostureCheckSummary)[vs[1].(int)]
+	}).(PostureCheckSummaryOutput)
+}
+
+type ProxyClusterSummary struct {
+	// Cluster address used for CNAME targets; the value to pass as a ReverseProxyDomain targetCluster.
+	Address string `pulumi:"address"`
+	// Number of proxy nodes currently connected.
+	ConnectedProxies int `pulumi:"connectedProxies"`
+	// Unique identifier of the proxy cluster.
+	Id string `pulumi:"id"`
+	// Whether at least one proxy in the cluster has heartbeated within the active window.
+	Online bool `pulumi:"online"`
+	// True when at least one connected proxy is embedded in a netbird client and serving over a WireGuard tunnel.
+	Private bool `pulumi:"private"`
+	// Whether services on this cluster must include a subdomain label.
+	RequireSubdomain bool `pulumi:"requireSubdomain"`
+	// Whether all active proxies in the cluster have CrowdSec configured.
+	SupportsCrowdsec bool `pulumi:"supportsCrowdsec"`
+	// Whether the cluster supports binding arbitrary TCP/UDP ports.
+	SupportsCustomPorts bool `pulumi:"supportsCustomPorts"`
+	// Source of the cluster: 'account' (BYOP) or 'shared' (operated by NetBird).
+	Type string `pulumi:"type"`
+}
+
+type ProxyClusterSummaryOutput struct{ *pulumi.OutputState }
+
+func (ProxyClusterSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProxyClusterSummary)(nil)).Elem()
+}
+
+func (o ProxyClusterSummaryOutput) ToProxyClusterSummaryOutput() ProxyClusterSummaryOutput {
+	return o
+}
+
+func (o ProxyClusterSummaryOutput) ToProxyClusterSummaryOutputWithContext(ctx context.Context) ProxyClusterSummaryOutput {
+	return o
+}
+
+// Cluster address used for CNAME targets; the value to pass as a ReverseProxyDomain targetCluster.
+func (o ProxyClusterSummaryOutput) Address() pulumi.StringOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) string { return v.Address }).(pulumi.StringOutput)
+}
+
+// Number of proxy nodes currently connected.
+func (o ProxyClusterSummaryOutput) ConnectedProxies() pulumi.IntOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) int { return v.ConnectedProxies }).(pulumi.IntOutput)
+}
+
+// Unique identifier of the proxy cluster.
+func (o ProxyClusterSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Whether at least one proxy in the cluster has heartbeated within the active window.
+func (o ProxyClusterSummaryOutput) Online() pulumi.BoolOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.Online }).(pulumi.BoolOutput)
+}
+
+// True when at least one connected proxy is embedded in a netbird client and serving over a WireGuard tunnel.
+func (o ProxyClusterSummaryOutput) Private() pulumi.BoolOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.Private }).(pulumi.BoolOutput)
+}
+
+// Whether services on this cluster must include a subdomain label.
+func (o ProxyClusterSummaryOutput) RequireSubdomain() pulumi.BoolOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.RequireSubdomain }).(pulumi.BoolOutput)
+}
+
+// Whether all active proxies in the cluster have CrowdSec configured.
+func (o ProxyClusterSummaryOutput) SupportsCrowdsec() pulumi.BoolOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.SupportsCrowdsec }).(pulumi.BoolOutput)
+}
+
+// Whether the cluster supports binding arbitrary TCP/UDP ports.
+func (o ProxyClusterSummaryOutput) SupportsCustomPorts() pulumi.BoolOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) bool { return v.SupportsCustomPorts }).(pulumi.BoolOutput)
+}
+
+// Source of the cluster: 'account' (BYOP) or 'shared' (operated by NetBird).
+func (o ProxyClusterSummaryOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v ProxyClusterSummary) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type ProxyClusterSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (ProxyClusterSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ProxyClusterSummary)(nil)).Elem()
+}
+
+func (o ProxyClusterSummaryArrayOutput) ToProxyClusterSummaryArrayOutput() ProxyClusterSummaryArrayOutput {
+	return o
+}
+
+func (o ProxyClusterSummaryArrayOutput) ToProxyClusterSummaryArrayOutputWithContext(ctx context.Context) ProxyClusterSummaryArrayOutput {
+	return o
+}
+
+func (o ProxyClusterSummaryArrayOutput) Index(i pulumi.IntInput) ProxyClusterSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ProxyClusterSummary {
+		return vs[0].([]ProxyClusterSummary)[vs[1].(int)]
+	}).(ProxyClusterSummaryOutput)
+}
+
+type ResourceRef struct {
+	// The unique identifier of the resource.
+	Id string `pulumi:"id"`
+	// The type of resource: 'domain', 'host', or 'subnet'.
+	Type string `pulumi:"type"`
+}
+
+type ResourceRefOutput struct{ *pulumi.OutputState }
+
+func (ResourceRefOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ResourceRef)(nil)).Elem()
+}
+
+func (o ResourceRefOutput) ToResourceRefOutput() ResourceRefOutput {
+	return o
+}
+
+func (o ResourceRefOutput) ToResourceRefOutputWithContext(ctx context.Context) ResourceRefOutput {
+	return o
+}
+
+// The unique identifier of the resource.
+func (o ResourceRefOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ResourceRef) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// The type of resource: 'domain', 'host', or 'subnet'.
+func (o ResourceRefOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v ResourceRef) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type ResourceRefArrayOutput struct{ *pulumi.OutputState }
+
+func (ResourceRefArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ResourceRef)(nil)).Elem()
+}
+
+func (o ResourceRefArrayOutput) ToResourceRefArrayOutput() ResourceRefArrayOutput {
+	return o
+}
+
+func (o ResourceRefArrayOutput) ToResourceRefArrayOutputWithContext(ctx context.Context) ResourceRefArrayOutput {
+	return o
+}
+
+func (o ResourceRefArrayOutput) Index(i pulumi.IntInput) ResourceRefOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ResourceRef {
+		return vs[0].([]ResourceRef)[vs[1].(int)]
+	}).(ResourceRefOutput)
+}
+
+type ReverseProxyDomainSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Domain name for the reverse proxy.
+	Domain string `pulumi:"domain"`
+	// The reverse proxy domain ID.
+	Id string `pulumi:"id"`
+	// Whether a subdomain label is required in front of this domain.
+	RequireSubdomain *bool `pulumi:"requireSubdomain"`
+	// Whether the cluster supports binding arbitrary TCP/UDP ports.
+	SupportsCustomPorts *bool `pulumi:"supportsCustomPorts"`
+	// The proxy cluster this domain is validated against.
+	TargetCluster string `pulumi:"targetCluster"`
+	// Type of the reverse proxy domain (custom or free).
+	Type resource.ReverseProxyDomainType `pulumi:"type"`
+	// Whether the domain has been validated.
+	Validated bool `pulumi:"validated"`
+}
+
+type ReverseProxyDomainSummaryOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyDomainSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReverseProxyDomainSummary)(nil)).Elem()
+}
+
+func (o ReverseProxyDomainSummaryOutput) ToReverseProxyDomainSummaryOutput() ReverseProxyDomainSummaryOutput {
+	return o
+}
+
+func (o ReverseProxyDomainSummaryOutput) ToReverseProxyDomainSummaryOutputWithContext(ctx context.Context) ReverseProxyDomainSummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o ReverseProxyDomainSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyDomainSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Domain name for the reverse proxy.
+func (o ReverseProxyDomainSummaryOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v ReverseProxyDomainSummary) string { return v.Domain }).(pulumi.StringOutput)
+}
+
+// The reverse proxy domain ID.
+func (o ReverseProxyDomainSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ReverseProxyDomainSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Whether a subdomain label is required in front of this domain.
+func (o ReverseProxyDomainSummaryOutput) RequireSubdomain() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReverseProxyDomainSummary) *bool { return v.RequireSubdomain }).(pulumi.BoolPtrOutput)
+}
+
+// Whether the cluster supports binding arbitrary TCP/UDP ports.
+func (o ReverseProxyDomainSummaryOutput) SupportsCustomPorts() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReverseProxyDomainSummary) *bool { return v.SupportsCustomPorts }).(pulumi.BoolPtrOutput)
+}
+
+// The proxy cluster this domain is validated against.
+func (o ReverseProxyDomainSummaryOutput) TargetCluster() pulumi.StringOutput {
+	return o.ApplyT(func(v ReverseProxyDomainSummary) string { return v.TargetCluster }).(pulumi.StringOutput)
+}
+
+// Type of the reverse proxy domain (custom or free).
+func (o ReverseProxyDomainSummaryOutput) Type() resource.ReverseProxyDomainTypeOutput {
+	return o.ApplyT(func(v ReverseProxyDomainSummary) resource.ReverseProxyDomainType { return v.Type }).(resource.ReverseProxyDomainTypeOutput)
+}
+
+// Whether the domain has been validated.
+func (o ReverseProxyDomainSummaryOutput) Validated() pulumi.BoolOutput {
+	return o.ApplyT(func(v ReverseProxyDomainSummary) bool { return v.Validated }).(pulumi.BoolOutput)
+}
+
+type ReverseProxyDomainSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyDomainSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReverseProxyDomainSummary)(nil)).Elem()
+}
+
+func (o ReverseProxyDomainSummaryArrayOutput) ToReverseProxyDomainSummaryArrayOutput() ReverseProxyDomainSummaryArrayOutput {
+	return o
+}
+
+func (o ReverseProxyDomainSummaryArrayOutput) ToReverseProxyDomainSummaryArrayOutputWithContext(ctx context.Context) ReverseProxyDomainSummaryArrayOutput {
+	return o
+}
+
+func (o ReverseProxyDomainSummaryArrayOutput) Index(i pulumi.IntInput) ReverseProxyDomainSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ReverseProxyDomainSummary {
+		return vs[0].([]ReverseProxyDomainSummary)[vs[1].(int)]
+	}).(ReverseProxyDomainSummaryOutput)
+}
+
+type ReverseProxyServiceSummary struct {
+	// NetBird group IDs whose peers may reach this private service over the tunnel. Required when private=true; ignored otherwise.
+	AccessGroups []string `pulumi:"accessGroups"`
+	// Connection-level access restrictions based on IP address or geography.
+	AccessRestrictions *resource.ReverseProxyAccessRestrictions `pulumi:"accessRestrictions"`
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Authentication configuration for the service.
+	Auth *resource.ReverseProxyAuth `pulumi:"auth"`
+	// Domain for the service.
+	Domain string `pulumi:"domain"`
+	// Whether the service is enabled.
+	Enabled bool `pulumi:"enabled"`
+	// The reverse proxy service ID.
+	Id string `pulumi:"id"`
+	// Port the proxy listens on (L4/TLS only).
+	ListenPort *int `pulumi:"listenPort"`
+	// Service mode: "http" for L7 reverse proxy, "tcp"/"udp"/"tls" for L4 passthrough.
+	Mode *resource.ReverseProxyServiceMode `pulumi:"mode"`
+	// Service name.
+	Name string `pulumi:"name"`
+	// When true, the original client Host header is passed through to the backend.
+	PassHostHeader *bool `pulumi:"passHostHeader"`
+	// Whether the listen port was auto-assigned.
+	PortAutoAssigned *bool `pulumi:"portAutoAssigned"`
+	// When true, the service is NetBird-only: peers authenticate via WireGuard tunnel identity and an ACL policy is auto-generated from accessGroups. Requires mode=http. Mutually exclusive with SSO/bearer auth.
+	Private *bool `pulumi:"private"`
+	// The proxy cluster handling this service (derived from domain).
+	ProxyCluster *string `pulumi:"proxyCluster"`
+	// When true, Location headers in backend responses are rewritten to the public-facing domain.
+	RewriteRedirects *bool `pulumi:"rewriteRedirects"`
+	// Current status of the service.
+	Status *resource.ReverseProxyServiceStatus `pulumi:"status"`
+	// List of target backends for this service.
+	Targets []resource.ReverseProxyTarget `pulumi:"targets"`
+	// Whether the service has been terminated. Terminated services cannot be updated.
+	Terminated *bool `pulumi:"terminated"`
+}
+
+type ReverseProxyServiceSummaryOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyServiceSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReverseProxyServiceSummary)(nil)).Elem()
+}
+
+func (o ReverseProxyServiceSummaryOutput) ToReverseProxyServiceSummaryOutput() ReverseProxyServiceSummaryOutput {
+	return o
+}
+
+func (o ReverseProxyServiceSummaryOutput) ToReverseProxyServiceSummaryOutputWithContext(ctx context.Context) ReverseProxyServiceSummaryOutput {
+	return o
+}
+
+// NetBird group IDs whose peers may reach this private service over the tunnel. Required when private=true; ignored otherwise.
+func (o ReverseProxyServiceSummaryOutput) AccessGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) []string { return v.AccessGroups }).(pulumi.StringArrayOutput)
+}
+
+// Connection-level access restrictions based on IP address or geography.
+func (o ReverseProxyServiceSummaryOutput) AccessRestrictions() resource.ReverseProxyAccessRestrictionsPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *resource.ReverseProxyAccessRestrictions {
+		return v.AccessRestrictions
+	}).(resource.ReverseProxyAccessRestrictionsPtrOutput)
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o ReverseProxyServiceSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Authentication configuration for the service.
+func (o ReverseProxyServiceSummaryOutput) Auth() resource.ReverseProxyAuthPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *resource.ReverseProxyAuth { return v.Auth }).(resource.ReverseProxyAuthPtrOutput)
+}
+
+// Domain for the service.
+func (o ReverseProxyServiceSummaryOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) string { return v.Domain }).(pulumi.StringOutput)
+}
+
+// Whether the service is enabled.
+func (o ReverseProxyServiceSummaryOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// The reverse proxy service ID.
+func (o ReverseProxyServiceSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Port the proxy listens on (L4/TLS only).
+func (o ReverseProxyServiceSummaryOutput) ListenPort() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *int { return v.ListenPort }).(pulumi.IntPtrOutput)
+}
+
+// Service mode: "http" for L7 reverse proxy, "tcp"/"udp"/"tls" for L4 passthrough.
+func (o ReverseProxyServiceSummaryOutput) Mode() resource.ReverseProxyServiceModePtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *resource.ReverseProxyServiceMode { return v.Mode }).(resource.ReverseProxyServiceModePtrOutput)
+}
+
+// Service name.
+func (o ReverseProxyServiceSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// When true, the original client Host header is passed through to the backend.
+func (o ReverseProxyServiceSummaryOutput) PassHostHeader() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *bool { return v.PassHostHeader }).(pulumi.BoolPtrOutput)
+}
+
+// Whether the listen port was auto-assigned.
+func (o ReverseProxyServiceSummaryOutput) PortAutoAssigned() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *bool { return v.PortAutoAssigned }).(pulumi.BoolPtrOutput)
+}
+
+// When true, the service is NetBird-only: peers authenticate via WireGuard tunnel identity and an ACL policy is auto-generated from accessGroups. Requires mode=http. Mutually exclusive with SSO/bearer auth.
+func (o ReverseProxyServiceSummaryOutput) Private() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *bool { return v.Private }).(pulumi.BoolPtrOutput)
+}
+
+// The proxy cluster handling this service (derived from domain).
+func (o ReverseProxyServiceSummaryOutput) ProxyCluster() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *string { return v.ProxyCluster }).(pulumi.StringPtrOutput)
+}
+
+// When true, Location headers in backend responses are rewritten to the public-facing domain.
+func (o ReverseProxyServiceSummaryOutput) RewriteRedirects() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *bool { return v.RewriteRedirects }).(pulumi.BoolPtrOutput)
+}
+
+// Current status of the service.
+func (o ReverseProxyServiceSummaryOutput) Status() resource.ReverseProxyServiceStatusPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *resource.ReverseProxyServiceStatus { return v.Status }).(resource.ReverseProxyServiceStatusPtrOutput)
+}
+
+// List of target backends for this service.
+func (o ReverseProxyServiceSummaryOutput) Targets() resource.ReverseProxyTargetArrayOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) []resource.ReverseProxyTarget { return v.Targets }).(resource.ReverseProxyTargetArrayOutput)
+}
+
+// Whether the service has been terminated. Terminated services cannot be updated.
+func (o ReverseProxyServiceSummaryOutput) Terminated() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ReverseProxyServiceSummary) *bool { return v.Terminated }).(pulumi.BoolPtrOutput)
+}
+
+type ReverseProxyServiceSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (ReverseProxyServiceSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ReverseProxyServiceSummary)(nil)).Elem()
+}
+
+func (o ReverseProxyServiceSummaryArrayOutput) ToReverseProxyServiceSummaryArrayOutput() ReverseProxyServiceSummaryArrayOutput {
+	return o
+}
+
+func (o ReverseProxyServiceSummaryArrayOutput) ToReverseProxyServiceSummaryArrayOutputWithContext(ctx context.Context) ReverseProxyServiceSummaryArrayOutput {
+	return o
+}
+
+func (o ReverseProxyServiceSummaryArrayOutput) Index(i pulumi.IntInput) ReverseProxyServiceSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ReverseProxyServiceSummary {
+		return vs[0].([]ReverseProxyServiceSummary)[vs[1].(int)]
+	}).(ReverseProxyServiceSummaryOutput)
+}
+
+type RouteSummary struct {
+	// Access control group IDs associated with this route.
+	AccessControlGroups []string `pulumi:"accessControlGroups"`
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Route description.
+	Description string `pulumi:"description"`
+	// Domain list for dynamic resolution.
+	Domains []string `pulumi:"domains"`
+	// Whether the route is enabled.
+	Enabled bool `pulumi:"enabled"`
+	// Group IDs whose peers will use this route.
+	Groups []string `pulumi:"groups"`
+	// The route ID.
+	Id string `pulumi:"id"`
+	// Keep the route after a domain no longer resolves to the IP.
+	KeepRoute bool `pulumi:"keepRoute"`
+	// Whether masquerading is enabled for this route.
+	Masquerade bool `pulumi:"masquerade"`
+	// Route metric; lower value means higher priority.
+	Metric int `pulumi:"metric"`
+	// Network CIDR range.
+	Network *string `pulumi:"network"`
+	// Route network identifier, used to group HA routes.
+	NetworkId string `pulumi:"networkId"`
+	// Network type (IPv4, IPv6, or domain) — computed by the API.
+	NetworkType *string `pulumi:"networkType"`
+	// Peer ID acting as the routing peer.
+	Peer *string `pulumi:"peer"`
+	// Peer group IDs acting as routing peers.
+	PeerGroups []string `pulumi:"peerGroups"`
+	// Skip auto-application for exit-node (0.0.0.0/0) routes.
+	SkipAutoApply *bool `pulumi:"skipAutoApply"`
+}
+
+type RouteSummaryOutput struct{ *pulumi.OutputState }
+
+func (RouteSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RouteSummary)(nil)).Elem()
+}
+
+func (o RouteSummaryOutput) ToRouteSummaryOutput() RouteSummaryOutput {
+	return o
+}
+
+func (o RouteSummaryOutput) ToRouteSummaryOutputWithContext(ctx context.Context) RouteSummaryOutput {
+	return o
+}
+
+// Access control group IDs associated with this route.
+func (o RouteSummaryOutput) AccessControlGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RouteSummary) []string { return v.AccessControlGroups }).(pulumi.StringArrayOutput)
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o RouteSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RouteSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Route description.
+func (o RouteSummaryOutput) Description() pulumi.StringOutput {
+	return o.ApplyT(func(v RouteSummary) string { return v.Description }).(pulumi.StringOutput)
+}
+
+// Domain list for dynamic resolution.
+func (o RouteSummaryOutput) Domains() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RouteSummary) []string { return v.Domains }).(pulumi.StringArrayOutput)
+}
+
+// Whether the route is enabled.
+func (o RouteSummaryOutput) Enabled() pulumi.BoolOutput {
+	return o.ApplyT(func(v RouteSummary) bool { return v.Enabled }).(pulumi.BoolOutput)
+}
+
+// Group IDs whose peers will use this route.
+func (o RouteSummaryOutput) Groups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RouteSummary) []string { return v.Groups }).(pulumi.StringArrayOutput)
+}
+
+// The route ID.
+func (o RouteSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v RouteSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Keep the route after a domain no longer resolves to the IP.
+func (o RouteSummaryOutput) KeepRoute() pulumi.BoolOutput {
+	return o.ApplyT(func(v RouteSummary) bool { return v.KeepRoute }).(pulumi.BoolOutput)
+}
+
+// Whether masquerading is enabled for this route.
+func (o RouteSummaryOutput) Masquerade() pulumi.BoolOutput {
+	return o.ApplyT(func(v RouteSummary) bool { return v.Masquerade }).(pulumi.BoolOutput)
+}
+
+// Route metric; lower value means higher priority.
+func (o RouteSummaryOutput) Metric() pulumi.IntOutput {
+	return o.ApplyT(func(v RouteSummary) int { return v.Metric }).(pulumi.IntOutput)
+}
+
+// Network CIDR range.
+func (o RouteSummaryOutput) Network() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RouteSummary) *string { return v.Network }).(pulumi.StringPtrOutput)
+}
+
+// Route network identifier, used to group HA routes.
+func (o RouteSummaryOutput) NetworkId() pulumi.StringOutput {
+	return o.ApplyT(func(v RouteSummary) string { return v.NetworkId }).(pulumi.StringOutput)
+}
+
+// Network type (IPv4, IPv6, or domain) — computed by the API.
+func (o RouteSummaryOutput) NetworkType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RouteSummary) *string { return v.NetworkType }).(pulumi.StringPtrOutput)
+}
+
+// Peer ID acting as the routing peer.
+func (o RouteSummaryOutput) Peer() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RouteSummary) *string { return v.Peer }).(pulumi.StringPtrOutput)
+}
+
+// Peer group IDs acting as routing peers.
+func (o RouteSummaryOutput) PeerGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RouteSummary) []string { return v.PeerGroups }).(pulumi.StringArrayOutput)
+}
+
+// Skip auto-application for exit-node (0.0.0.0/0) routes.
+func (o RouteSummaryOutput) SkipAutoApply() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v RouteSummary) *bool { return v.SkipAutoApply }).(pulumi.BoolPtrOutput)
+}
+
+type RouteSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (RouteSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]RouteSummary)(nil)).Elem()
+}
+
+func (o RouteSummaryArrayOutput) ToRouteSummaryArrayOutput() RouteSummaryArrayOutput {
+	return o
+}
+
+func (o RouteSummaryArrayOutput) ToRouteSummaryArrayOutputWithContext(ctx context.Context) RouteSummaryArrayOutput {
+	return o
+}
+
+func (o RouteSummaryArrayOutput) Index(i pulumi.IntInput) RouteSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) RouteSummary {
+		return vs[0].([]RouteSummary)[vs[1].(int)]
+	}).(RouteSummaryOutput)
+}
+
+type SetupKeyEnrolledPeer struct {
+	// When the peer enrolled, in RFC3339 format.
+	EnrolledAt string `pulumi:"enrolledAt"`
+	// Whether the peer still exists.
+	Exists bool `pulumi:"exists"`
+	// The peer name when it enrolled.
+	Name string `pulumi:"name"`
+	// The ID of the peer.
+	PeerId string `pulumi:"peerId"`
+}
+
+type SetupKeyEnrolledPeerOutput struct{ *pulumi.OutputState }
+
+func (SetupKeyEnrolledPeerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SetupKeyEnrolledPeer)(nil)).Elem()
+}
+
+func (o SetupKeyEnrolledPeerOutput) ToSetupKeyEnrolledPeerOutput() SetupKeyEnrolledPeerOutput {
+	return o
+}
+
+func (o SetupKeyEnrolledPeerOutput) ToSetupKeyEnrolledPeerOutputWithContext(ctx context.Context) SetupKeyEnrolledPeerOutput {
+	return o
+}
+
+// When the peer enrolled, in RFC3339 format.
+func (o SetupKeyEnrolledPeerOutput) EnrolledAt() pulumi.StringOutput {
+	return o.ApplyT(func(v SetupKeyEnrolledPeer) string { return v.EnrolledAt }).(pulumi.StringOutput)
+}
+
+// Whether the peer still exists.
+func (o SetupKeyEnrolledPeerOutput) Exists() pulumi.BoolOutput {
+	return o.ApplyT(func(v SetupKeyEnrolledPeer) bool { return v.Exists }).(pulumi.BoolOutput)
+}
+
+// The peer name when it enrolled.
+func (o SetupKeyEnrolledPeerOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v SetupKeyEnrolledPeer) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The ID of the peer.
+func (o SetupKeyEnrolledPeerOutput) PeerId() pulumi.StringOutput {
+	return o.ApplyT(func(v SetupKeyEnrolledPeer) string { return v.PeerId }).(pulumi.StringOutput)
+}
+
+type SetupKeyEnrolledPeerArrayOutput struct{ *pulumi.OutputState }
+
+func (SetupKeyEnrolledPeerArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SetupKeyEnrolledPeer)(nil)).Elem()
+}
+
+func (o SetupKeyEnrolledPeerArrayOutput) ToSetupKeyEnrolledPeerArrayOutput() SetupKeyEnrolledPeerArrayOutput {
+	return o
+}
+
+func (o SetupKeyEnrolledPeerArrayOutput) ToSetupKeyEnrolledPeerArrayOutputWithContext(ctx context.Context) SetupKeyEnrolledPeerArrayOutput {
+	return o
+}
+
+func (o SetupKeyEnrolledPeerArrayOutput) Index(i pulumi.IntInput) SetupKeyEnrolledPeerOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SetupKeyEnrolledPeer {
+		return vs[0].([]SetupKeyEnrolledPeer)[vs[1].(int)]
+	}).(SetupKeyEnrolledPeerOutput)
+}
+
+type SetupKeySummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Allow peers to add extra DNS labels beyond the base peer name.
+	AllowExtraDnsLabels *bool `pulumi:"allowExtraDnsLabels"`
+	// Group IDs to auto-assign to peers created with this key.
+	AutoGroups []string `pulumi:"autoGroups"`
+	// When the provider created the key. Unset for imported keys, which rotationInterval then never rotates.
+	CreatedAt *string `pulumi:"createdAt"`
+	// Public key to additionally encrypt the secret to: one or more age recipients (age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, non-secret output. Changing it re-encrypts the existing secret without replacing the resource.
+	EncryptFor *string `pulumi:"encryptFor"`
+	// The full key encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.
+	EncryptedKey *string `pulumi:"encryptedKey"`
+	// IDs of existing peers that enrolled with the key, oldest first, as of the last refresh. Taken from the account's audit events, so the provider token must be allowed to read them.
+	EnrolledPeers []string `pulumi:"enrolledPeers"`
+	// Whether peers registered with this key are ephemeral (auto-expire).
+	Ephemeral *bool   `pulumi:"ephemeral"`
+	Expires   *string `pulumi:"expires"`
+	// Time-to-live in seconds from creation; use 0 for no expiration if supported by the API.
+	ExpiresIn int `pulumi:"expiresIn"`
+	// The setup key ID.
+	Id  string  `pulumi:"id"`
+	Key *string `pulumi:"key"`
+	// When the key was last used to enroll a peer.
+	LastUsed *string `pulumi:"lastUsed"`
+	// Setup key display name.
+	Name string `pulumi:"name"`
+	// Seconds until the key expires, as of the last create or refresh. Unset for keys that do not expire.
+	RemainingValidity *int `pulumi:"remainingValidity"`
+	// Whether the key is revoked. A revoked key can no longer enroll peers but is kept, with its usage history, until the resource is deleted. When unset, the revocation state is left as is.
+	Revoked *bool `pulumi:"revoked"`
+	// Replace the key once it expires within this many seconds. The replacement key is created before the old one is deleted. Requires expiresIn > 0.
+	RotateBefore *int `pulumi:"rotateBefore"`
+	// Replace the key once it is this many seconds old. The replacement key is created before the old one is deleted.
+	RotationInterval *int `pulumi:"rotationInterval"`
+	// Key state: valid, overused, expired, or revoked.
+	State *string `pulumi:"state"`
+	// Setup key type: 'one-off' (single use) or 'reusable'.
+	Type      resource.SetupKeyType `pulumi:"type"`
+	UpdatedAt *string               `pulumi:"updatedAt"`
+	// Maximum uses for reusable keys; 0 = unlimited.
+	UsageLimit int `pulumi:"usageLimit"`
+	// How many times the key was used to enroll a peer.
+	UsedTimes *int `pulumi:"usedTimes"`
+	// Whether the key can currently enroll peers.
+	Valid *bool `pulumi:"valid"`
+}
+
+type SetupKeySummaryOutput struct{ *pulumi.OutputState }
+
+func (SetupKeySummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SetupKeySummary)(nil)).Elem()
+}
+
+func (o SetupKeySummaryOutput) ToSetupKeySummaryOutput() SetupKeySummaryOutput {
+	return o
+}
+
+func (o SetupKeySummaryOutput) ToSetupKeySummaryOutputWithContext(ctx context.Context) SetupKeySummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o SetupKeySummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Allow peers to add extra DNS labels beyond the base peer name.
+func (o SetupKeySummaryOutput) AllowExtraDnsLabels() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *bool { return v.AllowExtraDnsLabels }).(pulumi.BoolPtrOutput)
+}
+
+// Group IDs to auto-assign to peers created with this key.
+func (o SetupKeySummaryOutput) AutoGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v SetupKeySummary) []string { return v.AutoGroups }).(pulumi.StringArrayOutput)
+}
+
+// When the provider created the key. Unset for imported keys, which rotationInterval then never rotates.
+func (o SetupKeySummaryOutput) CreatedAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.CreatedAt }).(pulumi.StringPtrOutput)
+}
+
+// Public key to additionally encrypt the secret to: one or more age recipients (age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, non-secret output. Changing it re-encrypts the existing secret without replacing the resource.
+func (o SetupKeySummaryOutput) EncryptFor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.EncryptFor }).(pulumi.StringPtrOutput)
+}
+
+// The full key encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.
+func (o SetupKeySummaryOutput) EncryptedKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.EncryptedKey }).(pulumi.StringPtrOutput)
+}
+
+// IDs of existing peers that enrolled with the key, oldest first, as of the last refresh. Taken from the account's audit events, so the provider token must be allowed to read them.
+func (o SetupKeySummaryOutput) EnrolledPeers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v SetupKeySummary) []string { return v.EnrolledPeers }).(pulumi.StringArrayOutput)
+}
+
+// Whether peers registered with this key are ephemeral (auto-expire).
+func (o SetupKeySummaryOutput) Ephemeral() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *bool { return v.Ephemeral }).(pulumi.BoolPtrOutput)
+}
+
+func (o SetupKeySummaryOutput) Expires() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.Expires }).(pulumi.StringPtrOutput)
+}
+
+// Time-to-live in seconds from creation; use 0 for no expiration if supported by the API.
+func (o SetupKeySummaryOutput) ExpiresIn() pulumi.IntOutput {
+	return o.ApplyT(func(v SetupKeySummary) int { return v.ExpiresIn }).(pulumi.IntOutput)
+}
+
+// The setup key ID.
+func (o SetupKeySummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v SetupKeySummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+func (o SetupKeySummaryOutput) Key() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.Key }).(pulumi.StringPtrOutput)
+}
+
+// When the key was last used to enroll a peer.
+func (o SetupKeySummaryOutput) LastUsed() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.LastUsed }).(pulumi.StringPtrOutput)
+}
+
+// Setup key display name.
+func (o SetupKeySummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v SetupKeySummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Seconds until the key expires, as of the last create or refresh. Unset for keys that do not expire.
+func (o SetupKeySummaryOutput) RemainingValidity() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *int { return v.RemainingValidity }).(pulumi.IntPtrOutput)
+}
+
+// Whether the key is revoked. A revoked key can no longer enroll peers but is kept, with its usage history, until the resource is deleted. When unset, the revocation state is left as is.
+func (o SetupKeySummaryOutput) Revoked() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *bool { return v.Revoked }).(pulumi.BoolPtrOutput)
+}
+
+// Replace the key once it expires within this many seconds. The replacement key is created before the old one is deleted. Requires expiresIn > 0.
+func (o SetupKeySummaryOutput) RotateBefore() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *int { return v.RotateBefore }).(pulumi.IntPtrOutput)
+}
+
+// Replace the key once it is this many seconds old. The replacement key is created before the old one is deleted.
+func (o SetupKeySummaryOutput) RotationInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *int { return v.RotationInterval }).(pulumi.IntPtrOutput)
+}
+
+// Key state: valid, overused, expired, or revoked.
+func (o SetupKeySummaryOutput) State() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.State }).(pulumi.StringPtrOutput)
+}
+
+// Setup key type: 'one-off' (single use) or 'reusable'.
+func (o SetupKeySummaryOutput) Type() resource.SetupKeyTypeOutput {
+	return o.ApplyT(func(v SetupKeySummary) resource.SetupKeyType { return v.Type }).(resource.SetupKeyTypeOutput)
+}
+
+func (o SetupKeySummaryOutput) UpdatedAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *string { return v.UpdatedAt }).(pulumi.StringPtrOutput)
+}
+
+// Maximum uses for reusable keys; 0 = unlimited.
+func (o SetupKeySummaryOutput) UsageLimit() pulumi.IntOutput {
+	return o.ApplyT(func(v SetupKeySummary) int { return v.UsageLimit }).(pulumi.IntOutput)
+}
+
+// How many times the key was used to enroll a peer.
+func (o SetupKeySummaryOutput) UsedTimes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *int { return v.UsedTimes }).(pulumi.IntPtrOutput)
+}
+
+// Whether the key can currently enroll peers.
+func (o SetupKeySummaryOutput) Valid() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v SetupKeySummary) *bool { return v.Valid }).(pulumi.BoolPtrOutput)
+}
+
+type SetupKeySummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (SetupKeySummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SetupKeySummary)(nil)).Elem()
+}
+
+func (o SetupKeySummaryArrayOutput) ToSetupKeySummaryArrayOutput() SetupKeySummaryArrayOutput {
+	return o
+}
+
+func (o SetupKeySummaryArrayOutput) ToSetupKeySummaryArrayOutputWithContext(ctx context.Context) SetupKeySummaryArrayOutput {
+	return o
+}
+
+func (o SetupKeySummaryArrayOutput) Index(i pulumi.IntInput) SetupKeySummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SetupKeySummary {
+		return vs[0].([]SetupKeySummary)[vs[1].(int)]
+	}).(SetupKeySummaryOutput)
+}
+
+type TokenSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Timestamp the token was created.
+	CreatedAt *string `pulumi:"createdAt"`
+	// User ID of the principal that created the token.
+	CreatedBy *string `pulumi:"createdBy"`
+	// Public key the token is encrypted to in encryptedToken.
+	EncryptFor *string `pulumi:"encryptFor"`
+	// The plaintext token encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.
+	EncryptedToken *string `pulumi:"encryptedToken"`
+	// Timestamp the token expires.
+	ExpirationDate *string `pulumi:"expirationDate"`
+	// Token lifetime in days.
+	ExpiresIn int `pulumi:"expiresIn"`
+	// The token ID.
+	Id string `pulumi:"id"`
+	// Timestamp the token was last used, if ever.
+	LastUsed *string `pulumi:"lastUsed"`
+	// Display name of the token.
+	Name string `pulumi:"name"`
+	// Seconds until the token expires, as of the last create or refresh.
+	RemainingValidity *int `pulumi:"remainingValidity"`
+	// Days before expiry at which the token is replaced.
+	RotateBefore *int `pulumi:"rotateBefore"`
+	// Age in days at which the token is replaced.
+	RotationInterval *int `pulumi:"rotationInterval"`
+	// Plaintext token value. Only populated on creation; never returned by the API afterwards.
+	Token *string `pulumi:"token"`
+	// ID of the user the token is issued for.
+	UserId string `pulumi:"userId"`
+}
+
+type TokenSummaryOutput struct{ *pulumi.OutputState }
+
+func (TokenSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenSummary)(nil)).Elem()
+}
+
+func (o TokenSummaryOutput) ToTokenSummaryOutput() TokenSummaryOutput {
+	return o
+}
+
+func (o TokenSummaryOutput) ToTokenSummaryOutputWithContext(ctx context.Context) TokenSummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o TokenSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Timestamp the token was created.
+func (o TokenSummaryOutput) CreatedAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *string { return v.CreatedAt }).(pulumi.StringPtrOutput)
+}
+
+// User ID of the principal that created the token.
+func (o TokenSummaryOutput) CreatedBy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *string { return v.CreatedBy }).(pulumi.StringPtrOutput)
+}
+
+// Public key the token is encrypted to in encryptedToken.
+func (o TokenSummaryOutput) EncryptFor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *string { return v.EncryptFor }).(pulumi.StringPtrOutput)
+}
+
+// The plaintext token encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.
+func (o TokenSummaryOutput) EncryptedToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *string { return v.EncryptedToken }).(pulumi.StringPtrOutput)
+}
+
+// Timestamp the token expires.
+func (o TokenSummaryOutput) ExpirationDate() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *string { return v.ExpirationDate }).(pulumi.StringPtrOutput)
+}
+
+// Token lifetime in days.
+func (o TokenSummaryOutput) ExpiresIn() pulumi.IntOutput {
+	return o.ApplyT(func(v TokenSummary) int { return v.ExpiresIn }).(pulumi.IntOutput)
+}
+
+// The token ID.
+func (o TokenSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v TokenSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Timestamp the token was last used, if ever.
+func (o TokenSummaryOutput) LastUsed() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *string { return v.LastUsed }).(pulumi.StringPtrOutput)
+}
+
+// Display name of the token.
+func (o TokenSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v TokenSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Seconds until the token expires, as of the last create or refresh.
+func (o TokenSummaryOutput) RemainingValidity() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *int { return v.RemainingValidity }).(pulumi.IntPtrOutput)
+}
+
+// Days before expiry at which the token is replaced.
+func (o TokenSummaryOutput) RotateBefore() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *int { return v.RotateBefore }).(pulumi.IntPtrOutput)
+}
+
+// Age in days at which the token is replaced.
+func (o TokenSummaryOutput) RotationInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *int { return v.RotationInterval }).(pulumi.IntPtrOutput)
+}
+
+// Plaintext token value. Only populated on creation; never returned by the API afterwards.
+func (o TokenSummaryOutput) Token() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TokenSummary) *string { return v.Token }).(pulumi.StringPtrOutput)
+}
+
+// ID of the user the token is issued for.
+func (o TokenSummaryOutput) UserId() pulumi.StringOutput {
+	return o.ApplyT(func(v TokenSummary) string { return v.UserId }).(pulumi.StringOutput)
+}
+
+type TokenSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (TokenSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]TokenSummary)(nil)).Elem()
+}
+
+func (o TokenSummaryArrayOutput) ToTokenSummaryArrayOutput() TokenSummaryArrayOutput {
+	return o
+}
+
+func (o TokenSummaryArrayOutput) ToTokenSummaryArrayOutputWithContext(ctx context.Context) TokenSummaryArrayOutput {
+	return o
+}
+
+func (o TokenSummaryArrayOutput) Index(i pulumi.IntInput) TokenSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) TokenSummary {
+		return vs[0].([]TokenSummary)[vs[1].(int)]
+	}).(TokenSummaryOutput)
+}
+
+type UserSummary struct {
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId *string `pulumi:"accountId"`
+	// Groups this user’s peers are automatically assigned to.
+	AutoGroups []string `pulumi:"autoGroups"`
+	// Indicates whether the user is blocked from accessing the system
+	Blocked *bool `pulumi:"blocked"`
+	// Email address of the user.
+	Email *string `pulumi:"email"`
+	// The NetBird user ID.
+	Id string `pulumi:"id"`
+	// Whether this user is a service identity.
+	IsServiceUser bool `pulumi:"isServiceUser"`
+	// When the user last logged in (RFC 3339). Unset if the user never logged in.
+	LastLogin *string `pulumi:"lastLogin"`
+	// Full name of the user.
+	Name *string `pulumi:"name"`
+	// NetBird account role assigned to the user.
+	Role string `pulumi:"role"`
+	// Current account status of the user: 'active', 'blocked', or 'invited'.
+	Status *resource.UserStatus `pulumi:"status"`
+}
+
+type UserSummaryOutput struct{ *pulumi.OutputState }
+
+func (UserSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*UserSummary)(nil)).Elem()
+}
+
+func (o UserSummaryOutput) ToUserSummaryOutput() UserSummaryOutput {
+	return o
+}
+
+func (o UserSummaryOutput) ToUserSummaryOutputWithContext(ctx context.Context) UserSummaryOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o UserSummaryOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v UserSummary) *string { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Groups this user’s peers are automatically assigned to.
+func (o UserSummaryOutput) AutoGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v UserSummary) []string { return v.AutoGroups }).(pulumi.StringArrayOutput)
+}
+
+// Indicates whether the user is blocked from accessing the system
+func (o UserSummaryOutput) Blocked() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v UserSummary) *bool { return v.Blocked }).(pulumi.BoolPtrOutput)
+}
+
+// Email address of the user.
+func (o UserSummaryOutput) Email() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v UserSummary) *string { return v.Email }).(pulumi.StringPtrOutput)
+}
+
+// The NetBird user ID.
+func (o UserSummaryOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v UserSummary) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// Whether this user is a service identity.
+func (o UserSummaryOutput) IsServiceUser() pulumi.BoolOutput {
+	return o.ApplyT(func(v UserSummary) bool { return v.IsServiceUser }).(pulumi.BoolOutput)
+}
+
+// When the user last logged in (RFC 3339). Unset if the user never logged in.
+func (o UserSummaryOutput) LastLogin() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v UserSummary) *string { return v.LastLogin }).(pulumi.StringPtrOutput)
+}
+
+// Full name of the user.
+func (o UserSummaryOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v UserSummary) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// NetBird account role assigned to the user.
+func (o UserSummaryOutput) Role() pulumi.StringOutput {
+	return o.ApplyT(func(v UserSummary) string { return v.Role }).(pulumi.StringOutput)
+}
+
+// Current account status of the user: 'active', 'blocked', or 'invited'.
+func (o UserSummaryOutput) Status() resource.UserStatusPtrOutput {
+	return o.ApplyT(func(v UserSummary) *resource.UserStatus { return v.Status }).(resource.UserStatusPtrOutput)
+}
+
+type UserSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (UserSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]UserSummary)(nil)).Elem()
+}
+
+func (o UserSummaryArrayOutput) ToUserSummaryArrayOutput() UserSummaryArrayOutput {
+	return o
+}
+
+func (o UserSummaryArrayOutput) ToUserSummaryArrayOutputWithContext(ctx context.Context) UserSummaryArrayOutput {
+	return o
+}
+
+func (o UserSummaryArrayOutput) Index(i pulumi.IntInput) UserSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) UserSummary {
+		return vs[0].([]UserSummary)[vs[1].(int)]
+	}).(UserSummaryOutput)
 }
 
 func init() {
+	pulumi.RegisterOutputType(CapabilitySummaryOutput{})
+	pulumi.RegisterOutputType(CapabilitySummaryArrayOutput{})
 	pulumi.RegisterOutputType(CityOutput{})
 	pulumi.RegisterOutputType(CityArrayOutput{})
 	pulumi.RegisterOutputType(CountryOutput{})
 	pulumi.RegisterOutputType(CountryArrayOutput{})
+	pulumi.RegisterOutputType(DNSRecordSummaryOutput{})
+	pulumi.RegisterOutputType(DNSRecordSummaryArrayOutput{})
+	pulumi.RegisterOutputType(DNSZoneSummaryOutput{})
+	pulumi.RegisterOutputType(DNSZoneSummaryArrayOutput{})
+	pulumi.RegisterOutputType(GroupSummaryOutput{})
+	pulumi.RegisterOutputType(GroupSummaryArrayOutput{})
+	pulumi.RegisterOutputType(IdentityProviderSummaryOutput{})
+	pulumi.RegisterOutputType(IdentityProviderSummaryArrayOutput{})
+	pulumi.RegisterOutputType(NameserverGroupSummaryOutput{})
+	pulumi.RegisterOutputType(NameserverGroupSummaryArrayOutput{})
+	pulumi.RegisterOutputType(NetworkResourceSummaryOutput{})
+	pulumi.RegisterOutputType(NetworkResourceSummaryArrayOutput{})
+	pulumi.RegisterOutputType(NetworkRouterSummaryOutput{})
+	pulumi.RegisterOutputType(NetworkRouterSummaryArrayOutput{})
+	pulumi.RegisterOutputType(NetworkSummaryOutput{})
+	pulumi.RegisterOutputType(NetworkSummaryArrayOutput{})
 	pulumi.RegisterOutputType(PeerSummaryOutput{})
 	pulumi.RegisterOutputType(PeerSummaryArrayOutput{})
+	pulumi.RegisterOutputType(PendingPeerOutput{})
+	pulumi.RegisterOutputType(PendingPeerArrayOutput{})
+	pulumi.RegisterOutputType(PolicySummaryOutput{})
+	pulumi.RegisterOutputType(PolicySummaryArrayOutput{})
+	pulumi.RegisterOutputType(PostureCheckSummaryOutput{})
+	pulumi.RegisterOutputType(PostureCheckSummaryArrayOutput{})
 	pulumi.RegisterOutputType(ProxyClusterSummaryOutput{})
 	pulumi.RegisterOutputType(ProxyClusterSummaryArrayOutput{})
 	pulumi.RegisterOutputType(ResourceRefOutput{})
 	pulumi.RegisterOutputType(ResourceRefArrayOutput{})
+	pulumi.RegisterOutputType(ReverseProxyDomainSummaryOutput{})
+	pulumi.RegisterOutputType(ReverseProxyDomainSummaryArrayOutput{})
+	pulumi.RegisterOutputType(ReverseProxyServiceSummaryOutput{})
+	pulumi.RegisterOutputType(ReverseProxyServiceSummaryArrayOutput{})
+	pulumi.RegisterOutputType(RouteSummaryOutput{})
+	pulumi.RegisterOutputType(RouteSummaryArrayOutput{})
+	pulumi.RegisterOutputType(SetupKeyEnrolledPeerOutput{})
+	pulumi.RegisterOutputType(SetupKeyEnrolledPeerArrayOutput{})
+	pulumi.RegisterOutputType(SetupKeySummaryOutput{})
+	pulumi.RegisterOutputType(SetupKeySummaryArrayOutput{})
+	pulumi.RegisterOutputType(TokenSummaryOutput{})
+	pulumi.RegisterOutputType(TokenSummaryArrayOutput{})
+	pulumi.RegisterOutputType(UserSummaryOutput{})
+	pulumi.RegisterOutputType(UserSummaryArrayOutput{})
 }
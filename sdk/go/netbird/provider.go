@@ -15,8 +15,30 @@ import (
 type Provider struct {
 	pulumi.ProviderResourceState
 
+	// ID of the NetBird account to manage, for tokens with access to several accounts (e.g. MSP tenants). Resources record the account they belong to; moving a resource to another account replaces it.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
+	// Timeout for creating a resource, as a Go duration (e.g. 10m). Defaults to 5m. A resource's customTimeouts option takes precedence.
+	CreateTimeout pulumi.StringPtrOutput `pulumi:"createTimeout"`
+	// Timeout for deleting a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+	DeleteTimeout pulumi.StringPtrOutput `pulumi:"deleteTimeout"`
+	// NetBird edition of the management server: cloud or self-hosted. Takes precedence over detection from url, which treats only api.netbird.io as cloud. Set it when NetBird Cloud is reached through a proxy or a custom domain.
+	Edition pulumi.StringPtrOutput `pulumi:"edition"`
+	// Name of a credential profile in profilesFile. The profile's url, token, and accountId take precedence over url and token; an explicit accountId takes precedence over the profile's.
+	Profile pulumi.StringPtrOutput `pulumi:"profile"`
+	// Path to the YAML credential profiles file. Defaults to netbird/pulumi-profiles.yaml in the user config directory (e.g. ~/.config/netbird/pulumi-profiles.yaml).
+	ProfilesFile pulumi.StringPtrOutput `pulumi:"profilesFile"`
+	// Timeout for reading (refreshing or importing) a resource, as a Go duration. Defaults to 2m.
+	ReadTimeout pulumi.StringPtrOutput `pulumi:"readTimeout"`
 	// Netbird API Token
 	Token pulumi.StringOutput `pulumi:"token"`
+	// Command whose standard output is used as the API token, e.g. a call to a local secrets manager. Run through the system shell; takes precedence over token. The result is cached for tokenLifetime and refreshed when the server rejects it with 401.
+	TokenCommand pulumi.StringPtrOutput `pulumi:"tokenCommand"`
+	// Path to a file containing the API token, re-read after tokenLifetime or on 401. Takes precedence over token; mutually exclusive with tokenCommand.
+	TokenFile pulumi.StringPtrOutput `pulumi:"tokenFile"`
+	// How long a token from tokenCommand or tokenFile is reused, as a Go duration (e.g. 15m, 1h). Defaults to 15m; 0s fetches a token for every request.
+	TokenLifetime pulumi.StringPtrOutput `pulumi:"tokenLifetime"`
+	// Timeout for updating a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+	UpdateTimeout pulumi.StringPtrOutput `pulumi:"updateTimeout"`
 	// URL to Netbird API, example: https://api.netbird.io
 	Url pulumi.StringOutput `pulumi:"url"`
 }
@@ -28,11 +50,71 @@ func NewProvider(ctx *pulumi.Context,
 		return nil, errors.New("missing one or more required arguments")
 	}
 
+	if args.AccountId == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_ACCOUNT_ID"); d != nil {
+			args.AccountId = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.CreateTimeout == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_CREATE_TIMEOUT"); d != nil {
+			args.CreateTimeout = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.DeleteTimeout == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_DELETE_TIMEOUT"); d != nil {
+			args.DeleteTimeout = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.Edition == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_EDITION"); d != nil {
+			args.Edition = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.Profile == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_PROFILE"); d != nil {
+			args.Profile = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.ProfilesFile == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_PROFILES_FILE"); d != nil {
+			args.ProfilesFile = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.ReadTimeout == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_READ_TIMEOUT"); d != nil {
+			args.ReadTimeout = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.SkipConfigureValidation == nil {
+		if d := internal.GetEnvOrDefault(false, internal.ParseEnvBool, "NETBIRD_SKIP_CONFIGURE_VALIDATION"); d != nil {
+			args.SkipConfigureValidation = pulumi.BoolPtr(d.(bool))
+		}
+	}
 	if args.Token == nil {
 		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_TOKEN"); d != nil {
 			args.Token = pulumi.String(d.(string))
 		}
 	}
+	if args.TokenCommand == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_TOKEN_COMMAND"); d != nil {
+			args.TokenCommand = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.TokenFile == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_TOKEN_FILE"); d != nil {
+			args.TokenFile = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.TokenLifetime == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_TOKEN_LIFETIME"); d != nil {
+			args.TokenLifetime = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.UpdateTimeout == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NETBIRD_UPDATE_TIMEOUT"); d != nil {
+			args.UpdateTimeout = pulumi.StringPtr(d.(string))
+		}
+	}
 	if args.Url == nil {
 		if d := internal.GetEnvOrDefault("https://api.netbird.io", nil, "NETBIRD_URL"); d != nil {
 			args.Url = pulumi.String(d.(string))
@@ -55,16 +137,64 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// ID of the NetBird account to manage, for tokens with access to several accounts (e.g. MSP tenants). Resources record the account they belong to; moving a resource to another account replaces it.
+	AccountId *string `pulumi:"accountId"`
+	// Timeout for creating a resource, as a Go duration (e.g. 10m). Defaults to 5m. A resource's customTimeouts option takes precedence.
+	CreateTimeout *string `pulumi:"createTimeout"`
+	// Timeout for deleting a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+	DeleteTimeout *string `pulumi:"deleteTimeout"`
+	// NetBird edition of the management server: cloud or self-hosted. Takes precedence over detection from url, which treats only api.netbird.io as cloud. Set it when NetBird Cloud is reached through a proxy or a custom domain.
+	Edition *string `pulumi:"edition"`
+	// Name of a credential profile in profilesFile. The profile's url, token, and accountId take precedence over url and token; an explicit accountId takes precedence over the profile's.
+	Profile *string `pulumi:"profile"`
+	// Path to the YAML credential profiles file. Defaults to netbird/pulumi-profiles.yaml in the user config directory (e.g. ~/.config/netbird/pulumi-profiles.yaml).
+	ProfilesFile *string `pulumi:"profilesFile"`
+	// Timeout for reading (refreshing or importing) a resource, as a Go duration. Defaults to 2m.
+	ReadTimeout *string `pulumi:"readTimeout"`
+	// Skip validating the token and URL against the management server during provider configuration, e.g. for offline previews. Server version detection is skipped as well.
+	SkipConfigureValidation *bool `pulumi:"skipConfigureValidation"`
 	// Netbird API Token
 	Token string `pulumi:"token"`
+	// Command whose standard output is used as the API token, e.g. a call to a local secrets manager. Run through the system shell; takes precedence over token. The result is cached for tokenLifetime and refreshed when the server rejects it with 401.
+	TokenCommand *string `pulumi:"tokenCommand"`
+	// Path to a file containing the API token, re-read after tokenLifetime or on 401. Takes precedence over token; mutually exclusive with tokenCommand.
+	TokenFile *string `pulumi:"tokenFile"`
+	// How long a token from tokenCommand or tokenFile is reused, as a Go duration (e.g. 15m, 1h). Defaults to 15m; 0s fetches a token for every request.
+	TokenLifetime *string `pulumi:"tokenLifetime"`
+	// Timeout for updating a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+	UpdateTimeout *string `pulumi:"updateTimeout"`
 	// URL to Netbird API, example: https://api.netbird.io
 	Url string `pulumi:"url"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// ID of the NetBird account to manage, for tokens with access to several accounts (e.g. MSP tenants). Resources record the account they belong to; moving a resource to another account replaces it.
+	AccountId pulumi.StringPtrInput
+	// Timeout for creating a resource, as a Go duration (e.g. 10m). Defaults to 5m. A resource's customTimeouts option takes precedence.
+	CreateTimeout pulumi.StringPtrInput
+	// Timeout for deleting a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+	DeleteTimeout pulumi.StringPtrInput
+	// NetBird edition of the management server: cloud or self-hosted. Takes precedence over detection from url, which treats only api.netbird.io as cloud. Set it when NetBird Cloud is reached through a proxy or a custom domain.
+	Edition pulumi.StringPtrInput
+	// Name of a credential profile in profilesFile. The profile's url, token, and accountId take precedence over url and token; an explicit accountId takes precedence over the profile's.
+	Profile pulumi.StringPtrInput
+	// Path to the YAML credential profiles file. Defaults to netbird/pulumi-profiles.yaml in the user config directory (e.g. ~/.config/netbird/pulumi-profiles.yaml).
+	ProfilesFile pulumi.StringPtrInput
+	// Timeout for reading (refreshing or importing) a resource, as a Go duration. Defaults to 2m.
+	ReadTimeout pulumi.StringPtrInput
+	// Skip validating the token and URL against the management server during provider configuration, e.g. for offline previews. Server version detection is skipped as well.
+	SkipConfigureValidation pulumi.BoolPtrInput
 	// Netbird API Token
 	Token pulumi.StringInput
+	// Command whose standard output is used as the API token, e.g. a call to a local secrets manager. Run through the system shell; takes precedence over token. The result is cached for tokenLifetime and refreshed when the server rejects it with 401.
+	TokenCommand pulumi.StringPtrInput
+	// Path to a file containing the API token, re-read after tokenLifetime or on 401. Takes precedence over token; mutually exclusive with tokenCommand.
+	TokenFile pulumi.StringPtrInput
+	// How long a token from tokenCommand or tokenFile is reused, as a Go duration (e.g. 15m, 1h). Defaults to 15m; 0s fetches a token for every request.
+	TokenLifetime pulumi.StringPtrInput
+	// Timeout for updating a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+	UpdateTimeout pulumi.StringPtrInput
 	// URL to Netbird API, example: https://api.netbird.io
 	Url pulumi.StringInput
 }
@@ -106,11 +236,66 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
+// ID of the NetBird account to manage, for tokens with access to several accounts (e.g. MSP tenants). Resources record the account they belong to; moving a resource to another account replaces it.
+func (o ProviderOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Timeout for creating a resource, as a Go duration (e.g. 10m). Defaults to 5m. A resource's customTimeouts option takes precedence.
+func (o ProviderOutput) CreateTimeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.CreateTimeout }).(pulumi.StringPtrOutput)
+}
+
+// Timeout for deleting a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+func (o ProviderOutput) DeleteTimeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.DeleteTimeout }).(pulumi.StringPtrOutput)
+}
+
+// NetBird edition of the management server: cloud or self-hosted. Takes precedence over detection from url, which treats only api.netbird.io as cloud. Set it when NetBird Cloud is reached through a proxy or a custom domain.
+func (o ProviderOutput) Edition() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Edition }).(pulumi.StringPtrOutput)
+}
+
+// Name of a credential profile in profilesFile. The profile's url, token, and accountId take precedence over url and token; an explicit accountId takes precedence over the profile's.
+func (o ProviderOutput) Profile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Profile }).(pulumi.StringPtrOutput)
+}
+
+// Path to the YAML credential profiles file. Defaults to netbird/pulumi-profiles.yaml in the user config directory (e.g. ~/.config/netbird/pulumi-profiles.yaml).
+func (o ProviderOutput) ProfilesFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ProfilesFile }).(pulumi.StringPtrOutput)
+}
+
+// Timeout for reading (refreshing or importing) a resource, as a Go duration. Defaults to 2m.
+func (o ProviderOutput) ReadTimeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ReadTimeout }).(pulumi.StringPtrOutput)
+}
+
 // Netbird API Token
 func (o ProviderOutput) Token() pulumi.StringOutput {
 	return o.ApplyT(func(v *Provider) pulumi.StringOutput { return v.Token }).(pulumi.StringOutput)
 }
 
+// Command whose standard output is used as the API token, e.g. a call to a local secrets manager. Run through the system shell; takes precedence over token. The result is cached for tokenLifetime and refreshed when the server rejects it with 401.
+func (o ProviderOutput) TokenCommand() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.TokenCommand }).(pulumi.StringPtrOutput)
+}
+
+// Path to a file containing the API token, re-read after tokenLifetime or on 401. Takes precedence over token; mutually exclusive with tokenCommand.
+func (o ProviderOutput) TokenFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.TokenFile }).(pulumi.StringPtrOutput)
+}
+
+// How long a token from tokenCommand or tokenFile is reused, as a Go duration (e.g. 15m, 1h). Defaults to 15m; 0s fetches a token for every request.
+func (o ProviderOutput) TokenLifetime() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.TokenLifetime }).(pulumi.StringPtrOutput)
+}
+
+// Timeout for updating a resource, as a Go duration. Defaults to 5m. A resource's customTimeouts option takes precedence.
+func (o ProviderOutput) UpdateTimeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.UpdateTimeout }).(pulumi.StringPtrOutput)
+}
+
 // URL to Netbird API, example: https://api.netbird.io
 func (o ProviderOutput) Url() pulumi.StringOutput {
 	return o.ApplyT(func(v *Provider) pulumi.StringOutput { return v.Url }).(pulumi.StringOutput)
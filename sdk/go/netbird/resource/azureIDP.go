@@ -16,6 +16,8 @@ import (
 type AzureIDP struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Azure AD application (client) ID.
 	ClientId pulumi.StringOutput `pulumi:"clientId"`
 	// Base64-encoded Azure AD client secret. Not returned by the API; preserved from configuration.
@@ -226,6 +228,11 @@ func (o AzureIDPOutput) ToAzureIDPOutputWithContext(ctx context.Context) AzureID
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o AzureIDPOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureIDP) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Azure AD application (client) ID.
 func (o AzureIDPOutput) ClientId() pulumi.StringOutput {
 	return o.ApplyT(func(v *AzureIDP) pulumi.StringOutput { return v.ClientId }).(pulumi.StringOutput)
@@ -16,6 +16,8 @@ import (
 type DNS struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Description of the nameserver group
 	Description pulumi.StringOutput `pulumi:"description"`
 	// Domains Match domain list. It should be empty only if primary is true.
@@ -223,6 +225,11 @@ func (o DNSOutput) ToDNSOutputWithContext(ctx context.Context) DNSOutput {
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o DNSOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DNS) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Description of the nameserver group
 func (o DNSOutput) Description() pulumi.StringOutput {
 	return o.ApplyT(func(v *DNS) pulumi.StringOutput { return v.Description }).(pulumi.StringOutput)
@@ -16,6 +16,8 @@ import (
 type DNSRecord struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// DNS record content (IP address for A/AAAA, domain for CNAME).
 	Content pulumi.StringOutput `pulumi:"content"`
 	// FQDN for the DNS record. Must be a subdomain within or match the zone's domain.
@@ -196,6 +198,11 @@ func (o DNSRecordOutput) ToDNSRecordOutputWithContext(ctx context.Context) DNSRe
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o DNSRecordOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DNSRecord) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // DNS record content (IP address for A/AAAA, domain for CNAME).
 func (o DNSRecordOutput) Content() pulumi.StringOutput {
 	return o.ApplyT(func(v *DNSRecord) pulumi.StringOutput { return v.Content }).(pulumi.StringOutput)
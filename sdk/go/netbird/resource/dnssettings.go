@@ -16,6 +16,8 @@ import (
 type DNSSettings struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Group IDs whose DNS management is disabled.
 	DisabledManagementGroups pulumi.StringArrayOutput `pulumi:"disabledManagementGroups"`
 }
@@ -160,6 +162,11 @@ func (o DNSSettingsOutput) ToDNSSettingsOutputWithContext(ctx context.Context) D
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o DNSSettingsOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DNSSettings) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Group IDs whose DNS management is disabled.
 func (o DNSSettingsOutput) DisabledManagementGroups() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *DNSSettings) pulumi.StringArrayOutput { return v.DisabledManagementGroups }).(pulumi.StringArrayOutput)
@@ -16,6 +16,8 @@ import (
 type DNSZone struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Group IDs that define groups of peers that will resolve this zone.
 	DistributionGroups pulumi.StringArrayOutput `pulumi:"distributionGroups"`
 	// Zone domain (FQDN).
@@ -196,6 +198,11 @@ func (o DNSZoneOutput) ToDNSZoneOutputWithContext(ctx context.Context) DNSZoneOu
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o DNSZoneOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Group IDs that define groups of peers that will resolve this zone.
 func (o DNSZoneOutput) DistributionGroups() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *DNSZone) pulumi.StringArrayOutput { return v.DistributionGroups }).(pulumi.StringArrayOutput)
@@ -16,6 +16,8 @@ import (
 type GoogleIDP struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// DEX connector ID for embedded IdP setups.
 	ConnectorId pulumi.StringPtrOutput `pulumi:"connectorId"`
 	// Customer ID from Google Workspace account settings.
@@ -208,6 +210,11 @@ func (o GoogleIDPOutput) ToGoogleIDPOutputWithContext(ctx context.Context) Googl
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o GoogleIDPOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GoogleIDP) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // DEX connector ID for embedded IdP setups.
 func (o GoogleIDPOutput) ConnectorId() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *GoogleIDP) pulumi.StringPtrOutput { return v.ConnectorId }).(pulumi.StringPtrOutput)
@@ -16,6 +16,8 @@ import (
 type IdentityProvider struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// OAuth2 client ID.
 	ClientId pulumi.StringOutput `pulumi:"clientId"`
 	// OAuth2 client secret. Not returned by the API; preserved from configuration.
@@ -203,6 +205,11 @@ func (o IdentityProviderOutput) ToIdentityProviderOutputWithContext(ctx context.
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o IdentityProviderOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *IdentityProvider) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // OAuth2 client ID.
 func (o IdentityProviderOutput) ClientId() pulumi.StringOutput {
 	return o.ApplyT(func(v *IdentityProvider) pulumi.StringOutput { return v.ClientId }).(pulumi.StringOutput)
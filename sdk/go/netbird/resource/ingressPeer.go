@@ -16,6 +16,8 @@ import (
 type IngressPeer struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Forwarding ports remaining on the ingress peer.
 	AvailablePorts IngressAvailablePortsPtrOutput `pulumi:"availablePorts"`
 	// Whether the ingress peer is connected to the management server.
@@ -186,6 +188,11 @@ func (o IngressPeerOutput) ToIngressPeerOutputWithContext(ctx context.Context) I
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o IngressPeerOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *IngressPeer) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Forwarding ports remaining on the ingress peer.
 func (o IngressPeerOutput) AvailablePorts() IngressAvailablePortsPtrOutput {
 	return o.ApplyT(func(v *IngressPeer) IngressAvailablePortsPtrOutput { return v.AvailablePorts }).(IngressAvailablePortsPtrOutput)
@@ -39,6 +39,8 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 		r = &IdentityProvider{}
 	case "netbird:resource:IngressPeer":
 		r = &IngressPeer{}
+	case "netbird:resource:IngressPortAllocation":
+		r = &IngressPortAllocation{}
 	case "netbird:resource:Network":
 		r = &Network{}
 	case "netbird:resource:NetworkResource":
@@ -49,6 +51,8 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 		r = &OktaScimIDP{}
 	case "netbird:resource:Peer":
 		r = &Peer{}
+	case "netbird:resource:PeerApproval":
+		r = &PeerApproval{}
 	case "netbird:resource:Policy":
 		r = &Policy{}
 	case "netbird:resource:PostureCheck":
@@ -67,6 +71,10 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 		r = &Token{}
 	case "netbird:resource:User":
 		r = &User{}
+	case "netbird:resource:UserGroupMembership":
+		r = &UserGroupMembership{}
+	case "netbird:resource:UserInvite":
+		r = &UserInvite{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
@@ -16,6 +16,8 @@ import (
 type NetworkResource struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// CIDR or IP address block assigned to the resource.
 	Address pulumi.StringOutput `pulumi:"address"`
 	// Optional description of the resource.
@@ -202,6 +204,11 @@ func (o NetworkResourceOutput) ToNetworkResourceOutputWithContext(ctx context.Co
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o NetworkResourceOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NetworkResource) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // CIDR or IP address block assigned to the resource.
 func (o NetworkResourceOutput) Address() pulumi.StringOutput {
 	return o.ApplyT(func(v *NetworkResource) pulumi.StringOutput { return v.Address }).(pulumi.StringOutput)
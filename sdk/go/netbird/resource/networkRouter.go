@@ -16,6 +16,8 @@ import (
 type NetworkRouter struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Whether the router is enabled.
 	Enabled pulumi.BoolOutput `pulumi:"enabled"`
 	// Whether masquerading is enabled.
@@ -199,6 +201,11 @@ func (o NetworkRouterOutput) ToNetworkRouterOutputWithContext(ctx context.Contex
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o NetworkRouterOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NetworkRouter) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Whether the router is enabled.
 func (o NetworkRouterOutput) Enabled() pulumi.BoolOutput {
 	return o.ApplyT(func(v *NetworkRouter) pulumi.BoolOutput { return v.Enabled }).(pulumi.BoolOutput)
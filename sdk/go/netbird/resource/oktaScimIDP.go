@@ -16,6 +16,8 @@ import (
 type OktaScimIDP struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// SCIM API token. Returned in full only on creation; masked afterwards, so the created value is preserved.
 	AuthToken pulumi.StringPtrOutput `pulumi:"authToken"`
 	// The Okta enterprise connection name on Auth0.
@@ -192,6 +194,11 @@ func (o OktaScimIDPOutput) ToOktaScimIDPOutputWithContext(ctx context.Context) O
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o OktaScimIDPOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OktaScimIDP) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // SCIM API token. Returned in full only on creation; masked afterwards, so the created value is preserved.
 func (o OktaScimIDPOutput) AuthToken() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *OktaScimIDP) pulumi.StringPtrOutput { return v.AuthToken }).(pulumi.StringPtrOutput)
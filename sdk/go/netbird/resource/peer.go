@@ -16,6 +16,8 @@ import (
 type Peer struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Deprecated: Cloud only, not maintained in this provider
 	ApprovalRequired pulumi.BoolPtrOutput `pulumi:"approvalRequired"`
 	// Whether Inactivity Expiration is enabled.
@@ -184,6 +186,11 @@ func (o PeerOutput) ToPeerOutputWithContext(ctx context.Context) PeerOutput {
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o PeerOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Peer) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Deprecated: Cloud only, not maintained in this provider
 func (o PeerOutput) ApprovalRequired() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *Peer) pulumi.BoolPtrOutput { return v.ApprovalRequired }).(pulumi.BoolPtrOutput)
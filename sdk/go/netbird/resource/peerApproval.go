@@ -0,0 +1,265 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package resource
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Approves NetBird peers that are pending approval (NetBird Cloud peer approval). Select a single peer by peerId, or every pending peer matching groupId and/or nameRegex, the same selector getPeers uses. Peers that start matching later are approved on the next `pulumi up --refresh`.
+type PeerApproval struct {
+	pulumi.CustomResourceState
+
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
+	// Peers approved by this resource. Peers that were already approved are not recorded and never revoked.
+	Approved ApprovedPeerArrayOutput `pulumi:"approved"`
+	// Approve every pending peer that belongs to this group, as in getPeers. Combines with nameRegex; mutually exclusive with peerId. Changing this forces a replacement.
+	GroupId pulumi.StringPtrOutput `pulumi:"groupId"`
+	// Approve every pending peer whose name matches this regular expression (RE2 syntax), as in getPeers. Unanchored, so use ^ and $ for an exact match. Combines with groupId; mutually exclusive with peerId. Changing this forces a replacement.
+	NameRegex pulumi.StringPtrOutput `pulumi:"nameRegex"`
+	// ID of the peer to approve. Mutually exclusive with groupId and nameRegex. Changing this forces a replacement.
+	PeerId pulumi.StringPtrOutput `pulumi:"peerId"`
+	// IDs of matching peers found pending on the last refresh; they are approved on the next update.
+	Pending pulumi.StringArrayOutput `pulumi:"pending"`
+	// Whether deleting the resource puts the peers it approved back into the pending state. Defaults to false.
+	RevokeOnDelete pulumi.BoolPtrOutput `pulumi:"revokeOnDelete"`
+}
+
+// NewPeerApproval registers a new resource with the given unique name, arguments, and options.
+func NewPeerApproval(ctx *pulumi.Context,
+	name string, args *PeerApprovalArgs, opts ...pulumi.ResourceOption) (*PeerApproval, error) {
+	if args == nil {
+		args = &PeerApprovalArgs{}
+	}
+
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource PeerApproval
+	err := ctx.RegisterResource("netbird:resource:PeerApproval", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetPeerApproval gets an existing PeerApproval resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetPeerApproval(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *PeerApprovalState, opts ...pulumi.ResourceOption) (*PeerApproval, error) {
+	var resource PeerApproval
+	err := ctx.ReadResource("netbird:resource:PeerApproval", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering PeerApproval resources.
+type peerApprovalState struct {
+}
+
+type PeerApprovalState struct {
+}
+
+func (PeerApprovalState) ElementType() reflect.Type {
+	return reflect.TypeOf((*peerApprovalState)(nil)).Elem()
+}
+
+type peerApprovalArgs struct {
+	// Approve every pending peer that belongs to this group, as in getPeers. Combines with nameRegex; mutually exclusive with peerId. Changing this forces a replacement.
+	GroupId *string `pulumi:"groupId"`
+	// Approve every pending peer whose name matches this regular expression (RE2 syntax), as in getPeers. Unanchored, so use ^ and $ for an exact match. Combines with groupId; mutually exclusive with peerId. Changing this forces a replacement.
+	NameRegex *string `pulumi:"nameRegex"`
+	// ID of the peer to approve. Mutually exclusive with groupId and nameRegex. Changing this forces a replacement.
+	PeerId *string `pulumi:"peerId"`
+	// Whether deleting the resource puts the peers it approved back into the pending state. Defaults to false.
+	RevokeOnDelete *bool `pulumi:"revokeOnDelete"`
+}
+
+// The set of arguments for constructing a PeerApproval resource.
+type PeerApprovalArgs struct {
+	// Approve every pending peer that belongs to this group, as in getPeers. Combines with nameRegex; mutually exclusive with peerId. Changing this forces a replacement.
+	GroupId pulumi.StringPtrInput
+	// Approve every pending peer whose name matches this regular expression (RE2 syntax), as in getPeers. Unanchored, so use ^ and $ for an exact match. Combines with groupId; mutually exclusive with peerId. Changing this forces a replacement.
+	NameRegex pulumi.StringPtrInput
+	// ID of the peer to approve. Mutually exclusive with groupId and nameRegex. Changing this forces a replacement.
+	PeerId pulumi.StringPtrInput
+	// Whether deleting the resource puts the peers it approved back into the pending state. Defaults to false.
+	RevokeOnDelete pulumi.BoolPtrInput
+}
+
+func (PeerApprovalArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*peerApprovalArgs)(nil)).Elem()
+}
+
+type PeerApprovalInput interface {
+	pulumi.Input
+
+	ToPeerApprovalOutput() PeerApprovalOutput
+	ToPeerApprovalOutputWithContext(ctx context.Context) PeerApprovalOutput
+}
+
+func (*PeerApproval) ElementType() reflect.Type {
+	return reflect.TypeOf((**PeerApproval)(nil)).Elem()
+}
+
+func (i *PeerApproval) ToPeerApprovalOutput() PeerApprovalOutput {
+	return i.ToPeerApprovalOutputWithContext(context.Background())
+}
+
+func (i *PeerApproval) ToPeerApprovalOutputWithContext(ctx context.Context) PeerApprovalOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PeerApprovalOutput)
+}
+
+// PeerApprovalArrayInput is an input type that accepts PeerApprovalArray and PeerApprovalArrayOutput values.
+// You can construct a concrete instance of `PeerApprovalArrayInput` via:
+//
+//	PeerApprovalArray{ PeerApprovalArgs{...} }
+type PeerApprovalArrayInput interface {
+	pulumi.Input
+
+	ToPeerApprovalArrayOutput() PeerApprovalArrayOutput
+	ToPeerApprovalArrayOutputWithContext(context.Context) PeerApprovalArrayOutput
+}
+
+type PeerApprovalArray []PeerApprovalInput
+
+func (PeerApprovalArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*PeerApproval)(nil)).Elem()
+}
+
+func (i PeerApprovalArray) ToPeerApprovalArrayOutput() PeerApprovalArrayOutput {
+	return i.ToPeerApprovalArrayOutputWithContext(context.Background())
+}
+
+func (i PeerApprovalArray) ToPeerApprovalArrayOutputWithContext(ctx context.Context) PeerApprovalArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PeerApprovalArrayOutput)
+}
+
+// PeerApprovalMapInput is an input type that accepts PeerApprovalMap and PeerApprovalMapOutput values.
+// You can construct a concrete instance of `PeerApprovalMapInput` via:
+//
+//	PeerApprovalMap{ "key": PeerApprovalArgs{...} }
+type PeerApprovalMapInput interface {
+	pulumi.Input
+
+	ToPeerApprovalMapOutput() PeerApprovalMapOutput
+	ToPeerApprovalMapOutputWithContext(context.Context) PeerApprovalMapOutput
+}
+
+type PeerApprovalMap map[string]PeerApprovalInput
+
+func (PeerApprovalMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*PeerApproval)(nil)).Elem()
+}
+
+func (i PeerApprovalMap) ToPeerApprovalMapOutput() PeerApprovalMapOutput {
+	return i.ToPeerApprovalMapOutputWithContext(context.Background())
+}
+
+func (i PeerApprovalMap) ToPeerApprovalMapOutputWithContext(ctx context.Context) PeerApprovalMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PeerApprovalMapOutput)
+}
+
+type PeerApprovalOutput struct{ *pulumi.OutputState }
+
+func (PeerApprovalOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**PeerApproval)(nil)).Elem()
+}
+
+func (o PeerApprovalOutput) ToPeerApprovalOutput() PeerApprovalOutput {
+	return o
+}
+
+func (o PeerApprovalOutput) ToPeerApprovalOutputWithContext(ctx context.Context) PeerApprovalOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o PeerApprovalOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PeerApproval) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Peers approved by this resource. Peers that were already approved are not recorded and never revoked.
+func (o PeerApprovalOutput) Approved() ApprovedPeerArrayOutput {
+	return o.ApplyT(func(v *PeerApproval) ApprovedPeerArrayOutput { return v.Approved }).(ApprovedPeerArrayOutput)
+}
+
+// Approve every pending peer that belongs to this group, as in getPeers. Combines with nameRegex; mutually exclusive with peerId. Changing this forces a replacement.
+func (o PeerApprovalOutput) GroupId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PeerApproval) pulumi.StringPtrOutput { return v.GroupId }).(pulumi.StringPtrOutput)
+}
+
+// Approve every pending peer whose name matches this regular expression (RE2 syntax), as in getPeers. Unanchored, so use ^ and $ for an exact match. Combines with groupId; mutually exclusive with peerId. Changing this forces a replacement.
+func (o PeerApprovalOutput) NameRegex() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PeerApproval) pulumi.StringPtrOutput { return v.NameRegex }).(pulumi.StringPtrOutput)
+}
+
+// ID of the peer to approve. Mutually exclusive with groupId and nameRegex. Changing this forces a replacement.
+func (o PeerApprovalOutput) PeerId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PeerApproval) pulumi.StringPtrOutput { return v.PeerId }).(pulumi.StringPtrOutput)
+}
+
+// IDs of matching peers found pending on the last refresh; they are approved on the next update.
+func (o PeerApprovalOutput) Pending() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *PeerApproval) pulumi.StringArrayOutput { return v.Pending }).(pulumi.StringArrayOutput)
+}
+
+// Whether deleting the resource puts the peers it approved back into the pending state. Defaults to false.
+func (o PeerApprovalOutput) RevokeOnDelete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *PeerApproval) pulumi.BoolPtrOutput { return v.RevokeOnDelete }).(pulumi.BoolPtrOutput)
+}
+
+type PeerApprovalArrayOutput struct{ *pulumi.OutputState }
+
+func (PeerApprovalArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*PeerApproval)(nil)).Elem()
+}
+
+func (o PeerApprovalArrayOutput) ToPeerApprovalArrayOutput() PeerApprovalArrayOutput {
+	return o
+}
+
+func (o PeerApprovalArrayOutput) ToPeerApprovalArrayOutputWithContext(ctx context.Context) PeerApprovalArrayOutput {
+	return o
+}
+
+func (o PeerApprovalArrayOutput) Index(i pulumi.IntInput) PeerApprovalOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *PeerApproval {
+		return vs[0].([]*PeerApproval)[vs[1].(int)]
+	}).(PeerApprovalOutput)
+}
+
+type PeerApprovalMapOutput struct{ *pulumi.OutputState }
+
+func (PeerApprovalMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*PeerApproval)(nil)).Elem()
+}
+
+func (o PeerApprovalMapOutput) ToPeerApprovalMapOutput() PeerApprovalMapOutput {
+	return o
+}
+
+func (o PeerApprovalMapOutput) ToPeerApprovalMapOutputWithContext(ctx context.Context) PeerApprovalMapOutput {
+	return o
+}
+
+func (o PeerApprovalMapOutput) MapIndex(k pulumi.StringInput) PeerApprovalOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *PeerApproval {
+		return vs[0].(map[string]*PeerApproval)[vs[1].(string)]
+	}).(PeerApprovalOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*PeerApprovalInput)(nil)).Elem(), &PeerApproval{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PeerApprovalArrayInput)(nil)).Elem(), PeerApprovalArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PeerApprovalMapInput)(nil)).Elem(), PeerApprovalMap{})
+	pulumi.RegisterOutputType(PeerApprovalOutput{})
+	pulumi.RegisterOutputType(PeerApprovalArrayOutput{})
+	pulumi.RegisterOutputType(PeerApprovalMapOutput{})
+}
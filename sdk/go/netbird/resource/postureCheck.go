@@ -16,6 +16,8 @@ import (
 type PostureCheck struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// List of checks to perform against peer properties.
 	Checks PostureChecksConfigOutput `pulumi:"checks"`
 	// Posture check friendly description.
@@ -175,6 +177,11 @@ func (o PostureCheckOutput) ToPostureCheckOutputWithContext(ctx context.Context)
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o PostureCheckOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PostureCheck) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // List of checks to perform against peer properties.
 func (o PostureCheckOutput) Checks() PostureChecksConfigOutput {
 	return o.ApplyT(func(v *PostureCheck) PostureChecksConfigOutput { return v.Checks }).(PostureChecksConfigOutput)
@@ -532,6 +532,176 @@ func (in *identityProviderTypePtr) ToIdentityProviderTypePtrOutputWithContext(ct
 	return pulumi.ToOutputWithContext(ctx, in).(IdentityProviderTypePtrOutput)
 }
 
+type IngressProtocol string
+
+const (
+	// TCP only.
+	IngressProtocolTcp = IngressProtocol("tcp")
+	// UDP only.
+	IngressProtocolUdp = IngressProtocol("udp")
+	// Both TCP and UDP.
+	IngressProtocolTcpUdp = IngressProtocol("tcp/udp")
+)
+
+func (IngressProtocol) ElementType() reflect.Type {
+	return reflect.TypeOf((*IngressProtocol)(nil)).Elem()
+}
+
+func (e IngressProtocol) ToIngressProtocolOutput() IngressProtocolOutput {
+	return pulumi.ToOutput(e).(IngressProtocolOutput)
+}
+
+func (e IngressProtocol) ToIngressProtocolOutputWithContext(ctx context.Context) IngressProtocolOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(IngressProtocolOutput)
+}
+
+func (e IngressProtocol) ToIngressProtocolPtrOutput() IngressProtocolPtrOutput {
+	return e.ToIngressProtocolPtrOutputWithContext(context.Background())
+}
+
+func (e IngressProtocol) ToIngressProtocolPtrOutputWithContext(ctx context.Context) IngressProtocolPtrOutput {
+	return IngressProtocol(e).ToIngressProtocolOutputWithContext(ctx).ToIngressProtocolPtrOutputWithContext(ctx)
+}
+
+func (e IngressProtocol) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e IngressProtocol) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e IngressProtocol) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e IngressProtocol) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type IngressProtocolOutput struct{ *pulumi.OutputState }
+
+func (IngressProtocolOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*IngressProtocol)(nil)).Elem()
+}
+
+func (o IngressProtocolOutput) ToIngressProtocolOutput() IngressProtocolOutput {
+	return o
+}
+
+func (o IngressProtocolOutput) ToIngressProtocolOutputWithContext(ctx context.Context) IngressProtocolOutput {
+	return o
+}
+
+func (o IngressProtocolOutput) ToIngressProtocolPtrOutput() IngressProtocolPtrOutput {
+	return o.ToIngressProtocolPtrOutputWithContext(context.Background())
+}
+
+func (o IngressProtocolOutput) ToIngressProtocolPtrOutputWithContext(ctx context.Context) IngressProtocolPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v IngressProtocol) *IngressProtocol {
+		return &v
+	}).(IngressProtocolPtrOutput)
+}
+
+func (o IngressProtocolOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o IngressProtocolOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e IngressProtocol) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o IngressProtocolOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o IngressProtocolOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e IngressProtocol) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type IngressProtocolPtrOutput struct{ *pulumi.OutputState }
+
+func (IngressProtocolPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**IngressProtocol)(nil)).Elem()
+}
+
+func (o IngressProtocolPtrOutput) ToIngressProtocolPtrOutput() IngressProtocolPtrOutput {
+	return o
+}
+
+func (o IngressProtocolPtrOutput) ToIngressProtocolPtrOutputWithContext(ctx context.Context) IngressProtocolPtrOutput {
+	return o
+}
+
+func (o IngressProtocolPtrOutput) Elem() IngressProtocolOutput {
+	return o.ApplyT(func(v *IngressProtocol) IngressProtocol {
+		if v != nil {
+			return *v
+		}
+		var ret IngressProtocol
+		return ret
+	}).(IngressProtocolOutput)
+}
+
+func (o IngressProtocolPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o IngressProtocolPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *IngressProtocol) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// IngressProtocolInput is an input type that accepts values of the IngressProtocol enum
+// A concrete instance of `IngressProtocolInput` can be one of the following:
+//
+//	IngressProtocolTcp
+//	IngressProtocolUdp
+//	IngressProtocolTcpUdp
+type IngressProtocolInput interface {
+	pulumi.Input
+
+	ToIngressProtocolOutput() IngressProtocolOutput
+	ToIngressProtocolOutputWithContext(context.Context) IngressProtocolOutput
+}
+
+var ingressProtocolPtrType = reflect.TypeOf((**IngressProtocol)(nil)).Elem()
+
+type IngressProtocolPtrInput interface {
+	pulumi.Input
+
+	ToIngressProtocolPtrOutput() IngressProtocolPtrOutput
+	ToIngressProtocolPtrOutputWithContext(context.Context) IngressProtocolPtrOutput
+}
+
+type ingressProtocolPtr string
+
+func IngressProtocolPtr(v string) IngressProtocolPtrInput {
+	return (*ingressProtocolPtr)(&v)
+}
+
+func (*ingressProtocolPtr) ElementType() reflect.Type {
+	return ingressProtocolPtrType
+}
+
+func (in *ingressProtocolPtr) ToIngressProtocolPtrOutput() IngressProtocolPtrOutput {
+	return pulumi.ToOutput(in).(IngressProtocolPtrOutput)
+}
+
+func (in *ingressProtocolPtr) ToIngressProtocolPtrOutputWithContext(ctx context.Context) IngressProtocolPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(IngressProtocolPtrOutput)
+}
+
 type NameserverNsType string
 
 const (
@@ -1203,6 +1373,51 @@ func (in *protocolPtr) ToProtocolPtrOutputWithContext(ctx context.Context) Proto
 	return pulumi.ToOutputWithContext(ctx, in).(ProtocolPtrOutput)
 }
 
+// ProtocolArrayInput is an input type that accepts ProtocolArray and ProtocolArrayOutput values.
+// You can construct a concrete instance of `ProtocolArrayInput` via:
+//
+//	ProtocolArray{ ProtocolArgs{...} }
+type ProtocolArrayInput interface {
+	pulumi.Input
+
+	ToProtocolArrayOutput() ProtocolArrayOutput
+	ToProtocolArrayOutputWithContext(context.Context) ProtocolArrayOutput
+}
+
+type ProtocolArray []Protocol
+
+func (ProtocolArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Protocol)(nil)).Elem()
+}
+
+func (i ProtocolArray) ToProtocolArrayOutput() ProtocolArrayOutput {
+	return i.ToProtocolArrayOutputWithContext(context.Background())
+}
+
+func (i ProtocolArray) ToProtocolArrayOutputWithContext(ctx context.Context) ProtocolArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProtocolArrayOutput)
+}
+
+type ProtocolArrayOutput struct{ *pulumi.OutputState }
+
+func (ProtocolArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Protocol)(nil)).Elem()
+}
+
+func (o ProtocolArrayOutput) ToProtocolArrayOutput() ProtocolArrayOutput {
+	return o
+}
+
+func (o ProtocolArrayOutput) ToProtocolArrayOutputWithContext(ctx context.Context) ProtocolArrayOutput {
+	return o
+}
+
+func (o ProtocolArrayOutput) Index(i pulumi.IntInput) ProtocolOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Protocol {
+		return vs[0].([]Protocol)[vs[1].(int)]
+	}).(ProtocolOutput)
+}
+
 type ReverseProxyCrowdsecMode string
 
 const (
@@ -2763,6 +2978,42 @@ const (
 	UserStatusInvited = UserStatus("invited")
 )
 
+func (UserStatus) ElementType() reflect.Type {
+	return reflect.TypeOf((*UserStatus)(nil)).Elem()
+}
+
+func (e UserStatus) ToUserStatusOutput() UserStatusOutput {
+	return pulumi.ToOutput(e).(UserStatusOutput)
+}
+
+func (e UserStatus) ToUserStatusOutputWithContext(ctx context.Context) UserStatusOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(UserStatusOutput)
+}
+
+func (e UserStatus) ToUserStatusPtrOutput() UserStatusPtrOutput {
+	return e.ToUserStatusPtrOutputWithContext(context.Background())
+}
+
+func (e UserStatus) ToUserStatusPtrOutputWithContext(ctx context.Context) UserStatusPtrOutput {
+	return UserStatus(e).ToUserStatusOutputWithContext(ctx).ToUserStatusPtrOutputWithContext(ctx)
+}
+
+func (e UserStatus) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e UserStatus) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e UserStatus) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e UserStatus) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
 type UserStatusOutput struct{ *pulumi.OutputState }
 
 func (UserStatusOutput) ElementType() reflect.Type {
@@ -2846,6 +3097,46 @@ func (o UserStatusPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) p
 	}).(pulumi.StringPtrOutput)
 }
 
+// UserStatusInput is an input type that accepts values of the UserStatus enum
+// A concrete instance of `UserStatusInput` can be one of the following:
+//
+//	UserStatusActive
+//	UserStatusBlocked
+//	UserStatusInvited
+type UserStatusInput interface {
+	pulumi.Input
+
+	ToUserStatusOutput() UserStatusOutput
+	ToUserStatusOutputWithContext(context.Context) UserStatusOutput
+}
+
+var userStatusPtrType = reflect.TypeOf((**UserStatus)(nil)).Elem()
+
+type UserStatusPtrInput interface {
+	pulumi.Input
+
+	ToUserStatusPtrOutput() UserStatusPtrOutput
+	ToUserStatusPtrOutputWithContext(context.Context) UserStatusPtrOutput
+}
+
+type userStatusPtr string
+
+func UserStatusPtr(v string) UserStatusPtrInput {
+	return (*userStatusPtr)(&v)
+}
+
+func (*userStatusPtr) ElementType() reflect.Type {
+	return userStatusPtrType
+}
+
+func (in *userStatusPtr) ToUserStatusPtrOutput() UserStatusPtrOutput {
+	return pulumi.ToOutput(in).(UserStatusPtrOutput)
+}
+
+func (in *userStatusPtr) ToUserStatusPtrOutputWithContext(ctx context.Context) UserStatusPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(UserStatusPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*AzureHostInput)(nil)).Elem(), AzureHost("microsoft.com"))
 	pulumi.RegisterInputType(reflect.TypeOf((*AzureHostPtrInput)(nil)).Elem(), AzureHost("microsoft.com"))
@@ -2853,6 +3144,8 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordTypePtrInput)(nil)).Elem(), DNSRecordType("A"))
 	pulumi.RegisterInputType(reflect.TypeOf((*IdentityProviderTypeInput)(nil)).Elem(), IdentityProviderType("adfs"))
 	pulumi.RegisterInputType(reflect.TypeOf((*IdentityProviderTypePtrInput)(nil)).Elem(), IdentityProviderType("adfs"))
+	pulumi.RegisterInputType(reflect.TypeOf((*IngressProtocolInput)(nil)).Elem(), IngressProtocol("tcp"))
+	pulumi.RegisterInputType(reflect.TypeOf((*IngressProtocolPtrInput)(nil)).Elem(), IngressProtocol("tcp"))
 	pulumi.RegisterInputType(reflect.TypeOf((*NameserverNsTypeInput)(nil)).Elem(), NameserverNsType("udp"))
 	pulumi.RegisterInputType(reflect.TypeOf((*NameserverNsTypePtrInput)(nil)).Elem(), NameserverNsType("udp"))
 	pulumi.RegisterInputType(reflect.TypeOf((*PostureGeoLocationActionInput)(nil)).Elem(), PostureGeoLocationAction("allow"))
@@ -2861,6 +3154,7 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*PosturePeerNetworkRangeActionPtrInput)(nil)).Elem(), PosturePeerNetworkRangeAction("allow"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ProtocolInput)(nil)).Elem(), Protocol("all"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ProtocolPtrInput)(nil)).Elem(), Protocol("all"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ProtocolArrayInput)(nil)).Elem(), ProtocolArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyCrowdsecModeInput)(nil)).Elem(), ReverseProxyCrowdsecMode("enforce"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyCrowdsecModePtrInput)(nil)).Elem(), ReverseProxyCrowdsecMode("enforce"))
 	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyPathRewriteInput)(nil)).Elem(), ReverseProxyPathRewrite("preserve"))
@@ -2877,12 +3171,16 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*SetupKeyTypePtrInput)(nil)).Elem(), SetupKeyType("reusable"))
 	pulumi.RegisterInputType(reflect.TypeOf((*TypeInput)(nil)).Elem(), Type("domain"))
 	pulumi.RegisterInputType(reflect.TypeOf((*TypePtrInput)(nil)).Elem(), Type("domain"))
+	pulumi.RegisterInputType(reflect.TypeOf((*UserStatusInput)(nil)).Elem(), UserStatus("active"))
+	pulumi.RegisterInputType(reflect.TypeOf((*UserStatusPtrInput)(nil)).Elem(), UserStatus("active"))
 	pulumi.RegisterOutputType(AzureHostOutput{})
 	pulumi.RegisterOutputType(AzureHostPtrOutput{})
 	pulumi.RegisterOutputType(DNSRecordTypeOutput{})
 	pulumi.RegisterOutputType(DNSRecordTypePtrOutput{})
 	pulumi.RegisterOutputType(IdentityProviderTypeOutput{})
 	pulumi.RegisterOutputType(IdentityProviderTypePtrOutput{})
+	pulumi.RegisterOutputType(IngressProtocolOutput{})
+	pulumi.RegisterOutputType(IngressProtocolPtrOutput{})
 	pulumi.RegisterOutputType(NameserverNsTypeOutput{})
 	pulumi.RegisterOutputType(NameserverNsTypePtrOutput{})
 	pulumi.RegisterOutputType(PostureGeoLocationActionOutput{})
@@ -2891,6 +3189,7 @@ func init() {
 	pulumi.RegisterOutputType(PosturePeerNetworkRangeActionPtrOutput{})
 	pulumi.RegisterOutputType(ProtocolOutput{})
 	pulumi.RegisterOutputType(ProtocolPtrOutput{})
+	pulumi.RegisterOutputType(ProtocolArrayOutput{})
 	pulumi.RegisterOutputType(ReverseProxyCrowdsecModeOutput{})
 	pulumi.RegisterOutputType(ReverseProxyCrowdsecModePtrOutput{})
 	pulumi.RegisterOutputType(ReverseProxyDomainTypeOutput{})
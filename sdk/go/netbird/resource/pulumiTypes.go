@@ -13,6 +13,64 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+type ApprovedPeer struct {
+	// When the peer was approved (RFC 3339).
+	ApprovedAt string `pulumi:"approvedAt"`
+	// Hostname of the approved peer at approval time.
+	Hostname string `pulumi:"hostname"`
+	// ID of the approved peer.
+	PeerId string `pulumi:"peerId"`
+}
+
+type ApprovedPeerOutput struct{ *pulumi.OutputState }
+
+func (ApprovedPeerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApprovedPeer)(nil)).Elem()
+}
+
+func (o ApprovedPeerOutput) ToApprovedPeerOutput() ApprovedPeerOutput {
+	return o
+}
+
+func (o ApprovedPeerOutput) ToApprovedPeerOutputWithContext(ctx context.Context) ApprovedPeerOutput {
+	return o
+}
+
+// When the peer was approved (RFC 3339).
+func (o ApprovedPeerOutput) ApprovedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v ApprovedPeer) string { return v.ApprovedAt }).(pulumi.StringOutput)
+}
+
+// Hostname of the approved peer at approval time.
+func (o ApprovedPeerOutput) Hostname() pulumi.StringOutput {
+	return o.ApplyT(func(v ApprovedPeer) string { return v.Hostname }).(pulumi.StringOutput)
+}
+
+// ID of the approved peer.
+func (o ApprovedPeerOutput) PeerId() pulumi.StringOutput {
+	return o.ApplyT(func(v ApprovedPeer) string { return v.PeerId }).(pulumi.StringOutput)
+}
+
+type ApprovedPeerArrayOutput struct{ *pulumi.OutputState }
+
+func (ApprovedPeerArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApprovedPeer)(nil)).Elem()
+}
+
+func (o ApprovedPeerArrayOutput) ToApprovedPeerArrayOutput() ApprovedPeerArrayOutput {
+	return o
+}
+
+func (o ApprovedPeerArrayOutput) ToApprovedPeerArrayOutputWithContext(ctx context.Context) ApprovedPeerArrayOutput {
+	return o
+}
+
+func (o ApprovedPeerArrayOutput) Index(i pulumi.IntInput) ApprovedPeerOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ApprovedPeer {
+		return vs[0].([]ApprovedPeer)[vs[1].(int)]
+	}).(ApprovedPeerOutput)
+}
+
 type IngressAvailablePorts struct {
 	// Number of available TCP ports left on the ingress peer.
 	Tcp int `pulumi:"tcp"`
@@ -88,6 +146,234 @@ func (o IngressAvailablePortsPtrOutput) Udp() pulumi.IntPtrOutput {
 	}).(pulumi.IntPtrOutput)
 }
 
+type IngressPortMapping struct {
+	// Last public port on the ingress peer.
+	IngressEnd int `pulumi:"ingressEnd"`
+	// First public port on the ingress peer.
+	IngressStart int `pulumi:"ingressStart"`
+	// Protocol accepted by the ports.
+	Protocol string `pulumi:"protocol"`
+	// Last port on the peer the traffic is forwarded to.
+	TranslatedEnd int `pulumi:"translatedEnd"`
+	// First port on the peer the traffic is forwarded to.
+	TranslatedStart int `pulumi:"translatedStart"`
+}
+
+type IngressPortMappingOutput struct{ *pulumi.OutputState }
+
+func (IngressPortMappingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*IngressPortMapping)(nil)).Elem()
+}
+
+func (o IngressPortMappingOutput) ToIngressPortMappingOutput() IngressPortMappingOutput {
+	return o
+}
+
+func (o IngressPortMappingOutput) ToIngressPortMappingOutputWithContext(ctx context.Context) IngressPortMappingOutput {
+	return o
+}
+
+// Last public port on the ingress peer.
+func (o IngressPortMappingOutput) IngressEnd() pulumi.IntOutput {
+	return o.ApplyT(func(v IngressPortMapping) int { return v.IngressEnd }).(pulumi.IntOutput)
+}
+
+// First public port on the ingress peer.
+func (o IngressPortMappingOutput) IngressStart() pulumi.IntOutput {
+	return o.ApplyT(func(v IngressPortMapping) int { return v.IngressStart }).(pulumi.IntOutput)
+}
+
+// Protocol accepted by the ports.
+func (o IngressPortMappingOutput) Protocol() pulumi.StringOutput {
+	return o.ApplyT(func(v IngressPortMapping) string { return v.Protocol }).(pulumi.StringOutput)
+}
+
+// Last port on the peer the traffic is forwarded to.
+func (o IngressPortMappingOutput) TranslatedEnd() pulumi.IntOutput {
+	return o.ApplyT(func(v IngressPortMapping) int { return v.TranslatedEnd }).(pulumi.IntOutput)
+}
+
+// First port on the peer the traffic is forwarded to.
+func (o IngressPortMappingOutput) TranslatedStart() pulumi.IntOutput {
+	return o.ApplyT(func(v IngressPortMapping) int { return v.TranslatedStart }).(pulumi.IntOutput)
+}
+
+type IngressPortMappingArrayOutput struct{ *pulumi.OutputState }
+
+func (IngressPortMappingArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]IngressPortMapping)(nil)).Elem()
+}
+
+func (o IngressPortMappingArrayOutput) ToIngressPortMappingArrayOutput() IngressPortMappingArrayOutput {
+	return o
+}
+
+func (o IngressPortMappingArrayOutput) ToIngressPortMappingArrayOutputWithContext(ctx context.Context) IngressPortMappingArrayOutput {
+	return o
+}
+
+func (o IngressPortMappingArrayOutput) Index(i pulumi.IntInput) IngressPortMappingOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) IngressPortMapping {
+		return vs[0].([]IngressPortMapping)[vs[1].(int)]
+	}).(IngressPortMappingOutput)
+}
+
+type IngressPortRange struct {
+	// Last port of the range, inclusive.
+	End int `pulumi:"end"`
+	// First port of the range.
+	Start int `pulumi:"start"`
+}
+
+// IngressPortRangeInput is an input type that accepts IngressPortRangeArgs and IngressPortRangeOutput values.
+// You can construct a concrete instance of `IngressPortRangeInput` via:
+//
+//	IngressPortRangeArgs{...}
+type IngressPortRangeInput interface {
+	pulumi.Input
+
+	ToIngressPortRangeOutput() IngressPortRangeOutput
+	ToIngressPortRangeOutputWithContext(context.Context) IngressPortRangeOutput
+}
+
+type IngressPortRangeArgs struct {
+	// Last port of the range, inclusive.
+	End pulumi.IntInput `pulumi:"end"`
+	// First port of the range.
+	Start pulumi.IntInput `pulumi:"start"`
+}
+
+func (IngressPortRangeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*IngressPortRange)(nil)).Elem()
+}
+
+func (i IngressPortRangeArgs) ToIngressPortRangeOutput() IngressPortRangeOutput {
+	return i.ToIngressPortRangeOutputWithContext(context.Background())
+}
+
+func (i IngressPortRangeArgs) ToIngressPortRangeOutputWithContext(ctx context.Context) IngressPortRangeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(IngressPortRangeOutput)
+}
+
+func (i IngressPortRangeArgs) ToIngressPortRangePtrOutput() IngressPortRangePtrOutput {
+	return i.ToIngressPortRangePtrOutputWithContext(context.Background())
+}
+
+func (i IngressPortRangeArgs) ToIngressPortRangePtrOutputWithContext(ctx context.Context) IngressPortRangePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(IngressPortRangeOutput).ToIngressPortRangePtrOutputWithContext(ctx)
+}
+
+// IngressPortRangePtrInput is an input type that accepts IngressPortRangeArgs, IngressPortRangePtr and IngressPortRangePtrOutput values.
+// You can construct a concrete instance of `IngressPortRangePtrInput` via:
+//
+//	        IngressPortRangeArgs{...}
+//
+//	or:
+//
+//	        nil
+type IngressPortRangePtrInput interface {
+	pulumi.Input
+
+	ToIngressPortRangePtrOutput() IngressPortRangePtrOutput
+	ToIngressPortRangePtrOutputWithContext(context.Context) IngressPortRangePtrOutput
+}
+
+type ingressPortRangePtrType IngressPortRangeArgs
+
+func IngressPortRangePtr(v *IngressPortRangeArgs) IngressPortRangePtrInput {
+	return (*ingressPortRangePtrType)(v)
+}
+
+func (*ingressPortRangePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**IngressPortRange)(nil)).Elem()
+}
+
+func (i *ingressPortRangePtrType) ToIngressPortRangePtrOutput() IngressPortRangePtrOutput {
+	return i.ToIngressPortRangePtrOutputWithContext(context.Background())
+}
+
+func (i *ingressPortRangePtrType) ToIngressPortRangePtrOutputWithContext(ctx context.Context) IngressPortRangePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(IngressPortRangePtrOutput)
+}
+
+type IngressPortRangeOutput struct{ *pulumi.OutputState }
+
+func (IngressPortRangeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*IngressPortRange)(nil)).Elem()
+}
+
+func (o IngressPortRangeOutput) ToIngressPortRangeOutput() IngressPortRangeOutput {
+	return o
+}
+
+func (o IngressPortRangeOutput) ToIngressPortRangeOutputWithContext(ctx context.Context) IngressPortRangeOutput {
+	return o
+}
+
+func (o IngressPortRangeOutput) ToIngressPortRangePtrOutput() IngressPortRangePtrOutput {
+	return o.ToIngressPortRangePtrOutputWithContext(context.Background())
+}
+
+func (o IngressPortRangeOutput) ToIngressPortRangePtrOutputWithContext(ctx context.Context) IngressPortRangePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v IngressPortRange) *IngressPortRange {
+		return &v
+	}).(IngressPortRangePtrOutput)
+}
+
+// Last port of the range, inclusive.
+func (o IngressPortRangeOutput) End() pulumi.IntOutput {
+	return o.ApplyT(func(v IngressPortRange) int { return v.End }).(pulumi.IntOutput)
+}
+
+// First port of the range.
+func (o IngressPortRangeOutput) Start() pulumi.IntOutput {
+	return o.ApplyT(func(v IngressPortRange) int { return v.Start }).(pulumi.IntOutput)
+}
+
+type IngressPortRangePtrOutput struct{ *pulumi.OutputState }
+
+func (IngressPortRangePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**IngressPortRange)(nil)).Elem()
+}
+
+func (o IngressPortRangePtrOutput) ToIngressPortRangePtrOutput() IngressPortRangePtrOutput {
+	return o
+}
+
+func (o IngressPortRangePtrOutput) ToIngressPortRangePtrOutputWithContext(ctx context.Context) IngressPortRangePtrOutput {
+	return o
+}
+
+func (o IngressPortRangePtrOutput) Elem() IngressPortRangeOutput {
+	return o.ApplyT(func(v *IngressPortRange) IngressPortRange {
+		if v != nil {
+			return *v
+		}
+		var ret IngressPortRange
+		return ret
+	}).(IngressPortRangeOutput)
+}
+
+// Last port of the range, inclusive.
+func (o IngressPortRangePtrOutput) End() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *IngressPortRange) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.End
+	}).(pulumi.IntPtrOutput)
+}
+
+// First port of the range.
+func (o IngressPortRangePtrOutput) Start() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *IngressPortRange) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.Start
+	}).(pulumi.IntPtrOutput)
+}
+
 type Nameserver struct {
 	// IP of Nameserver
 	Ip string `pulumi:"ip"`
@@ -3713,6 +3999,8 @@ func (o RulePortRangeArrayOutput) Index(i pulumi.IntInput) RulePortRangeOutput {
 }
 
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*IngressPortRangeInput)(nil)).Elem(), IngressPortRangeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*IngressPortRangePtrInput)(nil)).Elem(), IngressPortRangeArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NameserverInput)(nil)).Elem(), NameserverArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*NameserverArrayInput)(nil)).Elem(), NameserverArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*PolicyRuleArgsInput)(nil)).Elem(), PolicyRuleArgsArgs{})
@@ -3757,8 +4045,14 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ReverseProxyTargetOptionsPtrInput)(nil)).Elem(), ReverseProxyTargetOptionsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RulePortRangeInput)(nil)).Elem(), RulePortRangeArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*RulePortRangeArrayInput)(nil)).Elem(), RulePortRangeArray{})
+	pulumi.RegisterOutputType(ApprovedPeerOutput{})
+	pulumi.RegisterOutputType(ApprovedPeerArrayOutput{})
 	pulumi.RegisterOutputType(IngressAvailablePortsOutput{})
 	pulumi.RegisterOutputType(IngressAvailablePortsPtrOutput{})
+	pulumi.RegisterOutputType(IngressPortMappingOutput{})
+	pulumi.RegisterOutputType(IngressPortMappingArrayOutput{})
+	pulumi.RegisterOutputType(IngressPortRangeOutput{})
+	pulumi.RegisterOutputType(IngressPortRangePtrOutput{})
 	pulumi.RegisterOutputType(NameserverOutput{})
 	pulumi.RegisterOutputType(NameserverArrayOutput{})
 	pulumi.RegisterOutputType(PolicyRuleArgsOutput{})
@@ -16,6 +16,8 @@ import (
 type ReverseProxyDomain struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Domain name for the reverse proxy.
 	Domain pulumi.StringOutput `pulumi:"domain"`
 	// Whether a subdomain label is required in front of this domain.
@@ -177,6 +179,11 @@ func (o ReverseProxyDomainOutput) ToReverseProxyDomainOutputWithContext(ctx cont
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o ReverseProxyDomainOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReverseProxyDomain) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Domain name for the reverse proxy.
 func (o ReverseProxyDomainOutput) Domain() pulumi.StringOutput {
 	return o.ApplyT(func(v *ReverseProxyDomain) pulumi.StringOutput { return v.Domain }).(pulumi.StringOutput)
@@ -20,6 +20,8 @@ type ReverseProxyService struct {
 	AccessGroups pulumi.StringArrayOutput `pulumi:"accessGroups"`
 	// Connection-level access restrictions based on IP address or geography.
 	AccessRestrictions ReverseProxyAccessRestrictionsPtrOutput `pulumi:"accessRestrictions"`
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Authentication configuration for the service.
 	Auth ReverseProxyAuthPtrOutput `pulumi:"auth"`
 	// Domain for the service.
@@ -253,6 +255,11 @@ func (o ReverseProxyServiceOutput) AccessRestrictions() ReverseProxyAccessRestri
 	return o.ApplyT(func(v *ReverseProxyService) ReverseProxyAccessRestrictionsPtrOutput { return v.AccessRestrictions }).(ReverseProxyAccessRestrictionsPtrOutput)
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o ReverseProxyServiceOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ReverseProxyService) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Authentication configuration for the service.
 func (o ReverseProxyServiceOutput) Auth() ReverseProxyAuthPtrOutput {
 	return o.ApplyT(func(v *ReverseProxyService) ReverseProxyAuthPtrOutput { return v.Auth }).(ReverseProxyAuthPtrOutput)
@@ -18,6 +18,8 @@ type Route struct {
 
 	// Access control group IDs associated with this route.
 	AccessControlGroups pulumi.StringArrayOutput `pulumi:"accessControlGroups"`
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Route description.
 	Description pulumi.StringOutput `pulumi:"description"`
 	// Domain list for dynamic resolution.
@@ -257,6 +259,11 @@ func (o RouteOutput) AccessControlGroups() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Route) pulumi.StringArrayOutput { return v.AccessControlGroups }).(pulumi.StringArrayOutput)
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o RouteOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Route) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Route description.
 func (o RouteOutput) Description() pulumi.StringOutput {
 	return o.ApplyT(func(v *Route) pulumi.StringOutput { return v.Description }).(pulumi.StringOutput)
@@ -16,6 +16,8 @@ import (
 type ScimIntegration struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// SCIM API token. Returned in full only on creation; masked afterwards, so the created value is preserved.
 	AuthToken pulumi.StringPtrOutput `pulumi:"authToken"`
 	// DEX connector ID for embedded IdP setups.
@@ -201,6 +203,11 @@ func (o ScimIntegrationOutput) ToScimIntegrationOutputWithContext(ctx context.Co
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o ScimIntegrationOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ScimIntegration) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // SCIM API token. Returned in full only on creation; masked afterwards, so the created value is preserved.
 func (o ScimIntegrationOutput) AuthToken() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *ScimIntegration) pulumi.StringPtrOutput { return v.AuthToken }).(pulumi.StringPtrOutput)
@@ -16,28 +16,49 @@ import (
 type SetupKey struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Allow peers to add extra DNS labels beyond the base peer name.
 	AllowExtraDnsLabels pulumi.BoolPtrOutput `pulumi:"allowExtraDnsLabels"`
 	// Group IDs to auto-assign to peers created with this key.
 	AutoGroups pulumi.StringArrayOutput `pulumi:"autoGroups"`
+	// When the provider created the key. Unset for imported keys, which rotationInterval then never rotates.
+	CreatedAt pulumi.StringPtrOutput `pulumi:"createdAt"`
+	// Public key to additionally encrypt the secret to: one or more age recipients (age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, non-secret output. Changing it re-encrypts the existing secret without replacing the resource.
+	EncryptFor pulumi.StringPtrOutput `pulumi:"encryptFor"`
+	// The full key encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.
+	EncryptedKey pulumi.StringPtrOutput `pulumi:"encryptedKey"`
+	// IDs of existing peers that enrolled with the key, oldest first, as of the last refresh. Taken from the account's audit events, so the provider token must be allowed to read them.
+	EnrolledPeers pulumi.StringArrayOutput `pulumi:"enrolledPeers"`
 	// Whether peers registered with this key are ephemeral (auto-expire).
 	Ephemeral pulumi.BoolPtrOutput   `pulumi:"ephemeral"`
 	Expires   pulumi.StringPtrOutput `pulumi:"expires"`
 	// Time-to-live in seconds from creation; use 0 for no expiration if supported by the API.
 	ExpiresIn pulumi.IntOutput       `pulumi:"expiresIn"`
 	Key       pulumi.StringPtrOutput `pulumi:"key"`
-	LastUsed  pulumi.StringPtrOutput `pulumi:"lastUsed"`
+	// When the key was last used to enroll a peer.
+	LastUsed pulumi.StringPtrOutput `pulumi:"lastUsed"`
 	// Setup key display name.
-	Name    pulumi.StringOutput    `pulumi:"name"`
-	Revoked pulumi.BoolPtrOutput   `pulumi:"revoked"`
-	State   pulumi.StringPtrOutput `pulumi:"state"`
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Seconds until the key expires, as of the last create or refresh. Unset for keys that do not expire.
+	RemainingValidity pulumi.IntPtrOutput `pulumi:"remainingValidity"`
+	// Whether the key is revoked. A revoked key can no longer enroll peers but is kept, with its usage history, until the resource is deleted. When unset, the revocation state is left as is.
+	Revoked pulumi.BoolPtrOutput `pulumi:"revoked"`
+	// Replace the key once it expires within this many seconds. The replacement key is created before the old one is deleted. Requires expiresIn > 0.
+	RotateBefore pulumi.IntPtrOutput `pulumi:"rotateBefore"`
+	// Replace the key once it is this many seconds old. The replacement key is created before the old one is deleted.
+	RotationInterval pulumi.IntPtrOutput `pulumi:"rotationInterval"`
+	// Key state: valid, overused, expired, or revoked.
+	State pulumi.StringPtrOutput `pulumi:"state"`
 	// Setup key type: 'one-off' (single use) or 'reusable'.
 	Type      SetupKeyTypeOutput     `pulumi:"type"`
 	UpdatedAt pulumi.StringPtrOutput `pulumi:"updatedAt"`
 	// Maximum uses for reusable keys; 0 = unlimited.
-	UsageLimit pulumi.IntOutput     `pulumi:"usageLimit"`
-	UsedTimes  pulumi.IntPtrOutput  `pulumi:"usedTimes"`
-	Valid      pulumi.BoolPtrOutput `pulumi:"valid"`
+	UsageLimit pulumi.IntOutput `pulumi:"usageLimit"`
+	// How many times the key was used to enroll a peer.
+	UsedTimes pulumi.IntPtrOutput `pulumi:"usedTimes"`
+	// Whether the key can currently enroll peers.
+	Valid pulumi.BoolPtrOutput `pulumi:"valid"`
 }
 
 // NewSetupKey registers a new resource with the given unique name, arguments, and options.
@@ -99,12 +120,20 @@ type setupKeyArgs struct {
 	AllowExtraDnsLabels *bool `pulumi:"allowExtraDnsLabels"`
 	// Group IDs to auto-assign to peers created with this key.
 	AutoGroups []string `pulumi:"autoGroups"`
+	// Public key to additionally encrypt the secret to: one or more age recipients (age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, non-secret output. Changing it re-encrypts the existing secret without replacing the resource.
+	EncryptFor *string `pulumi:"encryptFor"`
 	// Whether peers registered with this key are ephemeral (auto-expire).
 	Ephemeral *bool `pulumi:"ephemeral"`
 	// Time-to-live in seconds from creation; use 0 for no expiration if supported by the API.
 	ExpiresIn int `pulumi:"expiresIn"`
 	// Setup key display name.
 	Name string `pulumi:"name"`
+	// Whether the key is revoked. A revoked key can no longer enroll peers but is kept, with its usage history, until the resource is deleted. When unset, the revocation state is left as is.
+	Revoked *bool `pulumi:"revoked"`
+	// Replace the key once it expires within this many seconds. The replacement key is created before the old one is deleted. Requires expiresIn > 0.
+	RotateBefore *int `pulumi:"rotateBefore"`
+	// Replace the key once it is this many seconds old. The replacement key is created before the old one is deleted.
+	RotationInterval *int `pulumi:"rotationInterval"`
 	// Setup key type: 'one-off' (single use) or 'reusable'.
 	Type SetupKeyType `pulumi:"type"`
 	// Maximum uses for reusable keys; 0 = unlimited.
@@ -117,12 +146,20 @@ type SetupKeyArgs struct {
 	AllowExtraDnsLabels pulumi.BoolPtrInput
 	// Group IDs to auto-assign to peers created with this key.
 	AutoGroups pulumi.StringArrayInput
+	// Public key to additionally encrypt the secret to: one or more age recipients (age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, non-secret output. Changing it re-encrypts the existing secret without replacing the resource.
+	EncryptFor pulumi.StringPtrInput
 	// Whether peers registered with this key are ephemeral (auto-expire).
 	Ephemeral pulumi.BoolPtrInput
 	// Time-to-live in seconds from creation; use 0 for no expiration if supported by the API.
 	ExpiresIn pulumi.IntInput
 	// Setup key display name.
 	Name pulumi.StringInput
+	// Whether the key is revoked. A revoked key can no longer enroll peers but is kept, with its usage history, until the resource is deleted. When unset, the revocation state is left as is.
+	Revoked pulumi.BoolPtrInput
+	// Replace the key once it expires within this many seconds. The replacement key is created before the old one is deleted. Requires expiresIn > 0.
+	RotateBefore pulumi.IntPtrInput
+	// Replace the key once it is this many seconds old. The replacement key is created before the old one is deleted.
+	RotationInterval pulumi.IntPtrInput
 	// Setup key type: 'one-off' (single use) or 'reusable'.
 	Type SetupKeyTypeInput
 	// Maximum uses for reusable keys; 0 = unlimited.
@@ -216,6 +253,11 @@ func (o SetupKeyOutput) ToSetupKeyOutputWithContext(ctx context.Context) SetupKe
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o SetupKeyOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SetupKey) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Allow peers to add extra DNS labels beyond the base peer name.
 func (o SetupKeyOutput) AllowExtraDnsLabels() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.BoolPtrOutput { return v.AllowExtraDnsLabels }).(pulumi.BoolPtrOutput)
@@ -226,6 +268,26 @@ func (o SetupKeyOutput) AutoGroups() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.StringArrayOutput { return v.AutoGroups }).(pulumi.StringArrayOutput)
 }
 
+// When the provider created the key. Unset for imported keys, which rotationInterval then never rotates.
+func (o SetupKeyOutput) CreatedAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SetupKey) pulumi.StringPtrOutput { return v.CreatedAt }).(pulumi.StringPtrOutput)
+}
+
+// Public key to additionally encrypt the secret to: one or more age recipients (age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, non-secret output. Changing it re-encrypts the existing secret without replacing the resource.
+func (o SetupKeyOutput) EncryptFor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SetupKey) pulumi.StringPtrOutput { return v.EncryptFor }).(pulumi.StringPtrOutput)
+}
+
+// The full key encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.
+func (o SetupKeyOutput) EncryptedKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SetupKey) pulumi.StringPtrOutput { return v.EncryptedKey }).(pulumi.StringPtrOutput)
+}
+
+// IDs of existing peers that enrolled with the key, oldest first, as of the last refresh. Taken from the account's audit events, so the provider token must be allowed to read them.
+func (o SetupKeyOutput) EnrolledPeers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *SetupKey) pulumi.StringArrayOutput { return v.EnrolledPeers }).(pulumi.StringArrayOutput)
+}
+
 // Whether peers registered with this key are ephemeral (auto-expire).
 func (o SetupKeyOutput) Ephemeral() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.BoolPtrOutput { return v.Ephemeral }).(pulumi.BoolPtrOutput)
@@ -244,6 +306,7 @@ func (o SetupKeyOutput) Key() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.StringPtrOutput { return v.Key }).(pulumi.StringPtrOutput)
 }
 
+// When the key was last used to enroll a peer.
 func (o SetupKeyOutput) LastUsed() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.StringPtrOutput { return v.LastUsed }).(pulumi.StringPtrOutput)
 }
@@ -253,10 +316,27 @@ func (o SetupKeyOutput) Name() pulumi.StringOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
 }
 
+// Seconds until the key expires, as of the last create or refresh. Unset for keys that do not expire.
+func (o SetupKeyOutput) RemainingValidity() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SetupKey) pulumi.IntPtrOutput { return v.RemainingValidity }).(pulumi.IntPtrOutput)
+}
+
+// Whether the key is revoked. A revoked key can no longer enroll peers but is kept, with its usage history, until the resource is deleted. When unset, the revocation state is left as is.
 func (o SetupKeyOutput) Revoked() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.BoolPtrOutput { return v.Revoked }).(pulumi.BoolPtrOutput)
 }
 
+// Replace the key once it expires within this many seconds. The replacement key is created before the old one is deleted. Requires expiresIn > 0.
+func (o SetupKeyOutput) RotateBefore() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SetupKey) pulumi.IntPtrOutput { return v.RotateBefore }).(pulumi.IntPtrOutput)
+}
+
+// Replace the key once it is this many seconds old. The replacement key is created before the old one is deleted.
+func (o SetupKeyOutput) RotationInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SetupKey) pulumi.IntPtrOutput { return v.RotationInterval }).(pulumi.IntPtrOutput)
+}
+
+// Key state: valid, overused, expired, or revoked.
 func (o SetupKeyOutput) State() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.StringPtrOutput { return v.State }).(pulumi.StringPtrOutput)
 }
@@ -275,10 +355,12 @@ func (o SetupKeyOutput) UsageLimit() pulumi.IntOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.IntOutput { return v.UsageLimit }).(pulumi.IntOutput)
 }
 
+// How many times the key was used to enroll a peer.
 func (o SetupKeyOutput) UsedTimes() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.IntPtrOutput { return v.UsedTimes }).(pulumi.IntPtrOutput)
 }
 
+// Whether the key can currently enroll peers.
 func (o SetupKeyOutput) Valid() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v *SetupKey) pulumi.BoolPtrOutput { return v.Valid }).(pulumi.BoolPtrOutput)
 }
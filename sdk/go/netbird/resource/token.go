@@ -12,14 +12,20 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
-// A NetBird personal access token (PAT) for a user. The plaintext token is returned only once, on creation, and is exposed as a secret output. The token cannot be modified after creation; any input change forces a replacement.
+// A NetBird personal access token (PAT) for a user. The plaintext token is returned only once, on creation, and is exposed as a secret output. The token cannot be modified after creation; any input change other than the rotation settings or encryptFor forces a replacement.
 type Token struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Timestamp the token was created.
 	CreatedAt pulumi.StringPtrOutput `pulumi:"createdAt"`
 	// User ID of the principal that created the token.
 	CreatedBy pulumi.StringPtrOutput `pulumi:"createdBy"`
+	// Public key the token is encrypted to in encryptedToken.
+	EncryptFor pulumi.StringPtrOutput `pulumi:"encryptFor"`
+	// The plaintext token encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.
+	EncryptedToken pulumi.StringPtrOutput `pulumi:"encryptedToken"`
 	// Timestamp the token expires.
 	ExpirationDate pulumi.StringPtrOutput `pulumi:"expirationDate"`
 	// Token lifetime in days.
@@ -28,6 +34,12 @@ type Token struct {
 	LastUsed pulumi.StringPtrOutput `pulumi:"lastUsed"`
 	// Display name of the token.
 	Name pulumi.StringOutput `pulumi:"name"`
+	// Seconds until the token expires, as of the last create or refresh.
+	RemainingValidity pulumi.IntPtrOutput `pulumi:"remainingValidity"`
+	// Days before expiry at which the token is replaced.
+	RotateBefore pulumi.IntPtrOutput `pulumi:"rotateBefore"`
+	// Age in days at which the token is replaced.
+	RotationInterval pulumi.IntPtrOutput `pulumi:"rotationInterval"`
 	// Plaintext token value. Only populated on creation; never returned by the API afterwards.
 	Token pulumi.StringPtrOutput `pulumi:"token"`
 	// ID of the user the token is issued for.
@@ -87,20 +99,32 @@ func (TokenState) ElementType() reflect.Type {
 }
 
 type tokenArgs struct {
+	// Public key to additionally encrypt the secret to: one or more age recipients (age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, non-secret output. Changing it re-encrypts the existing secret without replacing the resource.
+	EncryptFor *string `pulumi:"encryptFor"`
 	// Token lifetime in days.
 	ExpiresIn int `pulumi:"expiresIn"`
 	// Display name of the token.
 	Name string `pulumi:"name"`
+	// Replace the token once it expires within this many days. The replacement token is created before the old one is deleted.
+	RotateBefore *int `pulumi:"rotateBefore"`
+	// Replace the token once it is this many days old. The replacement token is created before the old one is deleted.
+	RotationInterval *int `pulumi:"rotationInterval"`
 	// ID of the user the token is issued for.
 	UserId string `pulumi:"userId"`
 }
 
 // The set of arguments for constructing a Token resource.
 type TokenArgs struct {
+	// Public key to additionally encrypt the secret to: one or more age recipients (age1..., one per line) or an ASCII-armored OpenPGP public key block. The armored ciphertext is a regular, non-secret output. Changing it re-encrypts the existing secret without replacing the resource.
+	EncryptFor pulumi.StringPtrInput
 	// Token lifetime in days.
 	ExpiresIn pulumi.IntInput
 	// Display name of the token.
 	Name pulumi.StringInput
+	// Replace the token once it expires within this many days. The replacement token is created before the old one is deleted.
+	RotateBefore pulumi.IntPtrInput
+	// Replace the token once it is this many days old. The replacement token is created before the old one is deleted.
+	RotationInterval pulumi.IntPtrInput
 	// ID of the user the token is issued for.
 	UserId pulumi.StringInput
 }
@@ -192,6 +216,11 @@ func (o TokenOutput) ToTokenOutputWithContext(ctx context.Context) TokenOutput {
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o TokenOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Token) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Timestamp the token was created.
 func (o TokenOutput) CreatedAt() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Token) pulumi.StringPtrOutput { return v.CreatedAt }).(pulumi.StringPtrOutput)
@@ -202,6 +231,16 @@ func (o TokenOutput) CreatedBy() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Token) pulumi.StringPtrOutput { return v.CreatedBy }).(pulumi.StringPtrOutput)
 }
 
+// Public key the token is encrypted to in encryptedToken.
+func (o TokenOutput) EncryptFor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Token) pulumi.StringPtrOutput { return v.EncryptFor }).(pulumi.StringPtrOutput)
+}
+
+// The plaintext token encrypted to encryptFor, ASCII-armored. Safe to publish; set only with encryptFor.
+func (o TokenOutput) EncryptedToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Token) pulumi.StringPtrOutput { return v.EncryptedToken }).(pulumi.StringPtrOutput)
+}
+
 // Timestamp the token expires.
 func (o TokenOutput) ExpirationDate() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Token) pulumi.StringPtrOutput { return v.ExpirationDate }).(pulumi.StringPtrOutput)
@@ -222,6 +261,21 @@ func (o TokenOutput) Name() pulumi.StringOutput {
 	return o.ApplyT(func(v *Token) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
 }
 
+// Seconds until the token expires, as of the last create or refresh.
+func (o TokenOutput) RemainingValidity() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Token) pulumi.IntPtrOutput { return v.RemainingValidity }).(pulumi.IntPtrOutput)
+}
+
+// Days before expiry at which the token is replaced.
+func (o TokenOutput) RotateBefore() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Token) pulumi.IntPtrOutput { return v.RotateBefore }).(pulumi.IntPtrOutput)
+}
+
+// Age in days at which the token is replaced.
+func (o TokenOutput) RotationInterval() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Token) pulumi.IntPtrOutput { return v.RotationInterval }).(pulumi.IntPtrOutput)
+}
+
 // Plaintext token value. Only populated on creation; never returned by the API afterwards.
 func (o TokenOutput) Token() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Token) pulumi.StringPtrOutput { return v.Token }).(pulumi.StringPtrOutput)
@@ -16,6 +16,8 @@ import (
 type User struct {
 	pulumi.CustomResourceState
 
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
 	// Groups this user’s peers are automatically assigned to.
 	AutoGroups pulumi.StringArrayOutput `pulumi:"autoGroups"`
 	// Indicates whether the user is blocked from accessing the system
@@ -192,6 +194,11 @@ func (o UserOutput) ToUserOutputWithContext(ctx context.Context) UserOutput {
 	return o
 }
 
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o UserOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *User) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
 // Groups this user’s peers are automatically assigned to.
 func (o UserOutput) AutoGroups() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *User) pulumi.StringArrayOutput { return v.AutoGroups }).(pulumi.StringArrayOutput)
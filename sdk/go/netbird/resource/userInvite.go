@@ -0,0 +1,334 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package resource
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mbrav/pulumi-netbird/sdk/go/netbird/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Tracks and renews a NetBird user invite. With userId, re-sends the IdP invite of an existing user (e.g. one created by User) until it is accepted. With email, creates an invite link on self-hosted servers using the embedded IdP. In both modes the invite is renewed once its expiry falls within renewBefore.
+type UserInvite struct {
+	pulumi.CustomResourceState
+
+	// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+	AccountId pulumi.StringPtrOutput `pulumi:"accountId"`
+	// Group IDs to auto-assign to peers registered by the invited user. Only used with email.
+	AutoGroups pulumi.StringArrayOutput `pulumi:"autoGroups"`
+	// Email address to create an embedded IdP invite for (self-hosted only). Mutually exclusive with userId. Changing this forces a replacement.
+	Email pulumi.StringPtrOutput `pulumi:"email"`
+	// Whether the invite had expired on the last refresh.
+	Expired pulumi.BoolOutput `pulumi:"expired"`
+	// When the invite expires (RFC 3339).
+	ExpiresAt pulumi.StringOutput `pulumi:"expiresAt"`
+	// Invite validity in seconds. Defaults to 259200 (72 hours). With userId this is the assumed lifetime of the IdP invite, measured from when it was last sent.
+	ExpiresIn pulumi.IntPtrOutput `pulumi:"expiresIn"`
+	// Whether to output the invite token (secret) so the invite can be delivered out of band. Only used with email.
+	ExposeInviteToken pulumi.BoolPtrOutput `pulumi:"exposeInviteToken"`
+	// Token of the invite, not a full link. The invite link is the dashboard's invite page with this token, as shown by the dashboard when the invite is created. Only set with email and exposeInviteToken; it changes every time the invite is renewed.
+	InviteToken pulumi.StringPtrOutput `pulumi:"inviteToken"`
+	// Full name of the invited user. Required with email.
+	Name pulumi.StringPtrOutput `pulumi:"name"`
+	// Renew the invite once fewer than this many seconds of validity remain. Defaults to 0, renewing only after the invite expired.
+	RenewBefore pulumi.IntPtrOutput `pulumi:"renewBefore"`
+	// NetBird account role of the invited user. Defaults to 'user'. Only used with email.
+	Role pulumi.StringPtrOutput `pulumi:"role"`
+	// When the invite was last sent or regenerated (RFC 3339).
+	SentAt pulumi.StringOutput `pulumi:"sentAt"`
+	// Status of the invited user: 'invited' until the invite is accepted, then 'active' or 'blocked'.
+	Status UserStatusOutput `pulumi:"status"`
+	// ID of an existing user whose IdP invite is managed. Mutually exclusive with email. Changing this forces a replacement.
+	UserId pulumi.StringPtrOutput `pulumi:"userId"`
+}
+
+// NewUserInvite registers a new resource with the given unique name, arguments, and options.
+func NewUserInvite(ctx *pulumi.Context,
+	name string, args *UserInviteArgs, opts ...pulumi.ResourceOption) (*UserInvite, error) {
+	if args == nil {
+		args = &UserInviteArgs{}
+	}
+
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"inviteToken",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource UserInvite
+	err := ctx.RegisterResource("netbird:resource:UserInvite", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetUserInvite gets an existing UserInvite resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetUserInvite(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *UserInviteState, opts ...pulumi.ResourceOption) (*UserInvite, error) {
+	var resource UserInvite
+	err := ctx.ReadResource("netbird:resource:UserInvite", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering UserInvite resources.
+type userInviteState struct {
+}
+
+type UserInviteState struct {
+}
+
+func (UserInviteState) ElementType() reflect.Type {
+	return reflect.TypeOf((*userInviteState)(nil)).Elem()
+}
+
+type userInviteArgs struct {
+	// Group IDs to auto-assign to peers registered by the invited user. Only used with email.
+	AutoGroups []string `pulumi:"autoGroups"`
+	// Email address to create an embedded IdP invite for (self-hosted only). Mutually exclusive with userId. Changing this forces a replacement.
+	Email *string `pulumi:"email"`
+	// Invite validity in seconds. Defaults to 259200 (72 hours). With userId this is the assumed lifetime of the IdP invite, measured from when it was last sent.
+	ExpiresIn *int `pulumi:"expiresIn"`
+	// Whether to output the invite token (secret) so the invite can be delivered out of band. Only used with email.
+	ExposeInviteToken *bool `pulumi:"exposeInviteToken"`
+	// Full name of the invited user. Required with email.
+	Name *string `pulumi:"name"`
+	// Renew the invite once fewer than this many seconds of validity remain. Defaults to 0, renewing only after the invite expired.
+	RenewBefore *int `pulumi:"renewBefore"`
+	// NetBird account role of the invited user. Defaults to 'user'. Only used with email.
+	Role *string `pulumi:"role"`
+	// ID of an existing user whose IdP invite is managed. Mutually exclusive with email. Changing this forces a replacement.
+	UserId *string `pulumi:"userId"`
+}
+
+// The set of arguments for constructing a UserInvite resource.
+type UserInviteArgs struct {
+	// Group IDs to auto-assign to peers registered by the invited user. Only used with email.
+	AutoGroups pulumi.StringArrayInput
+	// Email address to create an embedded IdP invite for (self-hosted only). Mutually exclusive with userId. Changing this forces a replacement.
+	Email pulumi.StringPtrInput
+	// Invite validity in seconds. Defaults to 259200 (72 hours). With userId this is the assumed lifetime of the IdP invite, measured from when it was last sent.
+	ExpiresIn pulumi.IntPtrInput
+	// Whether to output the invite token (secret) so the invite can be delivered out of band. Only used with email.
+	ExposeInviteToken pulumi.BoolPtrInput
+	// Full name of the invited user. Required with email.
+	Name pulumi.StringPtrInput
+	// Renew the invite once fewer than this many seconds of validity remain. Defaults to 0, renewing only after the invite expired.
+	RenewBefore pulumi.IntPtrInput
+	// NetBird account role of the invited user. Defaults to 'user'. Only used with email.
+	Role pulumi.StringPtrInput
+	// ID of an existing user whose IdP invite is managed. Mutually exclusive with email. Changing this forces a replacement.
+	UserId pulumi.StringPtrInput
+}
+
+func (UserInviteArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*userInviteArgs)(nil)).Elem()
+}
+
+type UserInviteInput interface {
+	pulumi.Input
+
+	ToUserInviteOutput() UserInviteOutput
+	ToUserInviteOutputWithContext(ctx context.Context) UserInviteOutput
+}
+
+func (*UserInvite) ElementType() reflect.Type {
+	return reflect.TypeOf((**UserInvite)(nil)).Elem()
+}
+
+func (i *UserInvite) ToUserInviteOutput() UserInviteOutput {
+	return i.ToUserInviteOutputWithContext(context.Background())
+}
+
+func (i *UserInvite) ToUserInviteOutputWithContext(ctx context.Context) UserInviteOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UserInviteOutput)
+}
+
+// UserInviteArrayInput is an input type that accepts UserInviteArray and UserInviteArrayOutput values.
+// You can construct a concrete instance of `UserInviteArrayInput` via:
+//
+//	UserInviteArray{ UserInviteArgs{...} }
+type UserInviteArrayInput interface {
+	pulumi.Input
+
+	ToUserInviteArrayOutput() UserInviteArrayOutput
+	ToUserInviteArrayOutputWithContext(context.Context) UserInviteArrayOutput
+}
+
+type UserInviteArray []UserInviteInput
+
+func (UserInviteArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*UserInvite)(nil)).Elem()
+}
+
+func (i UserInviteArray) ToUserInviteArrayOutput() UserInviteArrayOutput {
+	return i.ToUserInviteArrayOutputWithContext(context.Background())
+}
+
+func (i UserInviteArray) ToUserInviteArrayOutputWithContext(ctx context.Context) UserInviteArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UserInviteArrayOutput)
+}
+
+// UserInviteMapInput is an input type that accepts UserInviteMap and UserInviteMapOutput values.
+// You can construct a concrete instance of `UserInviteMapInput` via:
+//
+//	UserInviteMap{ "key": UserInviteArgs{...} }
+type UserInviteMapInput interface {
+	pulumi.Input
+
+	ToUserInviteMapOutput() UserInviteMapOutput
+	ToUserInviteMapOutputWithContext(context.Context) UserInviteMapOutput
+}
+
+type UserInviteMap map[string]UserInviteInput
+
+func (UserInviteMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*UserInvite)(nil)).Elem()
+}
+
+func (i UserInviteMap) ToUserInviteMapOutput() UserInviteMapOutput {
+	return i.ToUserInviteMapOutputWithContext(context.Background())
+}
+
+func (i UserInviteMap) ToUserInviteMapOutputWithContext(ctx context.Context) UserInviteMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UserInviteMapOutput)
+}
+
+type UserInviteOutput struct{ *pulumi.OutputState }
+
+func (UserInviteOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**UserInvite)(nil)).Elem()
+}
+
+func (o UserInviteOutput) ToUserInviteOutput() UserInviteOutput {
+	return o
+}
+
+func (o UserInviteOutput) ToUserInviteOutputWithContext(ctx context.Context) UserInviteOutput {
+	return o
+}
+
+// ID of the NetBird account the resource belongs to. Changing the provider's account forces a replacement.
+func (o UserInviteOutput) AccountId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringPtrOutput { return v.AccountId }).(pulumi.StringPtrOutput)
+}
+
+// Group IDs to auto-assign to peers registered by the invited user. Only used with email.
+func (o UserInviteOutput) AutoGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringArrayOutput { return v.AutoGroups }).(pulumi.StringArrayOutput)
+}
+
+// Email address to create an embedded IdP invite for (self-hosted only). Mutually exclusive with userId. Changing this forces a replacement.
+func (o UserInviteOutput) Email() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringPtrOutput { return v.Email }).(pulumi.StringPtrOutput)
+}
+
+// Whether the invite had expired on the last refresh.
+func (o UserInviteOutput) Expired() pulumi.BoolOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.BoolOutput { return v.Expired }).(pulumi.BoolOutput)
+}
+
+// When the invite expires (RFC 3339).
+func (o UserInviteOutput) ExpiresAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringOutput { return v.ExpiresAt }).(pulumi.StringOutput)
+}
+
+// Invite validity in seconds. Defaults to 259200 (72 hours). With userId this is the assumed lifetime of the IdP invite, measured from when it was last sent.
+func (o UserInviteOutput) ExpiresIn() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.IntPtrOutput { return v.ExpiresIn }).(pulumi.IntPtrOutput)
+}
+
+// Whether to output the invite token (secret) so the invite can be delivered out of band. Only used with email.
+func (o UserInviteOutput) ExposeInviteToken() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.BoolPtrOutput { return v.ExposeInviteToken }).(pulumi.BoolPtrOutput)
+}
+
+// Token of the invite, not a full link. The invite link is the dashboard's invite page with this token, as shown by the dashboard when the invite is created. Only set with email and exposeInviteToken; it changes every time the invite is renewed.
+func (o UserInviteOutput) InviteToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringPtrOutput { return v.InviteToken }).(pulumi.StringPtrOutput)
+}
+
+// Full name of the invited user. Required with email.
+func (o UserInviteOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// Renew the invite once fewer than this many seconds of validity remain. Defaults to 0, renewing only after the invite expired.
+func (o UserInviteOutput) RenewBefore() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.IntPtrOutput { return v.RenewBefore }).(pulumi.IntPtrOutput)
+}
+
+// NetBird account role of the invited user. Defaults to 'user'. Only used with email.
+func (o UserInviteOutput) Role() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringPtrOutput { return v.Role }).(pulumi.StringPtrOutput)
+}
+
+// When the invite was last sent or regenerated (RFC 3339).
+func (o UserInviteOutput) SentAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringOutput { return v.SentAt }).(pulumi.StringOutput)
+}
+
+// Status of the invited user: 'invited' until the invite is accepted, then 'active' or 'blocked'.
+func (o UserInviteOutput) Status() UserStatusOutput {
+	return o.ApplyT(func(v *UserInvite) UserStatusOutput { return v.Status }).(UserStatusOutput)
+}
+
+// ID of an existing user whose IdP invite is managed. Mutually exclusive with email. Changing this forces a replacement.
+func (o UserInviteOutput) UserId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *UserInvite) pulumi.StringPtrOutput { return v.UserId }).(pulumi.StringPtrOutput)
+}
+
+type UserInviteArrayOutput struct{ *pulumi.OutputState }
+
+func (UserInviteArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*UserInvite)(nil)).Elem()
+}
+
+func (o UserInviteArrayOutput) ToUserInviteArrayOutput() UserInviteArrayOutput {
+	return o
+}
+
+func (o UserInviteArrayOutput) ToUserInviteArrayOutputWithContext(ctx context.Context) UserInviteArrayOutput {
+	return o
+}
+
+func (o UserInviteArrayOutput) Index(i pulumi.IntInput) UserInviteOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *UserInvite {
+		return vs[0].([]*UserInvite)[vs[1].(int)]
+	}).(UserInviteOutput)
+}
+
+type UserInviteMapOutput struct{ *pulumi.OutputState }
+
+func (UserInviteMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*UserInvite)(nil)).Elem()
+}
+
+func (o UserInviteMapOutput) ToUserInviteMapOutput() UserInviteMapOutput {
+	return o
+}
+
+func (o UserInviteMapOutput) ToUserInviteMapOutputWithContext(ctx context.Context) UserInviteMapOutput {
+	return o
+}
+
+func (o UserInviteMapOutput) MapIndex(k pulumi.StringInput) UserInviteOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *UserInvite {
+		return vs[0].(map[string]*UserInvite)[vs[1].(string)]
+	}).(UserInviteOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*UserInviteInput)(nil)).Elem(), &UserInvite{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UserInviteArrayInput)(nil)).Elem(), UserInviteArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UserInviteMapInput)(nil)).Elem(), UserInviteMap{})
+	pulumi.RegisterOutputType(UserInviteOutput{})
+	pulumi.RegisterOutputType(UserInviteArrayOutput{})
+	pulumi.RegisterOutputType(UserInviteMapOutput{})
+}
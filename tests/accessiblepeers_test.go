@@ -0,0 +1,75 @@
+package tests_test
+
+import (
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccessiblePeers(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddPeer("peer-laptop", "laptop", false, "devs")
+	backend.AddPeer("peer-db", "db", false, "servers")
+	backend.AddPeer("peer-gw", "gw", false, "routers")
+	backend.SetAccessiblePeers("peer-laptop", "peer-db")
+	server := newProviderServer(t, serveMock(t, backend))
+
+	create(t, server, testURN("Policy"), policyInputs().Set("rules", array(object(
+		"name", "devs-to-servers",
+		"enabled", true,
+		"bidirectional", false,
+		"action", "accept",
+		"protocol", "all",
+		"sources", stringArray("devs"),
+		"destinations", stringArray("servers"),
+	))))
+
+	network := create(t, server, testURN("Network"), props("name", "prod"))
+	for _, group := range []string{"servers", "finance"} {
+		create(t, server, testURN("NetworkResource"), props(
+			"name", group+"-db",
+			"networkID", network.ID,
+			"address", "10.0.0.10/32",
+			"enabled", true,
+			"groupIDs", stringArray(group),
+		))
+	}
+
+	for _, group := range []string{"devs", "finance"} {
+		create(t, server, testURN("Route"), routeInputs(group+"-net").Set("groups", stringArray(group)))
+	}
+
+	byName, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getAccessiblePeers", Args: props("name", "laptop")})
+	require.NoError(t, err)
+	assert.Equal(t, "peer-laptop", byName.Return.Get("peerId").AsString())
+	assert.Equal(t, []string{"db"}, itemValues(byName.Return.Get("peers"), "name"))
+	assert.Equal(t, property.Value{}, byName.Return.Get("resources"))
+
+	expanded, err := server.Invoke(p.InvokeRequest{
+		Token: "netbird:function:getAccessiblePeers",
+		Args:  props("peerId", "peer-laptop", "includeResources", true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"servers-db"}, itemValues(expanded.Return.Get("resources"), "name"))
+	assert.Equal(t, []string{"devs-net"}, itemValues(expanded.Return.Get("routes"), "networkId"))
+
+	_, err = server.Invoke(p.InvokeRequest{Token: "netbird:function:getAccessiblePeers", Args: property.Map{}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exactly one of peerId or name must be set")
+}
+
+// itemValues returns the string field of every object in an array value.
+func itemValues(items property.Value, field string) []string {
+	var values []string
+	for _, item := range items.AsArray().AsSlice() {
+		values = append(values, item.AsMap().Get(field).AsString())
+	}
+
+	return values
+}
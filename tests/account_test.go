@@ -0,0 +1,114 @@
+package tests_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProfiles(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestConfigureLoadsProfile(t *testing.T) {
+	t.Parallel()
+
+	mockURL := startMockServer(t)
+	path := writeProfiles(t, "profiles:\n"+
+		"  tenant:\n"+
+		"    url: "+mockURL+"\n"+
+		"    token: profile-token\n"+
+		"    accountId: "+mock.AccountID+"\n")
+
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs("http://127.0.0.1:1", "unused-token",
+		"profile", "tenant", "profilesFile", path)))
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getServerInfo", Args: property.Map{}})
+	require.NoError(t, err)
+	assert.Equal(t, property.New(mockURL), resp.Return.Get("url"))
+	assert.Equal(t, property.New(mock.AccountID), resp.Return.Get("accountId"))
+}
+
+func TestConfigureRejectsUnknownProfile(t *testing.T) {
+	t.Parallel()
+
+	path := writeProfiles(t, "profiles:\n  tenant:\n    token: profile-token\n")
+
+	err := newUnconfiguredServer(t).Configure(configureArgs(startMockServer(t), "test-token",
+		"profile", "missing", "profilesFile", path))
+	require.ErrorIs(t, err, config.ErrUnknownProfile)
+}
+
+func TestConfigureRejectsInaccessibleAccount(t *testing.T) {
+	t.Parallel()
+
+	err := newUnconfiguredServer(t).Configure(configureArgs(startMockServer(t), "test-token",
+		"accountId", "other-account"))
+	require.ErrorIs(t, err, config.ErrNetBirdAccountAccess)
+}
+
+func TestResourceAccountChangeForcesReplace(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	urn := testURN("Group")
+	inputs := groupInputs("account-group")
+
+	created := create(t, server, urn, inputs)
+	assert.Equal(t, property.New(mock.AccountID), created.Properties.Get("accountId"))
+	assertNoDiff(t, server, urn, created.ID, created.Properties, inputs)
+
+	moved := created.Properties.Set("accountId", property.New("previous-account"))
+	resp := diff(t, server, urn, created.ID, moved, inputs, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["accountId"].Kind)
+}
+
+// TestRefreshKeepsRecordedAccount checks that a refresh after switching
+// accounts keeps the account recorded in state, so the following diff still
+// plans the replacement. Resources whose state is built from the API response
+// must restore the recorded account on read.
+func TestRefreshKeepsRecordedAccount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		typ    string
+		inputs property.Map
+	}{
+		{typ: "Group", inputs: groupInputs("account-group")},
+		{typ: "Route", inputs: routeInputs("net-1")},
+		{typ: "SetupKey", inputs: setupKeyInputs()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			t.Parallel()
+
+			server := newProviderServer(t, startMockServer(t))
+			urn := testURN(tt.typ)
+
+			created := create(t, server, urn, tt.inputs)
+			moved := created.Properties.Set("accountId", property.New("previous-account"))
+
+			refreshed := read(t, server, urn, created.ID, moved, tt.inputs)
+			assert.Equal(t, property.New("previous-account"), refreshed.Properties.Get("accountId"))
+
+			resp := diff(t, server, urn, created.ID, refreshed.Properties, tt.inputs, tt.inputs)
+			require.True(t, resp.HasChanges)
+			assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["accountId"].Kind)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package tests_test
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func configureArgs(url, token string, kv ...any) p.ConfigureRequest {
+	return p.ConfigureRequest{Args: props(append([]any{"url", url, "token", token}, kv...)...)}
+}
+
+func TestConfigureResolvesIdentity(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getServerInfo", Args: property.Map{}})
+	require.NoError(t, err)
+	assert.Equal(t, property.New(mock.AccountID), resp.Return.Get("accountId"))
+	assert.Equal(t, property.New(mock.CurrentUserID), resp.Return.Get("userId"))
+	assert.Equal(t, property.New("admin"), resp.Return.Get("userRole"))
+}
+
+func TestConfigureRejectsRevokedToken(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.RequireToken("good-token")
+
+	err := newUnconfiguredServer(t).Configure(configureArgs(serveMock(t, backend), "revoked-token"))
+	require.ErrorIs(t, err, config.ErrNetBirdUnauthorized)
+}
+
+func TestConfigureReportsUnreachableHost(t *testing.T) {
+	t.Parallel()
+
+	// Reserve a port and release it so nothing is listening there.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	err = newUnconfiguredServer(t).Configure(configureArgs("http://"+addr, "test-token"))
+	require.ErrorIs(t, err, config.ErrNetBirdUnreachable)
+}
+
+func TestConfigureReportsTLSFailure(t *testing.T) {
+	t.Parallel()
+
+	// The test server's self-signed certificate is not trusted by the provider.
+	ts := httptest.NewTLSServer(mock.NewServer())
+	t.Cleanup(ts.Close)
+
+	err := newUnconfiguredServer(t).Configure(configureArgs(ts.URL, "test-token"))
+	require.ErrorIs(t, err, config.ErrNetBirdTLS)
+}
+
+func TestConfigureSkipValidation(t *testing.T) {
+	t.Parallel()
+
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs("http://127.0.0.1:1", "test-token", "skipConfigureValidation", true)))
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getServerInfo", Args: property.Map{}})
+	require.NoError(t, err)
+	assert.True(t, resp.Return.Get("accountId").IsNull())
+}
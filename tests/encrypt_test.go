@@ -0,0 +1,139 @@
+package tests_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	ageArmor "filippo.io/age/armor"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgpArmor "github.com/ProtonMail/go-crypto/openpgp/armor"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupKeyEncryptForAge(t *testing.T) {
+	t.Parallel()
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	server := newProviderServer(t, startMockServer(t))
+	urn := testURN("SetupKey")
+	inputs := setupKeyInputs().Set("encryptFor", property.New(identity.Recipient().String()))
+
+	created := create(t, server, urn, inputs)
+	ciphertext := created.Properties.Get("encryptedKey").AsString()
+	assert.True(t, strings.HasPrefix(ciphertext, "-----BEGIN AGE ENCRYPTED FILE-----"))
+	assert.Equal(t, created.Properties.Get("key").AsString(), decryptAge(t, ciphertext, identity))
+
+	// The ciphertext is kept across refreshes rather than re-encrypted.
+	refreshed := read(t, server, urn, created.ID, created.Properties, inputs)
+	assert.Equal(t, ciphertext, refreshed.Properties.Get("encryptedKey").AsString())
+	assertNoDiff(t, server, urn, created.ID, refreshed.Properties, inputs)
+
+	// A new recipient re-encrypts the existing key in place.
+	other, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	reencrypt := inputs.Set("encryptFor", property.New(other.Recipient().String()))
+	resp := diff(t, server, urn, created.ID, refreshed.Properties, reencrypt, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.Update, resp.DetailedDiff["encryptFor"].Kind)
+
+	updated := update(t, server, urn, created.ID, refreshed.Properties, reencrypt, inputs)
+	assert.Equal(t, created.Properties.Get("key").AsString(), decryptAge(t, updated.Properties.Get("encryptedKey").AsString(), other))
+
+	// Removing the recipient drops the ciphertext.
+	plain := setupKeyInputs()
+	cleared := update(t, server, urn, created.ID, updated.Properties, plain, reencrypt)
+	assert.Equal(t, property.Value{}, cleared.Properties.Get("encryptedKey"))
+}
+
+func TestTokenEncryptForOpenPGP(t *testing.T) {
+	t.Parallel()
+
+	entity, err := openpgp.NewEntity("ops", "", "ops@example.com", nil)
+	require.NoError(t, err)
+
+	server := newProviderServer(t, startMockServer(t))
+	urn := testURN("Token")
+	inputs := props("userId", "user-ci", "name", "ci", "expiresIn", 30.0, "encryptFor", armoredPublicKey(t, entity))
+
+	created := create(t, server, urn, inputs)
+	ciphertext := created.Properties.Get("encryptedToken").AsString()
+	assert.True(t, strings.HasPrefix(ciphertext, "-----BEGIN PGP MESSAGE-----"))
+	assert.Equal(t, created.Properties.Get("token").AsString(), decryptOpenPGP(t, ciphertext, entity))
+
+	// Switching to an age recipient re-encrypts the token without replacing it.
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	toAge := inputs.Set("encryptFor", property.New(identity.Recipient().String()))
+	resp := diff(t, server, urn, created.ID, created.Properties, toAge, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.Update, resp.DetailedDiff["encryptFor"].Kind)
+
+	updated := update(t, server, urn, created.ID, created.Properties, toAge, inputs)
+	assert.Equal(t, created.Properties.Get("token").AsString(), decryptAge(t, updated.Properties.Get("encryptedToken").AsString(), identity))
+}
+
+func TestEncryptForCheck(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	for _, recipient := range []string{"not-a-key", "AGE-SECRET-KEY-1QQQ", "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\n-----END PGP PUBLIC KEY BLOCK-----"} {
+		check, err := server.Check(p.CheckRequest{
+			Urn:    testURN("SetupKey"),
+			Inputs: setupKeyInputs().Set("encryptFor", property.New(recipient)),
+		})
+		require.NoError(t, err)
+		require.Len(t, check.Failures, 1, recipient)
+		assert.Equal(t, "encryptFor", check.Failures[0].Property)
+	}
+}
+
+func decryptAge(t *testing.T, ciphertext string, identity age.Identity) string {
+	t.Helper()
+
+	reader, err := age.Decrypt(ageArmor.NewReader(strings.NewReader(ciphertext)), identity)
+	require.NoError(t, err)
+
+	plaintext, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	return string(plaintext)
+}
+
+func decryptOpenPGP(t *testing.T, ciphertext string, entity *openpgp.Entity) string {
+	t.Helper()
+
+	block, err := pgpArmor.Decode(strings.NewReader(ciphertext))
+	require.NoError(t, err)
+
+	message, err := openpgp.ReadMessage(block.Body, openpgp.EntityList{entity}, nil, nil)
+	require.NoError(t, err)
+
+	plaintext, err := io.ReadAll(message.UnverifiedBody)
+	require.NoError(t, err)
+
+	return string(plaintext)
+}
+
+func armoredPublicKey(t *testing.T, entity *openpgp.Entity) string {
+	t.Helper()
+
+	var out bytes.Buffer
+
+	writer, err := pgpArmor.Encode(&out, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(writer))
+	require.NoError(t, writer.Close())
+
+	return out.String()
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	netbird "github.com/mbrav/pulumi-netbird/provider"
+	"github.com/mbrav/pulumi-netbird/provider/config"
 	"github.com/mbrav/pulumi-netbird/tests/mock"
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/integration"
@@ -20,7 +22,14 @@ import (
 // returns the server's base URL. The server is shut down when the test ends.
 func startMockServer(t *testing.T) string {
 	t.Helper()
-	ts := httptest.NewServer(mock.NewServer())
+
+	return serveMock(t, mock.NewServer())
+}
+
+// serveMock serves a preconfigured mock and returns its base URL.
+func serveMock(t *testing.T, server *mock.Server) string {
+	t.Helper()
+	ts := httptest.NewServer(server)
 	t.Cleanup(ts.Close)
 
 	return ts.URL
@@ -31,18 +40,25 @@ func startMockServer(t *testing.T) string {
 // so the mock accepts all requests.
 func newProviderServer(t *testing.T, mockURL string) integration.Server {
 	t.Helper()
-	ctx := context.Background()
-	server, err := integration.NewServer(
-		ctx,
-		netbird.Name,
-		semver.MustParse(netbird.Version),
-		integration.WithProvider(netbird.Provider()),
-	)
-	require.NoError(t, err)
+
+	return configureServer(t, newUnconfiguredServer(t), mockURL)
+}
+
+// newProviderServerWithClock is newProviderServer with the provider's clock
+// replaced by now, for tests of time-based behaviour.
+func newProviderServerWithClock(t *testing.T, mockURL string, now func() time.Time) integration.Server {
+	t.Helper()
+
+	return configureServer(t, newServer(t, now), mockURL)
+}
+
+// configureServer configures server against the mock at mockURL.
+func configureServer(t *testing.T, server integration.Server, mockURL string) integration.Server {
+	t.Helper()
 
 	// infer's Configure reads config from Args (a property.Map), not Variables.
 	// The keys match the pulumi struct tags on config.Config: "url" and "token".
-	err = server.Configure(p.ConfigureRequest{
+	err := server.Configure(p.ConfigureRequest{
 		Args: property.NewMap(map[string]property.Value{
 			"url":   property.New(mockURL),
 			"token": property.New("test-token"),
@@ -53,6 +69,35 @@ func newProviderServer(t *testing.T, mockURL string) integration.Server {
 	return server
 }
 
+// newUnconfiguredServer creates a provider server for tests that drive
+// Configure themselves.
+func newUnconfiguredServer(t *testing.T) integration.Server {
+	t.Helper()
+
+	return newServer(t, nil)
+}
+
+// newServer creates an unconfigured provider server. A non-nil now replaces
+// the provider's clock.
+func newServer(t *testing.T, now func() time.Time) integration.Server {
+	t.Helper()
+
+	ctx := context.Background()
+	if now != nil {
+		ctx = config.WithClock(ctx, now)
+	}
+
+	server, err := integration.NewServer(
+		ctx,
+		netbird.Name,
+		semver.MustParse(netbird.Version),
+		integration.WithProvider(netbird.Provider()),
+	)
+	require.NoError(t, err)
+
+	return server
+}
+
 // testURN builds a deterministic URN for the given resource type.
 // Resources live in the "resource" module (derived from their Go package path).
 func testURN(typ string) presource.URN {
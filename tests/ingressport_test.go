@@ -0,0 +1,146 @@
+package tests_test
+
+import (
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIngressPortAllocationLifecycle(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddPeer("peer-web", "web", false)
+	backend.AddIngressPeer("ingress-eu", "eu", "203.0.113.50")
+	server := newProviderServer(t, serveMock(t, backend))
+	urn := testURN("IngressPortAllocation")
+	inputs := ingressPortInputs().Set("region", property.New("eu"))
+
+	// Ingress is cloud-only, so Check rejects it against the self-hosted mock.
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, property.New("203.0.113.50"), created.Properties.Get("ingressIp"))
+	assert.Equal(t, property.New("ingress-eu"), created.Properties.Get("ingressPeerId"))
+	assert.Equal(t, property.New("eu"), created.Properties.Get("assignedRegion"))
+
+	start := created.Properties.Get("ingressPortStart").AsNumber()
+	assert.Equal(t, start+10, created.Properties.Get("ingressPortEnd").AsNumber())
+	assertNoDiff(t, server, urn, created.ID, created.Properties, inputs)
+
+	// Changing the forwarded ports updates the allocation in place.
+	single := inputs.Delete("portRange").Set("port", property.New(float64(8443)))
+	resp := diff(t, server, urn, created.ID, created.Properties, single, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.Update, resp.DetailedDiff["portRange"].Kind)
+
+	updated := update(t, server, urn, created.ID, created.Properties, single, inputs)
+	assert.Equal(t, updated.Properties.Get("ingressPortStart"), updated.Properties.Get("ingressPortEnd"))
+
+	// Moving to another peer replaces it.
+	moved := single.Set("peerId", property.New("peer-other"))
+	resp = diff(t, server, urn, created.ID, updated.Properties, moved, single)
+	assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["peerId"].Kind)
+
+	// Import recovers the inputs from the nested ID.
+	imported := read(t, server, urn, "peer-web/"+created.ID, property.Map{}, property.Map{})
+	assert.Equal(t, created.ID, imported.ID)
+	assert.Equal(t, property.New("peer-web"), imported.Inputs.Get("peerId"))
+	assert.Equal(t, property.New(float64(8443)), imported.Inputs.Get("port"))
+	assert.Equal(t, property.New("tcp"), imported.Inputs.Get("protocol"))
+
+	_, err = server.Read(p.ReadRequest{ID: created.ID, Urn: urn})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "<parentID>/<childID>")
+
+	deleteResource(t, server, urn, created.ID, updated.Properties)
+}
+
+func TestIngressPortAllocationRegion(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddPeer("peer-web", "web", false)
+	backend.AddIngressPeer("ingress-a", "eu", "203.0.113.50")
+	backend.AddIngressPeer("ingress-b", "us", "198.51.100.50")
+	server := newProviderServer(t, serveMock(t, backend))
+	urn := testURN("IngressPortAllocation")
+
+	_, err := server.Create(p.CreateRequest{Urn: urn, Properties: ingressPortInputs().Set("region", property.New("ap"))})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `no enabled ingress peer in region "ap"; available regions: eu, us`)
+
+	// The mock always assigns the eu ingress peer, so a us allocation is rolled back.
+	_, err = server.Create(p.CreateRequest{Urn: urn, Properties: ingressPortInputs().Set("region", property.New("us"))})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `region "eu" instead of "us"; the allocation was removed`)
+}
+
+func TestIngressPortAllocationCheck(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	tests := []struct {
+		name     string
+		inputs   property.Map
+		property string
+	}{
+		{"no ports", ingressPortInputs().Delete("portRange"), "port"},
+		{"port and range", ingressPortInputs().Set("port", property.New(float64(80))), "portRange"},
+		{"port out of range", ingressPortInputs().Delete("portRange").Set("port", property.New(float64(70000))), "port"},
+		{"range reversed", ingressPortInputs().Set("portRange", object("start", 9000.0, "end", 8000.0)), "portRange"},
+		{"range start zero", ingressPortInputs().Set("portRange", object("start", 0.0, "end", 8000.0)), "portRange.start"},
+		{"unknown protocol", ingressPortInputs().Set("protocol", property.New("icmp")), "protocol"},
+		{"self-hosted", ingressPortInputs(), "peerId"},
+	}
+
+	for _, tt := range tests {
+		check, err := server.Check(p.CheckRequest{Urn: testURN("IngressPortAllocation"), Inputs: tt.inputs})
+		require.NoError(t, err, tt.name)
+
+		properties := make([]string, 0, len(check.Failures))
+		for _, failure := range check.Failures {
+			properties = append(properties, failure.Property)
+		}
+
+		assert.Contains(t, properties, tt.property, tt.name)
+	}
+}
+
+// TestIngressPortAllocationKeepsRecordedAccount is TestRefreshKeepsRecordedAccount
+// for ingress, which Check rejects against the self-hosted mock.
+func TestIngressPortAllocationKeepsRecordedAccount(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddPeer("peer-web", "web", false)
+	backend.AddIngressPeer("ingress-eu", "eu", "203.0.113.50")
+	server := newProviderServer(t, serveMock(t, backend))
+	urn := testURN("IngressPortAllocation")
+	inputs := ingressPortInputs()
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+
+	moved := created.Properties.Set("accountId", property.New("previous-account"))
+	refreshed := read(t, server, urn, created.ID, moved, inputs)
+	assert.Equal(t, property.New("previous-account"), refreshed.Properties.Get("accountId"))
+
+	resp := diff(t, server, urn, created.ID, refreshed.Properties, inputs, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["accountId"].Kind)
+}
+
+func ingressPortInputs() property.Map {
+	return props(
+		"peerId", "peer-web",
+		"name", "web",
+		"protocol", "tcp",
+		"portRange", object("start", 8000.0, "end", 8010.0),
+		"enabled", true,
+	)
+}
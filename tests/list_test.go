@@ -0,0 +1,122 @@
+package tests_test
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listNames invokes a collection function and returns the value of field
+// (e.g. "name") of every returned item in collection.
+func listNames(t *testing.T, server integration.Server, function, collection, field string, args property.Map) []string {
+	t.Helper()
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: tokens.Type("netbird:function:" + function), Args: args})
+	require.NoError(t, err)
+	require.Empty(t, resp.Failures)
+
+	var values []string
+	for _, item := range resp.Return.Get(collection).AsArray().AsSlice() {
+		values = append(values, item.AsMap().Get(field).AsString())
+	}
+
+	return values
+}
+
+func TestGetGroups(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	for _, name := range []string{"web-1", "web-2", "db"} {
+		create(t, server, testURN("Group"), groupInputs(name))
+	}
+
+	assert.Len(t, listNames(t, server, "getGroups", "groups", "id", property.Map{}), 3)
+	assert.ElementsMatch(t, []string{"web-1", "web-2"}, listNames(t, server, "getGroups", "groups", "name", props("nameRegex", "^web-")))
+}
+
+func TestGetPolicies(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	create(t, server, testURN("Policy"), policyInputs())
+
+	disabled := policyInputs().Set("name", property.New("disabled-policy")).Set("enabled", property.New(false))
+	disabled = disabled.Set("rules", array(object(
+		"name", "deny-ssh",
+		"enabled", true,
+		"bidirectional", false,
+		"action", "drop",
+		"protocol", "tcp",
+		"ports", stringArray("22"),
+		"sources", stringArray("contractors"),
+		"destinations", stringArray("servers"),
+	)))
+	create(t, server, testURN("Policy"), disabled)
+
+	assert.Equal(t, []string{"disabled-policy"}, listNames(t, server, "getPolicies", "policies", "name", props("enabled", false)))
+	assert.Equal(t, []string{"disabled-policy"}, listNames(t, server, "getPolicies", "policies", "name", props("groupId", "servers")))
+	assert.Equal(t, []string{"test-policy"}, listNames(t, server, "getPolicies", "policies", "name", props("groupId", "all", "nameRegex", "test")))
+	assert.Empty(t, listNames(t, server, "getPolicies", "policies", "name", props("groupId", "all", "enabled", false)))
+}
+
+func TestGetNetworkResources(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	networkA := create(t, server, testURN("Network"), props("name", "network-a"))
+	networkB := create(t, server, testURN("Network"), props("name", "network-b"))
+
+	for _, network := range []p.CreateResponse{networkA, networkB} {
+		create(t, server, testURN("NetworkResource"), props(
+			"name", "db-"+network.Properties.Get("name").AsString(),
+			"networkID", network.ID,
+			"address", "10.0.0.10/32",
+			"enabled", true,
+			"groupIDs", stringArray(network.Properties.Get("name").AsString()),
+		))
+	}
+
+	assert.ElementsMatch(t, []string{networkA.ID, networkB.ID},
+		listNames(t, server, "getNetworkResources", "resources", "networkID", property.Map{}))
+	assert.Equal(t, []string{"db-network-b"},
+		listNames(t, server, "getNetworkResources", "resources", "name", props("networkId", networkB.ID)))
+	assert.Equal(t, []string{"db-network-a"},
+		listNames(t, server, "getNetworkResources", "resources", "name", props("groupId", "network-a")))
+}
+
+func TestGetSetupKeys(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	create(t, server, testURN("SetupKey"), setupKeyInputs())
+	create(t, server, testURN("SetupKey"), setupKeyInputs().Set("name", property.New("old-key")).Set("revoked", property.New(true)))
+
+	assert.Equal(t, []string{"test-key"}, listNames(t, server, "getSetupKeys", "setupKeys", "name", props("valid", true)))
+	assert.Equal(t, []string{"old-key"}, listNames(t, server, "getSetupKeys", "setupKeys", "name", props("valid", false)))
+	assert.Equal(t, []string{"old-key"}, listNames(t, server, "getSetupKeys", "setupKeys", "name", props("enabled", false)))
+	assert.Empty(t, listNames(t, server, "getSetupKeys", "setupKeys", "name", props("enabled", true, "valid", false)))
+}
+
+func TestListFilterValidation(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	_, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getGroups", Args: props("groupId", "all")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "getGroups does not support the groupId filter")
+
+	_, err = server.Invoke(p.InvokeRequest{Token: "netbird:function:getNetworkRouters", Args: props("nameRegex", "edge")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "getNetworkRouters does not support the nameRegex filter")
+
+	_, err = server.Invoke(p.InvokeRequest{Token: "netbird:function:getRoutes", Args: props("nameRegex", "(")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid nameRegex")
+}
@@ -0,0 +1,88 @@
+package tests_test
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupGroupAmbiguous(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	first := create(t, server, testURN("Group"), groupInputs("ops"))
+	create(t, server, testURN("Group"), groupInputs("dev"))
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:lookupGroup", Args: props("name", "ops")})
+	require.NoError(t, err)
+	assert.Equal(t, property.New(first.ID), resp.Return.Get("groupId"))
+
+	second := create(t, server, testURN("Group"), groupInputs("ops"))
+
+	_, err = server.Invoke(p.InvokeRequest{Token: "netbird:function:lookupGroup", Args: props("name", "ops")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `group "ops" is ambiguous: 2 matches`)
+	assert.Contains(t, err.Error(), first.ID)
+	assert.Contains(t, err.Error(), second.ID)
+
+	_, err = server.Invoke(p.InvokeRequest{Token: "netbird:function:lookupGroup", Args: props("name", "qa")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `group "qa" not found`)
+}
+
+func TestLookupPolicy(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	created := create(t, server, testURN("Policy"), policyInputs())
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:lookupPolicy", Args: props("name", "test-policy")})
+	require.NoError(t, err)
+	assert.Equal(t, property.New(created.ID), resp.Return.Get("policyId"))
+	assert.Equal(t, property.New(true), resp.Return.Get("enabled"))
+	assert.Len(t, resp.Return.Get("rules").AsArray().AsSlice(), 1)
+}
+
+func TestLookupNetworkResource(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	network := create(t, server, testURN("Network"), props("name", "office"))
+
+	for _, name := range []string{"db-primary", "db-replica"} {
+		create(t, server, testURN("NetworkResource"), props(
+			"name", name,
+			"networkID", network.ID,
+			"address", "db.internal",
+			"enabled", true,
+			"groupIDs", stringArray("databases"),
+		))
+	}
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "netbird:function:lookupNetworkResource",
+		Args:  props("networkId", network.ID, "name", "db-replica"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, property.New(network.ID), resp.Return.Get("networkId"))
+	_, ok := resp.Return.GetOk("networkID")
+	assert.False(t, ok)
+	assert.Equal(t, property.New("db.internal"), resp.Return.Get("address"))
+
+	_, err = server.Invoke(p.InvokeRequest{
+		Token: "netbird:function:lookupNetworkResource",
+		Args:  props("networkId", network.ID, "address", "db.internal"),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is ambiguous: 2 matches")
+
+	_, err = server.Invoke(p.InvokeRequest{
+		Token: "netbird:function:lookupNetworkResource",
+		Args:  props("networkId", network.ID),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "one of name or address must be set")
+}
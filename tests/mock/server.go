@@ -2,28 +2,235 @@
 package mock
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"path"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 )
 
+// AccountID and CurrentUserID identify the principal behind every mock token.
+const (
+	AccountID     = "account-1"
+	CurrentUserID = "user-current"
+)
+
+// TokenCreatedAt is the creation time the mock reports for every personal
+// access token; expiration dates count from it.
+var TokenCreatedAt = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC) //nolint:gochecknoglobals
+
 // Server implements the NetBird REST endpoints exercised by the provider tests.
 type Server struct {
-	mu     sync.Mutex
-	nextID int
-	items  map[string]map[string]map[string]any
+	mu         sync.Mutex
+	nextID     int
+	items      map[string]map[string]map[string]any
+	validToken string
+	delay      time.Duration
+	resends    map[string]int
 }
 
 // NewServer creates a mock NetBird management API.
 func NewServer() *Server {
-	return &Server{items: map[string]map[string]map[string]any{}}
+	s := &Server{items: map[string]map[string]map[string]any{}, resends: map[string]int{}}
+	s.store("accounts")[AccountID] = map[string]any{"id": AccountID, "domain": "example.com"}
+
+	return s
+}
+
+// RequireToken makes the mock reject every bearer token except the given one with 401.
+func (s *Server) RequireToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.validToken = token
+}
+
+// SetVersion makes GET /api/instance/version report the given management
+// server version. Without it the endpoint returns 404, like older servers.
+func (s *Server) SetVersion(version string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store("instance")["version"] = map[string]any{"management_current_version": version}
+}
+
+// SetDelay makes every subsequent request hang for d before it is handled,
+// simulating an unresponsive management server. Requests whose client gives
+// up first are dropped without being applied.
+func (s *Server) SetDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delay = d
+}
+
+// AddPeer seeds a peer, since peers cannot be created through the API.
+func (s *Server) AddPeer(id, hostname string, approvalRequired bool, groups ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store("peers")[id] = map[string]any{
+		"id":                id,
+		"name":              hostname,
+		"hostname":          hostname,
+		"os":                "linux",
+		"connection_ip":     "203.0.113.10",
+		"approval_required": approvalRequired,
+		"groups":            groupMinimums(toAny(groups)),
+		"last_seen":         "2026-01-01T00:00:00Z",
+	}
+}
+
+// SetAccessiblePeers makes GET /api/peers/{peerID}/accessible-peers return
+// the given seeded peers, standing in for the server's policy evaluation.
+func (s *Server) SetAccessiblePeers(peerID string, accessible ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	store := s.store("peers/" + peerID + "/accessible-peers")
+	for _, id := range accessible {
+		store[id] = s.store("peers")[id]
+	}
+}
+
+// RemovePeer deletes a seeded peer, as if it had been removed outside Pulumi.
+func (s *Server) RemovePeer(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.store("peers"), id)
+}
+
+// EnrollPeer seeds a peer as if it had joined with the given setup key: the
+// peer appears, the key's usage counters move, and the audit log records a
+// "peer added with setup key" event at the given time.
+func (s *Server) EnrollPeer(setupKeyID, peerID, hostname string, at time.Time) {
+	s.AddPeer(peerID, hostname, false)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.store("setup-keys")[setupKeyID]; ok {
+		usedTimes, _ := key["used_times"].(int)
+		key["used_times"] = usedTimes + 1
+		key["last_used"] = at.UTC().Format(time.RFC3339)
+	}
+
+	s.nextID++
+	id := fmt.Sprintf("event-%d", s.nextID)
+	s.store("events/audit")[id] = map[string]any{
+		"id":            id,
+		"activity":      "Peer added with setup key",
+		"activity_code": "peer.setupkey.add",
+		"initiator_id":  setupKeyID,
+		"target_id":     peerID,
+		"meta":          map[string]any{"name": hostname},
+		"timestamp":     at.UTC().Format(time.RFC3339),
+	}
+}
+
+// AddIngressPeer seeds an enabled ingress peer. Port allocations are assigned
+// to the first seeded ingress peer by ID, regardless of region.
+func (s *Server) AddIngressPeer(id, region, ingressIP string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store("ingress/peers")[id] = map[string]any{
+		"id":              id,
+		"peer_id":         "peer-" + id,
+		"region":          region,
+		"ingress_ip":      ingressIP,
+		"enabled":         true,
+		"fallback":        false,
+		"connected":       true,
+		"available_ports": map[string]any{"tcp": 1000, "udp": 1000},
+	}
+}
+
+// AddUser seeds a user with the given status (active, blocked, or invited)
+// and auto-groups.
+func (s *Server) AddUser(id, email, role, status string, autoGroups ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store("users")[id] = map[string]any{
+		"id":              id,
+		"email":           email,
+		"name":            email,
+		"role":            role,
+		"status":          status,
+		"auto_groups":     toAny(autoGroups),
+		"is_blocked":      status == "blocked",
+		"is_current":      false,
+		"is_service_user": false,
+	}
+}
+
+// UserAutoGroups returns the auto-groups of userID.
+func (s *Server) UserAutoGroups(userID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var groups []string
+	for _, group := range slice(s.store("users")[userID]["auto_groups"]) {
+		if group, ok := group.(string); ok {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// AcceptInvite simulates the invitee accepting the embedded IdP invite sent
+// to email: the invite disappears and an active user takes its place.
+func (s *Server) AcceptInvite(email string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, invite := range s.store("invites") {
+		if invite["email"] != email {
+			continue
+		}
+
+		delete(s.store("invites"), id)
+
+		s.nextID++
+		userID := fmt.Sprintf("users-%d", s.nextID)
+		s.store("users")[userID] = map[string]any{
+			"id":              userID,
+			"email":           email,
+			"name":            invite["name"],
+			"role":            invite["role"],
+			"status":          "active",
+			"auto_groups":     invite["auto_groups"],
+			"is_blocked":      false,
+			"is_current":      false,
+			"is_service_user": false,
+		}
+	}
+}
+
+// InvitesSent reports how often the IdP invite of userID was re-sent.
+func (s *Server) InvitesSent(userID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.resends[userID]
 }
 
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.wait(r) {
+		return
+	}
+
 	auth := r.Header.Get("Authorization")
-	if !strings.HasPrefix(auth, "Bearer ") || strings.TrimSpace(strings.TrimPrefix(auth, "Bearer ")) == "" {
+	token := strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	if !strings.HasPrefix(auth, "Bearer ") || token == "" || !s.acceptsToken(token) {
 		writeError(w, http.StatusUnauthorized, "unauthorized")
 
 		return
@@ -36,6 +243,21 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if len(parts) >= 3 && parts[1] == "users" && s.serveInvites(w, r, parts[2:]) {
+		return
+	}
+
+	// Nested collections such as /api/networks/{id}/resources are stored
+	// under their full path, e.g. "networks/{id}/resources".
+	switch {
+	case len(parts) >= 5 && parts[1] == "peers" && parts[3] == "ingress":
+		parts = append([]string{parts[0], strings.Join(parts[1:5], "/")}, parts[5:]...)
+	case len(parts) >= 4:
+		parts = append([]string{parts[0], strings.Join(parts[1:4], "/")}, parts[4:]...)
+	case len(parts) == 3 && (parts[1] == "events" || parts[1] == "ingress"):
+		parts = []string{parts[0], parts[1] + "/" + parts[2]}
+	}
+
 	switch r.Method {
 	case http.MethodPost:
 		if len(parts) == 2 {
@@ -44,6 +266,18 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	case http.MethodGet:
+		if len(parts) == 2 {
+			s.list(w, parts[1])
+
+			return
+		}
+
+		if len(parts) == 3 && parts[1] == "users" && parts[2] == "current" {
+			writeJSON(w, http.StatusOK, currentUser())
+
+			return
+		}
+
 		if len(parts) == 3 {
 			s.get(w, parts[1], parts[2])
 
@@ -66,6 +300,105 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	writeError(w, http.StatusNotFound, "not found")
 }
 
+// serveInvites handles the user invite endpoints below /api/users and reports
+// whether the request was one of them.
+func (s *Server) serveInvites(w http.ResponseWriter, r *http.Request, path []string) bool {
+	switch {
+	case len(path) == 2 && path[1] == "invite" && r.Method == http.MethodPost:
+		s.mu.Lock()
+		_, ok := s.store("users")[path[0]]
+		if ok {
+			s.resends[path[0]]++
+		}
+		s.mu.Unlock()
+
+		if !ok {
+			writeError(w, http.StatusNotFound, "not found")
+
+			return true
+		}
+
+		w.WriteHeader(http.StatusOK)
+	case path[0] != "invites":
+		return false
+	case len(path) == 1 && r.Method == http.MethodGet:
+		s.list(w, "invites")
+	case len(path) == 1 && r.Method == http.MethodPost:
+		s.createInvite(w, r)
+	case len(path) == 2 && r.Method == http.MethodDelete:
+		s.delete(w, "invites", path[1])
+	case len(path) == 3 && path[2] == "regenerate" && r.Method == http.MethodPost:
+		s.regenerateInvite(w, r, path[1])
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+
+	return true
+}
+
+func (s *Server) createInvite(w http.ResponseWriter, r *http.Request) {
+	data, ok := readJSON(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	id := fmt.Sprintf("invites-%d", s.nextID)
+	now := time.Now().UTC()
+	expiresIn, _ := data["expires_in"].(float64)
+
+	invite := map[string]any{
+		"id":          id,
+		"email":       data["email"],
+		"name":        data["name"],
+		"role":        data["role"],
+		"auto_groups": data["auto_groups"],
+		"created_at":  now.Format(time.RFC3339),
+		"expires_at":  now.Add(time.Duration(expiresIn) * time.Second).Format(time.RFC3339),
+		"expired":     false,
+	}
+	s.store("invites")[id] = invite
+
+	// The token is only returned once and never listed.
+	response := map[string]any{"invite_token": fmt.Sprintf("token-%d", s.nextID)}
+	for k, v := range invite {
+		response[k] = v
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) regenerateInvite(w http.ResponseWriter, r *http.Request, id string) {
+	data, ok := readJSON(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invite, ok := s.store("invites")[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+
+		return
+	}
+
+	s.nextID++
+	expiresIn, _ := data["expires_in"].(float64)
+	expiresAt := time.Now().UTC().Add(time.Duration(expiresIn) * time.Second).Format(time.RFC3339)
+	invite["expires_at"] = expiresAt
+	invite["expired"] = false
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"invite_token":      fmt.Sprintf("token-%d", s.nextID),
+		"invite_expires_at": expiresAt,
+	})
+}
+
 func (s *Server) create(w http.ResponseWriter, resource string, r *http.Request) {
 	data, ok := readJSON(w, r)
 	if !ok {
@@ -76,14 +409,45 @@ func (s *Server) create(w http.ResponseWriter, resource string, r *http.Request)
 	defer s.mu.Unlock()
 
 	s.nextID++
-	id := fmt.Sprintf("%s-%d", resource, s.nextID)
+	id := fmt.Sprintf("%s-%d", path.Base(resource), s.nextID)
 	data["id"] = id
 	data = apiShape(resource, data, s.nextID)
 
+	if path.Base(resource) == "ports" {
+		s.assignIngressPeer(data)
+	}
+
 	s.store(resource)[id] = data
+
+	// Tokens are returned once, wrapped together with their plaintext value.
+	if path.Base(resource) == "tokens" {
+		writeJSON(w, http.StatusOK, map[string]any{"plain_token": fmt.Sprintf("nbp_mock%d", s.nextID), "personal_access_token": data})
+
+		return
+	}
+
 	writeJSON(w, http.StatusOK, data)
 }
 
+func (s *Server) list(w http.ResponseWriter, resource string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.store(resource)))
+	for id := range s.store(resource) {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	out := make([]any, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, s.store(resource)[id])
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
 func (s *Server) get(w http.ResponseWriter, resource, id string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -114,8 +478,35 @@ func (s *Server) update(w http.ResponseWriter, resource, id string, r *http.Requ
 	}
 
 	data["id"] = id
+
+	// Peers, setup keys and users carry server-side fields (hostname, key,
+	// usage, email, ...) that the update request does not include.
+	if resource == "peers" || resource == "setup-keys" || resource == "users" {
+		merged := s.store(resource)[id]
+		for k, v := range data {
+			merged[k] = v
+		}
+
+		data = merged
+	}
+
 	data = apiShape(resource, data, s.nextID)
+
+	if path.Base(resource) == "ports" {
+		data["ingress_peer_id"] = s.store(resource)[id]["ingress_peer_id"]
+		data["ingress_ip"] = s.store(resource)[id]["ingress_ip"]
+		data["region"] = s.store(resource)[id]["region"]
+	}
+
 	s.store(resource)[id] = data
+
+	// Tokens are returned once, wrapped together with their plaintext value.
+	if path.Base(resource) == "tokens" {
+		writeJSON(w, http.StatusOK, map[string]any{"plain_token": fmt.Sprintf("nbp_mock%d", s.nextID), "personal_access_token": data})
+
+		return
+	}
+
 	writeJSON(w, http.StatusOK, data)
 }
 
@@ -127,6 +518,53 @@ func (s *Server) delete(w http.ResponseWriter, resource, id string) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func (s *Server) wait(r *http.Request) bool {
+	s.mu.Lock()
+	delay := s.delay
+	s.mu.Unlock()
+
+	if delay == 0 {
+		return true
+	}
+
+	// The server only notices a client hanging up once the request body has
+	// been consumed, so buffer it before waiting.
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return false
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	select {
+	case <-time.After(delay):
+		return true
+	case <-r.Context().Done():
+		return false
+	}
+}
+
+func (s *Server) acceptsToken(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.validToken == "" || s.validToken == token
+}
+
+func currentUser() map[string]any {
+	return map[string]any{
+		"id":              CurrentUserID,
+		"email":           "automation@example.com",
+		"name":            "automation",
+		"role":            "admin",
+		"status":          "active",
+		"auto_groups":     []any{},
+		"is_blocked":      false,
+		"is_current":      true,
+		"is_service_user": true,
+	}
+}
+
 func (s *Server) store(resource string) map[string]map[string]any {
 	if s.items[resource] == nil {
 		s.items[resource] = map[string]map[string]any{}
@@ -136,7 +574,7 @@ func (s *Server) store(resource string) map[string]map[string]any {
 }
 
 func apiShape(resource string, data map[string]any, seq int) map[string]any {
-	switch resource {
+	switch path.Base(resource) {
 	case "groups":
 		defaultValue(data, "peers", []any{})
 		defaultValue(data, "resources", []any{})
@@ -154,10 +592,42 @@ func apiShape(resource string, data map[string]any, seq int) map[string]any {
 			rule["destinations"] = groupMinimums(rule["destinations"])
 		}
 		defaultValue(data, "source_posture_checks", []any{})
+	case "resources":
+		data["groups"] = groupMinimums(data["groups"])
 	case "routes":
 		defaultValue(data, "groups", []any{})
 		defaultValue(data, "peer_groups", []any{})
 		defaultValue(data, "network_type", "range")
+	case "tokens":
+		// Tokens are created at a fixed instant so tests can place their clock.
+		expiresIn, _ := data["expires_in"].(float64)
+		delete(data, "expires_in")
+		defaultValue(data, "created_at", TokenCreatedAt.Format(time.RFC3339))
+		defaultValue(data, "created_by", CurrentUserID)
+		defaultValue(data, "expiration_date", TokenCreatedAt.AddDate(0, 0, int(expiresIn)).Format(time.RFC3339))
+	case "ports":
+		// Ingress ports are assigned from 40000 on, one block per allocation.
+		var mappings []any
+		for _, portRange := range slice(data["port_ranges"]) {
+			portRange, ok := portRange.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			start, _ := portRange["start"].(float64)
+			end, _ := portRange["end"].(float64)
+			ingressStart := 40000 + 100*seq
+			mappings = append(mappings, map[string]any{
+				"protocol":         portRange["protocol"],
+				"translated_start": start,
+				"translated_end":   end,
+				"ingress_start":    ingressStart,
+				"ingress_end":      ingressStart + int(end-start),
+			})
+		}
+
+		delete(data, "port_ranges")
+		data["port_range_mappings"] = mappings
 	case "setup-keys":
 		defaultValue(data, "key", fmt.Sprintf("mock-%s", data["id"]))
 		defaultValue(data, "state", "valid")
@@ -169,11 +639,33 @@ func apiShape(resource string, data map[string]any, seq int) map[string]any {
 		defaultValue(data, "updated_at", "2024-01-01T00:00:00Z")
 		defaultValue(data, "ephemeral", false)
 		defaultValue(data, "allow_extra_dns_labels", false)
+
+		if data["revoked"] == true {
+			data["state"] = "revoked"
+			data["valid"] = false
+		}
 	}
 
 	return data
 }
 
+// assignIngressPeer places a new port allocation on the first ingress peer.
+func (s *Server) assignIngressPeer(data map[string]any) {
+	ids := make([]string, 0, len(s.store("ingress/peers")))
+	for id := range s.store("ingress/peers") {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	data["ingress_peer_id"], data["ingress_ip"], data["region"] = "ingress-default", "198.51.100.1", "default"
+
+	if len(ids) > 0 {
+		peer := s.store("ingress/peers")[ids[0]]
+		data["ingress_peer_id"], data["ingress_ip"], data["region"] = peer["id"], peer["ingress_ip"], peer["region"]
+	}
+}
+
 func defaultValue(data map[string]any, key string, value any) {
 	if _, ok := data[key]; !ok {
 		data[key] = value
@@ -199,6 +691,15 @@ func groupMinimums(v any) []any {
 	return out
 }
 
+func toAny(values []string) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+
+	return out
+}
+
 func slice(v any) []any {
 	if v, ok := v.([]any); ok {
 		return v
@@ -0,0 +1,158 @@
+package tests_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pendingPeerIDs(t *testing.T, server integration.Server, args property.Map) []string {
+	t.Helper()
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getPendingPeers", Args: args})
+	require.NoError(t, err)
+	require.Empty(t, resp.Failures)
+
+	var ids []string
+	for _, peer := range resp.Return.Get("peers").AsArray().AsSlice() {
+		ids = append(ids, peer.AsMap().Get("id").AsString())
+	}
+
+	return ids
+}
+
+func approvedPeerIDs(state property.Map) []string {
+	var ids []string
+	for _, record := range state.Get("approved").AsArray().AsSlice() {
+		ids = append(ids, record.AsMap().Get("peerId").AsString())
+	}
+
+	return ids
+}
+
+func TestGetPendingPeers(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddPeer("peer-a", "laptop-a", true, "group-1")
+	backend.AddPeer("peer-b", "laptop-b", true, "group-2")
+	backend.AddPeer("peer-c", "laptop-c", false, "group-1")
+	server := newProviderServer(t, serveMock(t, backend))
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getPendingPeers", Args: property.Map{}})
+	require.NoError(t, err)
+
+	peers := resp.Return.Get("peers").AsArray().AsSlice()
+	require.Len(t, peers, 2)
+	assert.Equal(t, property.New("laptop-a"), peers[0].AsMap().Get("hostname"))
+	assert.Equal(t, property.New("linux"), peers[0].AsMap().Get("os"))
+	assert.Equal(t, property.New("203.0.113.10"), peers[0].AsMap().Get("connectionIp"))
+
+	assert.Equal(t, []string{"peer-b"}, pendingPeerIDs(t, server, props("groupId", "group-2")))
+}
+
+func TestPeerApprovalLifecycle(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddPeer("peer-a", "laptop-a", true, "group-1")
+	backend.AddPeer("peer-b", "laptop-b", true, "group-2")
+	backend.AddPeer("peer-c", "laptop-c", false, "group-1")
+	server := newProviderServer(t, serveMock(t, backend))
+
+	urn := testURN("PeerApproval")
+	inputs := props("groupId", "group-1", "revokeOnDelete", true)
+
+	// Peer approval is cloud-only, so Check rejects it against the self-hosted
+	// mock; Create is driven directly to exercise the API calls.
+	check, err := server.Check(p.CheckRequest{Urn: urn, Inputs: inputs})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Equal(t, "groupId", check.Failures[0].Property)
+	assert.Contains(t, check.Failures[0].Reason, "only available on NetBird Cloud")
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "group/group-1", created.ID)
+	assert.Equal(t, []string{"peer-a"}, approvedPeerIDs(created.Properties))
+	assert.Equal(t, []string{"peer-b"}, pendingPeerIDs(t, server, property.Map{}))
+
+	// A new peer joins the group; refresh notices it and the update approves it.
+	backend.AddPeer("peer-d", "laptop-d", true, "group-1")
+
+	refreshed := read(t, server, urn, created.ID, created.Properties, inputs)
+	assert.Equal(t, stringArray("peer-d"), refreshed.Properties.Get("pending"))
+	require.True(t, diff(t, server, urn, created.ID, refreshed.Properties, inputs, inputs).HasChanges)
+
+	updated := update(t, server, urn, created.ID, refreshed.Properties, inputs, inputs)
+	assert.Equal(t, []string{"peer-a", "peer-d"}, approvedPeerIDs(updated.Properties))
+	assert.Equal(t, []string{"peer-b"}, pendingPeerIDs(t, server, property.Map{}))
+
+	deleteResource(t, server, urn, created.ID, updated.Properties)
+	assert.Equal(t, []string{"peer-a", "peer-b", "peer-d"}, pendingPeerIDs(t, server, property.Map{}))
+}
+
+func TestPeerApprovalNameRegex(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddPeer("peer-a", "ci-runner-1", true, "group-1")
+	backend.AddPeer("peer-b", "laptop-b", true, "group-1")
+	backend.AddPeer("peer-c", "ci-runner-2", true, "group-2")
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := newProviderServerWithClock(t, serveMock(t, backend), func() time.Time { return now })
+
+	urn := testURN("PeerApproval")
+	inputs := props("groupId", "group-1", "nameRegex", "^ci-")
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "match/group-1/^ci-", created.ID)
+	assert.Equal(t, []string{"peer-a"}, approvedPeerIDs(created.Properties))
+	assert.Equal(t, []string{"peer-b", "peer-c"}, pendingPeerIDs(t, server, property.Map{}))
+
+	record := created.Properties.Get("approved").AsArray().Get(0).AsMap()
+	assert.Equal(t, "2026-03-01T12:00:00Z", record.Get("approvedAt").AsString())
+
+	// Without groupId, nameRegex alone selects across groups.
+	inputs = props("nameRegex", "^ci-")
+
+	created, err = server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"peer-c"}, approvedPeerIDs(created.Properties))
+	assert.Equal(t, []string{"peer-b"}, pendingPeerIDs(t, server, property.Map{}))
+}
+
+func TestPeerApprovalCheck(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	check, err := server.Check(p.CheckRequest{Urn: testURN("PeerApproval"), Inputs: props("peerId", "peer-a", "groupId", "group-1")})
+	require.NoError(t, err)
+	require.NotEmpty(t, check.Failures)
+	assert.Equal(t, "groupId", check.Failures[0].Property)
+
+	check, err = server.Check(p.CheckRequest{Urn: testURN("PeerApproval"), Inputs: props("peerId", "peer-a", "nameRegex", "^ci-")})
+	require.NoError(t, err)
+	require.NotEmpty(t, check.Failures)
+	assert.Equal(t, "nameRegex", check.Failures[0].Property)
+
+	check, err = server.Check(p.CheckRequest{Urn: testURN("PeerApproval"), Inputs: props("nameRegex", "(")})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 2)
+	assert.Equal(t, "nameRegex", check.Failures[0].Property)
+	assert.Contains(t, check.Failures[0].Reason, "invalid nameRegex")
+
+	check, err = server.Check(p.CheckRequest{Urn: testURN("PeerApproval"), Inputs: props("peerId", "peer-a")})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Contains(t, check.Failures[0].Reason, "only available on NetBird Cloud")
+}
@@ -0,0 +1,148 @@
+package tests_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testClock is a settable time source for the provider.
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+const day = 24 * 60 * 60
+
+// setupKeyExpiry is the expiry the mock reports for every setup key.
+var setupKeyExpiry = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC) //nolint:gochecknoglobals
+
+func TestSetupKeyRotateBefore(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: setupKeyExpiry.AddDate(0, 0, -30)}
+	server := newProviderServerWithClock(t, startMockServer(t), clock.Now)
+	urn := testURN("SetupKey")
+	inputs := setupKeyInputs().Set("expiresIn", property.New(float64(60*day))).Set("rotateBefore", property.New(float64(7*day)))
+
+	created := create(t, server, urn, inputs)
+	assert.Equal(t, property.New(float64(30*day)), created.Properties.Get("remainingValidity"))
+	assertNoDiff(t, server, urn, created.ID, created.Properties, inputs)
+
+	clock.Set(setupKeyExpiry.AddDate(0, 0, -6))
+
+	refreshed := read(t, server, urn, created.ID, created.Properties, inputs)
+	assert.Equal(t, property.New(float64(6*day)), refreshed.Properties.Get("remainingValidity"))
+
+	resp := diff(t, server, urn, created.ID, refreshed.Properties, inputs, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["expires"].Kind)
+	assert.False(t, resp.DeleteBeforeReplace)
+}
+
+func TestSetupKeyRotationInterval(t *testing.T) {
+	t.Parallel()
+
+	start := setupKeyExpiry.AddDate(0, 0, -60)
+	clock := &testClock{now: start}
+	server := newProviderServerWithClock(t, startMockServer(t), clock.Now)
+	urn := testURN("SetupKey")
+	inputs := setupKeyInputs().Set("rotationInterval", property.New(float64(10*day)))
+
+	created := create(t, server, urn, inputs)
+	assert.Equal(t, property.New(start.Format(time.RFC3339)), created.Properties.Get("createdAt"))
+
+	clock.Set(start.AddDate(0, 0, 9))
+	assertNoDiff(t, server, urn, created.ID, created.Properties, inputs)
+
+	clock.Set(start.AddDate(0, 0, 10))
+	resp := diff(t, server, urn, created.ID, created.Properties, inputs, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["expires"].Kind)
+
+	// Changing the rotation settings alone updates the key in place.
+	longer := inputs.Set("rotationInterval", property.New(float64(30*day)))
+	resp = diff(t, server, urn, created.ID, created.Properties, longer, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.Update, resp.DetailedDiff["rotationInterval"].Kind)
+	assert.NotContains(t, resp.DetailedDiff, "expires")
+}
+
+func TestTokenRotation(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: mock.TokenCreatedAt}
+	server := newProviderServerWithClock(t, startMockServer(t), clock.Now)
+	urn := testURN("Token")
+	inputs := props("userId", "user-ci", "name", "ci", "expiresIn", 30.0, "rotateBefore", 5.0, "rotationInterval", 20.0)
+
+	created := create(t, server, urn, inputs)
+	assert.NotEmpty(t, created.Properties.Get("token").AsString())
+	assert.Equal(t, property.New(float64(30*day)), created.Properties.Get("remainingValidity"))
+	assertNoDiff(t, server, urn, created.ID, created.Properties, inputs)
+
+	// The interval is reached first.
+	clock.Set(mock.TokenCreatedAt.AddDate(0, 0, 20))
+
+	resp := diff(t, server, urn, created.ID, created.Properties, inputs, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["expirationDate"].Kind)
+	assert.False(t, resp.DeleteBeforeReplace)
+
+	// Without an interval, rotation waits until the token expires within rotateBefore.
+	noInterval := inputs.Delete("rotationInterval")
+	updated := update(t, server, urn, created.ID, created.Properties, noInterval, inputs)
+	assert.Equal(t, property.Value{}, updated.Properties.Get("rotationInterval"))
+	assertNoDiff(t, server, urn, created.ID, updated.Properties, noInterval)
+
+	clock.Set(mock.TokenCreatedAt.AddDate(0, 0, 25))
+
+	refreshed := read(t, server, urn, created.ID, updated.Properties, noInterval)
+	assert.Equal(t, property.New(float64(5*day)), refreshed.Properties.Get("remainingValidity"))
+
+	resp = diff(t, server, urn, created.ID, refreshed.Properties, noInterval, noInterval)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.UpdateReplace, resp.DetailedDiff["expirationDate"].Kind)
+}
+
+func TestRotationCheck(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	check, err := server.Check(p.CheckRequest{
+		Urn:    testURN("Token"),
+		Inputs: props("userId", "user-ci", "name", "ci", "expiresIn", 30.0, "rotateBefore", 30.0),
+	})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Equal(t, "rotateBefore", check.Failures[0].Property)
+
+	check, err = server.Check(p.CheckRequest{
+		Urn:    testURN("SetupKey"),
+		Inputs: setupKeyInputs().Set("rotateBefore", property.New(float64(day))),
+	})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Contains(t, check.Failures[0].Reason, "expiresIn > 0")
+}
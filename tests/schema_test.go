@@ -0,0 +1,21 @@
+package tests_test
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetSchema guards against types the schema generator rejects, such as a
+// top-level "id" output on a function.
+func TestGetSchema(t *testing.T) {
+	t.Parallel()
+
+	server := newUnconfiguredServer(t)
+
+	resp, err := server.GetSchema(p.GetSchemaRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Schema, `"netbird:function:lookupPolicy"`)
+}
@@ -0,0 +1,122 @@
+package tests_test
+
+import (
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetServerInfo(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.SetVersion("v0.64.2")
+	server := newProviderServer(t, serveMock(t, backend))
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: "netbird:function:getServerInfo",
+		Args:  property.Map{},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Failures)
+
+	assert.Equal(t, property.New("0.64.2"), resp.Return.Get("version"))
+	assert.Equal(t, property.New("self-hosted"), resp.Return.Get("edition"))
+}
+
+func TestCheckRejectsFeatureNewerThanServer(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.SetVersion("0.60.0")
+	server := newProviderServer(t, serveMock(t, backend))
+
+	check, err := server.Check(p.CheckRequest{
+		Urn: testURN("DNSZone"),
+		Inputs: props(
+			"name", "corp",
+			"domain", "corp.example.com",
+			"enabled", true,
+			"enableSearchDomain", false,
+			"distributionGroups", stringArray("group-1"),
+		),
+	})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Equal(t, "domain", check.Failures[0].Property)
+	assert.Contains(t, check.Failures[0].Reason, "requires NetBird management server v0.63.0 or newer")
+}
+
+func TestCheckRejectsFeatureOnServerWithoutVersionEndpoint(t *testing.T) {
+	t.Parallel()
+
+	// The mock answers the version endpoint with 404, like servers that predate it.
+	server := newProviderServer(t, startMockServer(t))
+
+	check, err := server.Check(p.CheckRequest{
+		Urn: testURN("DNSZone"),
+		Inputs: props(
+			"name", "corp",
+			"domain", "corp.example.com",
+			"enabled", true,
+			"enableSearchDomain", false,
+			"distributionGroups", stringArray("group-1"),
+		),
+	})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Contains(t, check.Failures[0].Reason, "requires NetBird management server v0.63.0 or newer")
+}
+
+func TestCheckSkipsGatingForUnknownVersion(t *testing.T) {
+	t.Parallel()
+
+	// Development builds report a version that is not semver.
+	backend := mock.NewServer()
+	backend.SetVersion("development")
+	server := newProviderServer(t, serveMock(t, backend))
+
+	check, err := server.Check(p.CheckRequest{
+		Urn: testURN("DNSZone"),
+		Inputs: props(
+			"name", "corp",
+			"domain", "corp.example.com",
+			"enabled", true,
+			"enableSearchDomain", false,
+			"distributionGroups", stringArray("group-1"),
+		),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, check.Failures)
+}
+
+func TestEditionOverridesURL(t *testing.T) {
+	t.Parallel()
+
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs(startMockServer(t), "test-token", "edition", "cloud")))
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getServerInfo", Args: property.Map{}})
+	require.NoError(t, err)
+	assert.Equal(t, property.New("cloud"), resp.Return.Get("edition"))
+
+	// Cloud-only features pass Check although the URL is not api.netbird.io.
+	check, err := server.Check(p.CheckRequest{
+		Urn:    testURN("PeerApproval"),
+		Inputs: props("groupId", "group-1"),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, check.Failures)
+}
+
+func TestConfigureRejectsUnknownEdition(t *testing.T) {
+	t.Parallel()
+
+	err := newUnconfiguredServer(t).Configure(configureArgs(startMockServer(t), "test-token", "edition", "enterprise"))
+	require.ErrorIs(t, err, config.ErrInvalidEdition)
+}
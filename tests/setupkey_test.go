@@ -2,9 +2,13 @@ package tests_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi/sdk/v3/go/property"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSetupKeyLifecycle(t *testing.T) {
@@ -26,6 +30,73 @@ func TestSetupKeyLifecycle(t *testing.T) {
 	deleteResource(t, server, urn, created.ID, created.Properties)
 }
 
+func TestSetupKeyRevoke(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+	urn := testURN("SetupKey")
+	inputs := setupKeyInputs()
+
+	created := create(t, server, urn, inputs)
+	assert.Equal(t, property.New(false), created.Properties.Get("revoked"))
+
+	revoked := inputs.Set("revoked", property.New(true))
+	resp := diff(t, server, urn, created.ID, created.Properties, revoked, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Equal(t, p.Update, resp.DetailedDiff["revoked"].Kind)
+
+	updated := update(t, server, urn, created.ID, created.Properties, revoked, inputs)
+	assert.Equal(t, property.New(true), updated.Properties.Get("revoked"))
+	assert.Equal(t, property.New("revoked"), updated.Properties.Get("state"))
+	assert.Equal(t, property.New(false), updated.Properties.Get("valid"))
+	assert.Equal(t, created.Properties.Get("key"), updated.Properties.Get("key"))
+	assertNoDiff(t, server, urn, created.ID, updated.Properties, revoked)
+
+	// Leaving revoked unset keeps the key revoked.
+	assertNoDiff(t, server, urn, created.ID, updated.Properties, inputs)
+
+	// A key can also be revoked from the start.
+	createdRevoked := create(t, server, urn, revoked)
+	assert.Equal(t, property.New("revoked"), createdRevoked.Properties.Get("state"))
+	assert.False(t, createdRevoked.Properties.Get("key").IsNull())
+}
+
+func TestSetupKeyUsage(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	server := newProviderServer(t, serveMock(t, backend))
+	urn := testURN("SetupKey")
+	inputs := setupKeyInputs()
+
+	created := create(t, server, urn, inputs)
+
+	enrolledAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	backend.EnrollPeer(created.ID, "peer-b", "build-2", enrolledAt.Add(time.Hour))
+	backend.EnrollPeer(created.ID, "peer-a", "build-1", enrolledAt)
+	backend.EnrollPeer("setup-keys-other", "peer-c", "other", enrolledAt)
+	backend.RemovePeer("peer-b")
+
+	refreshed := read(t, server, urn, created.ID, created.Properties, inputs)
+	assert.Equal(t, stringArray("peer-a"), refreshed.Properties.Get("enrolledPeers"))
+	assert.Equal(t, property.New(float64(2)), refreshed.Properties.Get("usedTimes"))
+
+	usage, err := server.Invoke(p.InvokeRequest{
+		Token: "netbird:function:getSetupKeyUsage",
+		Args:  props("setupKeyId", created.ID),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, usage.Return.Get("setupKeyId").AsString())
+	assert.Equal(t, float64(2), usage.Return.Get("usedTimes").AsNumber())
+	assert.Equal(t, []string{"peer-a", "peer-b"}, itemValues(usage.Return.Get("peers"), "peerId"))
+	assert.Equal(t, []string{"build-1", "build-2"}, itemValues(usage.Return.Get("peers"), "name"))
+	assert.Equal(t, enrolledAt.Format(time.RFC3339), itemValues(usage.Return.Get("peers"), "enrolledAt")[0])
+
+	peers := usage.Return.Get("peers").AsArray()
+	assert.True(t, peers.Get(0).AsMap().Get("exists").AsBool())
+	assert.False(t, peers.Get(1).AsMap().Get("exists").AsBool())
+}
+
 func setupKeyInputs() property.Map {
 	return props(
 		"name", "test-key",
@@ -0,0 +1,83 @@
+package tests_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTimeoutNamesAPICall(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs(serveMock(t, backend), "test-token", "createTimeout", "100ms")))
+
+	backend.SetDelay(5 * time.Second)
+
+	_, err := server.Create(p.CreateRequest{
+		Urn:        testURN("Group"),
+		Properties: groupInputs("slow-group"),
+		DryRun:     false,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "POST /api/groups timed out after 100ms during create")
+	assert.Contains(t, err.Error(), "outcome on the server is unknown")
+}
+
+func TestReadTimeoutReportsNoChanges(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs(serveMock(t, backend), "test-token", "readTimeout", "100ms")))
+
+	urn := testURN("Group")
+	inputs := groupInputs("slow-read")
+	created := create(t, server, urn, inputs)
+
+	backend.SetDelay(5 * time.Second)
+
+	_, err := server.Read(p.ReadRequest{
+		ID:         created.ID,
+		Urn:        urn,
+		Properties: created.Properties,
+		Inputs:     inputs,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "GET /api/groups/"+created.ID+" timed out")
+	assert.Contains(t, err.Error(), "no changes were made")
+}
+
+func TestCustomTimeoutOverridesProviderTimeout(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs(serveMock(t, backend), "test-token", "createTimeout", "1h")))
+
+	backend.SetDelay(10 * time.Second)
+
+	start := time.Now()
+	_, err := server.Create(p.CreateRequest{
+		Urn:        testURN("Group"),
+		Properties: groupInputs("custom-timeout"),
+		Timeout:    1,
+		DryRun:     false,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "POST /api/groups timed out")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestConfigureRejectsInvalidTimeout(t *testing.T) {
+	t.Parallel()
+
+	err := newUnconfiguredServer(t).Configure(configureArgs(startMockServer(t), "test-token", "deleteTimeout", "soon"))
+	require.ErrorIs(t, err, config.ErrInvalidOperationTimeout)
+}
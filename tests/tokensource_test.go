@@ -0,0 +1,87 @@
+package tests_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/provider/config"
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTokenFile(t *testing.T, path, token string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(token+"\n"), 0o600))
+}
+
+func TestConfigureTokenFile(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.RequireToken("file-token")
+
+	path := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, path, "file-token")
+
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs(serveMock(t, backend), "", "tokenFile", path)))
+
+	created := create(t, server, testURN("Group"), groupInputs("file-group"))
+	assert.Equal(t, property.New("file-group"), created.Properties.Get("name"))
+}
+
+func TestConfigureTokenCommand(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.RequireToken("command-token")
+
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs(serveMock(t, backend), "static-token",
+		"tokenCommand", "echo command-token")))
+
+	created := create(t, server, testURN("Group"), groupInputs("command-group"))
+	assert.Equal(t, property.New("command-group"), created.Properties.Get("name"))
+}
+
+func TestTokenCommandRerunsOnUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.RequireToken("first-token")
+
+	path := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, path, "first-token")
+
+	server := newUnconfiguredServer(t)
+	require.NoError(t, server.Configure(configureArgs(serveMock(t, backend), "",
+		"tokenCommand", "cat "+path, "tokenLifetime", "1h")))
+
+	// Rotate the token behind the provider's back; the cached token is now
+	// rejected and the command must be run again.
+	backend.RequireToken("second-token")
+	writeTokenFile(t, path, "second-token")
+
+	created := create(t, server, testURN("Group"), groupInputs("rotated-group"))
+	assert.Equal(t, property.New("rotated-group"), created.Properties.Get("name"))
+}
+
+func TestConfigureTokenCommandFailure(t *testing.T) {
+	t.Parallel()
+
+	err := newUnconfiguredServer(t).Configure(configureArgs(startMockServer(t), "",
+		"tokenCommand", "echo locked >&2; exit 3"))
+	require.ErrorIs(t, err, config.ErrTokenSource)
+	assert.Contains(t, err.Error(), "locked")
+}
+
+func TestConfigureRejectsConflictingTokenSources(t *testing.T) {
+	t.Parallel()
+
+	err := newUnconfiguredServer(t).Configure(configureArgs(startMockServer(t), "",
+		"tokenCommand", "echo token", "tokenFile", "/dev/null"))
+	require.ErrorIs(t, err, config.ErrConflictingTokenSources)
+}
@@ -0,0 +1,66 @@
+package tests_test
+
+import (
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserGroupMembershipLifecycle(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddUser("user-a", "a@example.com", "user", "active", "group-old")
+	server := newProviderServer(t, serveMock(t, backend))
+	urn := testURN("UserGroupMembership")
+
+	team := create(t, server, urn, props("userId", "user-a", "groupId", "group-team"))
+	assert.Equal(t, "user-a/group-team", team.ID)
+	ops := create(t, server, urn, props("userId", "user-a", "groupId", "group-ops"))
+
+	// The user keeps the auto-groups it had, and memberships add to each other.
+	assert.Equal(t, []string{"group-old", "group-team", "group-ops"}, backend.UserAutoGroups("user-a"))
+
+	refreshed := read(t, server, urn, team.ID, team.Properties, props("userId", "user-a", "groupId", "group-team"))
+	assert.Equal(t, team.ID, refreshed.ID)
+
+	deleteResource(t, server, urn, team.ID, team.Properties)
+	assert.Equal(t, []string{"group-old", "group-ops"}, backend.UserAutoGroups("user-a"))
+
+	// A group removed outside Pulumi reads as a deleted membership.
+	gone := read(t, server, urn, team.ID, team.Properties, props("userId", "user-a", "groupId", "group-team"))
+	assert.Empty(t, gone.ID)
+
+	deleteResource(t, server, urn, ops.ID, ops.Properties)
+	assert.Equal(t, []string{"group-old"}, backend.UserAutoGroups("user-a"))
+}
+
+func TestUserGroupMembershipUnknownUser(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	_, err := server.Create(p.CreateRequest{
+		Urn:        testURN("UserGroupMembership"),
+		Properties: props("userId", "user-missing", "groupId", "group-team"),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "user with ID user-missing not found")
+}
+
+func TestUserGroupMembershipCheck(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	resp, err := server.Check(p.CheckRequest{
+		Urn:    testURN("UserGroupMembership"),
+		Inputs: props("userId", " ", "groupId", "group-team"),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Failures, 1)
+	assert.Equal(t, "userId", resp.Failures[0].Property)
+}
@@ -0,0 +1,135 @@
+package tests_test
+
+import (
+	"testing"
+
+	"github.com/mbrav/pulumi-netbird/tests/mock"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func userIDs(t *testing.T, server integration.Server, args property.Map) []string {
+	t.Helper()
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: "netbird:function:getUsers", Args: args})
+	require.NoError(t, err)
+	require.Empty(t, resp.Failures)
+
+	var ids []string
+	for _, user := range resp.Return.Get("users").AsArray().AsSlice() {
+		ids = append(ids, user.AsMap().Get("id").AsString())
+	}
+
+	return ids
+}
+
+func TestGetUsers(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddUser("user-a", "a@example.com", "admin", "active")
+	backend.AddUser("user-b", "b@example.com", "user", "invited")
+	backend.AddUser("user-c", "c@example.com", "user", "blocked")
+	backend.AddUser("user-d", "d@example.com", "user", "invited")
+	server := newProviderServer(t, serveMock(t, backend))
+
+	assert.Equal(t, []string{"user-a", "user-b", "user-c", "user-d"}, userIDs(t, server, property.Map{}))
+	assert.Equal(t, []string{"user-b", "user-d"}, userIDs(t, server, props("status", "invited")))
+	assert.Equal(t, []string{"user-a"}, userIDs(t, server, props("role", "admin")))
+	assert.Equal(t, []string{"user-c"}, userIDs(t, server, props("status", "blocked", "role", "user")))
+}
+
+func TestUserInviteEmailLifecycle(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	server := newProviderServer(t, serveMock(t, backend))
+
+	urn := testURN("UserInvite")
+	inputs := props(
+		"email", "new@example.com",
+		"name", "New User",
+		"expiresIn", 3600.0,
+		"renewBefore", 600.0,
+		"exposeInviteToken", true,
+	)
+
+	created := create(t, server, urn, inputs)
+	assert.Equal(t, property.New("invited"), created.Properties.Get("status"))
+	token := created.Properties.Get("inviteToken")
+	assert.NotEmpty(t, token.AsString())
+
+	assertNoDiff(t, server, urn, created.ID, created.Properties, inputs)
+
+	// Once the expiry falls within renewBefore the invite is regenerated with a new token.
+	due := created.Properties.Set("expiresAt", property.New("2020-01-01T00:00:00Z"))
+	resp := diff(t, server, urn, created.ID, due, inputs, inputs)
+	require.True(t, resp.HasChanges)
+	assert.Contains(t, resp.DetailedDiff, "expiresAt")
+
+	renewed := update(t, server, urn, created.ID, due, inputs, inputs)
+	assert.NotEqual(t, token.AsString(), renewed.Properties.Get("inviteToken").AsString())
+	assert.NotEqual(t, "2020-01-01T00:00:00Z", renewed.Properties.Get("expiresAt").AsString())
+
+	// Accepting the invite makes the user active and stops renewals.
+	backend.AcceptInvite("new@example.com")
+
+	refreshed := read(t, server, urn, created.ID, renewed.Properties, inputs)
+	assert.Equal(t, property.New("active"), refreshed.Properties.Get("status"))
+	assertNoDiff(t, server, urn, created.ID, refreshed.Properties.Set("expiresAt", property.New("2020-01-01T00:00:00Z")), inputs)
+
+	deleteResource(t, server, urn, created.ID, refreshed.Properties)
+}
+
+func TestUserInviteResendsExistingUser(t *testing.T) {
+	t.Parallel()
+
+	backend := mock.NewServer()
+	backend.AddUser("user-b", "b@example.com", "user", "invited")
+	server := newProviderServer(t, serveMock(t, backend))
+
+	urn := testURN("UserInvite")
+	inputs := props("userId", "user-b", "expiresIn", 3600.0)
+
+	created := create(t, server, urn, inputs)
+	assert.Equal(t, "user-b", created.ID)
+	assert.Equal(t, 1, backend.InvitesSent("user-b"))
+	assert.Equal(t, property.Value{}, created.Properties.Get("inviteToken"))
+
+	expired := created.Properties.Set("expiresAt", property.New("2020-01-01T00:00:00Z"))
+	refreshed := read(t, server, urn, created.ID, expired, inputs)
+	assert.Equal(t, property.New(true), refreshed.Properties.Get("expired"))
+
+	update(t, server, urn, created.ID, refreshed.Properties, inputs, inputs)
+	assert.Equal(t, 2, backend.InvitesSent("user-b"))
+
+	deleteResource(t, server, urn, created.ID, refreshed.Properties)
+	assert.Equal(t, []string{"user-b"}, userIDs(t, server, props("status", "invited")))
+}
+
+func TestUserInviteCheck(t *testing.T) {
+	t.Parallel()
+
+	server := newProviderServer(t, startMockServer(t))
+
+	check, err := server.Check(p.CheckRequest{Urn: testURN("UserInvite"), Inputs: props("userId", "user-b", "email", "b@example.com")})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Equal(t, "email", check.Failures[0].Property)
+
+	check, err = server.Check(p.CheckRequest{Urn: testURN("UserInvite"), Inputs: props("email", "b@example.com")})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Equal(t, "name", check.Failures[0].Property)
+
+	check, err = server.Check(p.CheckRequest{
+		Urn:    testURN("UserInvite"),
+		Inputs: props("userId", "user-b", "expiresIn", 600.0, "renewBefore", 600.0),
+	})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Equal(t, "renewBefore", check.Failures[0].Property)
+}